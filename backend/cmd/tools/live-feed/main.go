@@ -0,0 +1,155 @@
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"log/slog"
+	"math/rand"
+	"os"
+	"time"
+
+	dbadapters "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	appbracket "github.com/andrewcopp/Calcutta/backend/internal/app/bracket"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/prediction"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/winprob"
+	"github.com/andrewcopp/Calcutta/backend/internal/platform"
+)
+
+// feedFile is a recorded or hand-written live feed replayed frame by frame.
+type feedFile struct {
+	TournamentID string      `json:"tournamentId"`
+	Frames       []feedFrame `json:"frames"`
+}
+
+type feedFrame struct {
+	GameID           string `json:"gameId"`
+	Team1Score       int    `json:"team1Score"`
+	Team2Score       int    `json:"team2Score"`
+	SecondsRemaining int    `json:"secondsRemaining"`
+	PossessionTeamID string `json:"possessionTeamId,omitempty"`
+}
+
+func main() {
+	platform.InitLogger()
+	if err := run(); err != nil {
+		slog.Error("cmd_failed", "error", err)
+		os.Exit(1)
+	}
+}
+
+func run() error {
+	in := flag.String("in", "", "JSON feed file to replay")
+	tournamentID := flag.String("tournament", "", "tournament ID (overrides the feed file's)")
+	mockGame := flag.String("mock-game", "", "simulate a random game for this bracket game ID instead of reading a file")
+	step := flag.Int("step", 60, "mock feed: game-clock seconds per frame")
+	seed := flag.Int64("seed", 1, "mock feed: random seed")
+	interval := flag.Duration("interval", 2*time.Second, "wall-clock delay between frames")
+	flag.Parse()
+
+	feed, err := loadFeed(*in, *tournamentID, *mockGame, *step, *seed)
+	if err != nil {
+		return err
+	}
+
+	cfg, err := platform.LoadConfigFromEnv()
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+	pool, err := platform.OpenPGXPool(ctx, cfg, nil)
+	if err != nil {
+		return fmt.Errorf("failed to connect to database (pgxpool): %w", err)
+	}
+	defer pool.Close()
+
+	gameResultRepo := dbadapters.NewGameResultRepository(pool)
+	bracketSvc := appbracket.New(dbadapters.NewTournamentRepository(pool), gameResultRepo, gameResultRepo)
+	predictionRepo := dbadapters.NewPredictionRepository(pool)
+	predictionSvc := prediction.New(prediction.Ports{Batches: predictionRepo, Tournament: predictionRepo})
+
+	for i, f := range feed.Frames {
+		if i > 0 {
+			time.Sleep(*interval)
+		}
+		if _, err := bracketSvc.UpdateLiveGame(ctx, feed.TournamentID, f.GameID, appbracket.LiveGameUpdate{
+			Team1Score:       f.Team1Score,
+			Team2Score:       f.Team2Score,
+			SecondsRemaining: f.SecondsRemaining,
+			PossessionTeamID: f.PossessionTeamID,
+			Source:           "live-feed",
+		}); err != nil {
+			return fmt.Errorf("frame %d: %w", i, err)
+		}
+
+		params := prediction.RunParams{TournamentID: feed.TournamentID, ProbabilitySourceKey: "kenpom"}
+		if _, err := predictionSvc.Run(ctx, params); err != nil {
+			slog.Warn("prediction_refresh_failed", "frame", i, "error", err)
+		}
+
+		games, err := predictionSvc.LiveGames(ctx, params)
+		if err != nil {
+			return fmt.Errorf("frame %d: %w", i, err)
+		}
+		for _, g := range games {
+			if g.State.GameID == f.GameID {
+				fmt.Printf("%s %3d-%-3d %4ds left  p(team1)=%.3f (pregame %.3f)\n",
+					f.GameID, f.Team1Score, f.Team2Score, f.SecondsRemaining, g.PTeam1Wins, g.PregamePTeam1Wins)
+			}
+		}
+	}
+	return nil
+}
+
+func loadFeed(path, tournamentID, mockGame string, step int, seed int64) (*feedFile, error) {
+	if mockGame != "" {
+		if tournamentID == "" {
+			return nil, errors.New("-tournament is required with -mock-game")
+		}
+		if step <= 0 {
+			return nil, errors.New("-step must be positive")
+		}
+		return mockFeed(tournamentID, mockGame, step, rand.New(rand.NewSource(seed))), nil
+	}
+	if path == "" {
+		return nil, errors.New("one of -in or -mock-game is required")
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read feed: %w", err)
+	}
+	var feed feedFile
+	if err := json.Unmarshal(b, &feed); err != nil {
+		return nil, fmt.Errorf("failed to parse feed: %w", err)
+	}
+	if tournamentID != "" {
+		feed.TournamentID = tournamentID
+	}
+	if feed.TournamentID == "" {
+		return nil, errors.New("feed has no tournamentId; pass -tournament")
+	}
+	return &feed, nil
+}
+
+// mockFeed plays out a game with random scoring, one frame per step seconds
+// of game clock.
+func mockFeed(tournamentID, gameID string, step int, rng *rand.Rand) *feedFile {
+	feed := &feedFile{TournamentID: tournamentID}
+	var score1, score2 int
+	for secs := winprob.RegulationSeconds; secs >= 0; secs -= step {
+		feed.Frames = append(feed.Frames, feedFrame{
+			GameID:           gameID,
+			Team1Score:       score1,
+			Team2Score:       score2,
+			SecondsRemaining: secs,
+		})
+		// About two points per team per minute of game clock.
+		score1 += rng.Intn(step/15 + 1)
+		score2 += rng.Intn(step/15 + 1)
+	}
+	return feed
+}
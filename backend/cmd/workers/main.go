@@ -9,9 +9,12 @@ import (
 	"os/signal"
 	"sync"
 	"syscall"
+	"time"
 
+	feedadapters "github.com/andrewcopp/Calcutta/backend/internal/adapters/scorefeed"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/workers"
 	"github.com/andrewcopp/Calcutta/backend/internal/platform"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
 )
 
 // version is set at build time via -ldflags.
@@ -32,9 +35,12 @@ func run() error {
 	runLabPipelineWorker := flag.Bool("lab-pipeline-worker", true, "Run the lab pipeline worker")
 	runCoreComputeWorker := flag.Bool("core-compute-worker", true, "Run the core compute worker (predictions)")
 	runSimulationWorker := flag.Bool("simulation-worker", true, "Run the simulation worker")
+	runScoreIngestionWorker := flag.Bool("score-ingestion-worker", true, "Run the score ingestion worker (requires SCORE_FEED_KIND)")
+	runRevealWorker := flag.Bool("reveal-worker", true, "Run the reveal worker (freezes market summaries)")
+	runAuctionWorker := flag.Bool("auction-worker", true, "Run the auction worker (closes expired lots)")
 	flag.Parse()
 
-	if !*runTournamentImportWorker && !*runLabPipelineWorker && !*runCoreComputeWorker && !*runSimulationWorker {
+	if !*runTournamentImportWorker && !*runLabPipelineWorker && !*runCoreComputeWorker && !*runSimulationWorker && !*runScoreIngestionWorker && !*runRevealWorker && !*runAuctionWorker {
 		flag.Usage()
 		return fmt.Errorf("no workers selected")
 	}
@@ -62,10 +68,22 @@ func run() error {
 		PythonBin:          cfg.PythonBin,
 		RunJobsMaxAttempts: cfg.RunJobsMaxAttempts,
 		WorkerID:           cfg.WorkerID,
+		EvaluationTargetSE: cfg.EvaluationTargetSE,
 	})
 	coreComputeWorker := workers.NewCoreComputeWorker(pool)
 	simulationWorker := workers.NewSimulationWorker(pool)
 
+	var scoreFeed ports.ScoreFeed
+	if cfg.ScoreFeedKind != "" {
+		scoreFeed, err = feedadapters.New(cfg.ScoreFeedKind, cfg.ScoreFeedSource, cfg.ScoreFeedLocation)
+		if err != nil {
+			return fmt.Errorf("score_feed_config_invalid: %w", err)
+		}
+	}
+	scoreIngestionWorker := workers.NewScoreIngestionWorker(pool, scoreFeed, time.Duration(cfg.ScoreFeedPollSeconds)*time.Second)
+	revealWorker := workers.NewRevealWorker(pool)
+	auctionWorker := workers.NewAuctionWorker(pool)
+
 	ctx, cancel := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer cancel()
 
@@ -99,6 +117,27 @@ func run() error {
 			simulationWorker.Run(ctx)
 		}()
 	}
+	if *runScoreIngestionWorker {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			scoreIngestionWorker.Run(ctx)
+		}()
+	}
+	if *runRevealWorker {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			revealWorker.Run(ctx)
+		}()
+	}
+	if *runAuctionWorker {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			auctionWorker.Run(ctx)
+		}()
+	}
 
 	<-ctx.Done()
 	wg.Wait()
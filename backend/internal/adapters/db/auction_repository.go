@@ -0,0 +1,381 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ ports.AuctionRepository = (*AuctionRepository)(nil)
+
+// AuctionRepository stores live auction sessions. Sold lots are written to
+// core.investments, so scoring and payouts treat them like any other
+// investment.
+type AuctionRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewAuctionRepository(pool *pgxpool.Pool) *AuctionRepository {
+	return &AuctionRepository{pool: pool}
+}
+
+func (r *AuctionRepository) CreateAuctionSession(ctx context.Context, session *models.AuctionSession) error {
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO core.auction_sessions (pool_id, nomination_order, lot_seconds, bid_extension_seconds, min_increment_credits, created_by)
+		VALUES ($1::uuid, $2::uuid[], $3, $4, $5, $6::uuid)
+		RETURNING id::text, status, nomination_index, created_at, updated_at
+	`, session.PoolID, session.NominationOrder, session.LotSeconds, session.BidExtensionSeconds, session.MinIncrementCredits, session.CreatedBy,
+	).Scan(&session.ID, &session.Status, &session.NominationIndex, &session.CreatedAt, &session.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &apperrors.AlreadyExistsError{Resource: "auction session", Field: "pool_id", Value: session.PoolID}
+		}
+		return fmt.Errorf("creating auction session: %w", err)
+	}
+	return nil
+}
+
+const auctionSessionSelect = `
+	SELECT id::text, pool_id::text, status, nomination_order::text[], nomination_index,
+		lot_seconds, bid_extension_seconds, min_increment_credits, created_by::text,
+		started_at, completed_at, created_at, updated_at
+	FROM core.auction_sessions
+`
+
+func (r *AuctionRepository) GetAuctionSessionByPool(ctx context.Context, poolID string) (*models.AuctionSession, error) {
+	s, err := scanAuctionSession(r.pool.QueryRow(ctx, auctionSessionSelect+`
+		WHERE pool_id = $1::uuid
+			AND deleted_at IS NULL
+	`, poolID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &apperrors.NotFoundError{Resource: "auction session", ID: poolID}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting auction session: %w", err)
+	}
+	return s, nil
+}
+
+func (r *AuctionRepository) HasAuctionSession(ctx context.Context, poolID string) (bool, error) {
+	var exists bool
+	if err := r.pool.QueryRow(ctx, `
+		SELECT EXISTS (
+			SELECT 1
+			FROM core.auction_sessions
+			WHERE pool_id = $1::uuid
+				AND deleted_at IS NULL
+		)
+	`, poolID).Scan(&exists); err != nil {
+		return false, fmt.Errorf("checking auction session: %w", err)
+	}
+	return exists, nil
+}
+
+func (r *AuctionRepository) StartAuctionSession(ctx context.Context, sessionID string) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE core.auction_sessions
+		SET status = 'open',
+			started_at = NOW()
+		WHERE id = $1::uuid
+			AND status = 'pending'
+			AND deleted_at IS NULL
+	`, sessionID)
+	if err != nil {
+		return fmt.Errorf("starting auction session: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return &apperrors.InvalidArgumentError{Field: "status", Message: "auction has already started"}
+	}
+	return nil
+}
+
+func (r *AuctionRepository) CompleteAuctionSession(ctx context.Context, sessionID string) error {
+	if _, err := r.pool.Exec(ctx, `
+		UPDATE core.auction_sessions
+		SET status = 'completed',
+			completed_at = NOW()
+		WHERE id = $1::uuid
+			AND status = 'open'
+			AND deleted_at IS NULL
+	`, sessionID); err != nil {
+		return fmt.Errorf("completing auction session: %w", err)
+	}
+	return nil
+}
+
+const auctionLotSelect = `
+	SELECT id::text, session_id::text, team_id::text, nominated_by_portfolio_id::text, status,
+		high_bid_credits, high_bid_portfolio_id::text, closes_at, sold_at, created_at, updated_at
+	FROM core.auction_lots
+`
+
+func (r *AuctionRepository) ListAuctionLots(ctx context.Context, sessionID string) ([]*models.AuctionLot, error) {
+	rows, err := r.pool.Query(ctx, auctionLotSelect+`
+		WHERE session_id = $1::uuid
+		ORDER BY created_at ASC
+	`, sessionID)
+	if err != nil {
+		return nil, fmt.Errorf("listing auction lots: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*models.AuctionLot, 0)
+	for rows.Next() {
+		lot, err := scanAuctionLot(rows)
+		if err != nil {
+			return nil, fmt.Errorf("scanning auction lot: %w", err)
+		}
+		out = append(out, lot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating auction lots: %w", err)
+	}
+	return out, nil
+}
+
+func (r *AuctionRepository) NominateAuctionLot(ctx context.Context, lot *models.AuctionLot, expectedIndex, nextIndex int) error {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	var lotSeconds int
+	err = tx.QueryRow(ctx, `
+		UPDATE core.auction_sessions s
+		SET nomination_index = $3
+		WHERE s.id = $1::uuid
+			AND s.status = 'open'
+			AND s.nomination_index = $2
+			AND s.deleted_at IS NULL
+			AND NOT EXISTS (
+				SELECT 1
+				FROM core.auction_lots l
+				WHERE l.session_id = s.id
+					AND l.status = 'open'
+			)
+		RETURNING s.lot_seconds
+	`, lot.SessionID, expectedIndex, nextIndex).Scan(&lotSeconds)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return &apperrors.InvalidArgumentError{Field: "teamId", Message: "another nomination is already in progress"}
+	}
+	if err != nil {
+		return fmt.Errorf("advancing nomination: %w", err)
+	}
+
+	err = tx.QueryRow(ctx, `
+		INSERT INTO core.auction_lots (session_id, team_id, nominated_by_portfolio_id, high_bid_credits, high_bid_portfolio_id, closes_at)
+		VALUES ($1::uuid, $2::uuid, $3::uuid, $4, $3::uuid, NOW() + make_interval(secs => $5))
+		RETURNING id::text, status, closes_at, created_at, updated_at
+	`, lot.SessionID, lot.TeamID, lot.NominatedByPortfolioID, lot.HighBidCredits, lotSeconds,
+	).Scan(&lot.ID, &lot.Status, &lot.ClosesAt, &lot.CreatedAt, &lot.UpdatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &apperrors.AlreadyExistsError{Resource: "auction lot", Field: "team_id", Value: lot.TeamID}
+		}
+		return fmt.Errorf("creating auction lot: %w", err)
+	}
+	lot.HighBidPortfolioID = lot.NominatedByPortfolioID
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO core.auction_bids (lot_id, portfolio_id, credits)
+		VALUES ($1::uuid, $2::uuid, $3)
+	`, lot.ID, lot.NominatedByPortfolioID, lot.HighBidCredits); err != nil {
+		return fmt.Errorf("recording opening bid: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction: %w", err)
+	}
+	committed = true
+	return nil
+}
+
+func (r *AuctionRepository) PlaceAuctionBid(ctx context.Context, lotID, portfolioID string, credits int, validate func(models.AuctionBidContext) error) (*models.AuctionLot, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	lot, err := scanAuctionLot(tx.QueryRow(ctx, auctionLotSelect+`
+		WHERE id = $1::uuid
+		FOR UPDATE
+	`, lotID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &apperrors.NotFoundError{Resource: "auction lot", ID: lotID}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("locking auction lot: %w", err)
+	}
+
+	bidCtx := models.AuctionBidContext{Lot: lot}
+	var extensionSeconds int
+	if err := tx.QueryRow(ctx, `
+		SELECT s.min_increment_credits,
+			s.bid_extension_seconds,
+			COALESCE(SUM(inv.credits), 0)::int,
+			COUNT(inv.id)::int,
+			NOW()
+		FROM core.auction_sessions s
+		LEFT JOIN core.investments inv
+			ON inv.portfolio_id = $2::uuid
+			AND inv.deleted_at IS NULL
+		WHERE s.id = $1::uuid
+		GROUP BY s.id
+	`, lot.SessionID, portfolioID).Scan(&bidCtx.MinIncrementCredits, &extensionSeconds, &bidCtx.BidderSpentCredits, &bidCtx.BidderTeamsWon, &bidCtx.Now); err != nil {
+		return nil, fmt.Errorf("loading bid context: %w", err)
+	}
+	if err := validate(bidCtx); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		INSERT INTO core.auction_bids (lot_id, portfolio_id, credits)
+		VALUES ($1::uuid, $2::uuid, $3)
+	`, lotID, portfolioID, credits); err != nil {
+		return nil, fmt.Errorf("recording bid: %w", err)
+	}
+
+	lot, err = scanAuctionLot(tx.QueryRow(ctx, `
+		UPDATE core.auction_lots
+		SET high_bid_credits = $2,
+			high_bid_portfolio_id = $3::uuid,
+			closes_at = GREATEST(closes_at, NOW() + make_interval(secs => $4))
+		WHERE id = $1::uuid
+		RETURNING id::text, session_id::text, team_id::text, nominated_by_portfolio_id::text, status,
+			high_bid_credits, high_bid_portfolio_id::text, closes_at, sold_at, created_at, updated_at
+	`, lotID, credits, portfolioID, extensionSeconds))
+	if err != nil {
+		return nil, fmt.Errorf("updating auction lot: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	committed = true
+	return lot, nil
+}
+
+func (r *AuctionRepository) CloseExpiredAuctionLot(ctx context.Context, sessionID string) (*models.AuctionLot, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	// Selling the lot first makes the close conditional on it still being
+	// open: a concurrent close waits on the row, then finds nothing to sell.
+	lot, err := scanAuctionLot(tx.QueryRow(ctx, `
+		UPDATE core.auction_lots
+		SET status = 'sold',
+			sold_at = NOW()
+		WHERE session_id = $1::uuid
+			AND status = 'open'
+			AND closes_at <= NOW()
+		RETURNING id::text, session_id::text, team_id::text, nominated_by_portfolio_id::text, status,
+			high_bid_credits, high_bid_portfolio_id::text, closes_at, sold_at, created_at, updated_at
+	`, sessionID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("selling auction lot: %w", err)
+	}
+
+	var investmentID string
+	if err := tx.QueryRow(ctx, `
+		INSERT INTO core.investments (portfolio_id, team_id, credits)
+		VALUES ($1::uuid, $2::uuid, $3)
+		RETURNING id::text
+	`, lot.HighBidPortfolioID, lot.TeamID, lot.HighBidCredits).Scan(&investmentID); err != nil {
+		return nil, fmt.Errorf("creating investment: %w", err)
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE core.auction_lots
+		SET investment_id = $2::uuid
+		WHERE id = $1::uuid
+	`, lot.ID, investmentID); err != nil {
+		return nil, fmt.Errorf("linking auction lot investment: %w", err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing transaction: %w", err)
+	}
+	committed = true
+	return lot, nil
+}
+
+func (r *AuctionRepository) ListPoolIDsWithExpiredAuctionLots(ctx context.Context) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT DISTINCT s.pool_id::text
+		FROM core.auction_sessions s
+		JOIN core.auction_lots l
+			ON l.session_id = s.id
+			AND l.status = 'open'
+			AND l.closes_at <= NOW()
+		WHERE s.status = 'open'
+			AND s.deleted_at IS NULL
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("listing pools with expired auction lots: %w", err)
+	}
+	defer rows.Close()
+
+	var poolIDs []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scanning pool id: %w", err)
+		}
+		poolIDs = append(poolIDs, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating pools with expired auction lots: %w", err)
+	}
+	return poolIDs, nil
+}
+
+func scanAuctionSession(row pgx.Row) (*models.AuctionSession, error) {
+	s := &models.AuctionSession{}
+	if err := row.Scan(&s.ID, &s.PoolID, &s.Status, &s.NominationOrder, &s.NominationIndex,
+		&s.LotSeconds, &s.BidExtensionSeconds, &s.MinIncrementCredits, &s.CreatedBy,
+		&s.StartedAt, &s.CompletedAt, &s.CreatedAt, &s.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+func scanAuctionLot(row pgx.Row) (*models.AuctionLot, error) {
+	l := &models.AuctionLot{}
+	if err := row.Scan(&l.ID, &l.SessionID, &l.TeamID, &l.NominatedByPortfolioID, &l.Status,
+		&l.HighBidCredits, &l.HighBidPortfolioID, &l.ClosesAt, &l.SoldAt, &l.CreatedAt, &l.UpdatedAt); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
@@ -0,0 +1,102 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+// mustSeedOpenAuction creates an open auction over two empty portfolios and
+// nominates the first team with an opening bid of 5 from the first portfolio.
+func mustSeedOpenAuction(t *testing.T, ctx context.Context) (seedWithTeams, []*models.Portfolio, *models.AuctionLot) {
+	t.Helper()
+
+	seed := mustSeedWithTeams(t, ctx, 2)
+	portfolios := []*models.Portfolio{
+		{Name: "Alpha", UserID: &seed.user.ID, PoolID: seed.pool.ID},
+		{Name: "Beta", PoolID: seed.pool.ID},
+	}
+	for _, p := range portfolios {
+		if err := seed.poolRepo.CreatePortfolio(ctx, p, nil); err != nil {
+			t.Fatalf("creating portfolio: %v", err)
+		}
+	}
+
+	repo := db.NewAuctionRepository(pool)
+	session := &models.AuctionSession{PoolID: seed.pool.ID, NominationOrder: []string{portfolios[0].ID, portfolios[1].ID}}
+	session.ApplyDefaults()
+	if err := repo.CreateAuctionSession(ctx, session); err != nil {
+		t.Fatalf("creating session: %v", err)
+	}
+	if err := repo.StartAuctionSession(ctx, session.ID); err != nil {
+		t.Fatalf("starting session: %v", err)
+	}
+	lot := &models.AuctionLot{SessionID: session.ID, TeamID: seed.teams[0].ID, NominatedByPortfolioID: portfolios[0].ID, HighBidCredits: 5}
+	if err := repo.NominateAuctionLot(ctx, lot, 0, 1); err != nil {
+		t.Fatalf("nominating lot: %v", err)
+	}
+	return seed, portfolios, lot
+}
+
+func TestThatAuctionBidExtendsCountdown(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN an open lot about to close
+	_, portfolios, lot := mustSeedOpenAuction(t, ctx)
+	if _, err := pool.Exec(ctx, `UPDATE core.auction_lots SET closes_at = NOW() + interval '1 second' WHERE id = $1::uuid`, lot.ID); err != nil {
+		t.Fatalf("shortening countdown: %v", err)
+	}
+	repo := db.NewAuctionRepository(pool)
+
+	// WHEN another portfolio outbids the nominator
+	updated, err := repo.PlaceAuctionBid(ctx, lot.ID, portfolios[1].ID, 6, func(models.AuctionBidContext) error { return nil })
+	if err != nil {
+		t.Fatalf("placing bid: %v", err)
+	}
+
+	// THEN the lot closes no sooner than the bid extension from now
+	if updated.HighBidPortfolioID != portfolios[1].ID || !updated.ClosesAt.After(updated.UpdatedAt.Add(9*time.Second)) {
+		t.Errorf("expected beta to hold an extended lot, got %+v", updated)
+	}
+}
+
+func TestThatClosingExpiredAuctionLotWritesWinningInvestment(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN an open lot whose countdown has run out
+	seed, portfolios, lot := mustSeedOpenAuction(t, ctx)
+	if _, err := pool.Exec(ctx, `UPDATE core.auction_lots SET closes_at = NOW() - interval '1 second' WHERE id = $1::uuid`, lot.ID); err != nil {
+		t.Fatalf("expiring countdown: %v", err)
+	}
+	repo := db.NewAuctionRepository(pool)
+
+	// WHEN the lot is closed
+	if _, err := repo.CloseExpiredAuctionLot(ctx, lot.SessionID); err != nil {
+		t.Fatalf("closing lot: %v", err)
+	}
+
+	// THEN the nominator owns the team for its opening bid
+	investments, err := seed.poolRepo.GetInvestments(ctx, portfolios[0].ID)
+	if err != nil {
+		t.Fatalf("getting investments: %v", err)
+	}
+	if len(investments) != 1 || investments[0].TeamID != seed.teams[0].ID || investments[0].Credits != 5 {
+		t.Errorf("expected one 5-credit investment in the first team, got %+v", investments)
+	}
+}
@@ -0,0 +1,91 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ ports.BiddingWindowRepository = (*BiddingWindowRepository)(nil)
+
+// BiddingWindowRepository stores the spans during which pools take bids.
+type BiddingWindowRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewBiddingWindowRepository(pool *pgxpool.Pool) *BiddingWindowRepository {
+	return &BiddingWindowRepository{pool: pool}
+}
+
+func (r *BiddingWindowRepository) ListBiddingWindows(ctx context.Context, poolID string) ([]*models.BiddingWindow, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id::text, pool_id::text, opens_at, closes_at, created_at, updated_at
+		FROM core.pool_bidding_windows
+		WHERE pool_id = $1::uuid
+			AND deleted_at IS NULL
+		ORDER BY opens_at ASC
+	`, poolID)
+	if err != nil {
+		return nil, fmt.Errorf("listing bidding windows for pool %s: %w", poolID, err)
+	}
+	defer rows.Close()
+
+	out := make([]*models.BiddingWindow, 0)
+	for rows.Next() {
+		w := &models.BiddingWindow{}
+		if err := rows.Scan(&w.ID, &w.PoolID, &w.OpensAt, &w.ClosesAt, &w.CreatedAt, &w.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scanning bidding window: %w", err)
+		}
+		out = append(out, w)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating bidding windows: %w", err)
+	}
+	return out, nil
+}
+
+func (r *BiddingWindowRepository) ReplaceBiddingWindows(ctx context.Context, poolID string, windows []*models.BiddingWindow) error {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("beginning transaction to replace bidding windows for pool %s: %w", poolID, err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE core.pool_bidding_windows
+		SET deleted_at = NOW()
+		WHERE pool_id = $1::uuid
+			AND deleted_at IS NULL
+	`, poolID); err != nil {
+		return fmt.Errorf("soft-deleting bidding windows for pool %s: %w", poolID, err)
+	}
+
+	for _, w := range windows {
+		if w == nil {
+			continue
+		}
+		if err := tx.QueryRow(ctx, `
+			INSERT INTO core.pool_bidding_windows (pool_id, opens_at, closes_at)
+			VALUES ($1::uuid, $2, $3)
+			RETURNING id::text, created_at, updated_at
+		`, poolID, w.OpensAt, w.ClosesAt).Scan(&w.ID, &w.CreatedAt, &w.UpdatedAt); err != nil {
+			return fmt.Errorf("creating bidding window for pool %s: %w", poolID, err)
+		}
+		w.PoolID = poolID
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction to replace bidding windows for pool %s: %w", poolID, err)
+	}
+	committed = true
+	return nil
+}
@@ -0,0 +1,49 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+func TestThatReplaceBiddingWindowsSwapsWindows(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a pool with one bidding window
+	base := mustSeedBase(t, ctx)
+	repo := db.NewBiddingWindowRepository(pool)
+	opens := time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)
+	initial := []*models.BiddingWindow{{OpensAt: opens, ClosesAt: opens.Add(24 * time.Hour)}}
+	if err := repo.ReplaceBiddingWindows(ctx, base.pool.ID, initial); err != nil {
+		t.Fatalf("creating bidding windows: %v", err)
+	}
+
+	// WHEN replacing it with two windows around the First Four
+	replacement := []*models.BiddingWindow{
+		{OpensAt: opens.Add(72 * time.Hour), ClosesAt: opens.Add(96 * time.Hour)},
+		{OpensAt: opens, ClosesAt: opens.Add(36 * time.Hour)},
+	}
+	if err := repo.ReplaceBiddingWindows(ctx, base.pool.ID, replacement); err != nil {
+		t.Fatalf("replacing bidding windows: %v", err)
+	}
+
+	// THEN only the two new windows remain, earliest first
+	got, err := repo.ListBiddingWindows(ctx, base.pool.ID)
+	if err != nil {
+		t.Fatalf("listing bidding windows: %v", err)
+	}
+	if len(got) != 2 || !got[0].ClosesAt.Equal(opens.Add(36*time.Hour)) {
+		t.Errorf("expected the two replacement windows earliest first, got %+v", got)
+	}
+}
@@ -0,0 +1,74 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ChangeChannel is the NOTIFY channel; database triggers publish game result
+// and prediction batch changes on it too.
+const ChangeChannel = "calcutta_changes"
+
+var (
+	_ ports.ChangePublisher = (*ChangeFeed)(nil)
+	_ ports.ChangeListener  = (*ChangeFeed)(nil)
+)
+
+// ChangeFeed publishes and listens for change events over Postgres
+// LISTEN/NOTIFY.
+type ChangeFeed struct {
+	pool *pgxpool.Pool
+}
+
+func NewChangeFeed(pool *pgxpool.Pool) *ChangeFeed {
+	return &ChangeFeed{pool: pool}
+}
+
+func (f *ChangeFeed) PublishChange(ctx context.Context, ev models.ChangeEvent) error {
+	payload, err := json.Marshal(ev)
+	if err != nil {
+		return fmt.Errorf("encoding change event: %w", err)
+	}
+	if _, err := f.pool.Exec(ctx, `SELECT pg_notify($1, $2)`, ChangeChannel, string(payload)); err != nil {
+		return fmt.Errorf("publishing change event: %w", err)
+	}
+	return nil
+}
+
+// ListenChanges holds a pool connection for as long as it listens.
+func (f *ChangeFeed) ListenChanges(ctx context.Context, onListening func(), handle func(models.ChangeEvent)) error {
+	conn, err := f.pool.Acquire(ctx)
+	if err != nil {
+		return fmt.Errorf("acquiring listener connection: %w", err)
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+ChangeChannel); err != nil {
+		return fmt.Errorf("listening for changes: %w", err)
+	}
+	// The connection goes back to the pool afterwards; don't leave it
+	// subscribed.
+	defer func() {
+		_, _ = conn.Exec(context.Background(), "UNLISTEN "+ChangeChannel)
+	}()
+	onListening()
+
+	for {
+		n, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			return fmt.Errorf("waiting for change: %w", err)
+		}
+		var ev models.ChangeEvent
+		if err := json.Unmarshal([]byte(n.Payload), &ev); err != nil {
+			slog.Warn("change_feed_bad_payload", "payload", n.Payload, "error", err)
+			continue
+		}
+		handle(ev)
+	}
+}
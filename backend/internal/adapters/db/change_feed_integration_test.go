@@ -0,0 +1,55 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	db "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+func TestThatRecordingGameResultAnnouncesStandingsChange(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a listener on the change feed for a tournament with two teams
+	seed := mustSeedWithTeams(t, ctx, 2)
+	listenCtx, cancel := context.WithTimeout(ctx, 5*time.Second)
+	defer cancel()
+	listening := make(chan struct{})
+	changes := make(chan models.ChangeEvent, 8)
+	go func() {
+		_ = db.NewChangeFeed(pool).ListenChanges(listenCtx, func() { close(listening) }, func(ev models.ChangeEvent) { changes <- ev })
+	}()
+	select {
+	case <-listening:
+	case <-listenCtx.Done():
+		t.Fatal("listener did not start")
+	}
+
+	// WHEN a game result is recorded
+	err := db.NewGameResultRepository(pool).RecordGameResults(ctx, seed.tournament.ID, []*models.GameResult{
+		{GameID: "East-round_of_64-1", WinnerTeamID: seed.teams[0].ID, LoserTeamID: seed.teams[1].ID},
+	})
+	if err != nil {
+		t.Fatalf("recording result: %v", err)
+	}
+
+	// THEN a standings change for the tournament is announced
+	select {
+	case ev := <-changes:
+		if ev.Kind != models.ChangeKindStandings || ev.TournamentID != seed.tournament.ID {
+			t.Errorf("expected standings change for the tournament, got %+v", ev)
+		}
+	case <-listenCtx.Done():
+		t.Error("expected a standings change before the timeout")
+	}
+}
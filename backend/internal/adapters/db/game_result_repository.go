@@ -0,0 +1,226 @@
+package db
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ ports.GameResultRepository = (*GameResultRepository)(nil)
+
+type GameResultRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewGameResultRepository(pool *pgxpool.Pool) *GameResultRepository {
+	return &GameResultRepository{pool: pool}
+}
+
+func (r *GameResultRepository) ListGameResults(ctx context.Context, tournamentID string) ([]*models.GameResult, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id::text, tournament_id::text, game_id, winner_team_id::text, loser_team_id::text,
+			winner_score, loser_score, decided_at, entered_by::text, created_at, updated_at
+		FROM core.game_results
+		WHERE tournament_id = $1::uuid
+			AND deleted_at IS NULL
+		ORDER BY decided_at ASC, created_at ASC
+	`, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("listing game results: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*models.GameResult, 0)
+	for rows.Next() {
+		gr := &models.GameResult{}
+		if err := rows.Scan(
+			&gr.ID, &gr.TournamentID, &gr.GameID, &gr.WinnerTeamID, &gr.LoserTeamID,
+			&gr.WinnerScore, &gr.LoserScore, &gr.DecidedAt, &gr.EnteredBy, &gr.CreatedAt, &gr.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning game result: %w", err)
+		}
+		out = append(out, gr)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating game results: %w", err)
+	}
+	return out, nil
+}
+
+// RecordGameResults upserts the given results, clears the live state of the
+// decided games and re-derives team progress in a single transaction.
+// Re-recording a game replaces its previous result.
+func (r *GameResultRepository) RecordGameResults(ctx context.Context, tournamentID string, results []*models.GameResult) error {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	if err := upsertGameResults(ctx, tx, tournamentID, results); err != nil {
+		return err
+	}
+
+	gameIDs := make([]string, 0, len(results))
+	for _, gr := range results {
+		gameIDs = append(gameIDs, gr.GameID)
+	}
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM core.live_game_states
+		WHERE tournament_id = $1::uuid
+			AND game_id = ANY($2::text[])
+	`, tournamentID, gameIDs); err != nil {
+		return fmt.Errorf("clearing live game states: %w", err)
+	}
+
+	if err := syncTeamProgressFromResults(ctx, tx, tournamentID); err != nil {
+		return err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing game results: %w", err)
+	}
+	committed = true
+	return nil
+}
+
+// RollbackGameResults soft-deletes results for the given games, re-derives team
+// progress, and invalidates prediction batches and simulation batches built on
+// the removed results, all in a single transaction.
+func (r *GameResultRepository) RollbackGameResults(ctx context.Context, rollback models.GameResultRollback) (*models.RollbackInvalidations, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	if err := upsertGameResults(ctx, tx, rollback.TournamentID, rollback.Backfill); err != nil {
+		return nil, err
+	}
+
+	if _, err := tx.Exec(ctx, `
+		UPDATE core.game_results
+		SET deleted_at = NOW()
+		WHERE tournament_id = $1::uuid
+			AND game_id = ANY($2::text[])
+			AND deleted_at IS NULL
+	`, rollback.TournamentID, rollback.GameIDs); err != nil {
+		return nil, fmt.Errorf("deleting game results: %w", err)
+	}
+
+	if err := syncTeamProgressFromResults(ctx, tx, rollback.TournamentID); err != nil {
+		return nil, err
+	}
+
+	out := &models.RollbackInvalidations{}
+
+	tag, err := tx.Exec(ctx, `
+		UPDATE compute.prediction_batches
+		SET deleted_at = NOW()
+		WHERE tournament_id = $1::uuid
+			AND through_round >= $2
+			AND deleted_at IS NULL
+	`, rollback.TournamentID, rollback.FromCheckpoint)
+	if err != nil {
+		return nil, fmt.Errorf("invalidating prediction batches: %w", err)
+	}
+	out.PredictionBatches = int(tag.RowsAffected())
+
+	// A simulation is stale when its starting snapshot credits any team with
+	// more wins than the team now has.
+	tag, err = tx.Exec(ctx, `
+		UPDATE compute.simulated_tournaments st
+		SET deleted_at = NOW()
+		WHERE st.tournament_id = $1::uuid
+			AND st.deleted_at IS NULL
+			AND EXISTS (
+				SELECT 1
+				FROM compute.tournament_snapshot_teams sst
+				JOIN core.teams t ON t.id = sst.team_id
+				WHERE sst.tournament_snapshot_id = st.tournament_snapshot_id
+					AND sst.wins > t.wins
+			)
+	`, rollback.TournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("invalidating simulation batches: %w", err)
+	}
+	out.SimulationBatches = int(tag.RowsAffected())
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing game result rollback: %w", err)
+	}
+	committed = true
+	return out, nil
+}
+
+func upsertGameResults(ctx context.Context, tx pgx.Tx, tournamentID string, results []*models.GameResult) error {
+	for _, gr := range results {
+		var decidedAt *time.Time
+		if !gr.DecidedAt.IsZero() {
+			decidedAt = &gr.DecidedAt
+		}
+		if err := tx.QueryRow(ctx, `
+			INSERT INTO core.game_results (
+				tournament_id, game_id, winner_team_id, loser_team_id,
+				winner_score, loser_score, decided_at, entered_by
+			)
+			VALUES ($1::uuid, $2, $3::uuid, $4::uuid, $5, $6, COALESCE($7::timestamptz, NOW()), $8::uuid)
+			ON CONFLICT (tournament_id, game_id) WHERE (deleted_at IS NULL)
+			DO UPDATE SET
+				winner_team_id = EXCLUDED.winner_team_id,
+				loser_team_id = EXCLUDED.loser_team_id,
+				winner_score = EXCLUDED.winner_score,
+				loser_score = EXCLUDED.loser_score,
+				decided_at = EXCLUDED.decided_at,
+				entered_by = EXCLUDED.entered_by
+			RETURNING id::text, decided_at, created_at, updated_at
+		`, tournamentID, gr.GameID, gr.WinnerTeamID, gr.LoserTeamID,
+			gr.WinnerScore, gr.LoserScore, decidedAt, gr.EnteredBy,
+		).Scan(&gr.ID, &gr.DecidedAt, &gr.CreatedAt, &gr.UpdatedAt); err != nil {
+			return fmt.Errorf("recording result for game %s: %w", gr.GameID, err)
+		}
+		gr.TournamentID = tournamentID
+	}
+
+	return nil
+}
+
+// syncTeamProgressFromResults rewrites core.teams.wins and is_eliminated for a
+// tournament so they match its game results.
+func syncTeamProgressFromResults(ctx context.Context, tx pgx.Tx, tournamentID string) error {
+	if _, err := tx.Exec(ctx, `
+		UPDATE core.teams t
+		SET wins = (
+				SELECT COUNT(*)
+				FROM core.game_results gr
+				WHERE gr.winner_team_id = t.id
+					AND gr.deleted_at IS NULL
+			),
+			is_eliminated = EXISTS (
+				SELECT 1
+				FROM core.game_results gr
+				WHERE gr.loser_team_id = t.id
+					AND gr.deleted_at IS NULL
+			)
+		WHERE t.tournament_id = $1::uuid
+			AND t.deleted_at IS NULL
+	`, tournamentID); err != nil {
+		return fmt.Errorf("syncing team progress from game results: %w", err)
+	}
+	return nil
+}
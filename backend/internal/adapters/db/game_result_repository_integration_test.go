@@ -0,0 +1,143 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+func TestThatRecordingGameResultIncrementsWinnerWins(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a tournament with two teams
+	seed := mustSeedWithTeams(t, ctx, 2)
+	repo := db.NewGameResultRepository(pool)
+
+	// WHEN recording a result won by the first team
+	err := repo.RecordGameResults(ctx, seed.tournament.ID, []*models.GameResult{
+		{GameID: "East-round_of_64-1", WinnerTeamID: seed.teams[0].ID, LoserTeamID: seed.teams[1].ID},
+	})
+	if err != nil {
+		t.Fatalf("recording result: %v", err)
+	}
+
+	// THEN the winner's wins are derived from the result
+	team, err := seed.tournamentRepo.GetTournamentTeam(ctx, seed.teams[0].ID)
+	if err != nil {
+		t.Fatalf("getting team: %v", err)
+	}
+	if team.Wins != 1 {
+		t.Errorf("expected 1 win, got %d", team.Wins)
+	}
+}
+
+func TestThatRecordingGameResultEliminatesLoser(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a tournament with two teams
+	seed := mustSeedWithTeams(t, ctx, 2)
+	repo := db.NewGameResultRepository(pool)
+
+	// WHEN recording a result lost by the second team
+	err := repo.RecordGameResults(ctx, seed.tournament.ID, []*models.GameResult{
+		{GameID: "East-round_of_64-1", WinnerTeamID: seed.teams[0].ID, LoserTeamID: seed.teams[1].ID},
+	})
+	if err != nil {
+		t.Fatalf("recording result: %v", err)
+	}
+
+	// THEN the loser is marked eliminated
+	team, err := seed.tournamentRepo.GetTournamentTeam(ctx, seed.teams[1].ID)
+	if err != nil {
+		t.Fatalf("getting team: %v", err)
+	}
+	if !team.IsEliminated {
+		t.Errorf("expected loser to be eliminated")
+	}
+}
+
+func TestThatRecordingSameGameTwiceKeepsSingleResult(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a recorded result
+	seed := mustSeedWithTeams(t, ctx, 2)
+	repo := db.NewGameResultRepository(pool)
+	result := func() []*models.GameResult {
+		return []*models.GameResult{
+			{GameID: "East-round_of_64-1", WinnerTeamID: seed.teams[0].ID, LoserTeamID: seed.teams[1].ID},
+		}
+	}
+	if err := repo.RecordGameResults(ctx, seed.tournament.ID, result()); err != nil {
+		t.Fatalf("recording first result: %v", err)
+	}
+
+	// WHEN recording the same game again
+	if err := repo.RecordGameResults(ctx, seed.tournament.ID, result()); err != nil {
+		t.Fatalf("recording second result: %v", err)
+	}
+
+	// THEN only one result is stored
+	results, err := repo.ListGameResults(ctx, seed.tournament.ID)
+	if err != nil {
+		t.Fatalf("listing results: %v", err)
+	}
+	if len(results) != 1 {
+		t.Errorf("expected 1 result, got %d", len(results))
+	}
+}
+
+func TestThatRollingBackGameResultRestoresTeamProgress(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a recorded result
+	seed := mustSeedWithTeams(t, ctx, 2)
+	repo := db.NewGameResultRepository(pool)
+	if err := repo.RecordGameResults(ctx, seed.tournament.ID, []*models.GameResult{
+		{GameID: "East-round_of_64-1", WinnerTeamID: seed.teams[0].ID, LoserTeamID: seed.teams[1].ID},
+	}); err != nil {
+		t.Fatalf("recording result: %v", err)
+	}
+
+	// WHEN rolling back the result
+	if _, err := repo.RollbackGameResults(ctx, models.GameResultRollback{
+		TournamentID:   seed.tournament.ID,
+		GameIDs:        []string{"East-round_of_64-1"},
+		FromCheckpoint: 2,
+	}); err != nil {
+		t.Fatalf("rolling back result: %v", err)
+	}
+
+	// THEN the loser is no longer eliminated
+	team, err := seed.tournamentRepo.GetTournamentTeam(ctx, seed.teams[1].ID)
+	if err != nil {
+		t.Fatalf("getting team: %v", err)
+	}
+	if team.IsEliminated {
+		t.Errorf("expected loser to be reinstated")
+	}
+}
@@ -39,6 +39,14 @@ func derefString(p *string) string {
 	return *p
 }
 
+// derefFloat safely dereferences a *float64, returning 0 if nil.
+func derefFloat(p *float64) float64 {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
 // uuidToPtrString converts a pgtype.UUID to a *string.
 // Returns nil if the UUID is not valid.
 func uuidToPtrString(u pgtype.UUID) *string {
@@ -49,3 +57,20 @@ func uuidToPtrString(u pgtype.UUID) *string {
 	s := id.String()
 	return &s
 }
+
+func optionalInt(v *int32) *int {
+	if v == nil {
+		return nil
+	}
+	n := int(*v)
+	return &n
+}
+
+// optionalTimestamptz converts a *time.Time to a pgtype.Timestamptz that is
+// NULL when the time is nil.
+func optionalTimestamptz(t *time.Time) pgtype.Timestamptz {
+	if t == nil {
+		return pgtype.Timestamptz{}
+	}
+	return pgtype.Timestamptz{Time: *t, Valid: true}
+}
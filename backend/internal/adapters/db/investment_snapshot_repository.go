@@ -3,15 +3,19 @@ package db
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"time"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/adapters/db/sqlc"
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgtype"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
-var _ ports.InvestmentSnapshotWriter = (*InvestmentSnapshotRepository)(nil)
+var _ ports.InvestmentSnapshotRepository = (*InvestmentSnapshotRepository)(nil)
 
 type InvestmentSnapshotRepository struct {
 	q *sqlc.Queries
@@ -37,3 +41,47 @@ func (r *InvestmentSnapshotRepository) CreateInvestmentSnapshot(ctx context.Cont
 	}
 	return nil
 }
+
+func (r *InvestmentSnapshotRepository) ListInvestmentSnapshots(ctx context.Context, portfolioID string) ([]*models.InvestmentSnapshot, error) {
+	rows, err := r.q.ListInvestmentSnapshots(ctx, portfolioID)
+	if err != nil {
+		return nil, fmt.Errorf("listing investment snapshots: %w", err)
+	}
+	out := make([]*models.InvestmentSnapshot, 0, len(rows))
+	for _, row := range rows {
+		snapshot, err := toInvestmentSnapshot(row.ID, row.PortfolioID, row.ChangedBy, row.Reason, row.Investments, row.CreatedAt)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, snapshot)
+	}
+	return out, nil
+}
+
+func (r *InvestmentSnapshotRepository) GetInvestmentSnapshotAsOf(ctx context.Context, portfolioID string, at time.Time) (*models.InvestmentSnapshot, error) {
+	row, err := r.q.GetInvestmentSnapshotAsOf(ctx, sqlc.GetInvestmentSnapshotAsOfParams{
+		PortfolioID: portfolioID,
+		CreatedAt:   pgtype.Timestamptz{Time: at, Valid: true},
+	})
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting investment snapshot as of %s: %w", at.Format(time.RFC3339), err)
+	}
+	return toInvestmentSnapshot(row.ID, row.PortfolioID, row.ChangedBy, row.Reason, row.Investments, row.CreatedAt)
+}
+
+func toInvestmentSnapshot(id, portfolioID, changedBy, reason string, investmentsJSON []byte, createdAt pgtype.Timestamptz) (*models.InvestmentSnapshot, error) {
+	snapshot := &models.InvestmentSnapshot{
+		ID:          id,
+		PortfolioID: portfolioID,
+		ChangedBy:   changedBy,
+		Reason:      reason,
+		CreatedAt:   createdAt.Time.UTC(),
+	}
+	if err := json.Unmarshal(investmentsJSON, &snapshot.Investments); err != nil {
+		return nil, fmt.Errorf("unmarshalling investments of snapshot %s: %w", id, err)
+	}
+	return snapshot, nil
+}
@@ -5,6 +5,7 @@ package db_test
 import (
 	"context"
 	"testing"
+	"time"
 
 	db "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
@@ -112,3 +113,71 @@ func TestThatInvestmentSnapshotRequiresValidPortfolioID(t *testing.T) {
 		t.Error("expected error for invalid portfolio_id, got nil")
 	}
 }
+
+func TestThatInvestmentSnapshotsAreListedOldestFirst(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a portfolio that bid 30 and then raised to 50
+	seed := mustSeedWithTeams(t, ctx, 1)
+	portfolio := mustSeedPortfolio(t, ctx, seed.poolRepo, seed.pool.ID, seed.user.ID)
+	snapshotRepo := db.NewInvestmentSnapshotRepository(pool)
+	for _, credits := range []int{30, 50} {
+		snapshot := &models.InvestmentSnapshot{
+			PortfolioID: portfolio.ID,
+			ChangedBy:   seed.user.ID,
+			Investments: []models.InvestmentSnapshotEntry{{TeamID: seed.teams[0].ID, Credits: credits}},
+		}
+		if err := snapshotRepo.CreateInvestmentSnapshot(ctx, snapshot); err != nil {
+			t.Fatalf("creating snapshot: %v", err)
+		}
+	}
+
+	// WHEN listing its snapshots
+	got, err := snapshotRepo.ListInvestmentSnapshots(ctx, portfolio.ID)
+	if err != nil {
+		t.Fatalf("listing snapshots: %v", err)
+	}
+
+	// THEN the opening bid comes first
+	if len(got) != 2 || got[0].Investments[0].Credits != 30 {
+		t.Errorf("expected the 30-credit snapshot first of two, got %+v", got)
+	}
+}
+
+func TestThatInvestmentSnapshotAsOfBeforeFirstChangeIsNil(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a portfolio with one snapshot
+	seed := mustSeedWithTeams(t, ctx, 1)
+	portfolio := mustSeedPortfolio(t, ctx, seed.poolRepo, seed.pool.ID, seed.user.ID)
+	snapshotRepo := db.NewInvestmentSnapshotRepository(pool)
+	snapshot := &models.InvestmentSnapshot{
+		PortfolioID: portfolio.ID,
+		ChangedBy:   seed.user.ID,
+		Investments: []models.InvestmentSnapshotEntry{{TeamID: seed.teams[0].ID, Credits: 30}},
+	}
+	if err := snapshotRepo.CreateInvestmentSnapshot(ctx, snapshot); err != nil {
+		t.Fatalf("creating snapshot: %v", err)
+	}
+
+	// WHEN reconstructing its bids as of a day earlier
+	got, err := snapshotRepo.GetInvestmentSnapshotAsOf(ctx, portfolio.ID, time.Now().Add(-24*time.Hour))
+	if err != nil {
+		t.Fatalf("getting snapshot: %v", err)
+	}
+
+	// THEN there is no snapshot yet
+	if got != nil {
+		t.Errorf("expected nil, got %+v", got)
+	}
+}
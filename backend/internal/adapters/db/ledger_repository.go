@@ -0,0 +1,155 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ ports.LedgerRepository = (*LedgerRepository)(nil)
+
+// LedgerRepository stores the payments and payouts recorded against a pool's
+// portfolios, and each pool's settlement.
+type LedgerRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewLedgerRepository(pool *pgxpool.Pool) *LedgerRepository {
+	return &LedgerRepository{pool: pool}
+}
+
+const ledgerEntrySelect = `
+	SELECT le.id::text, le.pool_id::text, le.portfolio_id::text, le.kind, le.amount_cents,
+		le.note, le.recorded_by::text, le.created_at, le.updated_at
+	FROM core.pool_ledger_entries le
+`
+
+func (r *LedgerRepository) ListLedgerEntries(ctx context.Context, poolID string) ([]*models.LedgerEntry, error) {
+	rows, err := r.pool.Query(ctx, ledgerEntrySelect+`
+		WHERE le.pool_id = $1::uuid
+			AND le.deleted_at IS NULL
+		ORDER BY le.created_at ASC, le.id ASC
+	`, poolID)
+	if err != nil {
+		return nil, fmt.Errorf("listing ledger entries for pool %s: %w", poolID, err)
+	}
+	return scanLedgerEntries(rows)
+}
+
+func (r *LedgerRepository) ListLedgerEntriesByUser(ctx context.Context, userID string) ([]*models.LedgerEntry, error) {
+	rows, err := r.pool.Query(ctx, ledgerEntrySelect+`
+		JOIN core.portfolios p ON p.id = le.portfolio_id AND p.deleted_at IS NULL
+		JOIN core.pools c ON c.id = le.pool_id AND c.deleted_at IS NULL
+		WHERE p.user_id = $1::uuid
+			AND le.deleted_at IS NULL
+		ORDER BY le.created_at ASC, le.id ASC
+	`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing ledger entries for user %s: %w", userID, err)
+	}
+	return scanLedgerEntries(rows)
+}
+
+func scanLedgerEntries(rows pgx.Rows) ([]*models.LedgerEntry, error) {
+	defer rows.Close()
+
+	out := make([]*models.LedgerEntry, 0)
+	for rows.Next() {
+		e := &models.LedgerEntry{}
+		if err := rows.Scan(&e.ID, &e.PoolID, &e.PortfolioID, &e.Kind, &e.AmountCents, &e.Note, &e.RecordedBy, &e.CreatedAt, &e.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scanning ledger entry: %w", err)
+		}
+		out = append(out, e)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating ledger entries: %w", err)
+	}
+	return out, nil
+}
+
+func (r *LedgerRepository) CreateLedgerEntry(ctx context.Context, entry *models.LedgerEntry) error {
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO core.pool_ledger_entries (pool_id, portfolio_id, kind, amount_cents, note, recorded_by)
+		VALUES ($1::uuid, $2::uuid, $3, $4, $5, $6::uuid)
+		RETURNING id::text, created_at, updated_at
+	`, entry.PoolID, entry.PortfolioID, entry.Kind, entry.AmountCents, entry.Note, entry.RecordedBy,
+	).Scan(&entry.ID, &entry.CreatedAt, &entry.UpdatedAt)
+	if err != nil {
+		return fmt.Errorf("creating ledger entry: %w", err)
+	}
+	return nil
+}
+
+func (r *LedgerRepository) VoidLedgerEntry(ctx context.Context, poolID, entryID string) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE core.pool_ledger_entries
+		SET deleted_at = NOW()
+		WHERE id = $1::uuid
+			AND pool_id = $2::uuid
+			AND deleted_at IS NULL
+	`, entryID, poolID)
+	if err != nil {
+		return fmt.Errorf("voiding ledger entry %s: %w", entryID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return &apperrors.NotFoundError{Resource: "ledger entry", ID: entryID}
+	}
+	return nil
+}
+
+func (r *LedgerRepository) GetPoolSettlement(ctx context.Context, poolID string) (*models.PoolSettlement, error) {
+	s := &models.PoolSettlement{}
+	err := r.pool.QueryRow(ctx, `
+		SELECT id::text, pool_id::text, settled_by::text, settled_at
+		FROM core.pool_settlements
+		WHERE pool_id = $1::uuid
+			AND deleted_at IS NULL
+	`, poolID).Scan(&s.ID, &s.PoolID, &s.SettledBy, &s.SettledAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting settlement for pool %s: %w", poolID, err)
+	}
+	return s, nil
+}
+
+func (r *LedgerRepository) SettlePool(ctx context.Context, settlement *models.PoolSettlement) error {
+	err := r.pool.QueryRow(ctx, `
+		INSERT INTO core.pool_settlements (pool_id, settled_by)
+		VALUES ($1::uuid, $2::uuid)
+		RETURNING id::text, settled_at
+	`, settlement.PoolID, settlement.SettledBy,
+	).Scan(&settlement.ID, &settlement.SettledAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &apperrors.AlreadyExistsError{Resource: "pool settlement", Field: "pool_id", Value: settlement.PoolID}
+		}
+		return fmt.Errorf("settling pool %s: %w", settlement.PoolID, err)
+	}
+	return nil
+}
+
+func (r *LedgerRepository) ReopenPool(ctx context.Context, poolID string) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE core.pool_settlements
+		SET deleted_at = NOW()
+		WHERE pool_id = $1::uuid
+			AND deleted_at IS NULL
+	`, poolID)
+	if err != nil {
+		return fmt.Errorf("reopening pool %s: %w", poolID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return &apperrors.NotFoundError{Resource: "pool settlement", ID: poolID}
+	}
+	return nil
+}
@@ -0,0 +1,138 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	db "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+func TestThatVoidedLedgerEntryIsNoLongerListed(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a pool with one recorded entry fee
+	base := mustSeedBase(t, ctx)
+	portfolio := mustSeedPortfolio(t, ctx, base.poolRepo, base.pool.ID, base.user.ID)
+	repo := db.NewLedgerRepository(pool)
+	entry := &models.LedgerEntry{
+		PoolID:      base.pool.ID,
+		PortfolioID: portfolio.ID,
+		Kind:        models.LedgerEntryKindPaymentReceived,
+		AmountCents: 2000,
+		RecordedBy:  base.user.ID,
+	}
+	if err := repo.CreateLedgerEntry(ctx, entry); err != nil {
+		t.Fatalf("creating ledger entry: %v", err)
+	}
+
+	// WHEN voiding it
+	if err := repo.VoidLedgerEntry(ctx, base.pool.ID, entry.ID); err != nil {
+		t.Fatalf("voiding ledger entry: %v", err)
+	}
+
+	// THEN the pool's ledger is empty
+	got, err := repo.ListLedgerEntries(ctx, base.pool.ID)
+	if err != nil {
+		t.Fatalf("listing ledger entries: %v", err)
+	}
+	if len(got) != 0 {
+		t.Errorf("expected no ledger entries, got %d", len(got))
+	}
+}
+
+func TestThatListLedgerEntriesByUserSpansTheirPortfolios(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a fee and a payout recorded for the user's portfolio
+	base := mustSeedBase(t, ctx)
+	portfolio := mustSeedPortfolio(t, ctx, base.poolRepo, base.pool.ID, base.user.ID)
+	repo := db.NewLedgerRepository(pool)
+	for _, kind := range []string{models.LedgerEntryKindPaymentReceived, models.LedgerEntryKindPayoutSent} {
+		entry := &models.LedgerEntry{PoolID: base.pool.ID, PortfolioID: portfolio.ID, Kind: kind, AmountCents: 1000, RecordedBy: base.user.ID}
+		if err := repo.CreateLedgerEntry(ctx, entry); err != nil {
+			t.Fatalf("creating ledger entry: %v", err)
+		}
+	}
+
+	// WHEN listing the user's entries
+	got, err := repo.ListLedgerEntriesByUser(ctx, base.user.ID)
+	if err != nil {
+		t.Fatalf("listing ledger entries: %v", err)
+	}
+
+	// THEN both entries are returned
+	if len(got) != 2 {
+		t.Errorf("expected 2 ledger entries, got %d", len(got))
+	}
+}
+
+func TestThatSettlingASettledPoolReturnsAlreadyExists(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a settled pool
+	base := mustSeedBase(t, ctx)
+	repo := db.NewLedgerRepository(pool)
+	if err := repo.SettlePool(ctx, &models.PoolSettlement{PoolID: base.pool.ID, SettledBy: base.user.ID}); err != nil {
+		t.Fatalf("settling pool: %v", err)
+	}
+
+	// WHEN settling it again
+	err := repo.SettlePool(ctx, &models.PoolSettlement{PoolID: base.pool.ID, SettledBy: base.user.ID})
+
+	// THEN the error is an AlreadyExistsError
+	var alreadyExists *apperrors.AlreadyExistsError
+	if !errors.As(err, &alreadyExists) {
+		t.Errorf("expected *apperrors.AlreadyExistsError, got %T: %v", err, err)
+	}
+}
+
+func TestThatReopenedPoolHasNoSettlement(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a settled pool
+	base := mustSeedBase(t, ctx)
+	repo := db.NewLedgerRepository(pool)
+	if err := repo.SettlePool(ctx, &models.PoolSettlement{PoolID: base.pool.ID, SettledBy: base.user.ID}); err != nil {
+		t.Fatalf("settling pool: %v", err)
+	}
+
+	// WHEN reopening it
+	if err := repo.ReopenPool(ctx, base.pool.ID); err != nil {
+		t.Fatalf("reopening pool: %v", err)
+	}
+
+	// THEN the pool is unsettled
+	got, err := repo.GetPoolSettlement(ctx, base.pool.ID)
+	if err != nil {
+		t.Fatalf("getting settlement: %v", err)
+	}
+	if got != nil {
+		t.Errorf("expected no settlement, got %+v", got)
+	}
+}
@@ -0,0 +1,89 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ ports.LiveGameRepository = (*GameResultRepository)(nil)
+
+// UpsertLiveGameState stores the latest state of a game in progress,
+// replacing any earlier report for the game.
+func (r *GameResultRepository) UpsertLiveGameState(ctx context.Context, state *models.LiveGameState) error {
+	if err := r.pool.QueryRow(ctx, `
+		INSERT INTO core.live_game_states (
+			tournament_id, game_id, team1_id, team2_id, team1_score, team2_score,
+			seconds_remaining, possession_team_id, source
+		)
+		VALUES ($1::uuid, $2, $3::uuid, $4::uuid, $5, $6, $7, $8::uuid, $9)
+		ON CONFLICT (tournament_id, game_id)
+		DO UPDATE SET
+			team1_id = EXCLUDED.team1_id,
+			team2_id = EXCLUDED.team2_id,
+			team1_score = EXCLUDED.team1_score,
+			team2_score = EXCLUDED.team2_score,
+			seconds_remaining = EXCLUDED.seconds_remaining,
+			possession_team_id = EXCLUDED.possession_team_id,
+			source = EXCLUDED.source
+		RETURNING updated_at
+	`, state.TournamentID, state.GameID, state.Team1ID, state.Team2ID, state.Team1Score, state.Team2Score,
+		state.SecondsRemaining, state.PossessionTeamID, state.Source,
+	).Scan(&state.UpdatedAt); err != nil {
+		return fmt.Errorf("storing live state for game %s: %w", state.GameID, err)
+	}
+	return nil
+}
+
+// ListLiveGameStates returns the tournament's games in progress.
+func (r *GameResultRepository) ListLiveGameStates(ctx context.Context, tournamentID string) ([]*models.LiveGameState, error) {
+	return ListLiveGameStates(ctx, r.pool, tournamentID)
+}
+
+// ListLiveGameStates returns the tournament's games in progress ordered by
+// game ID.
+func ListLiveGameStates(ctx context.Context, pool *pgxpool.Pool, tournamentID string) ([]*models.LiveGameState, error) {
+	rows, err := pool.Query(ctx, `
+		SELECT tournament_id::text, game_id, team1_id::text, team2_id::text, team1_score, team2_score,
+			seconds_remaining, possession_team_id::text, source, updated_at
+		FROM core.live_game_states
+		WHERE tournament_id = $1::uuid
+		ORDER BY game_id ASC
+	`, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("listing live game states: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*models.LiveGameState, 0)
+	for rows.Next() {
+		s := &models.LiveGameState{}
+		if err := rows.Scan(
+			&s.TournamentID, &s.GameID, &s.Team1ID, &s.Team2ID, &s.Team1Score, &s.Team2Score,
+			&s.SecondsRemaining, &s.PossessionTeamID, &s.Source, &s.UpdatedAt,
+		); err != nil {
+			return nil, fmt.Errorf("scanning live game state: %w", err)
+		}
+		out = append(out, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating live game states: %w", err)
+	}
+	return out, nil
+}
+
+// DeleteLiveGameState clears the live state of a game. It is not an error
+// for the game to have none.
+func (r *GameResultRepository) DeleteLiveGameState(ctx context.Context, tournamentID, gameID string) error {
+	if _, err := r.pool.Exec(ctx, `
+		DELETE FROM core.live_game_states
+		WHERE tournament_id = $1::uuid
+			AND game_id = $2
+	`, tournamentID, gameID); err != nil {
+		return fmt.Errorf("deleting live state for game %s: %w", gameID, err)
+	}
+	return nil
+}
@@ -0,0 +1,85 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+func TestThatUpsertingLiveGameStateReplacesEarlierReport(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a game with a stored live state
+	seed := mustSeedWithTeams(t, ctx, 2)
+	repo := db.NewGameResultRepository(pool)
+	state := &models.LiveGameState{
+		TournamentID: seed.tournament.ID, GameID: "East-round_of_64-1",
+		Team1ID: seed.teams[0].ID, Team2ID: seed.teams[1].ID,
+		Team1Score: 10, Team2Score: 8, SecondsRemaining: 1800, Source: "manual",
+	}
+	if err := repo.UpsertLiveGameState(ctx, state); err != nil {
+		t.Fatalf("storing live state: %v", err)
+	}
+
+	// WHEN a later report for the game is stored
+	later := *state
+	later.Team1Score, later.Team2Score, later.SecondsRemaining = 40, 44, 600
+	if err := repo.UpsertLiveGameState(ctx, &later); err != nil {
+		t.Fatalf("storing live state: %v", err)
+	}
+
+	// THEN only the later report remains
+	states, err := repo.ListLiveGameStates(ctx, seed.tournament.ID)
+	if err != nil {
+		t.Fatalf("listing live states: %v", err)
+	}
+	if len(states) != 1 || states[0].SecondsRemaining != 600 {
+		t.Errorf("expected one state with 600 seconds left, got %+v", states)
+	}
+}
+
+func TestThatRecordingGameResultClearsLiveGameState(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a game in progress
+	seed := mustSeedWithTeams(t, ctx, 2)
+	repo := db.NewGameResultRepository(pool)
+	if err := repo.UpsertLiveGameState(ctx, &models.LiveGameState{
+		TournamentID: seed.tournament.ID, GameID: "East-round_of_64-1",
+		Team1ID: seed.teams[0].ID, Team2ID: seed.teams[1].ID,
+		Team1Score: 60, Team2Score: 58, SecondsRemaining: 30, Source: "manual",
+	}); err != nil {
+		t.Fatalf("storing live state: %v", err)
+	}
+
+	// WHEN the game's result is recorded
+	if err := repo.RecordGameResults(ctx, seed.tournament.ID, []*models.GameResult{
+		{GameID: "East-round_of_64-1", WinnerTeamID: seed.teams[0].ID, LoserTeamID: seed.teams[1].ID},
+	}); err != nil {
+		t.Fatalf("recording result: %v", err)
+	}
+
+	// THEN the live state is cleared
+	states, err := repo.ListLiveGameStates(ctx, seed.tournament.ID)
+	if err != nil {
+		t.Fatalf("listing live states: %v", err)
+	}
+	if len(states) != 0 {
+		t.Errorf("expected no live states, got %d", len(states))
+	}
+}
@@ -0,0 +1,124 @@
+package db
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ ports.MarketRevealRepository = (*MarketRevealRepository)(nil)
+
+// MarketRevealRepository stores the market summary frozen when a pool's bids
+// are revealed.
+type MarketRevealRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewMarketRevealRepository(pool *pgxpool.Pool) *MarketRevealRepository {
+	return &MarketRevealRepository{pool: pool}
+}
+
+func (r *MarketRevealRepository) GetMarketReveal(ctx context.Context, poolID string) (*models.MarketReveal, error) {
+	reveal := &models.MarketReveal{}
+	var teams []byte
+	err := r.pool.QueryRow(ctx, `
+		SELECT id::text, pool_id::text, revealed_at, revealed_by::text, prediction_batch_id::text,
+			total_credits, portfolio_count, teams, created_at
+		FROM core.pool_market_reveals
+		WHERE pool_id = $1::uuid
+			AND deleted_at IS NULL
+	`, poolID).Scan(
+		&reveal.ID, &reveal.PoolID, &reveal.RevealedAt, &reveal.RevealedBy, &reveal.PredictionBatchID,
+		&reveal.TotalCredits, &reveal.PortfolioCount, &teams, &reveal.CreatedAt,
+	)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("getting market reveal for pool %s: %w", poolID, err)
+	}
+	if err := json.Unmarshal(teams, &reveal.Teams); err != nil {
+		return nil, fmt.Errorf("decoding market reveal teams for pool %s: %w", poolID, err)
+	}
+	return reveal, nil
+}
+
+func (r *MarketRevealRepository) CreateMarketReveal(ctx context.Context, reveal *models.MarketReveal) error {
+	teams := reveal.Teams
+	if teams == nil {
+		teams = []models.MarketRevealTeam{}
+	}
+	teamsJSON, err := json.Marshal(teams)
+	if err != nil {
+		return fmt.Errorf("encoding market reveal teams for pool %s: %w", reveal.PoolID, err)
+	}
+
+	err = r.pool.QueryRow(ctx, `
+		INSERT INTO core.pool_market_reveals
+			(pool_id, revealed_at, revealed_by, prediction_batch_id, total_credits, portfolio_count, teams)
+		VALUES ($1::uuid, $2, $3::uuid, $4::uuid, $5, $6, $7::jsonb)
+		RETURNING id::text, created_at
+	`, reveal.PoolID, reveal.RevealedAt, reveal.RevealedBy, reveal.PredictionBatchID,
+		reveal.TotalCredits, reveal.PortfolioCount, teamsJSON,
+	).Scan(&reveal.ID, &reveal.CreatedAt)
+	if err != nil {
+		var pgErr *pgconn.PgError
+		if errors.As(err, &pgErr) && pgErr.Code == "23505" {
+			return &apperrors.AlreadyExistsError{Resource: "market reveal", Field: "pool_id", Value: reveal.PoolID}
+		}
+		return fmt.Errorf("creating market reveal for pool %s: %w", reveal.PoolID, err)
+	}
+	return nil
+}
+
+func (r *MarketRevealRepository) ListPoolIDsAwaitingReveal(ctx context.Context, now time.Time) ([]string, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT pl.id::text
+		FROM core.pools pl
+		JOIN core.tournaments t ON t.id = pl.tournament_id AND t.deleted_at IS NULL
+		WHERE pl.deleted_at IS NULL
+			AND NOT EXISTS (
+				SELECT 1
+				FROM core.pool_market_reveals mr
+				WHERE mr.pool_id = pl.id
+					AND mr.deleted_at IS NULL
+			)
+			AND (
+				t.starting_at <= $1
+				OR EXISTS (
+					SELECT 1
+					FROM core.pool_bidding_windows w
+					WHERE w.pool_id = pl.id
+						AND w.deleted_at IS NULL
+						AND w.closes_at <= $1
+				)
+			)
+		ORDER BY pl.created_at
+	`, now)
+	if err != nil {
+		return nil, fmt.Errorf("listing pools awaiting reveal: %w", err)
+	}
+	defer rows.Close()
+
+	var ids []string
+	for rows.Next() {
+		var id string
+		if err := rows.Scan(&id); err != nil {
+			return nil, fmt.Errorf("scanning pool awaiting reveal: %w", err)
+		}
+		ids = append(ids, id)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating pools awaiting reveal: %w", err)
+	}
+	return ids, nil
+}
@@ -0,0 +1,135 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	db "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+func TestThatMarketRevealRoundTripsTeams(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a pool whose market has been revealed
+	base := mustSeedBase(t, ctx)
+	repo := db.NewMarketRevealRepository(pool)
+	reveal := &models.MarketReveal{
+		PoolID:         base.pool.ID,
+		RevealedAt:     time.Date(2026, 3, 19, 16, 0, 0, 0, time.UTC),
+		TotalCredits:   100,
+		PortfolioCount: 1,
+		Teams:          []models.MarketRevealTeam{{TeamID: "team-1", TotalCredits: 100, Bidders: 1, MarketShare: 1, MarketRank: 1}},
+	}
+	if err := repo.CreateMarketReveal(ctx, reveal); err != nil {
+		t.Fatalf("creating market reveal: %v", err)
+	}
+
+	// WHEN reading it back
+	got, err := repo.GetMarketReveal(ctx, base.pool.ID)
+	if err != nil {
+		t.Fatalf("getting market reveal: %v", err)
+	}
+
+	// THEN the team summary survives the round trip
+	if len(got.Teams) != 1 || got.Teams[0].TotalCredits != 100 {
+		t.Errorf("expected one team with 100 credits, got %+v", got.Teams)
+	}
+}
+
+func TestThatSecondMarketRevealForPoolIsRejected(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a pool whose market has been revealed
+	base := mustSeedBase(t, ctx)
+	repo := db.NewMarketRevealRepository(pool)
+	revealedAt := time.Date(2026, 3, 19, 16, 0, 0, 0, time.UTC)
+	if err := repo.CreateMarketReveal(ctx, &models.MarketReveal{PoolID: base.pool.ID, RevealedAt: revealedAt}); err != nil {
+		t.Fatalf("creating market reveal: %v", err)
+	}
+
+	// WHEN revealing it again
+	err := repo.CreateMarketReveal(ctx, &models.MarketReveal{PoolID: base.pool.ID, RevealedAt: revealedAt.Add(time.Hour)})
+
+	// THEN the second reveal is rejected
+	var alreadyExists *apperrors.AlreadyExistsError
+	if !errors.As(err, &alreadyExists) {
+		t.Errorf("expected AlreadyExistsError, got %v", err)
+	}
+}
+
+func TestThatPoolWhoseTournamentStartedAwaitsReveal(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a pool whose tournament tipped off an hour ago
+	base := mustSeedBase(t, ctx)
+	now := time.Date(2026, 3, 19, 17, 0, 0, 0, time.UTC)
+	startingAt := now.Add(-time.Hour)
+	if err := base.tournamentRepo.UpdateStartingAt(ctx, base.tournament.ID, &startingAt); err != nil {
+		t.Fatalf("updating tournament start: %v", err)
+	}
+
+	// WHEN listing pools awaiting reveal
+	got, err := db.NewMarketRevealRepository(pool).ListPoolIDsAwaitingReveal(ctx, now)
+	if err != nil {
+		t.Fatalf("listing pools awaiting reveal: %v", err)
+	}
+
+	// THEN the pool is listed
+	if len(got) != 1 || got[0] != base.pool.ID {
+		t.Errorf("expected [%s], got %v", base.pool.ID, got)
+	}
+}
+
+func TestThatRevealedPoolNoLongerAwaitsReveal(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a started pool whose market has been frozen
+	base := mustSeedBase(t, ctx)
+	now := time.Date(2026, 3, 19, 17, 0, 0, 0, time.UTC)
+	startingAt := now.Add(-time.Hour)
+	if err := base.tournamentRepo.UpdateStartingAt(ctx, base.tournament.ID, &startingAt); err != nil {
+		t.Fatalf("updating tournament start: %v", err)
+	}
+	repo := db.NewMarketRevealRepository(pool)
+	if err := repo.CreateMarketReveal(ctx, &models.MarketReveal{PoolID: base.pool.ID, RevealedAt: startingAt}); err != nil {
+		t.Fatalf("creating market reveal: %v", err)
+	}
+
+	// WHEN listing pools awaiting reveal
+	got, err := repo.ListPoolIDsAwaitingReveal(ctx, now)
+	if err != nil {
+		t.Fatalf("listing pools awaiting reveal: %v", err)
+	}
+
+	// THEN the pool is not listed
+	if len(got) != 0 {
+		t.Errorf("expected no pools, got %v", got)
+	}
+}
@@ -0,0 +1,262 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// MatchupProbabilityRepository stores externally supplied matchup probability
+// tables.
+type MatchupProbabilityRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewMatchupProbabilityRepository(pool *pgxpool.Pool) *MatchupProbabilityRepository {
+	return &MatchupProbabilityRepository{pool: pool}
+}
+
+// LoadTeamIDsBySchoolSlug maps each school slug in the tournament to its team ID.
+func (r *MatchupProbabilityRepository) LoadTeamIDsBySchoolSlug(ctx context.Context, tournamentID string) (map[string]string, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT s.slug, t.id::text
+		FROM core.teams t
+		JOIN core.schools s
+			ON s.id = t.school_id
+			AND s.deleted_at IS NULL
+		WHERE t.tournament_id = $1::uuid
+			AND t.deleted_at IS NULL
+	`, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("querying tournament teams: %w", err)
+	}
+	defer rows.Close()
+
+	out := make(map[string]string)
+	for rows.Next() {
+		var slug, teamID string
+		if err := rows.Scan(&slug, &teamID); err != nil {
+			return nil, fmt.Errorf("scanning tournament team: %w", err)
+		}
+		out[slug] = teamID
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating tournament teams: %w", err)
+	}
+	return out, nil
+}
+
+// ReplaceMatchupProbabilityTable stores the table under its tournament and
+// source key, replacing any probabilities previously uploaded under that key.
+func (r *MatchupProbabilityRepository) ReplaceMatchupProbabilityTable(ctx context.Context, table *models.MatchupProbabilityTable) (*models.MatchupProbabilityTable, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	var tableID string
+	err = tx.QueryRow(ctx, `
+		SELECT id::text
+		FROM core.matchup_probability_tables
+		WHERE tournament_id = $1::uuid
+			AND source_key = $2
+			AND deleted_at IS NULL
+		FOR UPDATE
+	`, table.TournamentID, table.SourceKey).Scan(&tableID)
+	switch {
+	case errors.Is(err, pgx.ErrNoRows):
+		if err := tx.QueryRow(ctx, `
+			INSERT INTO core.matchup_probability_tables (tournament_id, source_key, description, uploaded_by)
+			VALUES ($1::uuid, $2, $3, $4::uuid)
+			RETURNING id::text
+		`, table.TournamentID, table.SourceKey, table.Description, table.UploadedBy).Scan(&tableID); err != nil {
+			return nil, fmt.Errorf("creating matchup probability table: %w", err)
+		}
+	case err != nil:
+		return nil, fmt.Errorf("locking matchup probability table: %w", err)
+	default:
+		if _, err := tx.Exec(ctx, `
+			UPDATE core.matchup_probability_tables
+			SET description = $2, uploaded_by = $3::uuid
+			WHERE id = $1::uuid
+		`, tableID, table.Description, table.UploadedBy); err != nil {
+			return nil, fmt.Errorf("updating matchup probability table: %w", err)
+		}
+		if _, err := tx.Exec(ctx, `
+			DELETE FROM core.matchup_probabilities
+			WHERE table_id = $1::uuid
+		`, tableID); err != nil {
+			return nil, fmt.Errorf("clearing matchup probabilities: %w", err)
+		}
+	}
+
+	pairs := make([]models.TeamPair, 0, len(table.Probabilities))
+	for pair := range table.Probabilities {
+		pairs = append(pairs, pair)
+	}
+	sort.Slice(pairs, func(i, j int) bool {
+		if pairs[i].Team1ID != pairs[j].Team1ID {
+			return pairs[i].Team1ID < pairs[j].Team1ID
+		}
+		return pairs[i].Team2ID < pairs[j].Team2ID
+	})
+	if _, err := tx.CopyFrom(ctx,
+		pgx.Identifier{"core", "matchup_probabilities"},
+		[]string{"table_id", "team1_id", "team2_id", "p_team1_wins"},
+		pgx.CopyFromSlice(len(pairs), func(i int) ([]any, error) {
+			p := pairs[i]
+			return []any{tableID, p.Team1ID, p.Team2ID, table.Probabilities[p]}, nil
+		}),
+	); err != nil {
+		return nil, fmt.Errorf("copying matchup probabilities: %w", err)
+	}
+
+	stored, err := getMatchupProbabilityTable(ctx, tx, table.TournamentID, table.SourceKey)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing matchup probability table: %w", err)
+	}
+	committed = true
+	return stored, nil
+}
+
+// ListMatchupProbabilityTables returns the tournament's tables without their
+// probabilities.
+func (r *MatchupProbabilityRepository) ListMatchupProbabilityTables(ctx context.Context, tournamentID string) ([]*models.MatchupProbabilityTable, error) {
+	rows, err := r.pool.Query(ctx, matchupProbabilityTableSelect+`
+		WHERE mpt.tournament_id = $1::uuid
+			AND mpt.deleted_at IS NULL
+		ORDER BY mpt.source_key ASC
+	`, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("listing matchup probability tables: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*models.MatchupProbabilityTable, 0)
+	for rows.Next() {
+		t, err := scanMatchupProbabilityTable(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, t)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating matchup probability tables: %w", err)
+	}
+	return out, nil
+}
+
+// LoadMatchupProbabilityTable returns the table with its probabilities, or nil
+// when nothing has been uploaded under the source key.
+func (r *MatchupProbabilityRepository) LoadMatchupProbabilityTable(ctx context.Context, tournamentID, sourceKey string) (*models.MatchupProbabilityTable, error) {
+	table, err := getMatchupProbabilityTable(ctx, r.pool, tournamentID, sourceKey)
+	if err != nil {
+		var notFound *apperrors.NotFoundError
+		if errors.As(err, &notFound) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	rows, err := r.pool.Query(ctx, `
+		SELECT team1_id::text, team2_id::text, p_team1_wins
+		FROM core.matchup_probabilities
+		WHERE table_id = $1::uuid
+	`, table.ID)
+	if err != nil {
+		return nil, fmt.Errorf("querying matchup probabilities: %w", err)
+	}
+	defer rows.Close()
+
+	table.Probabilities = make(map[models.TeamPair]float64, table.PairCount*2)
+	for rows.Next() {
+		var pair models.TeamPair
+		var p float64
+		if err := rows.Scan(&pair.Team1ID, &pair.Team2ID, &p); err != nil {
+			return nil, fmt.Errorf("scanning matchup probability: %w", err)
+		}
+		table.Probabilities[pair] = p
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating matchup probabilities: %w", err)
+	}
+	return table, nil
+}
+
+// DeleteMatchupProbabilityTable soft-deletes the table uploaded under the
+// source key.
+func (r *MatchupProbabilityRepository) DeleteMatchupProbabilityTable(ctx context.Context, tournamentID, sourceKey string) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE core.matchup_probability_tables
+		SET deleted_at = NOW()
+		WHERE tournament_id = $1::uuid
+			AND source_key = $2
+			AND deleted_at IS NULL
+	`, tournamentID, sourceKey)
+	if err != nil {
+		return fmt.Errorf("deleting matchup probability table: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return &apperrors.NotFoundError{Resource: "matchup probability table", ID: sourceKey}
+	}
+	return nil
+}
+
+const matchupProbabilityTableSelect = `
+	SELECT
+		mpt.id::text,
+		mpt.tournament_id::text,
+		mpt.source_key,
+		mpt.description,
+		mpt.uploaded_by::text,
+		(SELECT COUNT(*) FROM core.matchup_probabilities mp WHERE mp.table_id = mpt.id) / 2 AS pair_count,
+		mpt.created_at,
+		mpt.updated_at
+	FROM core.matchup_probability_tables mpt
+`
+
+type queryRower interface {
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+func getMatchupProbabilityTable(ctx context.Context, q queryRower, tournamentID, sourceKey string) (*models.MatchupProbabilityTable, error) {
+	row := q.QueryRow(ctx, matchupProbabilityTableSelect+`
+		WHERE mpt.tournament_id = $1::uuid
+			AND mpt.source_key = $2
+			AND mpt.deleted_at IS NULL
+	`, tournamentID, sourceKey)
+	t, err := scanMatchupProbabilityTable(row)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &apperrors.NotFoundError{Resource: "matchup probability table", ID: sourceKey}
+	}
+	return t, err
+}
+
+func scanMatchupProbabilityTable(row pgx.Row) (*models.MatchupProbabilityTable, error) {
+	t := &models.MatchupProbabilityTable{}
+	var pairCount int64
+	if err := row.Scan(&t.ID, &t.TournamentID, &t.SourceKey, &t.Description, &t.UploadedBy, &pairCount, &t.CreatedAt, &t.UpdatedAt); err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, err
+		}
+		return nil, fmt.Errorf("scanning matchup probability table: %w", err)
+	}
+	t.PairCount = int(pairCount)
+	return t, nil
+}
@@ -0,0 +1,108 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+func TestThatStoredMatchupProbabilityTableLoadsBothOrientations(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a table storing both orientations of one pair
+	seed := mustSeedWithTeams(t, ctx, 2)
+	repo := db.NewMatchupProbabilityRepository(pool)
+	a, b := seed.teams[0].ID, seed.teams[1].ID
+	_, err := repo.ReplaceMatchupProbabilityTable(ctx, &models.MatchupProbabilityTable{
+		TournamentID: seed.tournament.ID,
+		SourceKey:    "analyst_v1",
+		Probabilities: map[models.TeamPair]float64{
+			{Team1ID: a, Team2ID: b}: 0.7,
+			{Team1ID: b, Team2ID: a}: 0.3,
+		},
+	})
+	if err != nil {
+		t.Fatalf("storing table: %v", err)
+	}
+
+	// WHEN loading the table
+	table, err := repo.LoadMatchupProbabilityTable(ctx, seed.tournament.ID, "analyst_v1")
+	if err != nil {
+		t.Fatalf("loading table: %v", err)
+	}
+
+	// THEN the reverse orientation is available
+	if p, ok := table.Lookup(b, a); !ok || p != 0.3 {
+		t.Errorf("expected 0.3 for reverse pair, got %v (found=%v)", p, ok)
+	}
+}
+
+func TestThatReplacingMatchupProbabilityTableDropsPreviousPairs(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a table uploaded for teams a and b
+	seed := mustSeedWithTeams(t, ctx, 3)
+	repo := db.NewMatchupProbabilityRepository(pool)
+	a, b, c := seed.teams[0].ID, seed.teams[1].ID, seed.teams[2].ID
+	for _, probs := range []map[models.TeamPair]float64{
+		{{Team1ID: a, Team2ID: b}: 0.6, {Team1ID: b, Team2ID: a}: 0.4},
+		// WHEN it is replaced by a table for teams a and c
+		{{Team1ID: a, Team2ID: c}: 0.8, {Team1ID: c, Team2ID: a}: 0.2},
+	} {
+		if _, err := repo.ReplaceMatchupProbabilityTable(ctx, &models.MatchupProbabilityTable{
+			TournamentID:  seed.tournament.ID,
+			SourceKey:     "analyst_v1",
+			Probabilities: probs,
+		}); err != nil {
+			t.Fatalf("storing table: %v", err)
+		}
+	}
+
+	// THEN the earlier pair is gone
+	table, err := repo.LoadMatchupProbabilityTable(ctx, seed.tournament.ID, "analyst_v1")
+	if err != nil {
+		t.Fatalf("loading table: %v", err)
+	}
+	if _, ok := table.Lookup(a, b); ok {
+		t.Errorf("expected pair from the replaced upload to be removed")
+	}
+}
+
+func TestThatLoadingMissingMatchupProbabilityTableReturnsNil(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a tournament with no uploaded tables
+	seed := mustSeedWithTeams(t, ctx, 2)
+	repo := db.NewMatchupProbabilityRepository(pool)
+
+	// WHEN loading a table by source key
+	table, err := repo.LoadMatchupProbabilityTable(ctx, seed.tournament.ID, "analyst_v1")
+	if err != nil {
+		t.Fatalf("loading table: %v", err)
+	}
+
+	// THEN nil is returned
+	if table != nil {
+		t.Errorf("expected nil table, got %+v", table)
+	}
+}
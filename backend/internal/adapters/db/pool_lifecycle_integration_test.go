@@ -529,7 +529,7 @@ func TestThatLifecycleCompletedPayoutsDistributeCorrectly(t *testing.T) {
 		t.Fatalf("getting payouts: %v", err)
 	}
 
-	standings := pool.ComputeStandings(portfolios, returnsByPortfolio, payouts)
+	standings := pool.ComputeStandings(portfolios, returnsByPortfolio, payouts, nil)
 	standingsByID := make(map[string]*models.PortfolioStanding, len(standings))
 	for _, s := range standings {
 		standingsByID[s.PortfolioID] = s
@@ -0,0 +1,97 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+func TestThatWinnerTakeAllPoolGivesTopBidderWholeTeam(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a winner-take-all pool where Alpha bids 30 and Beta bids 10 on one team
+	seed := mustSeedWithTeams(t, ctx, 1)
+	seed.pool.OwnershipMode = models.OwnershipModeWinnerTakeAll
+	if err := seed.poolRepo.Update(ctx, seed.pool); err != nil {
+		t.Fatalf("updating pool: %v", err)
+	}
+	alpha := &models.Portfolio{Name: "Alpha", UserID: &seed.user.ID, PoolID: seed.pool.ID}
+	beta := &models.Portfolio{Name: "Beta", PoolID: seed.pool.ID}
+	for p, credits := range map[*models.Portfolio]int{alpha: 30, beta: 10} {
+		investments := []*models.Investment{{TeamID: seed.teams[0].ID, Credits: credits}}
+		if err := seed.poolRepo.CreatePortfolio(ctx, p, investments); err != nil {
+			t.Fatalf("creating portfolio: %v", err)
+		}
+	}
+
+	// WHEN reading ownership details
+	details, err := seed.poolRepo.GetOwnershipDetailsByPortfolioIDs(ctx, []string{alpha.ID})
+	if err != nil {
+		t.Fatalf("getting ownership details: %v", err)
+	}
+
+	// THEN Alpha owns the whole team
+	if len(details[alpha.ID]) != 1 || details[alpha.ID][0].OwnershipPercentage != 1 {
+		t.Errorf("expected Alpha to own the whole team, got %+v", details[alpha.ID])
+	}
+}
+
+func TestThatOwnershipDetailsMatchTheSharedOwnershipCases(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN one pool per ownership case, each owner bidding on its one team
+	for _, c := range testutil.OwnershipCases() {
+		seed := mustSeedWithTeams(t, ctx, 1)
+		seed.pool.OwnershipMode = c.Mode
+		seed.pool.OwnershipCapPercent = nil
+		if c.CapPercent > 0 {
+			seed.pool.OwnershipCapPercent = &c.CapPercent
+		}
+		if err := seed.poolRepo.Update(ctx, seed.pool); err != nil {
+			t.Fatalf("%s: updating pool: %v", c.Name, err)
+		}
+		ownerByPortfolio := make(map[string]string, len(c.Credits))
+		portfolioIDs := make([]string, 0, len(c.Credits))
+		for owner, credits := range c.Credits {
+			p := &models.Portfolio{Name: owner, PoolID: seed.pool.ID}
+			investments := []*models.Investment{{TeamID: seed.teams[0].ID, Credits: credits}}
+			if err := seed.poolRepo.CreatePortfolio(ctx, p, investments); err != nil {
+				t.Fatalf("%s: creating portfolio: %v", c.Name, err)
+			}
+			ownerByPortfolio[p.ID] = owner
+			portfolioIDs = append(portfolioIDs, p.ID)
+		}
+
+		// WHEN reading ownership details
+		details, err := seed.poolRepo.GetOwnershipDetailsByPortfolioIDs(ctx, portfolioIDs)
+		if err != nil {
+			t.Fatalf("%s: getting ownership details: %v", c.Name, err)
+		}
+
+		// THEN every owner gets the case's share, as app/ownership computes it
+		for portfolioID, owner := range ownerByPortfolio {
+			got := 0.0
+			for _, d := range details[portfolioID] {
+				got += d.OwnershipPercentage
+			}
+			if math.Abs(got-c.Want[owner]) > 1e-9 {
+				t.Errorf("%s: expected %s to own %v, got %v", c.Name, owner, c.Want[owner], got)
+			}
+		}
+	}
+}
@@ -40,6 +40,66 @@ func TestThatCreatePortfolioRejectsDuplicateUserInSamePool(t *testing.T) {
 	}
 }
 
+func TestThatCreatePortfolioAllowsSecondPortfolioWhenPoolLimitIsTwo(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a pool allowing two portfolios per user and a user holding one
+	base := mustSeedBase(t, ctx)
+	base.pool.MaxPortfoliosPerUser = 2
+	if err := base.poolRepo.Update(ctx, base.pool); err != nil {
+		t.Fatalf("updating pool: %v", err)
+	}
+	_ = mustSeedPortfolio(t, ctx, base.poolRepo, base.pool.ID, base.user.ID)
+
+	// WHEN creating a second portfolio for the same user
+	_ = mustSeedPortfolio(t, ctx, base.poolRepo, base.pool.ID, base.user.ID)
+
+	// THEN the user holds two portfolios
+	count, err := base.poolRepo.CountPortfoliosByUser(ctx, base.pool.ID, base.user.ID)
+	if err != nil {
+		t.Fatalf("counting portfolios: %v", err)
+	}
+	if count != 2 {
+		t.Errorf("expected 2 portfolios, got %d", count)
+	}
+}
+
+func TestThatCreatePortfolioRejectsPortfolioBeyondPoolLimit(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a pool allowing two portfolios per user and a user holding two
+	base := mustSeedBase(t, ctx)
+	base.pool.MaxPortfoliosPerUser = 2
+	if err := base.poolRepo.Update(ctx, base.pool); err != nil {
+		t.Fatalf("updating pool: %v", err)
+	}
+	_ = mustSeedPortfolio(t, ctx, base.poolRepo, base.pool.ID, base.user.ID)
+	_ = mustSeedPortfolio(t, ctx, base.poolRepo, base.pool.ID, base.user.ID)
+
+	// WHEN creating a third portfolio for the same user
+	err := base.poolRepo.CreatePortfolio(ctx, &models.Portfolio{
+		Name:   "Third Portfolio",
+		UserID: &base.user.ID,
+		PoolID: base.pool.ID,
+	})
+
+	// THEN the error is an AlreadyExistsError
+	var alreadyExists *apperrors.AlreadyExistsError
+	if !errors.As(err, &alreadyExists) {
+		t.Errorf("expected *apperrors.AlreadyExistsError, got %T: %v", err, err)
+	}
+}
+
 func TestThatCreatePortfolioAllowsSameUserInDifferentPools(t *testing.T) {
 	ctx := context.Background()
 	t.Cleanup(func() {
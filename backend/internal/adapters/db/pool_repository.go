@@ -43,6 +43,13 @@ func (r *PoolRepository) GetAll(ctx context.Context) ([]*models.Pool, error) {
 			MaxInvestmentCredits: int(row.MaxInvestmentCredits),
 			BudgetCredits:        int(row.BudgetCredits),
 			Visibility:           row.Visibility,
+			OwnershipMode:        row.OwnershipMode,
+			OwnershipCapPercent:  optionalInt(row.OwnershipCapPercent),
+			UnclaimedMode:        row.UnclaimedMode,
+			TieBreaker:           row.TieBreaker,
+			EntryFeeCents:        int(row.EntryFeeCents),
+			MaxPortfoliosPerUser: int(row.MaxPortfoliosPerUser),
+			RevealAt:             TimestamptzToPtrTimeUTC(row.RevealAt),
 			CreatedAt:            row.CreatedAt.Time,
 			UpdatedAt:            row.UpdatedAt.Time,
 			DeletedAt:            nil,
@@ -70,6 +77,13 @@ func (r *PoolRepository) GetByUserID(ctx context.Context, userID string) ([]*mod
 			MaxInvestmentCredits: int(row.MaxInvestmentCredits),
 			BudgetCredits:        int(row.BudgetCredits),
 			Visibility:           row.Visibility,
+			OwnershipMode:        row.OwnershipMode,
+			OwnershipCapPercent:  optionalInt(row.OwnershipCapPercent),
+			UnclaimedMode:        row.UnclaimedMode,
+			TieBreaker:           row.TieBreaker,
+			EntryFeeCents:        int(row.EntryFeeCents),
+			MaxPortfoliosPerUser: int(row.MaxPortfoliosPerUser),
+			RevealAt:             TimestamptzToPtrTimeUTC(row.RevealAt),
 			CreatedAt:            row.CreatedAt.Time,
 			UpdatedAt:            row.UpdatedAt.Time,
 		})
@@ -111,6 +125,13 @@ func (r *PoolRepository) GetByID(ctx context.Context, id string) (*models.Pool,
 		MaxInvestmentCredits: int(row.MaxInvestmentCredits),
 		BudgetCredits:        int(row.BudgetCredits),
 		Visibility:           row.Visibility,
+		OwnershipMode:        row.OwnershipMode,
+		OwnershipCapPercent:  optionalInt(row.OwnershipCapPercent),
+		UnclaimedMode:        row.UnclaimedMode,
+		TieBreaker:           row.TieBreaker,
+		EntryFeeCents:        int(row.EntryFeeCents),
+		MaxPortfoliosPerUser: int(row.MaxPortfoliosPerUser),
+		RevealAt:             TimestamptzToPtrTimeUTC(row.RevealAt),
 		CreatedAt:            row.CreatedAt.Time,
 		UpdatedAt:            row.UpdatedAt.Time,
 		DeletedAt:            nil,
@@ -136,6 +157,13 @@ func (r *PoolRepository) GetPoolsByTournament(ctx context.Context, tournamentID
 			MaxInvestmentCredits: int(row.MaxInvestmentCredits),
 			BudgetCredits:        int(row.BudgetCredits),
 			Visibility:           row.Visibility,
+			OwnershipMode:        row.OwnershipMode,
+			OwnershipCapPercent:  optionalInt(row.OwnershipCapPercent),
+			UnclaimedMode:        row.UnclaimedMode,
+			TieBreaker:           row.TieBreaker,
+			EntryFeeCents:        int(row.EntryFeeCents),
+			MaxPortfoliosPerUser: int(row.MaxPortfoliosPerUser),
+			RevealAt:             TimestamptzToPtrTimeUTC(row.RevealAt),
 			CreatedAt:            row.CreatedAt.Time,
 			UpdatedAt:            row.UpdatedAt.Time,
 			DeletedAt:            TimestamptzToPtrTime(row.DeletedAt),
@@ -164,6 +192,18 @@ func (r *PoolRepository) Create(ctx context.Context, pool *models.Pool) error {
 	if pool.Visibility == "" {
 		pool.Visibility = "private"
 	}
+	if pool.OwnershipMode == "" {
+		pool.OwnershipMode = models.OwnershipModeProportional
+	}
+	if pool.UnclaimedMode == "" {
+		pool.UnclaimedMode = models.UnclaimedModeForfeit
+	}
+	if pool.TieBreaker == "" {
+		pool.TieBreaker = models.TieBreakerSplit
+	}
+	if pool.MaxPortfoliosPerUser == 0 {
+		pool.MaxPortfoliosPerUser = models.DefaultMaxPortfoliosPerUser
+	}
 	params := sqlc.CreatePoolParams{
 		ID:                   pool.ID,
 		TournamentID:         pool.TournamentID,
@@ -175,6 +215,13 @@ func (r *PoolRepository) Create(ctx context.Context, pool *models.Pool) error {
 		MaxInvestmentCredits: int32(pool.MaxInvestmentCredits),
 		BudgetCredits:        int32(pool.BudgetCredits),
 		Visibility:           pool.Visibility,
+		OwnershipMode:        pool.OwnershipMode,
+		OwnershipCapPercent:  optionalInt32(pool.OwnershipCapPercent),
+		UnclaimedMode:        pool.UnclaimedMode,
+		TieBreaker:           pool.TieBreaker,
+		EntryFeeCents:        int32(pool.EntryFeeCents),
+		MaxPortfoliosPerUser: int32(pool.MaxPortfoliosPerUser),
+		RevealAt:             optionalTimestamptz(pool.RevealAt),
 		CreatedAt:            pgtype.Timestamptz{Time: pool.CreatedAt, Valid: true},
 		UpdatedAt:            pgtype.Timestamptz{Time: pool.UpdatedAt, Valid: true},
 	}
@@ -211,6 +258,13 @@ func (r *PoolRepository) Update(ctx context.Context, pool *models.Pool) error {
 		MaxInvestmentCredits: int32(pool.MaxInvestmentCredits),
 		BudgetCredits:        int32(pool.BudgetCredits),
 		Visibility:           pool.Visibility,
+		OwnershipMode:        pool.OwnershipMode,
+		OwnershipCapPercent:  optionalInt32(pool.OwnershipCapPercent),
+		UnclaimedMode:        pool.UnclaimedMode,
+		TieBreaker:           pool.TieBreaker,
+		EntryFeeCents:        int32(pool.EntryFeeCents),
+		MaxPortfoliosPerUser: int32(pool.MaxPortfoliosPerUser),
+		RevealAt:             optionalTimestamptz(pool.RevealAt),
 		UpdatedAt:            pgtype.Timestamptz{Time: pool.UpdatedAt, Valid: true},
 		ID:                   pool.ID,
 	}
@@ -240,6 +294,7 @@ func (r *PoolRepository) GetScoringRules(ctx context.Context, poolID string) ([]
 		out = append(out, &models.ScoringRule{
 			ID:            row.ID,
 			PoolID:        row.PoolID,
+			Kind:          row.Kind,
 			WinIndex:      int(row.Round),
 			PointsAwarded: int(row.Points),
 			CreatedAt:     row.CreatedAt.Time,
@@ -255,6 +310,9 @@ func (r *PoolRepository) CreateScoringRule(ctx context.Context, rule *models.Sco
 	rule.ID = uuid.New().String()
 	rule.CreatedAt = now
 	rule.UpdatedAt = now
+	if rule.Kind == "" {
+		rule.Kind = models.ScoringRuleKindRound
+	}
 
 	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
 	if err != nil {
@@ -270,6 +328,7 @@ func (r *PoolRepository) CreateScoringRule(ctx context.Context, rule *models.Sco
 	params := sqlc.CreateScoringRuleParams{
 		ID:            rule.ID,
 		PoolID:        rule.PoolID,
+		Kind:          rule.Kind,
 		WinIndex:      int32(rule.WinIndex),
 		PointsAwarded: int32(rule.PointsAwarded),
 		CreatedAt:     pgtype.Timestamptz{Time: rule.CreatedAt, Valid: true},
@@ -42,6 +42,28 @@ func (r *PoolRepository) CreatePortfolio(ctx context.Context, portfolio *models.
 
 	qtx := r.q.WithTx(tx)
 
+	// Lock the pool row so concurrent creates for the same user see each
+	// other's portfolios before the limit is checked.
+	if portfolio.UserID != nil {
+		var limit, held int32
+		limit, err = qtx.LockPoolPortfolioLimit(ctx, portfolio.PoolID)
+		if err != nil {
+			if errors.Is(err, pgx.ErrNoRows) {
+				err = &apperrors.NotFoundError{Resource: "pool", ID: portfolio.PoolID}
+				return err
+			}
+			return fmt.Errorf("locking pool %s: %w", portfolio.PoolID, err)
+		}
+		held, err = qtx.CountPortfoliosByPoolAndUser(ctx, sqlc.CountPortfoliosByPoolAndUserParams{PoolID: portfolio.PoolID, UserID: userID})
+		if err != nil {
+			return fmt.Errorf("counting portfolios for user %s: %w", *portfolio.UserID, err)
+		}
+		if held >= limit {
+			err = &apperrors.AlreadyExistsError{Resource: "portfolio", Field: "user_id", Value: *portfolio.UserID}
+			return err
+		}
+	}
+
 	params := sqlc.CreatePortfolioParams{
 		ID:     portfolio.ID,
 		Name:   portfolio.Name,
@@ -80,6 +102,21 @@ func (r *PoolRepository) CreatePortfolio(ctx context.Context, portfolio *models.
 	return nil
 }
 
+func (r *PoolRepository) CountPortfoliosByUser(ctx context.Context, poolID, userID string) (int, error) {
+	parsed, err := uuid.Parse(userID)
+	if err != nil {
+		return 0, fmt.Errorf("parsing user ID %s: %w", userID, err)
+	}
+	count, err := r.q.CountPortfoliosByPoolAndUser(ctx, sqlc.CountPortfoliosByPoolAndUserParams{
+		PoolID: poolID,
+		UserID: pgtype.UUID{Bytes: parsed, Valid: true},
+	})
+	if err != nil {
+		return 0, fmt.Errorf("counting portfolios for user %s in pool %s: %w", userID, poolID, err)
+	}
+	return int(count), nil
+}
+
 func (r *PoolRepository) GetPortfolios(ctx context.Context, poolID string) ([]*models.Portfolio, map[string]float64, error) {
 	rows, err := r.q.ListPortfoliosByPoolID(ctx, poolID)
 	if err != nil {
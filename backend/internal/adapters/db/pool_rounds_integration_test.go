@@ -119,3 +119,38 @@ func TestThatCreateScoringRuleAllowsZeroPointsAwarded(t *testing.T) {
 		t.Errorf("expected no error for zero points_awarded, got %v", err)
 	}
 }
+
+func TestThatScoringRulesOfDifferentKindsCanShareWinIndex(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a pool with a round rule at win index 7
+	base := mustSeedBase(t, ctx)
+	round := &models.ScoringRule{PoolID: base.pool.ID, WinIndex: 7, PointsAwarded: 320}
+	if err := base.poolRepo.CreateScoringRule(ctx, round); err != nil {
+		t.Fatalf("creating round rule: %v", err)
+	}
+
+	// WHEN adding a champion bonus at the same win index and reading the rules back
+	bonus := &models.ScoringRule{PoolID: base.pool.ID, Kind: models.ScoringRuleKindChampionBonus, WinIndex: 7, PointsAwarded: 100}
+	if err := base.poolRepo.CreateScoringRule(ctx, bonus); err != nil {
+		t.Fatalf("creating champion bonus: %v", err)
+	}
+	rules, err := base.poolRepo.GetScoringRules(ctx, base.pool.ID)
+	if err != nil {
+		t.Fatalf("getting scoring rules: %v", err)
+	}
+
+	// THEN both rules are returned with their kinds
+	kinds := map[string]bool{}
+	for _, r := range rules {
+		kinds[r.Kind] = true
+	}
+	if len(rules) != 2 || !kinds[models.ScoringRuleKindRound] || !kinds[models.ScoringRuleKindChampionBonus] {
+		t.Errorf("expected a round rule and a champion bonus, got %+v", rules)
+	}
+}
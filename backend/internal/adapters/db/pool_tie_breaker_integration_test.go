@@ -0,0 +1,38 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+func TestThatPoolTieBreakerRoundTrips(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a pool switched to the fewest-credits tie-breaker
+	seed := mustSeedBase(t, ctx)
+	seed.pool.TieBreaker = models.TieBreakerFewestCredits
+	if err := seed.poolRepo.Update(ctx, seed.pool); err != nil {
+		t.Fatalf("updating pool: %v", err)
+	}
+
+	// WHEN reading the pool back
+	got, err := seed.poolRepo.GetByID(ctx, seed.pool.ID)
+	if err != nil {
+		t.Fatalf("getting pool: %v", err)
+	}
+
+	// THEN the tie-breaker is stored
+	if got.TieBreaker != models.TieBreakerFewestCredits {
+		t.Errorf("expected tie-breaker %q, got %q", models.TieBreakerFewestCredits, got.TieBreaker)
+	}
+}
@@ -0,0 +1,53 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"math"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+func TestThatProRataPoolSharesUnclaimedTeamByCredits(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a pro-rata pool where Alpha bids 30 and Beta bids 10 on one team and nobody bids on the other
+	seed := mustSeedWithTeams(t, ctx, 2)
+	seed.pool.UnclaimedMode = models.UnclaimedModeProRata
+	if err := seed.poolRepo.Update(ctx, seed.pool); err != nil {
+		t.Fatalf("updating pool: %v", err)
+	}
+	alpha := &models.Portfolio{Name: "Alpha", UserID: &seed.user.ID, PoolID: seed.pool.ID}
+	beta := &models.Portfolio{Name: "Beta", PoolID: seed.pool.ID}
+	for p, credits := range map[*models.Portfolio]int{alpha: 30, beta: 10} {
+		investments := []*models.Investment{{TeamID: seed.teams[0].ID, Credits: credits}}
+		if err := seed.poolRepo.CreatePortfolio(ctx, p, investments); err != nil {
+			t.Fatalf("creating portfolio: %v", err)
+		}
+	}
+
+	// WHEN reading ownership details
+	details, err := seed.poolRepo.GetOwnershipDetailsByPortfolioIDs(ctx, []string{alpha.ID})
+	if err != nil {
+		t.Fatalf("getting ownership details: %v", err)
+	}
+
+	// THEN Alpha owns three quarters of the unclaimed team
+	var unclaimedShare float64
+	for _, d := range details[alpha.ID] {
+		if d.TeamID == seed.teams[1].ID {
+			unclaimedShare = d.OwnershipPercentage
+		}
+	}
+	if math.Abs(unclaimedShare-0.75) > 1e-9 {
+		t.Errorf("expected Alpha to own 0.75 of the unclaimed team, got %v", unclaimedShare)
+	}
+}
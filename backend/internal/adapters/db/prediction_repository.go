@@ -94,11 +94,42 @@ func (r *PredictionRepository) GetTeamValues(ctx context.Context, batchID string
 			PRound6:              row.PRound6,
 			PRound7:              row.PRound7,
 			FavoritesTotalPoints: row.FavoritesTotalPoints,
+			ExpectedUpsetMargins: row.ExpectedUpsetMargins,
 		})
 	}
 	return out, nil
 }
 
+// GetMatchups returns the batch's matchups ordered by round and game.
+func (r *PredictionRepository) GetMatchups(ctx context.Context, batchID string) ([]models.PredictedMatchup, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT game_id, round_order, team1_id::text, team2_id::text, p_matchup, p_team1_wins, projected_spread, spread_sd
+		FROM compute.predicted_matchups
+		WHERE prediction_batch_id = $1::uuid
+		ORDER BY round_order ASC, game_id ASC, p_matchup DESC
+	`, batchID)
+	if err != nil {
+		return nil, fmt.Errorf("querying predicted matchups: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]models.PredictedMatchup, 0)
+	for rows.Next() {
+		var m models.PredictedMatchup
+		var round int32
+		if err := rows.Scan(&m.GameID, &round, &m.Team1ID, &m.Team2ID, &m.PMatchup, &m.PTeam1WinsGivenMatchup, &m.ProjectedSpread, &m.SpreadSD); err != nil {
+			return nil, fmt.Errorf("scanning predicted matchup: %w", err)
+		}
+		m.RoundOrder = int(round)
+		m.PTeam2WinsGivenMatchup = 1.0 - m.PTeam1WinsGivenMatchup
+		out = append(out, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating predicted matchups: %w", err)
+	}
+	return out, nil
+}
+
 func (r *PredictionRepository) LoadTeams(ctx context.Context, tournamentID string) ([]models.PredictionTeamInput, error) {
 	rows, err := r.q.GetTeamsWithKenpomForPrediction(ctx, tournamentID)
 	if err != nil {
@@ -107,17 +138,31 @@ func (r *PredictionRepository) LoadTeams(ctx context.Context, tournamentID strin
 	out := make([]models.PredictionTeamInput, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, models.PredictionTeamInput{
-			ID:        row.TID,
-			Seed:      int(row.Seed),
-			Region:    row.Region,
-			KenPomNet: row.KenpomNet,
-			Wins:      int(row.Wins),
-			Byes:      int(row.Byes),
+			ID:            row.TID,
+			Seed:          int(row.Seed),
+			Region:        row.Region,
+			KenPomNet:     row.KenpomNet,
+			Wins:          int(row.Wins),
+			Byes:          int(row.Byes),
+			WinPct:        seasonWinPct(row.SeasonWins, row.SeasonLosses),
+			ORtg:          derefFloat(row.ORtg),
+			DRtg:          derefFloat(row.DRtg),
+			AdjT:          derefFloat(row.AdjT),
+			HomeLatitude:  row.Latitude,
+			HomeLongitude: row.Longitude,
 		})
 	}
 	return out, nil
 }
 
+// seasonWinPct returns wins/(wins+losses), or 0 when the record is unknown.
+func seasonWinPct(wins, losses *int32) float64 {
+	if wins == nil || losses == nil || *wins+*losses == 0 {
+		return 0
+	}
+	return float64(*wins) / float64(*wins+*losses)
+}
+
 func (r *PredictionRepository) LoadScoringRules(ctx context.Context, tournamentID string) ([]scoring.Rule, error) {
 	rows, err := r.q.GetScoringRulesForTournament(ctx, tournamentID)
 	if err != nil {
@@ -126,6 +171,7 @@ func (r *PredictionRepository) LoadScoringRules(ctx context.Context, tournamentI
 	out := make([]scoring.Rule, 0, len(rows))
 	for _, row := range rows {
 		out = append(out, scoring.Rule{
+			Kind:          row.Kind,
 			WinIndex:      int(row.PsrWinIndex),
 			PointsAwarded: int(row.PsrPointsAwarded),
 		})
@@ -155,6 +201,26 @@ func (r *PredictionRepository) LoadFinalFourConfig(ctx context.Context, tourname
 	return cfg, nil
 }
 
+func (r *PredictionRepository) LoadBracketTemplate(ctx context.Context, tournamentID string) (*models.BracketTemplate, error) {
+	return LoadBracketTemplate(ctx, r.pool, tournamentID)
+}
+
+func (r *PredictionRepository) LoadGameResults(ctx context.Context, tournamentID string) ([]*models.GameResult, error) {
+	return NewGameResultRepository(r.pool).ListGameResults(ctx, tournamentID)
+}
+
+func (r *PredictionRepository) LoadMatchupProbabilityTable(ctx context.Context, tournamentID, sourceKey string) (*models.MatchupProbabilityTable, error) {
+	return NewMatchupProbabilityRepository(r.pool).LoadMatchupProbabilityTable(ctx, tournamentID, sourceKey)
+}
+
+func (r *PredictionRepository) LoadGameSites(ctx context.Context, tournamentID string) ([]*models.GameSite, error) {
+	return ListGameSites(ctx, r.pool, tournamentID)
+}
+
+func (r *PredictionRepository) LoadLiveGameStates(ctx context.Context, tournamentID string) ([]*models.LiveGameState, error) {
+	return ListLiveGameStates(ctx, r.pool, tournamentID)
+}
+
 func (r *PredictionRepository) ListEligibleTournamentsForBackfill(ctx context.Context) ([]string, error) {
 	ids, err := r.q.ListEligibleTournamentsForBackfill(ctx)
 	if err != nil {
@@ -169,6 +235,7 @@ func (r *PredictionRepository) StorePredictions(
 	probSourceKey string,
 	specJSON []byte,
 	values []models.PredictedTeamValue,
+	matchups []models.PredictedMatchup,
 	throughRound int,
 ) (string, error) {
 	tx, err := r.pool.Begin(ctx)
@@ -207,12 +274,24 @@ func (r *PredictionRepository) StorePredictions(
 			PRound6:              &v.PRound6,
 			PRound7:              &v.PRound7,
 			FavoritesTotalPoints: &v.FavoritesTotalPoints,
+			ExpectedUpsetMargins: v.ExpectedUpsetMargins,
 		}
 	}
 	if _, err := qtx.BulkCreatePredictedTeamValues(ctx, bulkParams); err != nil {
 		return "", fmt.Errorf("failed to bulk insert team values: %w", err)
 	}
 
+	if _, err := tx.CopyFrom(ctx,
+		pgx.Identifier{"compute", "predicted_matchups"},
+		[]string{"prediction_batch_id", "game_id", "round_order", "team1_id", "team2_id", "p_matchup", "p_team1_wins", "projected_spread", "spread_sd"},
+		pgx.CopyFromSlice(len(matchups), func(i int) ([]any, error) {
+			m := matchups[i]
+			return []any{batchID, m.GameID, int32(m.RoundOrder), m.Team1ID, m.Team2ID, m.PMatchup, m.PTeam1WinsGivenMatchup, m.ProjectedSpread, m.SpreadSD}, nil
+		}),
+	); err != nil {
+		return "", fmt.Errorf("failed to copy predicted matchups: %w", err)
+	}
+
 	if err := tx.Commit(ctx); err != nil {
 		return "", fmt.Errorf("committing predictions: %w", err)
 	}
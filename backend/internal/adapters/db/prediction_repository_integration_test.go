@@ -392,7 +392,7 @@ func TestThatStorePredictionsAndGetTeamValuesRoundTripAllFields(t *testing.T) {
 	repo := db.NewPredictionRepository(pool)
 
 	// WHEN storing predictions and retrieving them
-	batchID, err := repo.StorePredictions(ctx, seed.tournamentID, "kenpom", []byte(`{"kind":"kenpom"}`), values, 0)
+	batchID, err := repo.StorePredictions(ctx, seed.tournamentID, "kenpom", []byte(`{"kind":"kenpom"}`), values, nil, 0)
 	if err != nil {
 		t.Fatalf("storing predictions: %v", err)
 	}
@@ -459,7 +459,7 @@ func TestThatStorePredictionsCreatesRetrievableBatch(t *testing.T) {
 	repo := db.NewPredictionRepository(pool)
 
 	// WHEN storing predictions with throughRound=2
-	batchID, err := repo.StorePredictions(ctx, seed.tournamentID, "kenpom-v2", []byte(`{}`), values, 2)
+	batchID, err := repo.StorePredictions(ctx, seed.tournamentID, "kenpom-v2", []byte(`{}`), values, nil, 2)
 	if err != nil {
 		t.Fatalf("storing predictions: %v", err)
 	}
@@ -824,3 +824,40 @@ func TestThatPredictionServiceRunCreatesTeamValuesForAllTeams(t *testing.T) {
 		}
 	}
 }
+
+func TestThatStorePredictionsAndGetMatchupsRoundTripProjectedSpread(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() { testutil.TruncateAll(ctx, pool) })
+
+	// GIVEN a matchup with a projected spread
+	seed := seedTournamentWithTeamsAndKenPom(t, ctx)
+	values := buildKnownTeamValues(seed.teamIDs)
+	spread, sd := 4.5, 10.2
+	matchups := []models.PredictedMatchup{{
+		GameID:                 "R2-East-1-16",
+		RoundOrder:             2,
+		Team1ID:                seed.teamIDs[0],
+		Team2ID:                seed.teamIDs[1],
+		PMatchup:               1.0,
+		PTeam1WinsGivenMatchup: 0.67,
+		PTeam2WinsGivenMatchup: 0.33,
+		ProjectedSpread:        &spread,
+		SpreadSD:               &sd,
+	}}
+	repo := db.NewPredictionRepository(pool)
+
+	// WHEN storing predictions and retrieving the matchups
+	batchID, err := repo.StorePredictions(ctx, seed.tournamentID, "kenpom", []byte(`{"kind":"tempo"}`), values, matchups, 0)
+	if err != nil {
+		t.Fatalf("storing predictions: %v", err)
+	}
+	got, err := repo.GetMatchups(ctx, batchID)
+	if err != nil {
+		t.Fatalf("getting matchups: %v", err)
+	}
+
+	// THEN the projected spread is returned
+	if len(got) != 1 || got[0].ProjectedSpread == nil || math.Abs(*got[0].ProjectedSpread-spread) > 0.001 {
+		t.Errorf("expected one matchup with spread %.1f, got %+v", spread, got)
+	}
+}
@@ -34,6 +34,8 @@ func (r *SchoolRepository) List(ctx context.Context) ([]models.School, error) {
 		schools = append(schools, models.School{
 			ID:      row.ID,
 			Name:    row.Name,
+			Latitude:  row.Latitude,
+			Longitude: row.Longitude,
 			CreatedAt: row.CreatedAt.Time,
 			UpdatedAt: row.UpdatedAt.Time,
 		})
@@ -70,7 +72,25 @@ func (r *SchoolRepository) GetByID(ctx context.Context, id string) (*models.Scho
 	return &models.School{
 		ID:      row.ID,
 		Name:    row.Name,
+		Latitude:  row.Latitude,
+		Longitude: row.Longitude,
 		CreatedAt: row.CreatedAt.Time,
 		UpdatedAt: row.UpdatedAt.Time,
 	}, nil
 }
+
+// UpdateLocation sets or, with nil coordinates, clears the campus location.
+func (r *SchoolRepository) UpdateLocation(ctx context.Context, id string, latitude, longitude *float64) error {
+	n, err := r.q.UpdateSchoolLocation(ctx, sqlc.UpdateSchoolLocationParams{
+		Latitude:  latitude,
+		Longitude: longitude,
+		ID:        id,
+	})
+	if err != nil {
+		return fmt.Errorf("updating school location %s: %w", id, err)
+	}
+	if n == 0 {
+		return &apperrors.NotFoundError{Resource: "school", ID: id}
+	}
+	return nil
+}
@@ -0,0 +1,247 @@
+package db
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ ports.ScoreFeedRepository = (*ScoreFeedRepository)(nil)
+
+// ScoreFeedRepository stores school name mappings and the review queue for
+// feed team names that need an admin to match them.
+type ScoreFeedRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewScoreFeedRepository(pool *pgxpool.Pool) *ScoreFeedRepository {
+	return &ScoreFeedRepository{pool: pool}
+}
+
+// ListSchoolSlugs returns every school's ID, slug and name.
+func (r *ScoreFeedRepository) ListSchoolSlugs(ctx context.Context) ([]models.SchoolSlug, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id::text, slug, name
+		FROM core.schools
+		WHERE deleted_at IS NULL
+		ORDER BY name ASC
+	`)
+	if err != nil {
+		return nil, fmt.Errorf("listing schools: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]models.SchoolSlug, 0)
+	for rows.Next() {
+		var s models.SchoolSlug
+		if err := rows.Scan(&s.ID, &s.Slug, &s.Name); err != nil {
+			return nil, fmt.Errorf("scanning school: %w", err)
+		}
+		out = append(out, s)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating schools: %w", err)
+	}
+	return out, nil
+}
+
+const schoolNameMappingSelect = `
+	SELECT id::text, source, external_name, name_key, school_id::text, created_by::text, created_at, updated_at
+	FROM core.school_name_mappings
+`
+
+func (r *ScoreFeedRepository) ListSchoolNameMappings(ctx context.Context, source string) ([]*models.SchoolNameMapping, error) {
+	rows, err := r.pool.Query(ctx, schoolNameMappingSelect+`
+		WHERE deleted_at IS NULL
+			AND ($1 = '' OR source = $1)
+		ORDER BY source ASC, name_key ASC
+	`, source)
+	if err != nil {
+		return nil, fmt.Errorf("listing school name mappings: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*models.SchoolNameMapping, 0)
+	for rows.Next() {
+		m, err := scanSchoolNameMapping(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, m)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating school name mappings: %w", err)
+	}
+	return out, nil
+}
+
+// UpsertSchoolNameMapping stores the mapping, repointing any existing mapping
+// for the same source and name key.
+func (r *ScoreFeedRepository) UpsertSchoolNameMapping(ctx context.Context, mapping *models.SchoolNameMapping) error {
+	return upsertSchoolNameMapping(ctx, r.pool, mapping)
+}
+
+func upsertSchoolNameMapping(ctx context.Context, q queryRower, mapping *models.SchoolNameMapping) error {
+	if err := q.QueryRow(ctx, `
+		INSERT INTO core.school_name_mappings (source, external_name, name_key, school_id, created_by)
+		VALUES ($1, $2, $3, $4::uuid, $5::uuid)
+		ON CONFLICT (source, name_key) WHERE (deleted_at IS NULL)
+		DO UPDATE SET
+			external_name = EXCLUDED.external_name,
+			school_id = EXCLUDED.school_id,
+			created_by = EXCLUDED.created_by
+		RETURNING id::text, created_at, updated_at
+	`, mapping.Source, mapping.ExternalName, mapping.NameKey, mapping.SchoolID, mapping.CreatedBy,
+	).Scan(&mapping.ID, &mapping.CreatedAt, &mapping.UpdatedAt); err != nil {
+		return fmt.Errorf("storing school name mapping: %w", err)
+	}
+	return nil
+}
+
+func (r *ScoreFeedRepository) DeleteSchoolNameMapping(ctx context.Context, id string) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE core.school_name_mappings
+		SET deleted_at = NOW()
+		WHERE id = $1::uuid
+			AND deleted_at IS NULL
+	`, id)
+	if err != nil {
+		return fmt.Errorf("deleting school name mapping: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return &apperrors.NotFoundError{Resource: "school name mapping", ID: id}
+	}
+	return nil
+}
+
+func scanSchoolNameMapping(row pgx.Row) (*models.SchoolNameMapping, error) {
+	m := &models.SchoolNameMapping{}
+	if err := row.Scan(&m.ID, &m.Source, &m.ExternalName, &m.NameKey, &m.SchoolID, &m.CreatedBy, &m.CreatedAt, &m.UpdatedAt); err != nil {
+		return nil, fmt.Errorf("scanning school name mapping: %w", err)
+	}
+	return m, nil
+}
+
+const scoreFeedReviewSelect = `
+	SELECT id::text, source, external_name, name_key, candidate_school_ids::text[], status,
+		resolved_school_id::text, resolved_by::text, resolved_at, created_at, updated_at
+	FROM core.score_feed_reviews
+`
+
+func (r *ScoreFeedRepository) EnqueueScoreFeedReview(ctx context.Context, review *models.ScoreFeedReview) error {
+	candidates := review.CandidateSchoolIDs
+	if candidates == nil {
+		candidates = []string{}
+	}
+	if _, err := r.pool.Exec(ctx, `
+		INSERT INTO core.score_feed_reviews (source, external_name, name_key, candidate_school_ids)
+		VALUES ($1, $2, $3, $4::uuid[])
+		ON CONFLICT (source, name_key) WHERE (status = 'pending')
+		DO UPDATE SET candidate_school_ids = EXCLUDED.candidate_school_ids
+	`, review.Source, review.ExternalName, review.NameKey, candidates); err != nil {
+		return fmt.Errorf("enqueueing score feed review: %w", err)
+	}
+	return nil
+}
+
+func (r *ScoreFeedRepository) ListScoreFeedReviews(ctx context.Context, status string) ([]*models.ScoreFeedReview, error) {
+	rows, err := r.pool.Query(ctx, scoreFeedReviewSelect+`
+		WHERE ($1 = '' OR status = $1)
+		ORDER BY created_at ASC
+	`, status)
+	if err != nil {
+		return nil, fmt.Errorf("listing score feed reviews: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*models.ScoreFeedReview, 0)
+	for rows.Next() {
+		rv, err := scanScoreFeedReview(rows)
+		if err != nil {
+			return nil, err
+		}
+		out = append(out, rv)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating score feed reviews: %w", err)
+	}
+	return out, nil
+}
+
+func (r *ScoreFeedRepository) ResolveScoreFeedReview(ctx context.Context, id, schoolID string, resolvedBy *string) (*models.SchoolNameMapping, error) {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("beginning transaction: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	var source, externalName, nameKey string
+	err = tx.QueryRow(ctx, `
+		UPDATE core.score_feed_reviews
+		SET status = 'resolved', resolved_school_id = $2::uuid, resolved_by = $3::uuid, resolved_at = NOW()
+		WHERE id = $1::uuid
+			AND status = 'pending'
+		RETURNING source, external_name, name_key
+	`, id, schoolID, resolvedBy).Scan(&source, &externalName, &nameKey)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, &apperrors.NotFoundError{Resource: "pending score feed review", ID: id}
+	}
+	if err != nil {
+		return nil, fmt.Errorf("resolving score feed review: %w", err)
+	}
+
+	mapping := &models.SchoolNameMapping{
+		Source:       source,
+		ExternalName: externalName,
+		NameKey:      nameKey,
+		SchoolID:     schoolID,
+		CreatedBy:    resolvedBy,
+	}
+	if err := upsertSchoolNameMapping(ctx, tx, mapping); err != nil {
+		return nil, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("committing score feed review: %w", err)
+	}
+	committed = true
+	return mapping, nil
+}
+
+func (r *ScoreFeedRepository) DismissScoreFeedReview(ctx context.Context, id string, resolvedBy *string) error {
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE core.score_feed_reviews
+		SET status = 'dismissed', resolved_by = $2::uuid, resolved_at = NOW()
+		WHERE id = $1::uuid
+			AND status = 'pending'
+	`, id, resolvedBy)
+	if err != nil {
+		return fmt.Errorf("dismissing score feed review: %w", err)
+	}
+	if tag.RowsAffected() == 0 {
+		return &apperrors.NotFoundError{Resource: "pending score feed review", ID: id}
+	}
+	return nil
+}
+
+func scanScoreFeedReview(row pgx.Row) (*models.ScoreFeedReview, error) {
+	rv := &models.ScoreFeedReview{}
+	if err := row.Scan(
+		&rv.ID, &rv.Source, &rv.ExternalName, &rv.NameKey, &rv.CandidateSchoolIDs, &rv.Status,
+		&rv.ResolvedSchoolID, &rv.ResolvedBy, &rv.ResolvedAt, &rv.CreatedAt, &rv.UpdatedAt,
+	); err != nil {
+		return nil, fmt.Errorf("scanning score feed review: %w", err)
+	}
+	return rv, nil
+}
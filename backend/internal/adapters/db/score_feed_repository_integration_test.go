@@ -0,0 +1,78 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+func TestThatEnqueueingScoreFeedReviewTwiceKeepsOnePendingReview(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a feed name already queued for review
+	seed := mustSeedWithTeams(t, ctx, 2)
+	repo := db.NewScoreFeedRepository(pool)
+	review := &models.ScoreFeedReview{Source: "test", ExternalName: "UVM", NameKey: "uvm"}
+	if err := repo.EnqueueScoreFeedReview(ctx, review); err != nil {
+		t.Fatalf("enqueueing review: %v", err)
+	}
+
+	// WHEN the same name is queued again with candidates
+	again := &models.ScoreFeedReview{Source: "test", ExternalName: "UVM", NameKey: "uvm", CandidateSchoolIDs: []string{seed.teams[0].SchoolID}}
+	if err := repo.EnqueueScoreFeedReview(ctx, again); err != nil {
+		t.Fatalf("enqueueing review: %v", err)
+	}
+
+	// THEN one pending review remains with the latest candidates
+	reviews, err := repo.ListScoreFeedReviews(ctx, models.ScoreFeedReviewPending)
+	if err != nil {
+		t.Fatalf("listing reviews: %v", err)
+	}
+	if len(reviews) != 1 || len(reviews[0].CandidateSchoolIDs) != 1 {
+		t.Errorf("expected one review with one candidate, got %+v", reviews)
+	}
+}
+
+func TestThatResolvingScoreFeedReviewCreatesMapping(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a pending review
+	seed := mustSeedWithTeams(t, ctx, 1)
+	repo := db.NewScoreFeedRepository(pool)
+	if err := repo.EnqueueScoreFeedReview(ctx, &models.ScoreFeedReview{Source: "test", ExternalName: "UVM", NameKey: "uvm"}); err != nil {
+		t.Fatalf("enqueueing review: %v", err)
+	}
+	reviews, err := repo.ListScoreFeedReviews(ctx, models.ScoreFeedReviewPending)
+	if err != nil {
+		t.Fatalf("listing reviews: %v", err)
+	}
+
+	// WHEN the review is resolved to a school
+	if _, err := repo.ResolveScoreFeedReview(ctx, reviews[0].ID, seed.teams[0].SchoolID, nil); err != nil {
+		t.Fatalf("resolving review: %v", err)
+	}
+
+	// THEN the source maps the name to the school
+	mappings, err := repo.ListSchoolNameMappings(ctx, "test")
+	if err != nil {
+		t.Fatalf("listing mappings: %v", err)
+	}
+	if len(mappings) != 1 || mappings[0].NameKey != "uvm" || mappings[0].SchoolID != seed.teams[0].SchoolID {
+		t.Errorf("expected uvm mapped to the school, got %+v", mappings)
+	}
+}
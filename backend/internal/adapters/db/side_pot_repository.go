@@ -0,0 +1,147 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+var _ ports.SidePotRepository = (*SidePotRepository)(nil)
+
+// SidePotRepository stores a pool's side pots and their payout tables.
+type SidePotRepository struct {
+	pool *pgxpool.Pool
+}
+
+func NewSidePotRepository(pool *pgxpool.Pool) *SidePotRepository {
+	return &SidePotRepository{pool: pool}
+}
+
+func (r *SidePotRepository) ListSidePots(ctx context.Context, poolID string) ([]*models.SidePot, error) {
+	rows, err := r.pool.Query(ctx, `
+		SELECT id::text, pool_id::text, name, metric, through_win_index, created_at, updated_at
+		FROM core.side_pots
+		WHERE pool_id = $1::uuid
+			AND deleted_at IS NULL
+		ORDER BY created_at ASC, name ASC
+	`, poolID)
+	if err != nil {
+		return nil, fmt.Errorf("listing side pots for pool %s: %w", poolID, err)
+	}
+	defer rows.Close()
+
+	out := make([]*models.SidePot, 0)
+	byID := make(map[string]*models.SidePot)
+	for rows.Next() {
+		pot := &models.SidePot{Payouts: []*models.SidePotPayout{}}
+		if err := rows.Scan(&pot.ID, &pot.PoolID, &pot.Name, &pot.Metric, &pot.ThroughWinIndex, &pot.CreatedAt, &pot.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scanning side pot: %w", err)
+		}
+		out = append(out, pot)
+		byID[pot.ID] = pot
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating side pots: %w", err)
+	}
+	if len(out) == 0 {
+		return out, nil
+	}
+
+	payoutRows, err := r.pool.Query(ctx, `
+		SELECT pp.side_pot_id::text, pp.position, pp.amount_cents
+		FROM core.side_pot_payouts pp
+		JOIN core.side_pots sp ON sp.id = pp.side_pot_id
+		WHERE sp.pool_id = $1::uuid
+			AND sp.deleted_at IS NULL
+			AND pp.deleted_at IS NULL
+		ORDER BY pp.position ASC
+	`, poolID)
+	if err != nil {
+		return nil, fmt.Errorf("listing side pot payouts for pool %s: %w", poolID, err)
+	}
+	defer payoutRows.Close()
+
+	for payoutRows.Next() {
+		var potID string
+		payout := &models.SidePotPayout{}
+		if err := payoutRows.Scan(&potID, &payout.Position, &payout.AmountCents); err != nil {
+			return nil, fmt.Errorf("scanning side pot payout: %w", err)
+		}
+		if pot, ok := byID[potID]; ok {
+			pot.Payouts = append(pot.Payouts, payout)
+		}
+	}
+	if err := payoutRows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating side pot payouts: %w", err)
+	}
+	return out, nil
+}
+
+func (r *SidePotRepository) ReplaceSidePots(ctx context.Context, poolID string, pots []*models.SidePot) error {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("beginning transaction to replace side pots for pool %s: %w", poolID, err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	// Soft-delete existing pots and their payouts
+	if _, err := tx.Exec(ctx, `
+		UPDATE core.side_pot_payouts
+		SET deleted_at = NOW()
+		WHERE deleted_at IS NULL
+			AND side_pot_id IN (
+				SELECT id FROM core.side_pots WHERE pool_id = $1::uuid AND deleted_at IS NULL
+			)
+	`, poolID); err != nil {
+		return fmt.Errorf("soft-deleting side pot payouts for pool %s: %w", poolID, err)
+	}
+	if _, err := tx.Exec(ctx, `
+		UPDATE core.side_pots
+		SET deleted_at = NOW()
+		WHERE pool_id = $1::uuid
+			AND deleted_at IS NULL
+	`, poolID); err != nil {
+		return fmt.Errorf("soft-deleting side pots for pool %s: %w", poolID, err)
+	}
+
+	// Insert new pots
+	for _, pot := range pots {
+		if pot == nil {
+			continue
+		}
+		if err := tx.QueryRow(ctx, `
+			INSERT INTO core.side_pots (pool_id, name, metric, through_win_index)
+			VALUES ($1::uuid, $2, $3, $4)
+			RETURNING id::text, created_at, updated_at
+		`, poolID, pot.Name, pot.Metric, pot.ThroughWinIndex).Scan(&pot.ID, &pot.CreatedAt, &pot.UpdatedAt); err != nil {
+			return fmt.Errorf("creating side pot %q for pool %s: %w", pot.Name, poolID, err)
+		}
+		pot.PoolID = poolID
+		for _, payout := range pot.Payouts {
+			if payout == nil {
+				continue
+			}
+			if _, err := tx.Exec(ctx, `
+				INSERT INTO core.side_pot_payouts (side_pot_id, position, amount_cents)
+				VALUES ($1::uuid, $2, $3)
+			`, pot.ID, payout.Position, payout.AmountCents); err != nil {
+				return fmt.Errorf("creating payout for side pot %q: %w", pot.Name, err)
+			}
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing transaction to replace side pots for pool %s: %w", poolID, err)
+	}
+	committed = true
+	return nil
+}
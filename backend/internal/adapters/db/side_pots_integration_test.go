@@ -0,0 +1,50 @@
+//go:build integration
+
+package db_test
+
+import (
+	"context"
+	"testing"
+
+	db "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+func TestThatReplaceSidePotsSwapsPotsAndPayouts(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() {
+		if err := testutil.TruncateAll(ctx, pool); err != nil {
+			t.Fatalf("truncating tables: %v", err)
+		}
+	})
+
+	// GIVEN a pool with a last-place side pot
+	base := mustSeedBase(t, ctx)
+	repo := db.NewSidePotRepository(pool)
+	initial := []*models.SidePot{{Name: "Last Place", Metric: models.SidePotMetricFewestPoints, Payouts: []*models.SidePotPayout{{Position: 1, AmountCents: 1000}}}}
+	if err := repo.ReplaceSidePots(ctx, base.pool.ID, initial); err != nil {
+		t.Fatalf("creating side pots: %v", err)
+	}
+
+	// WHEN replacing it with a first-weekend pot paying two places
+	through := 3
+	replacement := []*models.SidePot{{
+		Name:            "First Weekend",
+		Metric:          models.SidePotMetricPointsThroughRound,
+		ThroughWinIndex: &through,
+		Payouts:         []*models.SidePotPayout{{Position: 1, AmountCents: 1000}, {Position: 2, AmountCents: 500}},
+	}}
+	if err := repo.ReplaceSidePots(ctx, base.pool.ID, replacement); err != nil {
+		t.Fatalf("replacing side pots: %v", err)
+	}
+
+	// THEN only the first-weekend pot and its payouts remain
+	got, err := repo.ListSidePots(ctx, base.pool.ID)
+	if err != nil {
+		t.Fatalf("listing side pots: %v", err)
+	}
+	if len(got) != 1 || got[0].Name != "First Weekend" || len(got[0].Payouts) != 2 {
+		t.Errorf("expected one first-weekend pot with two payouts, got %+v", got)
+	}
+}
@@ -10,46 +10,28 @@ import (
 )
 
 const getRegionAnalytics = `-- name: GetRegionAnalytics :many
-WITH portfolio_investments AS (
+WITH team_points AS (
   SELECT
-    inv.team_id,
-    p.pool_id,
-    inv.credits::float AS credits,
-    SUM(inv.credits::float) OVER (
-      PARTITION BY p.pool_id, inv.team_id
-    ) AS team_total_credits,
-    tt.wins,
-    tt.byes
-  FROM core.investments inv
-  JOIN core.portfolios p ON p.id = inv.portfolio_id AND p.deleted_at IS NULL
-  JOIN core.teams tt ON tt.id = inv.team_id AND tt.deleted_at IS NULL
-  WHERE inv.deleted_at IS NULL
+    od.team_id,
+    SUM(od.actual_returns)::float AS total_points
+  FROM derived.ownership_details od
+  GROUP BY od.team_id
 ),
-team_agg AS (
+team_bids AS (
   SELECT
-    team_id,
-    COALESCE(
-      SUM(
-        CASE
-          WHEN team_total_credits > 0 THEN
-            core.pool_returns_for_progress(pool_id, wins, byes)::float
-            * (credits / team_total_credits)
-          ELSE 0
-        END
-      ),
-      0
-    )::float AS total_points,
-    COALESCE(SUM(credits), 0)::float AS total_investment
-  FROM portfolio_investments
-  GROUP BY team_id
+    sh.team_id,
+    SUM(sh.credits)::float AS total_investment
+  FROM derived.investment_shares sh
+  GROUP BY sh.team_id
 )
 SELECT
   tt.region,
-  COALESCE(SUM(ta.total_points), 0)::float AS total_points,
-  COALESCE(SUM(ta.total_investment), 0)::float AS total_investment,
+  COALESCE(SUM(tp.total_points), 0)::float AS total_points,
+  COALESCE(SUM(tb.total_investment), 0)::float AS total_investment,
   COUNT(DISTINCT tt.id)::int AS team_count
 FROM core.teams tt
-LEFT JOIN team_agg ta ON ta.team_id = tt.id
+LEFT JOIN team_points tp ON tp.team_id = tt.id
+LEFT JOIN team_bids tb ON tb.team_id = tt.id
 WHERE tt.deleted_at IS NULL
 GROUP BY tt.region
 ORDER BY tt.region
@@ -88,46 +70,28 @@ func (q *Queries) GetRegionAnalytics(ctx context.Context) ([]GetRegionAnalyticsR
 }
 
 const getSeedAnalytics = `-- name: GetSeedAnalytics :many
-WITH portfolio_investments AS (
+WITH team_points AS (
   SELECT
-    inv.team_id,
-    p.pool_id,
-    inv.credits::float AS credits,
-    SUM(inv.credits::float) OVER (
-      PARTITION BY p.pool_id, inv.team_id
-    ) AS team_total_credits,
-    tt.wins,
-    tt.byes
-  FROM core.investments inv
-  JOIN core.portfolios p ON p.id = inv.portfolio_id AND p.deleted_at IS NULL
-  JOIN core.teams tt ON tt.id = inv.team_id AND tt.deleted_at IS NULL
-  WHERE inv.deleted_at IS NULL
+    od.team_id,
+    SUM(od.actual_returns)::float AS total_points
+  FROM derived.ownership_details od
+  GROUP BY od.team_id
 ),
-team_agg AS (
+team_bids AS (
   SELECT
-    team_id,
-    COALESCE(
-      SUM(
-        CASE
-          WHEN team_total_credits > 0 THEN
-            core.pool_returns_for_progress(pool_id, wins, byes)::float
-            * (credits / team_total_credits)
-          ELSE 0
-        END
-      ),
-      0
-    )::float AS total_points,
-    COALESCE(SUM(credits), 0)::float AS total_investment
-  FROM portfolio_investments
-  GROUP BY team_id
+    sh.team_id,
+    SUM(sh.credits)::float AS total_investment
+  FROM derived.investment_shares sh
+  GROUP BY sh.team_id
 )
 SELECT
   tt.seed,
-  COALESCE(SUM(ta.total_points), 0)::float AS total_points,
-  COALESCE(SUM(ta.total_investment), 0)::float AS total_investment,
+  COALESCE(SUM(tp.total_points), 0)::float AS total_points,
+  COALESCE(SUM(tb.total_investment), 0)::float AS total_investment,
   COUNT(DISTINCT tt.id)::int AS team_count
 FROM core.teams tt
-LEFT JOIN team_agg ta ON ta.team_id = tt.id
+LEFT JOIN team_points tp ON tp.team_id = tt.id
+LEFT JOIN team_bids tb ON tb.team_id = tt.id
 WHERE tt.deleted_at IS NULL
 GROUP BY tt.seed
 ORDER BY tt.seed
@@ -250,7 +214,7 @@ WITH team_investments AS (
     c.id AS pool_id,
     tt.id AS team_id,
     SUM(inv.credits)::float AS total_bid,
-    core.pool_returns_for_progress(c.id, tt.wins, tt.byes)::float AS actual_points
+    core.pool_team_returns(c.id, tt.id, COALESCE(tt.wins, 0) + COALESCE(tt.byes, 0))::float AS actual_points
   FROM core.investments inv
   JOIN core.portfolios p ON p.id = inv.portfolio_id AND p.deleted_at IS NULL
   JOIN core.pools c ON c.id = p.pool_id AND c.deleted_at IS NULL
@@ -319,51 +283,31 @@ func (q *Queries) GetSeedVarianceAnalytics(ctx context.Context) ([]GetSeedVarian
 }
 
 const getTeamAnalytics = `-- name: GetTeamAnalytics :many
-WITH portfolio_investments AS (
+WITH team_points AS (
   SELECT
-    inv.team_id,
-    p.pool_id,
-    inv.credits::float AS credits,
-    SUM(inv.credits::float) OVER (
-      PARTITION BY p.pool_id, inv.team_id
-    ) AS team_total_credits,
-    tt.school_id,
-    tt.wins,
-    tt.byes
-  FROM core.investments inv
-  JOIN core.portfolios p ON p.id = inv.portfolio_id AND p.deleted_at IS NULL
-  JOIN core.teams tt ON tt.id = inv.team_id AND tt.deleted_at IS NULL
-  WHERE inv.deleted_at IS NULL
+    od.team_id,
+    SUM(od.actual_returns)::float AS total_points
+  FROM derived.ownership_details od
+  GROUP BY od.team_id
 ),
-team_agg AS (
+team_bids AS (
   SELECT
-    team_id,
-    school_id,
-    COALESCE(
-      SUM(
-        CASE
-          WHEN team_total_credits > 0 THEN
-            core.pool_returns_for_progress(pool_id, wins, byes)::float
-            * (credits / team_total_credits)
-          ELSE 0
-        END
-      ),
-      0
-    )::float AS total_points,
-    COALESCE(SUM(credits), 0)::float AS total_investment
-  FROM portfolio_investments
-  GROUP BY team_id, school_id
+    sh.team_id,
+    SUM(sh.credits)::float AS total_investment
+  FROM derived.investment_shares sh
+  GROUP BY sh.team_id
 )
 SELECT
   s.id AS school_id,
   s.name AS school_name,
-  COALESCE(SUM(ta.total_points), 0)::float AS total_points,
-  COALESCE(SUM(ta.total_investment), 0)::float AS total_investment,
+  COALESCE(SUM(tp.total_points), 0)::float AS total_points,
+  COALESCE(SUM(tb.total_investment), 0)::float AS total_investment,
   COUNT(DISTINCT tt.id)::int AS appearances,
   COALESCE(SUM(tt.seed), 0)::int AS total_seed
 FROM core.schools s
 LEFT JOIN core.teams tt ON tt.school_id = s.id AND tt.deleted_at IS NULL
-LEFT JOIN team_agg ta ON ta.team_id = tt.id
+LEFT JOIN team_points tp ON tp.team_id = tt.id
+LEFT JOIN team_bids tb ON tb.team_id = tt.id
 WHERE s.deleted_at IS NULL
 GROUP BY s.id, s.name
 HAVING COUNT(DISTINCT tt.id) > 0
@@ -7,6 +7,8 @@ package sqlc
 
 import (
 	"context"
+
+	"github.com/jackc/pgx/v5/pgtype"
 )
 
 const createInvestmentSnapshot = `-- name: CreateInvestmentSnapshot :exec
@@ -30,3 +32,85 @@ func (q *Queries) CreateInvestmentSnapshot(ctx context.Context, arg CreateInvest
 	)
 	return err
 }
+
+const getInvestmentSnapshotAsOf = `-- name: GetInvestmentSnapshotAsOf :one
+SELECT id, portfolio_id, changed_by, reason, investments, created_at
+FROM core.investment_snapshots
+WHERE portfolio_id = $1
+  AND created_at <= $2
+  AND deleted_at IS NULL
+ORDER BY created_at DESC, id DESC
+LIMIT 1
+`
+
+type GetInvestmentSnapshotAsOfParams struct {
+	PortfolioID string
+	CreatedAt   pgtype.Timestamptz
+}
+
+type GetInvestmentSnapshotAsOfRow struct {
+	ID          string
+	PortfolioID string
+	ChangedBy   string
+	Reason      string
+	Investments []byte
+	CreatedAt   pgtype.Timestamptz
+}
+
+func (q *Queries) GetInvestmentSnapshotAsOf(ctx context.Context, arg GetInvestmentSnapshotAsOfParams) (GetInvestmentSnapshotAsOfRow, error) {
+	row := q.db.QueryRow(ctx, getInvestmentSnapshotAsOf, arg.PortfolioID, arg.CreatedAt)
+	var i GetInvestmentSnapshotAsOfRow
+	err := row.Scan(
+		&i.ID,
+		&i.PortfolioID,
+		&i.ChangedBy,
+		&i.Reason,
+		&i.Investments,
+		&i.CreatedAt,
+	)
+	return i, err
+}
+
+const listInvestmentSnapshots = `-- name: ListInvestmentSnapshots :many
+SELECT id, portfolio_id, changed_by, reason, investments, created_at
+FROM core.investment_snapshots
+WHERE portfolio_id = $1
+  AND deleted_at IS NULL
+ORDER BY created_at ASC, id ASC
+`
+
+type ListInvestmentSnapshotsRow struct {
+	ID          string
+	PortfolioID string
+	ChangedBy   string
+	Reason      string
+	Investments []byte
+	CreatedAt   pgtype.Timestamptz
+}
+
+func (q *Queries) ListInvestmentSnapshots(ctx context.Context, portfolioID string) ([]ListInvestmentSnapshotsRow, error) {
+	rows, err := q.db.Query(ctx, listInvestmentSnapshots, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+	var items []ListInvestmentSnapshotsRow
+	for rows.Next() {
+		var i ListInvestmentSnapshotsRow
+		if err := rows.Scan(
+			&i.ID,
+			&i.PortfolioID,
+			&i.ChangedBy,
+			&i.Reason,
+			&i.Investments,
+			&i.CreatedAt,
+		); err != nil {
+			return nil, err
+		}
+		items = append(items, i)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return items, nil
+}
@@ -37,6 +37,7 @@ type ComputePredictedTeamValue struct {
 	DeletedAt            pgtype.Timestamptz
 	FavoritesTotalPoints *float64
 	ActualPoints         *float64
+	ExpectedUpsetMargins []float64
 }
 
 // Stores metadata for prediction generation runs (analogous to simulated_tournaments for simulations)
@@ -53,6 +54,17 @@ type ComputePredictionBatch struct {
 	ThroughRound        int32
 }
 
+type ComputeSimulatedOutcomeChunk struct {
+	ID                    string
+	SimulatedTournamentID string
+	SimOffset             int32
+	NSims                 int32
+	BytesPerSim           int32
+	Outcomes              []byte
+	CreatedAt             pgtype.Timestamptz
+	Weights               []float64
+}
+
 type ComputeSimulatedTeam struct {
 	ID                    string
 	TournamentID          string
@@ -65,6 +77,17 @@ type ComputeSimulatedTeam struct {
 	UpdatedAt             pgtype.Timestamptz
 	DeletedAt             pgtype.Timestamptz
 	SimulatedTournamentID pgtype.UUID
+	BeatenSeeds           []int32
+}
+
+type ComputeSimulatedTeamRoundReach struct {
+	ID                    string
+	SimulatedTournamentID string
+	TournamentID          string
+	TeamID                string
+	ProgressCounts        []int32
+	CreatedAt             pgtype.Timestamptz
+	ProgressWeights       []float64
 }
 
 type ComputeSimulatedTournament struct {
@@ -77,6 +100,10 @@ type ComputeSimulatedTournament struct {
 	CreatedAt            pgtype.Timestamptz
 	UpdatedAt            pgtype.Timestamptz
 	DeletedAt            pgtype.Timestamptz
+	Storage              string
+	OutcomeLayout        []byte
+	StrengthUncertainty  []byte
+	Sampling             []byte
 }
 
 type ComputeTournamentSnapshot struct {
@@ -215,6 +242,12 @@ type CorePool struct {
 	Visibility           string
 	CreatedBy            string
 	EntryFeeCents        int32
+	OwnershipMode        string
+	OwnershipCapPercent  *int32
+	UnclaimedMode        string
+	TieBreaker           string
+	MaxPortfoliosPerUser int32
+	RevealAt             pgtype.Timestamptz
 }
 
 type CorePoolInvitation struct {
@@ -237,6 +270,7 @@ type CorePoolScoringRule struct {
 	CreatedAt     pgtype.Timestamptz
 	UpdatedAt     pgtype.Timestamptz
 	DeletedAt     pgtype.Timestamptz
+	Kind          string
 }
 
 type CorePortfolio struct {
@@ -274,6 +308,8 @@ type CoreSchool struct {
 	UpdatedAt pgtype.Timestamptz
 	DeletedAt pgtype.Timestamptz
 	ShortName *string
+	Latitude  *float64
+	Longitude *float64
 }
 
 type CoreSeason struct {
@@ -299,14 +335,16 @@ type CoreTeam struct {
 }
 
 type CoreTeamKenpomStat struct {
-	TeamID    string
-	NetRtg    *float64
-	ORtg      *float64
-	DRtg      *float64
-	AdjT      *float64
-	CreatedAt pgtype.Timestamptz
-	UpdatedAt pgtype.Timestamptz
-	DeletedAt pgtype.Timestamptz
+	TeamID       string
+	NetRtg       *float64
+	ORtg         *float64
+	DRtg         *float64
+	AdjT         *float64
+	CreatedAt    pgtype.Timestamptz
+	UpdatedAt    pgtype.Timestamptz
+	DeletedAt    pgtype.Timestamptz
+	SeasonWins   *int32
+	SeasonLosses *int32
 }
 
 type CoreTournament struct {
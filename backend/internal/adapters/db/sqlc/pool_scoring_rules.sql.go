@@ -12,13 +12,14 @@ import (
 )
 
 const createScoringRule = `-- name: CreateScoringRule :exec
-INSERT INTO core.pool_scoring_rules (id, pool_id, win_index, points_awarded, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6)
+INSERT INTO core.pool_scoring_rules (id, pool_id, kind, win_index, points_awarded, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 `
 
 type CreateScoringRuleParams struct {
 	ID            string
 	PoolID        string
+	Kind          string
 	WinIndex      int32
 	PointsAwarded int32
 	CreatedAt     pgtype.Timestamptz
@@ -29,6 +30,7 @@ func (q *Queries) CreateScoringRule(ctx context.Context, arg CreateScoringRulePa
 	_, err := q.db.Exec(ctx, createScoringRule,
 		arg.ID,
 		arg.PoolID,
+		arg.Kind,
 		arg.WinIndex,
 		arg.PointsAwarded,
 		arg.CreatedAt,
@@ -41,18 +43,20 @@ const listScoringRules = `-- name: ListScoringRules :many
 SELECT
     id,
     pool_id,
+    kind,
     win_index AS round,
     points_awarded AS points,
     created_at,
     updated_at
 FROM core.pool_scoring_rules
 WHERE pool_id = $1 AND deleted_at IS NULL
-ORDER BY win_index ASC
+ORDER BY win_index ASC, kind ASC
 `
 
 type ListScoringRulesRow struct {
 	ID        string
 	PoolID    string
+	Kind      string
 	Round     int32
 	Points    int32
 	CreatedAt pgtype.Timestamptz
@@ -71,6 +75,7 @@ func (q *Queries) ListScoringRules(ctx context.Context, poolID string) ([]ListSc
 		if err := rows.Scan(
 			&i.ID,
 			&i.PoolID,
+			&i.Kind,
 			&i.Round,
 			&i.Points,
 			&i.CreatedAt,
@@ -12,8 +12,8 @@ import (
 )
 
 const createPool = `-- name: CreatePool :exec
-INSERT INTO core.pools (id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12)
+INSERT INTO core.pools (id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, ownership_mode, ownership_cap_percent, unclaimed_mode, tie_breaker, entry_fee_cents, max_portfolios_per_user, reveal_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19)
 `
 
 type CreatePoolParams struct {
@@ -27,6 +27,13 @@ type CreatePoolParams struct {
 	MaxInvestmentCredits int32
 	BudgetCredits        int32
 	Visibility           string
+	OwnershipMode        string
+	OwnershipCapPercent  *int32
+	UnclaimedMode        string
+	TieBreaker           string
+	EntryFeeCents        int32
+	MaxPortfoliosPerUser int32
+	RevealAt             pgtype.Timestamptz
 	CreatedAt            pgtype.Timestamptz
 	UpdatedAt            pgtype.Timestamptz
 }
@@ -43,6 +50,13 @@ func (q *Queries) CreatePool(ctx context.Context, arg CreatePoolParams) error {
 		arg.MaxInvestmentCredits,
 		arg.BudgetCredits,
 		arg.Visibility,
+		arg.OwnershipMode,
+		arg.OwnershipCapPercent,
+		arg.UnclaimedMode,
+		arg.TieBreaker,
+		arg.EntryFeeCents,
+		arg.MaxPortfoliosPerUser,
+		arg.RevealAt,
 		arg.CreatedAt,
 		arg.UpdatedAt,
 	)
@@ -50,7 +64,7 @@ func (q *Queries) CreatePool(ctx context.Context, arg CreatePoolParams) error {
 }
 
 const getPoolByID = `-- name: GetPoolByID :one
-SELECT id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, created_at, updated_at
+SELECT id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, ownership_mode, ownership_cap_percent, unclaimed_mode, tie_breaker, entry_fee_cents, max_portfolios_per_user, reveal_at, created_at, updated_at
 FROM core.pools
 WHERE id = $1 AND deleted_at IS NULL
 `
@@ -66,6 +80,13 @@ type GetPoolByIDRow struct {
 	MaxInvestmentCredits int32
 	BudgetCredits        int32
 	Visibility           string
+	OwnershipMode        string
+	OwnershipCapPercent  *int32
+	UnclaimedMode        string
+	TieBreaker           string
+	EntryFeeCents        int32
+	MaxPortfoliosPerUser int32
+	RevealAt             pgtype.Timestamptz
 	CreatedAt            pgtype.Timestamptz
 	UpdatedAt            pgtype.Timestamptz
 }
@@ -84,6 +105,13 @@ func (q *Queries) GetPoolByID(ctx context.Context, id string) (GetPoolByIDRow, e
 		&i.MaxInvestmentCredits,
 		&i.BudgetCredits,
 		&i.Visibility,
+		&i.OwnershipMode,
+		&i.OwnershipCapPercent,
+		&i.UnclaimedMode,
+		&i.TieBreaker,
+		&i.EntryFeeCents,
+		&i.MaxPortfoliosPerUser,
+		&i.RevealAt,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -91,7 +119,7 @@ func (q *Queries) GetPoolByID(ctx context.Context, id string) (GetPoolByIDRow, e
 }
 
 const getPoolsByTournament = `-- name: GetPoolsByTournament :many
-SELECT id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, created_at, updated_at, deleted_at
+SELECT id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, ownership_mode, ownership_cap_percent, unclaimed_mode, tie_breaker, entry_fee_cents, max_portfolios_per_user, reveal_at, created_at, updated_at, deleted_at
 FROM core.pools
 WHERE tournament_id = $1 AND deleted_at IS NULL
 `
@@ -107,6 +135,13 @@ type GetPoolsByTournamentRow struct {
 	MaxInvestmentCredits int32
 	BudgetCredits        int32
 	Visibility           string
+	OwnershipMode        string
+	OwnershipCapPercent  *int32
+	UnclaimedMode        string
+	TieBreaker           string
+	EntryFeeCents        int32
+	MaxPortfoliosPerUser int32
+	RevealAt             pgtype.Timestamptz
 	CreatedAt            pgtype.Timestamptz
 	UpdatedAt            pgtype.Timestamptz
 	DeletedAt            pgtype.Timestamptz
@@ -132,6 +167,13 @@ func (q *Queries) GetPoolsByTournament(ctx context.Context, tournamentID string)
 			&i.MaxInvestmentCredits,
 			&i.BudgetCredits,
 			&i.Visibility,
+			&i.OwnershipMode,
+			&i.OwnershipCapPercent,
+			&i.UnclaimedMode,
+			&i.TieBreaker,
+			&i.EntryFeeCents,
+			&i.MaxPortfoliosPerUser,
+			&i.RevealAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 			&i.DeletedAt,
@@ -147,7 +189,7 @@ func (q *Queries) GetPoolsByTournament(ctx context.Context, tournamentID string)
 }
 
 const listPools = `-- name: ListPools :many
-SELECT id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, created_at, updated_at
+SELECT id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, ownership_mode, ownership_cap_percent, unclaimed_mode, tie_breaker, entry_fee_cents, max_portfolios_per_user, reveal_at, created_at, updated_at
 FROM core.pools
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC
@@ -164,6 +206,13 @@ type ListPoolsRow struct {
 	MaxInvestmentCredits int32
 	BudgetCredits        int32
 	Visibility           string
+	OwnershipMode        string
+	OwnershipCapPercent  *int32
+	UnclaimedMode        string
+	TieBreaker           string
+	EntryFeeCents        int32
+	MaxPortfoliosPerUser int32
+	RevealAt             pgtype.Timestamptz
 	CreatedAt            pgtype.Timestamptz
 	UpdatedAt            pgtype.Timestamptz
 }
@@ -188,6 +237,13 @@ func (q *Queries) ListPools(ctx context.Context) ([]ListPoolsRow, error) {
 			&i.MaxInvestmentCredits,
 			&i.BudgetCredits,
 			&i.Visibility,
+			&i.OwnershipMode,
+			&i.OwnershipCapPercent,
+			&i.UnclaimedMode,
+			&i.TieBreaker,
+			&i.EntryFeeCents,
+			&i.MaxPortfoliosPerUser,
+			&i.RevealAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -202,7 +258,7 @@ func (q *Queries) ListPools(ctx context.Context) ([]ListPoolsRow, error) {
 }
 
 const listPoolsByUserID = `-- name: ListPoolsByUserID :many
-SELECT DISTINCT c.id, c.tournament_id, c.owner_id, c.created_by, c.name, c.min_teams, c.max_teams, c.max_investment_credits, c.budget_credits, c.visibility, c.created_at, c.updated_at
+SELECT DISTINCT c.id, c.tournament_id, c.owner_id, c.created_by, c.name, c.min_teams, c.max_teams, c.max_investment_credits, c.budget_credits, c.visibility, c.ownership_mode, c.ownership_cap_percent, c.unclaimed_mode, c.tie_breaker, c.entry_fee_cents, c.max_portfolios_per_user, c.reveal_at, c.created_at, c.updated_at
 FROM core.pools c
 WHERE c.deleted_at IS NULL
   AND (c.owner_id = $1
@@ -224,6 +280,13 @@ type ListPoolsByUserIDRow struct {
 	MaxInvestmentCredits int32
 	BudgetCredits        int32
 	Visibility           string
+	OwnershipMode        string
+	OwnershipCapPercent  *int32
+	UnclaimedMode        string
+	TieBreaker           string
+	EntryFeeCents        int32
+	MaxPortfoliosPerUser int32
+	RevealAt             pgtype.Timestamptz
 	CreatedAt            pgtype.Timestamptz
 	UpdatedAt            pgtype.Timestamptz
 }
@@ -248,6 +311,13 @@ func (q *Queries) ListPoolsByUserID(ctx context.Context, ownerID string) ([]List
 			&i.MaxInvestmentCredits,
 			&i.BudgetCredits,
 			&i.Visibility,
+			&i.OwnershipMode,
+			&i.OwnershipCapPercent,
+			&i.UnclaimedMode,
+			&i.TieBreaker,
+			&i.EntryFeeCents,
+			&i.MaxPortfoliosPerUser,
+			&i.RevealAt,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -271,8 +341,15 @@ SET tournament_id = $1,
     max_investment_credits = $6,
     budget_credits = $7,
     visibility = $8,
-    updated_at = $9
-WHERE id = $10 AND deleted_at IS NULL
+    ownership_mode = $9,
+    ownership_cap_percent = $10,
+    unclaimed_mode = $11,
+    tie_breaker = $12,
+    entry_fee_cents = $13,
+    max_portfolios_per_user = $14,
+    reveal_at = $15,
+    updated_at = $16
+WHERE id = $17 AND deleted_at IS NULL
 `
 
 type UpdatePoolParams struct {
@@ -284,6 +361,13 @@ type UpdatePoolParams struct {
 	MaxInvestmentCredits int32
 	BudgetCredits        int32
 	Visibility           string
+	OwnershipMode        string
+	OwnershipCapPercent  *int32
+	UnclaimedMode        string
+	TieBreaker           string
+	EntryFeeCents        int32
+	MaxPortfoliosPerUser int32
+	RevealAt             pgtype.Timestamptz
 	UpdatedAt            pgtype.Timestamptz
 	ID                   string
 }
@@ -298,6 +382,13 @@ func (q *Queries) UpdatePool(ctx context.Context, arg UpdatePoolParams) (int64,
 		arg.MaxInvestmentCredits,
 		arg.BudgetCredits,
 		arg.Visibility,
+		arg.OwnershipMode,
+		arg.OwnershipCapPercent,
+		arg.UnclaimedMode,
+		arg.TieBreaker,
+		arg.EntryFeeCents,
+		arg.MaxPortfoliosPerUser,
+		arg.RevealAt,
 		arg.UpdatedAt,
 		arg.ID,
 	)
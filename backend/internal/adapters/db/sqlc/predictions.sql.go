@@ -27,6 +27,7 @@ type BulkCreatePredictedTeamValuesParams struct {
 	PRound6              *float64
 	PRound7              *float64
 	FavoritesTotalPoints *float64
+	ExpectedUpsetMargins []float64
 }
 
 const createPredictedTeamValue = `-- name: CreatePredictedTeamValue :exec
@@ -203,7 +204,8 @@ SELECT
     COALESCE(p_round_5, 0) AS p_round_5,
     COALESCE(p_round_6, 0) AS p_round_6,
     COALESCE(p_round_7, 0) AS p_round_7,
-    COALESCE(favorites_total_points, 0) AS favorites_total_points
+    COALESCE(favorites_total_points, 0) AS favorites_total_points,
+    expected_upset_margins
 FROM compute.predicted_team_values
 WHERE prediction_batch_id = $1::uuid
     AND deleted_at IS NULL
@@ -223,6 +225,7 @@ type GetPredictedTeamValuesRow struct {
 	PRound6              float64
 	PRound7              float64
 	FavoritesTotalPoints float64
+	ExpectedUpsetMargins []float64
 }
 
 func (q *Queries) GetPredictedTeamValues(ctx context.Context, dollar_1 string) ([]GetPredictedTeamValuesRow, error) {
@@ -248,6 +251,7 @@ func (q *Queries) GetPredictedTeamValues(ctx context.Context, dollar_1 string) (
 			&i.PRound6,
 			&i.PRound7,
 			&i.FavoritesTotalPoints,
+			&i.ExpectedUpsetMargins,
 		); err != nil {
 			return nil, err
 		}
@@ -286,7 +290,7 @@ func (q *Queries) GetPredictionBatchByID(ctx context.Context, dollar_1 string) (
 }
 
 const getScoringRulesForTournament = `-- name: GetScoringRulesForTournament :many
-SELECT psr.win_index::int, psr.points_awarded::int
+SELECT psr.kind, psr.win_index::int, psr.points_awarded::int
 FROM core.pool_scoring_rules psr
 JOIN core.pools p ON p.id = psr.pool_id AND p.deleted_at IS NULL
 WHERE p.tournament_id = $1::uuid
@@ -296,6 +300,7 @@ LIMIT 10
 `
 
 type GetScoringRulesForTournamentRow struct {
+	Kind             string
 	PsrWinIndex      int32
 	PsrPointsAwarded int32
 }
@@ -309,7 +314,7 @@ func (q *Queries) GetScoringRulesForTournament(ctx context.Context, dollar_1 str
 	var items []GetScoringRulesForTournamentRow
 	for rows.Next() {
 		var i GetScoringRulesForTournamentRow
-		if err := rows.Scan(&i.PsrWinIndex, &i.PsrPointsAwarded); err != nil {
+		if err := rows.Scan(&i.Kind, &i.PsrWinIndex, &i.PsrPointsAwarded); err != nil {
 			return nil, err
 		}
 		items = append(items, i)
@@ -327,23 +332,40 @@ SELECT
     t.region,
     COALESCE(ks.net_rtg, 0) AS kenpom_net,
     t.wins,
-    COALESCE(t.byes, 0) AS byes
+    COALESCE(t.byes, 0) AS byes,
+    ks.season_wins,
+    ks.season_losses,
+    ks.o_rtg,
+    ks.d_rtg,
+    ks.adj_t,
+    s.latitude,
+    s.longitude
 FROM core.teams t
 LEFT JOIN core.team_kenpom_stats ks
     ON ks.team_id = t.id
     AND ks.deleted_at IS NULL
+LEFT JOIN core.schools s
+    ON s.id = t.school_id
+    AND s.deleted_at IS NULL
 WHERE t.tournament_id = $1::uuid
     AND t.deleted_at IS NULL
 ORDER BY t.region, t.seed
 `
 
 type GetTeamsWithKenpomForPredictionRow struct {
-	TID       string
-	Seed      int32
-	Region    string
-	KenpomNet float64
-	Wins      int32
-	Byes      int32
+	TID          string
+	Seed         int32
+	Region       string
+	KenpomNet    float64
+	Wins         int32
+	Byes         int32
+	SeasonWins   *int32
+	SeasonLosses *int32
+	ORtg         *float64
+	DRtg         *float64
+	AdjT         *float64
+	Latitude     *float64
+	Longitude    *float64
 }
 
 func (q *Queries) GetTeamsWithKenpomForPrediction(ctx context.Context, dollar_1 string) ([]GetTeamsWithKenpomForPredictionRow, error) {
@@ -362,6 +384,13 @@ func (q *Queries) GetTeamsWithKenpomForPrediction(ctx context.Context, dollar_1
 			&i.KenpomNet,
 			&i.Wins,
 			&i.Byes,
+			&i.SeasonWins,
+			&i.SeasonLosses,
+			&i.ORtg,
+			&i.DRtg,
+			&i.AdjT,
+			&i.Latitude,
+			&i.Longitude,
 		); err != nil {
 			return nil, err
 		}
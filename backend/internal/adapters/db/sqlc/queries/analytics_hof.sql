@@ -10,45 +10,41 @@ WITH latest_pool AS (
     c.created_at DESC
   LIMIT 1
 ),
-latest_portfolios AS (
-  SELECT DISTINCT TRIM(p.name) AS portfolio_name
+career_names AS (
+  -- A user with several portfolios in one pool has one career entry for that
+  -- pool, filed under the name of their first portfolio.
+  SELECT
+    p.id AS portfolio_id,
+    p.pool_id,
+    CASE
+      WHEN p.user_id IS NULL THEN TRIM(p.name)
+      ELSE FIRST_VALUE(TRIM(p.name)) OVER (
+        PARTITION BY p.pool_id, p.user_id
+        ORDER BY p.created_at ASC, p.id ASC
+      )
+    END AS portfolio_name
   FROM core.portfolios p
-  JOIN latest_pool lp ON lp.pool_id = p.pool_id
   WHERE p.deleted_at IS NULL
-    AND TRIM(p.name) <> ''
+),
+latest_portfolios AS (
+  SELECT DISTINCT cn.portfolio_name
+  FROM career_names cn
+  JOIN latest_pool lp ON lp.pool_id = cn.pool_id
+  WHERE cn.portfolio_name <> ''
 ),
 portfolio_returns AS (
   SELECT
     c.id AS pool_id,
     p.id AS portfolio_id,
     p.created_at AS portfolio_created_at,
-    TRIM(p.name) AS portfolio_name,
-    COALESCE(
-      SUM(
-        CASE
-          WHEN team_total_credits > 0 THEN
-            core.pool_returns_for_progress(p.pool_id, tt.wins, tt.byes)::float
-            * (inv.credits::float / team_total_credits)
-          ELSE 0
-        END
-      ),
-      0
-    )::float AS total_returns
+    cn.portfolio_name,
+    COALESCE(SUM(od.actual_returns), 0)::float AS total_returns
   FROM core.portfolios p
+  JOIN career_names cn ON cn.portfolio_id = p.id
   JOIN core.pools c ON c.id = p.pool_id AND c.deleted_at IS NULL
-  LEFT JOIN core.investments inv ON inv.portfolio_id = p.id AND inv.deleted_at IS NULL
-  LEFT JOIN core.teams tt ON tt.id = inv.team_id AND tt.deleted_at IS NULL
-  LEFT JOIN LATERAL (
-    SELECT
-      SUM(inv2.credits::float) AS team_total_credits
-    FROM core.investments inv2
-    JOIN core.portfolios p2 ON p2.id = inv2.portfolio_id AND p2.deleted_at IS NULL
-    WHERE p2.pool_id = p.pool_id
-      AND inv2.team_id = inv.team_id
-      AND inv2.deleted_at IS NULL
-  ) team_investments ON true
+  LEFT JOIN derived.ownership_details od ON od.portfolio_id = p.id
   WHERE p.deleted_at IS NULL
-  GROUP BY c.id, p.id, p.created_at, p.name
+  GROUP BY c.id, p.id, p.created_at, cn.portfolio_name
 ),
 group_stats AS (
   SELECT
@@ -137,34 +133,45 @@ career_agg AS (
     SUM(CASE WHEN finish_position = 1 THEN 1 ELSE 0 END)::int AS wins,
     SUM(CASE WHEN finish_position <= 3 THEN 1 ELSE 0 END)::int AS podiums,
     SUM(CASE WHEN finish_position <= COALESCE(pp.max_paid_position, 0) THEN 1 ELSE 0 END)::int AS in_the_moneys,
-    SUM(CASE WHEN finish_position <= 10 THEN 1 ELSE 0 END)::int AS top_10s,
-    SUM(payout_cents)::int AS career_earnings_cents
+    SUM(CASE WHEN finish_position <= 10 THEN 1 ELSE 0 END)::int AS top_10s
   FROM per_pool pp_data
   LEFT JOIN paid_positions pp ON pp.pool_id = pp_data.pool_id
   GROUP BY portfolio_name
+),
+ledger_earnings AS (
+  SELECT
+    cn.portfolio_name,
+    SUM(le.amount_cents)::int AS earnings_cents
+  FROM core.pool_ledger_entries le
+  JOIN career_names cn ON cn.portfolio_id = le.portfolio_id
+  JOIN core.pools c ON c.id = le.pool_id AND c.deleted_at IS NULL
+  WHERE le.kind = 'payout_sent'
+    AND le.deleted_at IS NULL
+  GROUP BY cn.portfolio_name
 )
 SELECT
-  portfolio_name,
+  career_agg.portfolio_name,
   years,
   best_finish,
   wins,
   podiums,
   in_the_moneys,
   top_10s,
-  career_earnings_cents,
+  COALESCE(le.earnings_cents, 0)::int AS career_earnings_cents,
   EXISTS (
     SELECT 1
     FROM latest_portfolios lp
     WHERE lp.portfolio_name = career_agg.portfolio_name
   ) AS active_in_latest_pool
 FROM career_agg
+LEFT JOIN ledger_earnings le ON le.portfolio_name = career_agg.portfolio_name
 ORDER BY
-  (career_earnings_cents::float / NULLIF(years, 0)) DESC,
+  (COALESCE(le.earnings_cents, 0)::float / NULLIF(years, 0)) DESC,
   wins DESC,
   podiums DESC,
   in_the_moneys DESC,
   top_10s DESC,
-  portfolio_name ASC
+  career_agg.portfolio_name ASC
 LIMIT $1::int;
 
 -- name: GetBestInvestmentBids :many
@@ -178,19 +185,17 @@ WITH investment_credits AS (
     tt.id AS team_id,
     s.name AS school_name,
     tt.seed,
-    core.pool_returns_for_progress(c.id, tt.wins, tt.byes)::float AS team_points,
-    inv.credits::float AS investment,
-    SUM(inv.credits::float) OVER (PARTITION BY c.id, tt.id) AS team_total_investment,
-    SUM(inv.credits::float) OVER (PARTITION BY c.id) AS pool_total_investment
+    core.pool_team_returns(c.id, tt.id, COALESCE(tt.wins, 0) + COALESCE(tt.byes, 0))::float AS team_points,
+    sh.credits AS investment,
+    sh.share
   FROM core.teams tt
-  JOIN core.investments inv ON inv.team_id = tt.id AND inv.deleted_at IS NULL
-  JOIN core.portfolios p ON p.id = inv.portfolio_id AND p.deleted_at IS NULL
-  JOIN core.pools c ON c.id = p.pool_id AND c.deleted_at IS NULL
+  JOIN derived.investment_shares sh ON sh.team_id = tt.id
+  JOIN core.portfolios p ON p.id = sh.portfolio_id
+  JOIN core.pools c ON c.id = sh.pool_id
   JOIN core.tournaments t ON t.id = c.tournament_id AND t.deleted_at IS NULL
   JOIN core.competitions comp ON comp.id = t.competition_id
   JOIN core.seasons seas ON seas.id = t.season_id
   JOIN core.schools s ON s.id = tt.school_id AND s.deleted_at IS NULL
-  WHERE inv.deleted_at IS NULL
 ),
 pool_points AS (
   SELECT
@@ -219,10 +224,10 @@ SELECT
   school_name,
   seed,
   investment,
-  CASE WHEN team_total_investment > 0 THEN (investment / team_total_investment) ELSE 0 END::float AS ownership_percentage,
-  CASE WHEN team_total_investment > 0 THEN (team_points * (investment / team_total_investment)) ELSE 0 END::float AS raw_returns,
+  share::float AS ownership_percentage,
+  (team_points * share)::float AS raw_returns,
   CASE
-    WHEN team_total_investment > 0 AND pp.pool_total_points > 0 AND ppar.total_participants > 0 THEN (team_points * (investment / team_total_investment)) * (100.0 * ppar.total_participants) / pp.pool_total_points
+    WHEN pp.pool_total_points > 0 AND ppar.total_participants > 0 THEN (team_points * share) * (100.0 * ppar.total_participants) / pp.pool_total_points
     ELSE 0
   END::float AS normalized_returns
 FROM investment_credits ic
@@ -241,33 +246,13 @@ WITH portfolio_returns AS (
     c.id AS pool_id,
     p.id AS portfolio_id,
     p.name AS portfolio_name,
-    COALESCE(
-      SUM(
-        CASE
-          WHEN team_total_credits > 0 THEN
-            core.pool_returns_for_progress(p.pool_id, tt.wins, tt.byes)::float
-            * (inv.credits::float / team_total_credits)
-          ELSE 0
-        END
-      ),
-      0
-    )::float AS total_returns
+    COALESCE(SUM(od.actual_returns), 0)::float AS total_returns
   FROM core.portfolios p
   JOIN core.pools c ON c.id = p.pool_id AND c.deleted_at IS NULL
   JOIN core.tournaments t ON t.id = c.tournament_id AND t.deleted_at IS NULL
   JOIN core.competitions comp ON comp.id = t.competition_id
   JOIN core.seasons seas ON seas.id = t.season_id
-  LEFT JOIN core.investments inv ON inv.portfolio_id = p.id AND inv.deleted_at IS NULL
-  LEFT JOIN core.teams tt ON tt.id = inv.team_id AND tt.deleted_at IS NULL
-  LEFT JOIN LATERAL (
-    SELECT
-      SUM(inv2.credits::float) AS team_total_credits
-    FROM core.investments inv2
-    JOIN core.portfolios p2 ON p2.id = inv2.portfolio_id AND p2.deleted_at IS NULL
-    WHERE p2.pool_id = p.pool_id
-      AND inv2.team_id = inv.team_id
-      AND inv2.deleted_at IS NULL
-  ) team_investments ON true
+  LEFT JOIN derived.ownership_details od ON od.portfolio_id = p.id
   WHERE p.deleted_at IS NULL
   GROUP BY comp.name, tournament_year, c.id, p.id, p.name
 ),
@@ -307,7 +292,7 @@ WITH team_investments AS (
     s.name AS school_name,
     tt.seed,
     tt.region,
-    core.pool_returns_for_progress(c.id, tt.wins, tt.byes)::float AS team_points,
+    core.pool_team_returns(c.id, tt.id, COALESCE(tt.wins, 0) + COALESCE(tt.byes, 0))::float AS team_points,
     SUM(inv.credits)::float AS total_bid
   FROM core.investments inv
   JOIN core.portfolios p ON p.id = inv.portfolio_id AND p.deleted_at IS NULL
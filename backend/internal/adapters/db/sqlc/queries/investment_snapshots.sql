@@ -1,3 +1,19 @@
 -- name: CreateInvestmentSnapshot :exec
 INSERT INTO core.investment_snapshots (portfolio_id, changed_by, reason, investments)
 VALUES ($1, $2, $3, $4);
+
+-- name: ListInvestmentSnapshots :many
+SELECT id, portfolio_id, changed_by, reason, investments, created_at
+FROM core.investment_snapshots
+WHERE portfolio_id = $1
+  AND deleted_at IS NULL
+ORDER BY created_at ASC, id ASC;
+
+-- name: GetInvestmentSnapshotAsOf :one
+SELECT id, portfolio_id, changed_by, reason, investments, created_at
+FROM core.investment_snapshots
+WHERE portfolio_id = $1
+  AND created_at <= $2
+  AND deleted_at IS NULL
+ORDER BY created_at DESC, id DESC
+LIMIT 1;
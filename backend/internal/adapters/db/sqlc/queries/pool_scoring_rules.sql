@@ -2,14 +2,15 @@
 SELECT
     id,
     pool_id,
+    kind,
     win_index AS round,
     points_awarded AS points,
     created_at,
     updated_at
 FROM core.pool_scoring_rules
 WHERE pool_id = $1 AND deleted_at IS NULL
-ORDER BY win_index ASC;
+ORDER BY win_index ASC, kind ASC;
 
 -- name: CreateScoringRule :exec
-INSERT INTO core.pool_scoring_rules (id, pool_id, win_index, points_awarded, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6);
+INSERT INTO core.pool_scoring_rules (id, pool_id, kind, win_index, points_awarded, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7);
@@ -1,17 +1,17 @@
 -- name: ListPools :many
-SELECT id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, created_at, updated_at
+SELECT id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, ownership_mode, ownership_cap_percent, unclaimed_mode, tie_breaker, entry_fee_cents, max_portfolios_per_user, reveal_at, created_at, updated_at
 FROM core.pools
 WHERE deleted_at IS NULL
 ORDER BY created_at DESC;
 
 -- name: GetPoolByID :one
-SELECT id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, created_at, updated_at
+SELECT id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, ownership_mode, ownership_cap_percent, unclaimed_mode, tie_breaker, entry_fee_cents, max_portfolios_per_user, reveal_at, created_at, updated_at
 FROM core.pools
 WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: CreatePool :exec
-INSERT INTO core.pools (id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, created_at, updated_at)
-VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12);
+INSERT INTO core.pools (id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, ownership_mode, ownership_cap_percent, unclaimed_mode, tie_breaker, entry_fee_cents, max_portfolios_per_user, reveal_at, created_at, updated_at)
+VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16, $17, $18, $19);
 
 -- name: UpdatePool :execrows
 UPDATE core.pools
@@ -23,16 +23,23 @@ SET tournament_id = $1,
     max_investment_credits = $6,
     budget_credits = $7,
     visibility = $8,
-    updated_at = $9
-WHERE id = $10 AND deleted_at IS NULL;
+    ownership_mode = $9,
+    ownership_cap_percent = $10,
+    unclaimed_mode = $11,
+    tie_breaker = $12,
+    entry_fee_cents = $13,
+    max_portfolios_per_user = $14,
+    reveal_at = $15,
+    updated_at = $16
+WHERE id = $17 AND deleted_at IS NULL;
 
 -- name: GetPoolsByTournament :many
-SELECT id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, created_at, updated_at, deleted_at
+SELECT id, tournament_id, owner_id, created_by, name, min_teams, max_teams, max_investment_credits, budget_credits, visibility, ownership_mode, ownership_cap_percent, unclaimed_mode, tie_breaker, entry_fee_cents, max_portfolios_per_user, reveal_at, created_at, updated_at, deleted_at
 FROM core.pools
 WHERE tournament_id = $1 AND deleted_at IS NULL;
 
 -- name: ListPoolsByUserID :many
-SELECT DISTINCT c.id, c.tournament_id, c.owner_id, c.created_by, c.name, c.min_teams, c.max_teams, c.max_investment_credits, c.budget_credits, c.visibility, c.created_at, c.updated_at
+SELECT DISTINCT c.id, c.tournament_id, c.owner_id, c.created_by, c.name, c.min_teams, c.max_teams, c.max_investment_credits, c.budget_credits, c.visibility, c.ownership_mode, c.ownership_cap_percent, c.unclaimed_mode, c.tie_breaker, c.entry_fee_cents, c.max_portfolios_per_user, c.reveal_at, c.created_at, c.updated_at
 FROM core.pools c
 WHERE c.deleted_at IS NULL
   AND (c.owner_id = $1
@@ -1,36 +1,13 @@
 -- name: ListPortfoliosByPoolID :many
-WITH portfolio_investments AS (
+WITH portfolio_returns AS (
+    -- ownership_details applies the pool's ownership and unclaimed-team modes
     SELECT
-        inv.portfolio_id,
-        p.pool_id,
-        inv.team_id,
-        inv.credits::float8 AS credits,
-        SUM(inv.credits::float8) OVER (
-            PARTITION BY p.pool_id, inv.team_id
-        ) AS team_total_credits
-    FROM core.investments inv
-    JOIN core.portfolios p ON p.id = inv.portfolio_id AND p.deleted_at IS NULL
-    WHERE inv.deleted_at IS NULL
-),
-portfolio_returns AS (
-    SELECT
-        p.id AS portfolio_id,
-        COALESCE(
-            SUM(
-                CASE
-                    WHEN pi.team_total_credits > 0 THEN
-                        core.pool_returns_for_progress(p.pool_id, tt.wins, tt.byes)::float8
-                        * (pi.credits / pi.team_total_credits)
-                    ELSE 0
-                END
-            ),
-            0
-        )::float8 AS total_returns
-    FROM core.portfolios p
-    LEFT JOIN portfolio_investments pi ON pi.portfolio_id = p.id AND pi.pool_id = p.pool_id
-    LEFT JOIN core.teams tt ON tt.id = pi.team_id AND tt.deleted_at IS NULL
-    WHERE p.deleted_at IS NULL
-    GROUP BY p.id, p.pool_id
+        od.portfolio_id,
+        SUM(od.actual_returns)::float8 AS total_returns
+    FROM derived.ownership_details od
+    JOIN core.portfolios p ON p.id = od.portfolio_id
+    WHERE p.pool_id = $1
+    GROUP BY od.portfolio_id
 )
 SELECT
     p.id,
@@ -62,6 +39,17 @@ WHERE id = $1 AND deleted_at IS NULL;
 INSERT INTO core.portfolios (id, name, user_id, pool_id, created_at, updated_at)
 VALUES ($1, $2, $3, $4, NOW(), NOW());
 
+-- name: CountPortfoliosByPoolAndUser :one
+SELECT COUNT(*)::int AS portfolio_count
+FROM core.portfolios
+WHERE pool_id = $1 AND user_id = $2 AND deleted_at IS NULL;
+
+-- name: LockPoolPortfolioLimit :one
+SELECT max_portfolios_per_user
+FROM core.pools
+WHERE id = $1 AND deleted_at IS NULL
+FOR UPDATE;
+
 -- name: SoftDeletePortfolio :execrows
 UPDATE core.portfolios
 SET deleted_at = NOW(), updated_at = NOW()
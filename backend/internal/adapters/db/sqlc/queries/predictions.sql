@@ -33,7 +33,8 @@ SELECT
     COALESCE(p_round_5, 0) AS p_round_5,
     COALESCE(p_round_6, 0) AS p_round_6,
     COALESCE(p_round_7, 0) AS p_round_7,
-    COALESCE(favorites_total_points, 0) AS favorites_total_points
+    COALESCE(favorites_total_points, 0) AS favorites_total_points,
+    expected_upset_margins
 FROM compute.predicted_team_values
 WHERE prediction_batch_id = $1::uuid
     AND deleted_at IS NULL;
@@ -51,17 +52,27 @@ SELECT
     t.region,
     COALESCE(ks.net_rtg, 0) AS kenpom_net,
     t.wins,
-    COALESCE(t.byes, 0) AS byes
+    COALESCE(t.byes, 0) AS byes,
+    ks.season_wins,
+    ks.season_losses,
+    ks.o_rtg,
+    ks.d_rtg,
+    ks.adj_t,
+    s.latitude,
+    s.longitude
 FROM core.teams t
 LEFT JOIN core.team_kenpom_stats ks
     ON ks.team_id = t.id
     AND ks.deleted_at IS NULL
+LEFT JOIN core.schools s
+    ON s.id = t.school_id
+    AND s.deleted_at IS NULL
 WHERE t.tournament_id = $1::uuid
     AND t.deleted_at IS NULL
 ORDER BY t.region, t.seed;
 
 -- name: GetScoringRulesForTournament :many
-SELECT psr.win_index::int, psr.points_awarded::int
+SELECT psr.kind, psr.win_index::int, psr.points_awarded::int
 FROM core.pool_scoring_rules psr
 JOIN core.pools p ON p.id = psr.pool_id AND p.deleted_at IS NULL
 WHERE p.tournament_id = $1::uuid
@@ -120,8 +131,8 @@ INSERT INTO compute.predicted_team_values (
     prediction_batch_id, tournament_id, team_id,
     actual_points, expected_points, variance_points, std_points,
     p_round_1, p_round_2, p_round_3, p_round_4, p_round_5, p_round_6, p_round_7,
-    favorites_total_points
-) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15);
+    favorites_total_points, expected_upset_margins
+) VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $12, $13, $14, $15, $16);
 
 -- name: PruneOldBatchesForCheckpoint :execrows
 DELETE FROM compute.prediction_batches pb_outer
@@ -1,14 +1,19 @@
 -- name: ListSchools :many
-SELECT id, name, created_at, updated_at
+SELECT id, name, latitude, longitude, created_at, updated_at
 FROM core.schools
 WHERE deleted_at IS NULL
 ORDER BY name ASC;
 
 -- name: GetSchoolByID :one
-SELECT id, name, created_at, updated_at
+SELECT id, name, latitude, longitude, created_at, updated_at
 FROM core.schools
 WHERE id = $1 AND deleted_at IS NULL;
 
 -- name: CreateSchool :exec
 INSERT INTO core.schools (id, name, slug, created_at, updated_at)
 VALUES ($1, $2, $3, $4, $5);
+
+-- name: UpdateSchoolLocation :execrows
+UPDATE core.schools
+SET latitude = sqlc.narg('latitude'), longitude = sqlc.narg('longitude'), updated_at = NOW()
+WHERE id = sqlc.arg('id') AND deleted_at IS NULL;
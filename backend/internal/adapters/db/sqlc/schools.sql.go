@@ -36,7 +36,7 @@ func (q *Queries) CreateSchool(ctx context.Context, arg CreateSchoolParams) erro
 }
 
 const getSchoolByID = `-- name: GetSchoolByID :one
-SELECT id, name, created_at, updated_at
+SELECT id, name, latitude, longitude, created_at, updated_at
 FROM core.schools
 WHERE id = $1 AND deleted_at IS NULL
 `
@@ -44,6 +44,8 @@ WHERE id = $1 AND deleted_at IS NULL
 type GetSchoolByIDRow struct {
 	ID        string
 	Name      string
+	Latitude  *float64
+	Longitude *float64
 	CreatedAt pgtype.Timestamptz
 	UpdatedAt pgtype.Timestamptz
 }
@@ -54,6 +56,8 @@ func (q *Queries) GetSchoolByID(ctx context.Context, id string) (GetSchoolByIDRo
 	err := row.Scan(
 		&i.ID,
 		&i.Name,
+		&i.Latitude,
+		&i.Longitude,
 		&i.CreatedAt,
 		&i.UpdatedAt,
 	)
@@ -61,7 +65,7 @@ func (q *Queries) GetSchoolByID(ctx context.Context, id string) (GetSchoolByIDRo
 }
 
 const listSchools = `-- name: ListSchools :many
-SELECT id, name, created_at, updated_at
+SELECT id, name, latitude, longitude, created_at, updated_at
 FROM core.schools
 WHERE deleted_at IS NULL
 ORDER BY name ASC
@@ -70,6 +74,8 @@ ORDER BY name ASC
 type ListSchoolsRow struct {
 	ID        string
 	Name      string
+	Latitude  *float64
+	Longitude *float64
 	CreatedAt pgtype.Timestamptz
 	UpdatedAt pgtype.Timestamptz
 }
@@ -86,6 +92,8 @@ func (q *Queries) ListSchools(ctx context.Context) ([]ListSchoolsRow, error) {
 		if err := rows.Scan(
 			&i.ID,
 			&i.Name,
+			&i.Latitude,
+			&i.Longitude,
 			&i.CreatedAt,
 			&i.UpdatedAt,
 		); err != nil {
@@ -98,3 +106,23 @@ func (q *Queries) ListSchools(ctx context.Context) ([]ListSchoolsRow, error) {
 	}
 	return items, nil
 }
+
+const updateSchoolLocation = `-- name: UpdateSchoolLocation :execrows
+UPDATE core.schools
+SET latitude = $1, longitude = $2, updated_at = NOW()
+WHERE id = $3 AND deleted_at IS NULL
+`
+
+type UpdateSchoolLocationParams struct {
+	Latitude  *float64
+	Longitude *float64
+	ID        string
+}
+
+func (q *Queries) UpdateSchoolLocation(ctx context.Context, arg UpdateSchoolLocationParams) (int64, error) {
+	result, err := q.db.Exec(ctx, updateSchoolLocation, arg.Latitude, arg.Longitude, arg.ID)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected(), nil
+}
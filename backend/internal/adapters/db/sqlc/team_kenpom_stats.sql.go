@@ -10,24 +10,28 @@ import (
 )
 
 const upsertTeamKenPomStats = `-- name: UpsertTeamKenPomStats :exec
-INSERT INTO core.team_kenpom_stats (team_id, net_rtg, o_rtg, d_rtg, adj_t)
-VALUES ($1, $2, $3, $4, $5)
+INSERT INTO core.team_kenpom_stats (team_id, net_rtg, o_rtg, d_rtg, adj_t, season_wins, season_losses)
+VALUES ($1, $2, $3, $4, $5, $6, $7)
 ON CONFLICT (team_id)
 DO UPDATE SET
     net_rtg = EXCLUDED.net_rtg,
     o_rtg = EXCLUDED.o_rtg,
     d_rtg = EXCLUDED.d_rtg,
     adj_t = EXCLUDED.adj_t,
+    season_wins = COALESCE(EXCLUDED.season_wins, core.team_kenpom_stats.season_wins),
+    season_losses = COALESCE(EXCLUDED.season_losses, core.team_kenpom_stats.season_losses),
     updated_at = NOW(),
     deleted_at = NULL
 `
 
 type UpsertTeamKenPomStatsParams struct {
-	TeamID string
-	NetRtg *float64
-	ORtg   *float64
-	DRtg   *float64
-	AdjT   *float64
+	TeamID       string
+	NetRtg       *float64
+	ORtg         *float64
+	DRtg         *float64
+	AdjT         *float64
+	SeasonWins   *int32
+	SeasonLosses *int32
 }
 
 func (q *Queries) UpsertTeamKenPomStats(ctx context.Context, arg UpsertTeamKenPomStatsParams) error {
@@ -37,6 +41,8 @@ func (q *Queries) UpsertTeamKenPomStats(ctx context.Context, arg UpsertTeamKenPo
 		arg.ORtg,
 		arg.DRtg,
 		arg.AdjT,
+		arg.SeasonWins,
+		arg.SeasonLosses,
 	)
 	return err
 }
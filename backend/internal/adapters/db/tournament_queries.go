@@ -2,9 +2,12 @@ package db
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -29,6 +32,10 @@ func (r *TournamentQueryRepository) LoadFinalFourConfig(ctx context.Context, cor
 	return LoadFinalFourConfig(ctx, r.pool, coreTournamentID)
 }
 
+func (r *TournamentQueryRepository) LoadBracketTemplate(ctx context.Context, coreTournamentID string) (*models.BracketTemplate, error) {
+	return LoadBracketTemplate(ctx, r.pool, coreTournamentID)
+}
+
 // ResolveCoreTournamentID finds the core tournament ID for a given season year.
 func ResolveCoreTournamentID(ctx context.Context, pool *pgxpool.Pool, season int) (string, error) {
 	var id string
@@ -104,3 +111,31 @@ func LoadFinalFourConfig(ctx context.Context, pool *pgxpool.Pool, coreTournament
 
 	return cfg, nil
 }
+
+// LoadBracketTemplate loads the stored bracket template for a tournament. It
+// returns nil when the tournament uses the default NCAA layout.
+func LoadBracketTemplate(ctx context.Context, pool *pgxpool.Pool, coreTournamentID string) (*models.BracketTemplate, error) {
+	var raw []byte
+	err := pool.QueryRow(ctx, `
+		SELECT bracket_template
+		FROM core.tournaments
+		WHERE id = $1::uuid
+			AND deleted_at IS NULL
+		LIMIT 1
+	`, coreTournamentID).Scan(&raw)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("loading bracket template for tournament %s: %w", coreTournamentID, err)
+	}
+	if raw == nil {
+		return nil, nil
+	}
+
+	tmpl := &models.BracketTemplate{}
+	if err := json.Unmarshal(raw, tmpl); err != nil {
+		return nil, fmt.Errorf("decoding bracket template for tournament %s: %w", coreTournamentID, err)
+	}
+	return tmpl, nil
+}
@@ -3,6 +3,7 @@ package db
 import (
 	"context"
 	"crypto/md5"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"regexp"
@@ -239,3 +240,37 @@ func (r *TournamentRepository) UpdateFinalFour(ctx context.Context, tournamentID
 	return nil
 }
 
+
+// GetBracketTemplate returns the tournament's stored bracket template, or nil
+// when the tournament uses the default NCAA layout.
+func (r *TournamentRepository) GetBracketTemplate(ctx context.Context, tournamentID string) (*models.BracketTemplate, error) {
+	return LoadBracketTemplate(ctx, r.pool, tournamentID)
+}
+
+// UpdateBracketTemplate stores the tournament's bracket template. A nil
+// template restores the default NCAA layout.
+func (r *TournamentRepository) UpdateBracketTemplate(ctx context.Context, tournamentID string, tmpl *models.BracketTemplate) error {
+	var raw []byte
+	if tmpl != nil {
+		b, err := json.Marshal(tmpl)
+		if err != nil {
+			return fmt.Errorf("encoding bracket template: %w", err)
+		}
+		raw = b
+	}
+
+	tag, err := r.pool.Exec(ctx, `
+		UPDATE core.tournaments
+		SET bracket_template = $2::jsonb,
+			updated_at = NOW()
+		WHERE id = $1::uuid
+			AND deleted_at IS NULL
+	`, tournamentID, raw)
+	if err != nil {
+		return fmt.Errorf("updating bracket template for tournament %s: %w", tournamentID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return &apperrors.NotFoundError{Resource: "tournament", ID: tournamentID}
+	}
+	return nil
+}
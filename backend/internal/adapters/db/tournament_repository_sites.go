@@ -0,0 +1,79 @@
+package db
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+// ListGameSites returns the tournament's game sites ordered by game ID.
+func (r *TournamentRepository) ListGameSites(ctx context.Context, tournamentID string) ([]*models.GameSite, error) {
+	return ListGameSites(ctx, r.pool, tournamentID)
+}
+
+// ListGameSites returns the tournament's game sites ordered by game ID.
+func ListGameSites(ctx context.Context, pool *pgxpool.Pool, tournamentID string) ([]*models.GameSite, error) {
+	rows, err := pool.Query(ctx, `
+		SELECT tournament_id::text, game_id, name, latitude, longitude, updated_at
+		FROM core.game_sites
+		WHERE tournament_id = $1::uuid
+		ORDER BY game_id ASC
+	`, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("listing game sites: %w", err)
+	}
+	defer rows.Close()
+
+	out := make([]*models.GameSite, 0)
+	for rows.Next() {
+		gs := &models.GameSite{}
+		if err := rows.Scan(&gs.TournamentID, &gs.GameID, &gs.Name, &gs.Latitude, &gs.Longitude, &gs.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("scanning game site: %w", err)
+		}
+		out = append(out, gs)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterating game sites: %w", err)
+	}
+	return out, nil
+}
+
+// ReplaceGameSites replaces all of the tournament's game sites in a single
+// transaction.
+func (r *TournamentRepository) ReplaceGameSites(ctx context.Context, tournamentID string, sites []*models.GameSite) error {
+	tx, err := r.pool.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return fmt.Errorf("beginning transaction: %w", err)
+	}
+	committed := false
+	defer func() {
+		if !committed {
+			_ = tx.Rollback(ctx)
+		}
+	}()
+
+	if _, err := tx.Exec(ctx, `
+		DELETE FROM core.game_sites
+		WHERE tournament_id = $1::uuid
+	`, tournamentID); err != nil {
+		return fmt.Errorf("clearing game sites: %w", err)
+	}
+
+	for _, gs := range sites {
+		if _, err := tx.Exec(ctx, `
+			INSERT INTO core.game_sites (tournament_id, game_id, name, latitude, longitude)
+			VALUES ($1::uuid, $2, $3, $4, $5)
+		`, tournamentID, gs.GameID, gs.Name, gs.Latitude, gs.Longitude); err != nil {
+			return fmt.Errorf("inserting game site %s: %w", gs.GameID, err)
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("committing game sites: %w", err)
+	}
+	committed = true
+	return nil
+}
@@ -253,11 +253,13 @@ func (r *TournamentRepository) BulkUpsertKenPomStats(ctx context.Context, update
 	qtx := r.q.WithTx(tx)
 	for _, u := range updates {
 		params := sqlc.UpsertTeamKenPomStatsParams{
-			TeamID: u.TeamID,
-			NetRtg: &u.NetRtg,
-			ORtg:   &u.ORtg,
-			DRtg:   &u.DRtg,
-			AdjT:   &u.AdjT,
+			TeamID:       u.TeamID,
+			NetRtg:       &u.NetRtg,
+			ORtg:         &u.ORtg,
+			DRtg:         &u.DRtg,
+			AdjT:         &u.AdjT,
+			SeasonWins:   optionalInt32(u.SeasonWins),
+			SeasonLosses: optionalInt32(u.SeasonLosses),
 		}
 		if err = qtx.UpsertTeamKenPomStats(ctx, params); err != nil {
 			return fmt.Errorf("upserting kenpom stats for team %s: %w", u.TeamID, err)
@@ -291,3 +293,11 @@ func tournamentTeamFromRow(id, tournamentID, schoolID string, seed int32, region
 	}
 	return team
 }
+
+func optionalInt32(v *int) *int32 {
+	if v == nil {
+		return nil
+	}
+	n := int32(*v)
+	return &n
+}
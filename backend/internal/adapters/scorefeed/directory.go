@@ -0,0 +1,51 @@
+package scorefeed
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// DirectoryFeed reads results from every .json file in a directory, in file
+// name order. Files are left in place; ingesting them again is a no-op.
+type DirectoryFeed struct {
+	source string
+	dir    string
+}
+
+func NewDirectoryFeed(source, dir string) *DirectoryFeed {
+	return &DirectoryFeed{source: source, dir: dir}
+}
+
+func (f *DirectoryFeed) Source() string {
+	return f.source
+}
+
+func (f *DirectoryFeed) Fetch(ctx context.Context) ([]models.ScoreFeedResult, error) {
+	paths, err := filepath.Glob(filepath.Join(f.dir, "*.json"))
+	if err != nil {
+		return nil, fmt.Errorf("listing feed files: %w", err)
+	}
+	sort.Strings(paths)
+
+	var out []models.ScoreFeedResult
+	for _, path := range paths {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+		b, err := os.ReadFile(path)
+		if err != nil {
+			return nil, fmt.Errorf("reading feed file %s: %w", path, err)
+		}
+		results, err := decodeResults(b)
+		if err != nil {
+			return nil, fmt.Errorf("parsing feed file %s: %w", path, err)
+		}
+		out = append(out, results...)
+	}
+	return out, nil
+}
@@ -0,0 +1,60 @@
+// Package scorefeed provides results-feed adapters for the score-ingestion
+// worker.
+package scorefeed
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+)
+
+const (
+	KindDirectory = "dir"
+	KindHTTP      = "http"
+)
+
+// New returns the feed adapter for kind, reading from location: a directory
+// path for KindDirectory or a URL for KindHTTP. Source names the feed for
+// school name mappings and defaults to kind.
+func New(kind, source, location string) (ports.ScoreFeed, error) {
+	if strings.TrimSpace(location) == "" {
+		return nil, fmt.Errorf("score feed location is required")
+	}
+	if strings.TrimSpace(source) == "" {
+		source = kind
+	}
+	switch kind {
+	case KindDirectory:
+		return NewDirectoryFeed(source, location), nil
+	case KindHTTP:
+		return NewHTTPFeed(source, location), nil
+	default:
+		return nil, fmt.Errorf("unknown score feed kind %q (want %q or %q)", kind, KindDirectory, KindHTTP)
+	}
+}
+
+// envelope is the object form of a feed document.
+type envelope struct {
+	Results []models.ScoreFeedResult `json:"results"`
+}
+
+// decodeResults parses a feed document: either an array of results or an
+// object with a "results" array.
+func decodeResults(b []byte) ([]models.ScoreFeedResult, error) {
+	trimmed := strings.TrimSpace(string(b))
+	if strings.HasPrefix(trimmed, "[") {
+		var results []models.ScoreFeedResult
+		if err := json.Unmarshal(b, &results); err != nil {
+			return nil, err
+		}
+		return results, nil
+	}
+	var env envelope
+	if err := json.Unmarshal(b, &env); err != nil {
+		return nil, err
+	}
+	return env.Results, nil
+}
@@ -0,0 +1,84 @@
+package scorefeed
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestThatDirectoryFeedReadsArrayAndObjectFiles(t *testing.T) {
+	// GIVEN a directory with one file of each shape and a non-JSON file
+	dir := t.TempDir()
+	files := map[string]string{
+		"a.json":    `[{"season":2026,"team1Name":"Duke","team2Name":"Vermont","team1Score":80,"team2Score":60,"final":true}]`,
+		"b.json":    `{"results":[{"season":2026,"team1Name":"Baylor","team2Name":"Yale","team1Score":70,"team2Score":72,"final":true}]}`,
+		"notes.txt": `ignored`,
+	}
+	for name, body := range files {
+		if err := os.WriteFile(filepath.Join(dir, name), []byte(body), 0o600); err != nil {
+			t.Fatalf("writing %s: %v", name, err)
+		}
+	}
+
+	// WHEN fetching from the directory
+	results, err := NewDirectoryFeed("test", dir).Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN both results are returned in file name order
+	if len(results) != 2 || results[0].Team1Name != "Duke" || results[1].Team1Name != "Baylor" {
+		t.Errorf("expected Duke then Baylor, got %+v", results)
+	}
+}
+
+func TestThatHTTPFeedDecodesResults(t *testing.T) {
+	// GIVEN a server returning one result
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"results":[{"season":2026,"team1Name":"Duke","team2Name":"Vermont","team1Score":50,"team2Score":44,"secondsRemaining":600}]}`))
+	}))
+	defer srv.Close()
+
+	// WHEN fetching from the server
+	results, err := NewHTTPFeed("test", srv.URL).Fetch(context.Background())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the game in progress is returned
+	if len(results) != 1 || results[0].SecondsRemaining == nil || *results[0].SecondsRemaining != 600 {
+		t.Errorf("expected one game with 600 seconds left, got %+v", results)
+	}
+}
+
+func TestThatHTTPFeedRejectsErrorStatus(t *testing.T) {
+	// GIVEN a server that is failing
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusBadGateway)
+	}))
+	defer srv.Close()
+
+	// WHEN fetching from the server
+	_, err := NewHTTPFeed("test", srv.URL).Fetch(context.Background())
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected an error")
+	}
+}
+
+func TestThatNewRejectsUnknownKind(t *testing.T) {
+	// GIVEN an unsupported feed kind
+	kind := "ftp"
+
+	// WHEN building the feed
+	_, err := New(kind, "", "ftp://example.com")
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected an error")
+	}
+}
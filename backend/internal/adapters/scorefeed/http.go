@@ -0,0 +1,57 @@
+package scorefeed
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// maxResponseBytes caps how much of a feed response is read.
+const maxResponseBytes = 10 << 20
+
+// HTTPFeed fetches results with a GET request to a URL that returns JSON in
+// the same shape as the directory feed's files.
+type HTTPFeed struct {
+	source string
+	url    string
+	client *http.Client
+}
+
+func NewHTTPFeed(source, url string) *HTTPFeed {
+	return &HTTPFeed{source: source, url: url, client: &http.Client{Timeout: 15 * time.Second}}
+}
+
+func (f *HTTPFeed) Source() string {
+	return f.source
+}
+
+func (f *HTTPFeed) Fetch(ctx context.Context) ([]models.ScoreFeedResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, f.url, nil)
+	if err != nil {
+		return nil, fmt.Errorf("building feed request: %w", err)
+	}
+	req.Header.Set("Accept", "application/json")
+
+	resp, err := f.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("fetching feed: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("fetching feed: unexpected status %d", resp.StatusCode)
+	}
+	b, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBytes))
+	if err != nil {
+		return nil, fmt.Errorf("reading feed response: %w", err)
+	}
+	results, err := decodeResults(b)
+	if err != nil {
+		return nil, fmt.Errorf("parsing feed response: %w", err)
+	}
+	return results, nil
+}
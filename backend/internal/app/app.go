@@ -2,11 +2,16 @@ package app
 
 import (
 	appanalytics "github.com/andrewcopp/Calcutta/backend/internal/app/analytics"
+	appauction "github.com/andrewcopp/Calcutta/backend/internal/app/auction"
 	appauth "github.com/andrewcopp/Calcutta/backend/internal/app/auth"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/bracket"
+	appchangefeed "github.com/andrewcopp/Calcutta/backend/internal/app/changefeed"
+	appledger "github.com/andrewcopp/Calcutta/backend/internal/app/ledger"
 	apppool "github.com/andrewcopp/Calcutta/backend/internal/app/pool"
 	appprediction "github.com/andrewcopp/Calcutta/backend/internal/app/prediction"
+	appreveal "github.com/andrewcopp/Calcutta/backend/internal/app/reveal"
 	appschool "github.com/andrewcopp/Calcutta/backend/internal/app/school"
+	appscorefeed "github.com/andrewcopp/Calcutta/backend/internal/app/scorefeed"
 	apptournament "github.com/andrewcopp/Calcutta/backend/internal/app/tournament"
 	appusermgmt "github.com/andrewcopp/Calcutta/backend/internal/app/usermanagement"
 
@@ -15,12 +20,17 @@ import (
 
 type App struct {
 	Analytics      *appanalytics.Service
+	Auction        *appauction.Service
 	Lab            *applab.Service
 	Bracket        *bracket.Service
+	Changes        *appchangefeed.Hub
+	Ledger         *appledger.Service
 	Pool           *apppool.Service
 	Prediction     *appprediction.Service
+	Reveal         *appreveal.Service
 	Auth           *appauth.Service
 	School         *appschool.Service
+	ScoreFeed      *appscorefeed.Service
 	Tournament     *apptournament.Service
 	UserManagement *appusermgmt.Service
 }
@@ -0,0 +1,11 @@
+package auction
+
+// Event types published while an auction runs, as the Detail of an auction
+// change event.
+const (
+	EventSessionStarted   = "session_started"
+	EventLotOpened        = "lot_opened"
+	EventBid              = "bid"
+	EventLotSold          = "lot_sold"
+	EventSessionCompleted = "session_completed"
+)
@@ -0,0 +1,491 @@
+// Package auction runs live ascending auctions, the traditional Calcutta
+// format. Portfolios take turns nominating a team, everyone bids against a
+// countdown that each bid extends, and the high bidder buys the whole team.
+// Sold teams are written as ordinary investments, so standings and payouts
+// work unchanged. Pools that auction their leftovers run one after the
+// sealed-bid deadline for the teams nobody bid on.
+package auction
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+)
+
+// closeGrace is how long after a lot's countdown the close is attempted, and
+// the shortest wait between attempts.
+const closeGrace = 250 * time.Millisecond
+
+// TeamLister lists a tournament's teams; every one of them is auctioned.
+type TeamLister interface {
+	GetTeams(ctx context.Context, tournamentID string) ([]*models.TournamentTeam, error)
+}
+
+// TournamentGetter reads the tournament a pool is played on, to tell whether
+// its sealed bidding has closed.
+type TournamentGetter interface {
+	GetByID(ctx context.Context, id string) (*models.Tournament, error)
+}
+
+type Ports struct {
+	Auctions    ports.AuctionRepository
+	Pools       ports.PoolReader
+	Portfolios  ports.PortfolioReader
+	Teams       TeamLister
+	Tournaments TournamentGetter
+	// BiddingWindows is optional; without it pools close sealed bidding when
+	// their tournament starts.
+	BiddingWindows ports.BiddingWindowReader
+	Changes        ports.ChangePublisher
+}
+
+// Service runs auctions and closes lots when their countdown ends. Every
+// change is announced as an auction change event for the pool.
+type Service struct {
+	ports Ports
+
+	mu     sync.Mutex
+	timers map[string]*time.Timer
+}
+
+func New(ports Ports) *Service {
+	return &Service{ports: ports, timers: make(map[string]*time.Timer)}
+}
+
+// SessionSettings configures a new auction. Zero values take the defaults;
+// an empty nomination order uses the pool's portfolios in creation order.
+type SessionSettings struct {
+	NominationOrder     []string
+	LotSeconds          int
+	BidExtensionSeconds int
+	MinIncrementCredits int
+}
+
+// State is a snapshot of a pool's auction.
+type State struct {
+	Session *models.AuctionSession
+	Lots    []*models.AuctionLot
+	OpenLot *models.AuctionLot
+	// CurrentNominator is the portfolio whose turn it is; portfolios that can
+	// no longer afford a team are skipped. Empty while a lot is open.
+	CurrentNominator string
+	// RemainingTeamIDs excludes teams already held through sealed bids.
+	RemainingTeamIDs []string
+	ServerTime       time.Time
+
+	nominatorIndex int
+	sealed         map[string]holding
+}
+
+// holding is what a portfolio bought before the auction, through sealed bids.
+type holding struct {
+	spent int
+	won   int
+}
+
+func (s *Service) IsAuctionPool(ctx context.Context, poolID string) (bool, error) {
+	return s.ports.Auctions.HasAuctionSession(ctx, poolID)
+}
+
+// CreateSession sets up a pending auction for a pool that has no sealed bids,
+// or a leftovers round for a leftovers pool whose sealed bidding has closed.
+func (s *Service) CreateSession(ctx context.Context, poolID string, createdBy *string, settings SessionSettings) (*models.AuctionSession, error) {
+	if settings.LotSeconds < 0 || settings.BidExtensionSeconds < 0 || settings.MinIncrementCredits < 0 {
+		return nil, &apperrors.InvalidArgumentError{Field: "settings", Message: "auction timing and increment cannot be negative"}
+	}
+
+	portfolios, _, err := s.ports.Portfolios.GetPortfolios(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	portfolioIDs := make([]string, 0, len(portfolios))
+	for _, p := range portfolios {
+		portfolioIDs = append(portfolioIDs, p.ID)
+	}
+
+	order := settings.NominationOrder
+	if len(order) == 0 {
+		order = portfolioIDs
+	}
+	if len(order) == 0 {
+		return nil, &apperrors.InvalidArgumentError{Field: "nominationOrder", Message: "pool has no portfolios to nominate"}
+	}
+	inPool := make(map[string]bool, len(portfolioIDs))
+	for _, id := range portfolioIDs {
+		inPool[id] = true
+	}
+	seen := make(map[string]bool, len(order))
+	for _, id := range order {
+		if !inPool[id] {
+			return nil, &apperrors.InvalidArgumentError{Field: "nominationOrder", Message: "portfolio " + id + " is not in this pool"}
+		}
+		if seen[id] {
+			return nil, &apperrors.InvalidArgumentError{Field: "nominationOrder", Message: "portfolio " + id + " is listed twice"}
+		}
+		seen[id] = true
+	}
+
+	investments, err := s.ports.Portfolios.GetInvestmentsByPortfolioIDs(ctx, portfolioIDs)
+	if err != nil {
+		return nil, err
+	}
+	hasSealedBids := false
+	for _, invs := range investments {
+		if len(invs) > 0 {
+			hasSealedBids = true
+		}
+	}
+	if hasSealedBids {
+		leftovers, err := s.leftoversOpen(ctx, poolID)
+		if err != nil {
+			return nil, err
+		}
+		if !leftovers {
+			return nil, &apperrors.InvalidArgumentError{Field: "poolId", Message: "pool already has sealed bids"}
+		}
+	}
+
+	session := &models.AuctionSession{
+		PoolID:              poolID,
+		NominationOrder:     order,
+		LotSeconds:          settings.LotSeconds,
+		BidExtensionSeconds: settings.BidExtensionSeconds,
+		MinIncrementCredits: settings.MinIncrementCredits,
+		CreatedBy:           createdBy,
+	}
+	session.ApplyDefaults()
+	if err := s.ports.Auctions.CreateAuctionSession(ctx, session); err != nil {
+		return nil, err
+	}
+	return session, nil
+}
+
+// leftoversOpen reports whether a pool auctions its unclaimed teams and its
+// sealed bidding has closed.
+func (s *Service) leftoversOpen(ctx context.Context, poolID string) (bool, error) {
+	pool, err := s.ports.Pools.GetByID(ctx, poolID)
+	if err != nil {
+		return false, err
+	}
+	if pool.UnclaimedMode != models.UnclaimedModeLeftovers {
+		return false, nil
+	}
+	tournament, err := s.ports.Tournaments.GetByID(ctx, pool.TournamentID)
+	if err != nil {
+		return false, err
+	}
+	var windows []*models.BiddingWindow
+	if s.ports.BiddingWindows != nil {
+		if windows, err = s.ports.BiddingWindows.ListBiddingWindows(ctx, poolID); err != nil {
+			return false, err
+		}
+	}
+	return models.NewBiddingSchedule(tournament, windows).IsFinished(time.Now()), nil
+}
+
+// Start opens a pending auction for nominations.
+func (s *Service) Start(ctx context.Context, poolID string) (*State, error) {
+	session, err := s.ports.Auctions.GetAuctionSessionByPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	if err := s.ports.Auctions.StartAuctionSession(ctx, session.ID); err != nil {
+		return nil, err
+	}
+	state, err := s.GetState(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(ctx, EventSessionStarted, poolID)
+	return state, nil
+}
+
+// GetState returns the auction's state, first selling a lot whose countdown
+// has run out.
+func (s *Service) GetState(ctx context.Context, poolID string) (*State, error) {
+	session, err := s.ports.Auctions.GetAuctionSessionByPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	if session.Status == models.AuctionStatusOpen {
+		return s.closeDueLot(ctx, session)
+	}
+	return s.loadState(ctx, session)
+}
+
+// CloseExpiredLots sells every lot whose countdown has run out, for lots
+// whose timer was lost with the process that armed it. One pool's failure is
+// logged and does not hold up the others.
+func (s *Service) CloseExpiredLots(ctx context.Context) error {
+	poolIDs, err := s.ports.Auctions.ListPoolIDsWithExpiredAuctionLots(ctx)
+	if err != nil {
+		return err
+	}
+	for _, poolID := range poolIDs {
+		if _, err := s.GetState(ctx, poolID); err != nil {
+			slog.Warn("auction_close_failed", "pool_id", poolID, "error", err)
+		}
+	}
+	return nil
+}
+
+// Nominate puts a team up for auction with the nominator's opening bid.
+func (s *Service) Nominate(ctx context.Context, poolID, portfolioID, teamID string, credits int) (*State, error) {
+	state, err := s.GetState(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	if state.Session.Status != models.AuctionStatusOpen {
+		return nil, &apperrors.InvalidArgumentError{Field: "poolId", Message: "auction is not open"}
+	}
+	if state.OpenLot != nil {
+		return nil, &apperrors.InvalidArgumentError{Field: "teamId", Message: "a team is already up for auction"}
+	}
+	if state.CurrentNominator != portfolioID {
+		return nil, &apperrors.InvalidArgumentError{Field: "portfolioId", Message: "it is not this portfolio's turn to nominate"}
+	}
+	available := false
+	for _, id := range state.RemainingTeamIDs {
+		if id == teamID {
+			available = true
+			break
+		}
+	}
+	if !available {
+		return nil, &apperrors.InvalidArgumentError{Field: "teamId", Message: "team is not available for nomination"}
+	}
+
+	pool, err := s.ports.Pools.GetByID(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	spent, won := wonBy(state.Lots, portfolioID)
+	spent += state.sealed[portfolioID].spent
+	won += state.sealed[portfolioID].won
+	if err := ValidateOpeningBid(pool, spent, won, credits); err != nil {
+		return nil, err
+	}
+
+	lot := &models.AuctionLot{
+		SessionID:              state.Session.ID,
+		TeamID:                 teamID,
+		NominatedByPortfolioID: portfolioID,
+		HighBidCredits:         credits,
+	}
+	if err := s.ports.Auctions.NominateAuctionLot(ctx, lot, state.Session.NominationIndex, state.nominatorIndex+1); err != nil {
+		return nil, err
+	}
+
+	state, err = s.GetState(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(ctx, EventLotOpened, poolID)
+	return state, nil
+}
+
+// Bid raises the high bid on the open lot.
+func (s *Service) Bid(ctx context.Context, poolID, portfolioID, lotID string, credits int) (*State, error) {
+	session, err := s.ports.Auctions.GetAuctionSessionByPool(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+	if session.Status != models.AuctionStatusOpen {
+		return nil, &apperrors.InvalidArgumentError{Field: "poolId", Message: "auction is not open"}
+	}
+	pool, err := s.ports.Pools.GetByID(ctx, poolID)
+	if err != nil {
+		return nil, err
+	}
+
+	validate := func(bidCtx models.AuctionBidContext) error {
+		if bidCtx.Lot.SessionID != session.ID {
+			return &apperrors.NotFoundError{Resource: "auction lot", ID: lotID}
+		}
+		return ValidateAuctionBid(pool, bidCtx, portfolioID, credits)
+	}
+	if _, err := s.ports.Auctions.PlaceAuctionBid(ctx, lotID, portfolioID, credits, validate); err != nil {
+		return nil, err
+	}
+
+	state, err := s.loadState(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	s.publish(ctx, EventBid, poolID)
+	return state, nil
+}
+
+func (s *Service) closeDueLot(ctx context.Context, session *models.AuctionSession) (*State, error) {
+	sold, err := s.ports.Auctions.CloseExpiredAuctionLot(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	if sold != nil {
+		// The nomination index may have moved; reload before deciding
+		// whether anyone is left to buy.
+		if session, err = s.ports.Auctions.GetAuctionSessionByPool(ctx, session.PoolID); err != nil {
+			return nil, err
+		}
+	}
+
+	state, err := s.loadState(ctx, session)
+	if err != nil {
+		return nil, err
+	}
+	if state.OpenLot == nil && (len(state.RemainingTeamIDs) == 0 || state.CurrentNominator == "") {
+		if err := s.ports.Auctions.CompleteAuctionSession(ctx, session.ID); err != nil {
+			return nil, err
+		}
+		if state.Session, err = s.ports.Auctions.GetAuctionSessionByPool(ctx, session.PoolID); err != nil {
+			return nil, err
+		}
+		if sold != nil {
+			s.publish(ctx, EventLotSold, session.PoolID)
+		}
+		s.publish(ctx, EventSessionCompleted, session.PoolID)
+		return state, nil
+	}
+	if sold != nil {
+		s.publish(ctx, EventLotSold, session.PoolID)
+	}
+	return state, nil
+}
+
+func (s *Service) loadState(ctx context.Context, session *models.AuctionSession) (*State, error) {
+	pool, err := s.ports.Pools.GetByID(ctx, session.PoolID)
+	if err != nil {
+		return nil, err
+	}
+	lots, err := s.ports.Auctions.ListAuctionLots(ctx, session.ID)
+	if err != nil {
+		return nil, err
+	}
+	teams, err := s.ports.Teams.GetTeams(ctx, pool.TournamentID)
+	if err != nil {
+		return nil, err
+	}
+	portfolios, _, err := s.ports.Portfolios.GetPortfolios(ctx, pool.ID)
+	if err != nil {
+		return nil, err
+	}
+	portfolioIDs := make([]string, 0, len(portfolios))
+	for _, p := range portfolios {
+		portfolioIDs = append(portfolioIDs, p.ID)
+	}
+	investments, err := s.ports.Portfolios.GetInvestmentsByPortfolioIDs(ctx, portfolioIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	state := &State{Session: session, Lots: lots, ServerTime: time.Now(), sealed: make(map[string]holding)}
+	auctioned := make(map[string]bool, len(lots))
+	for _, lot := range lots {
+		auctioned[lot.TeamID] = true
+		if lot.Status == models.AuctionLotOpen {
+			state.OpenLot = lot
+		}
+	}
+	// Sold lots are written as investments too; only the rest were sealed bids.
+	held := make(map[string]bool)
+	for portfolioID, invs := range investments {
+		for _, inv := range invs {
+			if auctioned[inv.TeamID] {
+				continue
+			}
+			held[inv.TeamID] = true
+			h := state.sealed[portfolioID]
+			h.spent += inv.Credits
+			h.won++
+			state.sealed[portfolioID] = h
+		}
+	}
+	state.RemainingTeamIDs = make([]string, 0, len(teams))
+	for _, team := range teams {
+		if !auctioned[team.ID] && !held[team.ID] {
+			state.RemainingTeamIDs = append(state.RemainingTeamIDs, team.ID)
+		}
+	}
+
+	if state.OpenLot != nil {
+		if session.Status == models.AuctionStatusOpen {
+			s.scheduleClose(session.PoolID, state.OpenLot.ClosesAt)
+		}
+		return state, nil
+	}
+
+	active := make(map[string]bool, len(portfolios))
+	for _, p := range portfolios {
+		active[p.ID] = true
+	}
+	n := len(session.NominationOrder)
+	for i := 0; i < n; i++ {
+		idx := session.NominationIndex + i
+		id := session.NominationOrder[idx%n]
+		spent, won := wonBy(lots, id)
+		spent += state.sealed[id].spent
+		won += state.sealed[id].won
+		if active[id] && canStillBuy(pool, spent, won) {
+			state.CurrentNominator = id
+			state.nominatorIndex = idx
+			break
+		}
+	}
+	return state, nil
+}
+
+// scheduleClose arranges for the pool's open lot to be sold once its
+// countdown ends. The close is retried from state reads and by the auction
+// worker's sweep, so a lost timer (say, a restart) only delays the sale.
+func (s *Service) scheduleClose(poolID string, closesAt time.Time) {
+	delay := time.Until(closesAt) + closeGrace
+	if delay < closeGrace {
+		delay = closeGrace
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if t, ok := s.timers[poolID]; ok {
+		t.Stop()
+	}
+	var timer *time.Timer
+	timer = time.AfterFunc(delay, func() {
+		s.mu.Lock()
+		if s.timers[poolID] == timer {
+			delete(s.timers, poolID)
+		}
+		s.mu.Unlock()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+		defer cancel()
+		if _, err := s.GetState(ctx, poolID); err != nil {
+			slog.Warn("auction_close_failed", "pool_id", poolID, "error", err)
+		}
+	})
+	s.timers[poolID] = timer
+}
+
+// publish is best effort: a lost event only delays viewers until the next
+// one, or until they read the state.
+func (s *Service) publish(ctx context.Context, eventType, poolID string) {
+	ev := models.ChangeEvent{Kind: models.ChangeKindAuction, PoolID: poolID, Detail: eventType}
+	if err := s.ports.Changes.PublishChange(ctx, ev); err != nil {
+		slog.Warn("auction_publish_failed", "pool_id", poolID, "event", eventType, "error", err)
+	}
+}
+
+// wonBy totals the credits spent and teams bought by a portfolio.
+func wonBy(lots []*models.AuctionLot, portfolioID string) (spent, won int) {
+	for _, lot := range lots {
+		if lot.Status == models.AuctionLotSold && lot.HighBidPortfolioID == portfolioID {
+			spent += lot.HighBidCredits
+			won++
+		}
+	}
+	return spent, won
+}
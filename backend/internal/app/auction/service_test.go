@@ -0,0 +1,312 @@
+package auction
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+)
+
+type fakeAuctionRepo struct {
+	ports.AuctionRepository
+	session   *models.AuctionSession
+	lots      []*models.AuctionLot
+	nominated *models.AuctionLot
+	nextIndex int
+	completed bool
+	closed    []string
+
+	expiredPoolIDs []string
+}
+
+func (f *fakeAuctionRepo) CreateAuctionSession(_ context.Context, session *models.AuctionSession) error {
+	session.ID = "session-1"
+	session.Status = models.AuctionStatusPending
+	f.session = session
+	return nil
+}
+
+func (f *fakeAuctionRepo) GetAuctionSessionByPool(context.Context, string) (*models.AuctionSession, error) {
+	return f.session, nil
+}
+
+func (f *fakeAuctionRepo) CompleteAuctionSession(context.Context, string) error {
+	f.completed = true
+	f.session.Status = models.AuctionStatusCompleted
+	return nil
+}
+
+func (f *fakeAuctionRepo) ListAuctionLots(context.Context, string) ([]*models.AuctionLot, error) {
+	return f.lots, nil
+}
+
+func (f *fakeAuctionRepo) NominateAuctionLot(_ context.Context, lot *models.AuctionLot, _, nextIndex int) error {
+	lot.ID = "lot-new"
+	lot.Status = models.AuctionLotOpen
+	lot.HighBidPortfolioID = lot.NominatedByPortfolioID
+	lot.ClosesAt = time.Now().Add(time.Minute)
+	f.nominated = lot
+	f.nextIndex = nextIndex
+	return nil
+}
+
+func (f *fakeAuctionRepo) CloseExpiredAuctionLot(_ context.Context, sessionID string) (*models.AuctionLot, error) {
+	f.closed = append(f.closed, sessionID)
+	return nil, nil
+}
+
+func (f *fakeAuctionRepo) ListPoolIDsWithExpiredAuctionLots(context.Context) ([]string, error) {
+	return f.expiredPoolIDs, nil
+}
+
+type fakePools struct {
+	ports.PoolReader
+	pool *models.Pool
+}
+
+func (f fakePools) GetByID(context.Context, string) (*models.Pool, error) {
+	return f.pool, nil
+}
+
+type fakePortfolios struct {
+	ports.PortfolioReader
+	ids         []string
+	investments map[string][]*models.Investment
+}
+
+func (f fakePortfolios) GetPortfolios(context.Context, string) ([]*models.Portfolio, map[string]float64, error) {
+	out := make([]*models.Portfolio, 0, len(f.ids))
+	for _, id := range f.ids {
+		out = append(out, &models.Portfolio{ID: id, PoolID: "pool-1"})
+	}
+	return out, nil, nil
+}
+
+func (f fakePortfolios) GetInvestmentsByPortfolioIDs(context.Context, []string) (map[string][]*models.Investment, error) {
+	return f.investments, nil
+}
+
+type fakeChanges struct {
+	events []models.ChangeEvent
+}
+
+func (f *fakeChanges) PublishChange(_ context.Context, ev models.ChangeEvent) error {
+	f.events = append(f.events, ev)
+	return nil
+}
+
+type fakeTeams []string
+
+func (f fakeTeams) GetTeams(context.Context, string) ([]*models.TournamentTeam, error) {
+	out := make([]*models.TournamentTeam, 0, len(f))
+	for _, id := range f {
+		out = append(out, &models.TournamentTeam{ID: id})
+	}
+	return out, nil
+}
+
+type fakeTournaments struct {
+	startingAt time.Time
+}
+
+func (f fakeTournaments) GetByID(context.Context, string) (*models.Tournament, error) {
+	return &models.Tournament{ID: "tournament-1", StartingAt: &f.startingAt}, nil
+}
+
+func newLeftoversService(repo *fakeAuctionRepo, portfolios fakePortfolios, teams fakeTeams) *Service {
+	pool := newTestPool()
+	pool.UnclaimedMode = models.UnclaimedModeLeftovers
+	started := fakeTournaments{startingAt: time.Now().Add(-time.Hour)}
+	return New(Ports{Auctions: repo, Pools: fakePools{pool: pool}, Portfolios: portfolios, Teams: teams, Tournaments: started, Changes: &fakeChanges{}})
+}
+
+func newTestService(repo *fakeAuctionRepo, portfolios fakePortfolios, teams fakeTeams) *Service {
+	return New(Ports{Auctions: repo, Pools: fakePools{pool: newTestPool()}, Portfolios: portfolios, Teams: teams, Changes: &fakeChanges{}})
+}
+
+func newOpenSession(order ...string) *models.AuctionSession {
+	return &models.AuctionSession{ID: "session-1", PoolID: "pool-1", Status: models.AuctionStatusOpen, NominationOrder: order}
+}
+
+func TestThatCreateSessionDefaultsToPoolPortfolios(t *testing.T) {
+	// GIVEN a pool with two portfolios and no sealed bids
+	repo := &fakeAuctionRepo{}
+	svc := newTestService(repo, fakePortfolios{ids: []string{"p1", "p2"}}, nil)
+
+	// WHEN creating a session without a nomination order
+	session, err := svc.CreateSession(context.Background(), "pool-1", nil, SessionSettings{})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the portfolios nominate in pool order
+	if len(session.NominationOrder) != 2 || session.NominationOrder[0] != "p1" || session.NominationOrder[1] != "p2" {
+		t.Errorf("expected [p1 p2], got %v", session.NominationOrder)
+	}
+}
+
+func TestThatCreateSessionRejectsPoolWithSealedBids(t *testing.T) {
+	// GIVEN a pool where a portfolio already has sealed bids
+	investments := map[string][]*models.Investment{"p1": {{TeamID: "t1", Credits: 10}}}
+	svc := newTestService(&fakeAuctionRepo{}, fakePortfolios{ids: []string{"p1"}, investments: investments}, nil)
+
+	// WHEN creating a session
+	_, err := svc.CreateSession(context.Background(), "pool-1", nil, SessionSettings{})
+
+	// THEN it is rejected
+	if err == nil {
+		t.Error("expected error for pool with sealed bids")
+	}
+}
+
+func TestThatCreateSessionAllowsLeftoversRoundAfterSealedBidding(t *testing.T) {
+	// GIVEN a leftovers pool with sealed bids whose tournament has started
+	investments := map[string][]*models.Investment{"p1": {{TeamID: "t1", Credits: 10}}}
+	svc := newLeftoversService(&fakeAuctionRepo{}, fakePortfolios{ids: []string{"p1"}, investments: investments}, nil)
+
+	// WHEN creating a session
+	_, err := svc.CreateSession(context.Background(), "pool-1", nil, SessionSettings{})
+
+	// THEN it is allowed
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestThatLeftoversRoundOnlyOffersUnclaimedTeams(t *testing.T) {
+	// GIVEN a leftovers round where p1 bought t1 with a sealed bid
+	investments := map[string][]*models.Investment{"p1": {{TeamID: "t1", Credits: 10}}}
+	repo := &fakeAuctionRepo{session: newOpenSession("p1", "p2")}
+	svc := newLeftoversService(repo, fakePortfolios{ids: []string{"p1", "p2"}, investments: investments}, fakeTeams{"t1", "t2"})
+
+	// WHEN reading the state
+	state, err := svc.GetState(context.Background(), "pool-1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN only t2 remains
+	if len(state.RemainingTeamIDs) != 1 || state.RemainingTeamIDs[0] != "t2" {
+		t.Errorf("expected [t2], got %v", state.RemainingTeamIDs)
+	}
+}
+
+func TestThatCreateSessionRejectsPortfolioOutsidePool(t *testing.T) {
+	// GIVEN a pool with one portfolio
+	svc := newTestService(&fakeAuctionRepo{}, fakePortfolios{ids: []string{"p1"}}, nil)
+
+	// WHEN the nomination order names another pool's portfolio
+	_, err := svc.CreateSession(context.Background(), "pool-1", nil, SessionSettings{NominationOrder: []string{"p1", "p9"}})
+
+	// THEN it is rejected
+	if err == nil {
+		t.Error("expected error for portfolio outside the pool")
+	}
+}
+
+func TestThatNominateRejectsPortfolioOutOfTurn(t *testing.T) {
+	// GIVEN an open auction where it is p1's turn
+	repo := &fakeAuctionRepo{session: newOpenSession("p1", "p2")}
+	svc := newTestService(repo, fakePortfolios{ids: []string{"p1", "p2"}}, fakeTeams{"t1", "t2"})
+
+	// WHEN p2 nominates
+	_, err := svc.Nominate(context.Background(), "pool-1", "p2", "t1", 5)
+
+	// THEN it is rejected
+	if err == nil {
+		t.Error("expected error for nomination out of turn")
+	}
+}
+
+func TestThatNominateRejectsSoldTeam(t *testing.T) {
+	// GIVEN an open auction where t1 has already been sold
+	repo := &fakeAuctionRepo{
+		session: newOpenSession("p1", "p2"),
+		lots:    []*models.AuctionLot{{TeamID: "t1", Status: models.AuctionLotSold, HighBidPortfolioID: "p2", HighBidCredits: 5}},
+	}
+	svc := newTestService(repo, fakePortfolios{ids: []string{"p1", "p2"}}, fakeTeams{"t1", "t2"})
+
+	// WHEN p1 nominates t1
+	_, err := svc.Nominate(context.Background(), "pool-1", "p1", "t1", 5)
+
+	// THEN it is rejected
+	if err == nil {
+		t.Error("expected error for nominating a sold team")
+	}
+}
+
+func TestThatNominationSkipsPortfolioAtTeamLimit(t *testing.T) {
+	// GIVEN p1 has bought the pool's maximum of 2 teams and it is p1's turn
+	repo := &fakeAuctionRepo{
+		session: newOpenSession("p1", "p2"),
+		lots: []*models.AuctionLot{
+			{TeamID: "t1", Status: models.AuctionLotSold, HighBidPortfolioID: "p1", HighBidCredits: 5},
+			{TeamID: "t2", Status: models.AuctionLotSold, HighBidPortfolioID: "p1", HighBidCredits: 5},
+		},
+	}
+	svc := newTestService(repo, fakePortfolios{ids: []string{"p1", "p2"}}, fakeTeams{"t1", "t2", "t3"})
+
+	// WHEN p2 nominates the next team
+	if _, err := svc.Nominate(context.Background(), "pool-1", "p2", "t3", 5); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the turn passes beyond p2
+	if repo.nextIndex != 2 {
+		t.Errorf("expected next nomination index 2, got %d", repo.nextIndex)
+	}
+}
+
+func TestThatAuctionCompletesWhenEveryTeamIsSold(t *testing.T) {
+	// GIVEN an open auction whose only team has been sold
+	repo := &fakeAuctionRepo{
+		session: newOpenSession("p1"),
+		lots:    []*models.AuctionLot{{TeamID: "t1", Status: models.AuctionLotSold, HighBidPortfolioID: "p1", HighBidCredits: 5}},
+	}
+	svc := newTestService(repo, fakePortfolios{ids: []string{"p1"}}, fakeTeams{"t1"})
+
+	// WHEN reading the state
+	if _, err := svc.GetState(context.Background(), "pool-1"); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the session is completed
+	if !repo.completed {
+		t.Error("expected the session to be completed")
+	}
+}
+
+func TestThatNominationPublishesLotOpenedChange(t *testing.T) {
+	// GIVEN an open auction
+	repo := &fakeAuctionRepo{session: newOpenSession("p1", "p2")}
+	changes := &fakeChanges{}
+	svc := New(Ports{Auctions: repo, Pools: fakePools{pool: newTestPool()}, Portfolios: fakePortfolios{ids: []string{"p1", "p2"}}, Teams: fakeTeams{"t1", "t2"}, Changes: changes})
+
+	// WHEN p1 nominates a team
+	if _, err := svc.Nominate(context.Background(), "pool-1", "p1", "t1", 5); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN a lot-opened auction change is published for the pool
+	if len(changes.events) != 1 || changes.events[0].Detail != EventLotOpened || changes.events[0].PoolID != "pool-1" {
+		t.Errorf("expected one lot_opened change for pool-1, got %+v", changes.events)
+	}
+}
+
+func TestThatCloseExpiredLotsClosesEachPoolsDueLot(t *testing.T) {
+	// GIVEN an open auction whose lot's countdown ran out with no timer armed
+	repo := &fakeAuctionRepo{session: newOpenSession("p1", "p2"), expiredPoolIDs: []string{"pool-1"}}
+	svc := newTestService(repo, fakePortfolios{ids: []string{"p1", "p2"}}, fakeTeams{"t1", "t2"})
+
+	// WHEN sweeping for expired lots
+	if err := svc.CloseExpiredLots(context.Background()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the pool's session has its due lot closed
+	if len(repo.closed) != 1 || repo.closed[0] != repo.session.ID {
+		t.Errorf("expected one close for %s, got %v", repo.session.ID, repo.closed)
+	}
+}
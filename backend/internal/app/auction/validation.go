@@ -0,0 +1,52 @@
+package auction
+
+import (
+	"fmt"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// ValidateOpeningBid checks a nominator's opening bid against the pool's
+// limits, given what the nominator has already won in the auction.
+func ValidateOpeningBid(pool *models.Pool, spentCredits, teamsWon, credits int) error {
+	if credits < 1 {
+		return &apperrors.InvalidArgumentError{Field: "credits", Message: "minimum bid is 1 credit"}
+	}
+	return checkBidderLimits(pool, spentCredits, teamsWon, credits)
+}
+
+// ValidateAuctionBid checks a bid on an open lot. A winning bid buys the whole
+// team, so the pool's per-team cap, team limit and budget all apply to it.
+func ValidateAuctionBid(pool *models.Pool, bidCtx models.AuctionBidContext, portfolioID string, credits int) error {
+	lot := bidCtx.Lot
+	if lot.Status != models.AuctionLotOpen || !bidCtx.Now.Before(lot.ClosesAt) {
+		return &apperrors.InvalidArgumentError{Field: "lotId", Message: "bidding on this team has closed"}
+	}
+	if lot.HighBidPortfolioID == portfolioID {
+		return &apperrors.InvalidArgumentError{Field: "portfolioId", Message: "portfolio already holds the high bid"}
+	}
+	minimum := lot.HighBidCredits + bidCtx.MinIncrementCredits
+	if credits < minimum {
+		return &apperrors.InvalidArgumentError{Field: "credits", Message: fmt.Sprintf("bid must be at least %d credits", minimum)}
+	}
+	return checkBidderLimits(pool, bidCtx.BidderSpentCredits, bidCtx.BidderTeamsWon, credits)
+}
+
+func checkBidderLimits(pool *models.Pool, spentCredits, teamsWon, credits int) error {
+	if teamsWon >= pool.MaxTeams {
+		return &apperrors.InvalidArgumentError{Field: "portfolioId", Message: fmt.Sprintf("investors may invest in a maximum of %d teams", pool.MaxTeams)}
+	}
+	if credits > pool.MaxInvestmentCredits {
+		return &apperrors.InvalidArgumentError{Field: "credits", Message: fmt.Sprintf("maximum investment in any single team is %d credits", pool.MaxInvestmentCredits)}
+	}
+	if spentCredits+credits > pool.BudgetCredits {
+		return &apperrors.InvalidArgumentError{Field: "credits", Message: fmt.Sprintf("total investments cannot exceed budget of %d credits", pool.BudgetCredits)}
+	}
+	return nil
+}
+
+// canStillBuy reports whether a portfolio could afford an opening bid.
+func canStillBuy(pool *models.Pool, spentCredits, teamsWon int) bool {
+	return checkBidderLimits(pool, spentCredits, teamsWon, 1) == nil
+}
@@ -0,0 +1,129 @@
+package auction
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func newTestPool() *models.Pool {
+	return &models.Pool{
+		ID:                   "pool-1",
+		TournamentID:         "tournament-1",
+		MinTeams:             3,
+		MaxTeams:             2,
+		MaxInvestmentCredits: 50,
+		BudgetCredits:        100,
+	}
+}
+
+func newTestBidContext(highBid int) models.AuctionBidContext {
+	now := time.Date(2026, 3, 15, 12, 0, 0, 0, time.UTC)
+	return models.AuctionBidContext{
+		Lot: &models.AuctionLot{
+			ID:                 "lot-1",
+			Status:             models.AuctionLotOpen,
+			HighBidCredits:     highBid,
+			HighBidPortfolioID: "p-high",
+			ClosesAt:           now.Add(10 * time.Second),
+		},
+		MinIncrementCredits: 1,
+		Now:                 now,
+	}
+}
+
+func TestThatBidAboveHighBidPassesValidation(t *testing.T) {
+	// GIVEN a lot with a high bid of 10
+	bidCtx := newTestBidContext(10)
+
+	// WHEN another portfolio bids 11
+	err := ValidateAuctionBid(newTestPool(), bidCtx, "p-other", 11)
+
+	// THEN the bid is accepted
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestThatBidBelowMinimumIncrementIsRejected(t *testing.T) {
+	// GIVEN a lot with a high bid of 10 and an increment of 5
+	bidCtx := newTestBidContext(10)
+	bidCtx.MinIncrementCredits = 5
+
+	// WHEN another portfolio bids 14
+	err := ValidateAuctionBid(newTestPool(), bidCtx, "p-other", 14)
+
+	// THEN the bid is rejected
+	if err == nil {
+		t.Error("expected error for bid below the minimum increment")
+	}
+}
+
+func TestThatBidAfterCountdownIsRejected(t *testing.T) {
+	// GIVEN a lot whose countdown has run out
+	bidCtx := newTestBidContext(10)
+	bidCtx.Now = bidCtx.Lot.ClosesAt
+
+	// WHEN another portfolio bids
+	err := ValidateAuctionBid(newTestPool(), bidCtx, "p-other", 20)
+
+	// THEN the bid is rejected
+	if err == nil {
+		t.Error("expected error for bid after the countdown")
+	}
+}
+
+func TestThatHighBidderCannotRaiseOwnBid(t *testing.T) {
+	// GIVEN a lot held by p-high
+	bidCtx := newTestBidContext(10)
+
+	// WHEN p-high bids again
+	err := ValidateAuctionBid(newTestPool(), bidCtx, "p-high", 20)
+
+	// THEN the bid is rejected
+	if err == nil {
+		t.Error("expected error for bidding against yourself")
+	}
+}
+
+func TestThatBidOverRemainingBudgetIsRejected(t *testing.T) {
+	// GIVEN a bidder who has already spent 60 of 100 credits
+	bidCtx := newTestBidContext(10)
+	bidCtx.BidderSpentCredits = 60
+	bidCtx.BidderTeamsWon = 1
+
+	// WHEN the bidder bids 41
+	err := ValidateAuctionBid(newTestPool(), bidCtx, "p-other", 41)
+
+	// THEN the bid is rejected
+	if err == nil {
+		t.Error("expected error for bid over the remaining budget")
+	}
+}
+
+func TestThatBidOverPerTeamCapIsRejected(t *testing.T) {
+	// GIVEN a pool capping any single team at 50 credits
+	bidCtx := newTestBidContext(10)
+
+	// WHEN a portfolio bids 51
+	err := ValidateAuctionBid(newTestPool(), bidCtx, "p-other", 51)
+
+	// THEN the bid is rejected
+	if err == nil {
+		t.Error("expected error for bid over the per-team cap")
+	}
+}
+
+func TestThatBidderAtMaxTeamsCannotOpen(t *testing.T) {
+	// GIVEN a nominator who already owns the pool's maximum of 2 teams
+	pool := newTestPool()
+
+	// WHEN the nominator opens with 1 credit
+	err := ValidateOpeningBid(pool, 10, 2, 1)
+
+	// THEN the opening bid is rejected
+	if err == nil {
+		t.Error("expected error for nominator at the team limit")
+	}
+}
@@ -6,12 +6,17 @@ import (
 	dbadapters "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
 	"github.com/andrewcopp/Calcutta/backend/internal/app"
 	appanalytics "github.com/andrewcopp/Calcutta/backend/internal/app/analytics"
+	appauction "github.com/andrewcopp/Calcutta/backend/internal/app/auction"
 	appauth "github.com/andrewcopp/Calcutta/backend/internal/app/auth"
 	appbracket "github.com/andrewcopp/Calcutta/backend/internal/app/bracket"
+	appchangefeed "github.com/andrewcopp/Calcutta/backend/internal/app/changefeed"
 	apppool "github.com/andrewcopp/Calcutta/backend/internal/app/pool"
 	applab "github.com/andrewcopp/Calcutta/backend/internal/app/lab"
+	appledger "github.com/andrewcopp/Calcutta/backend/internal/app/ledger"
 	appprediction "github.com/andrewcopp/Calcutta/backend/internal/app/prediction"
+	appreveal "github.com/andrewcopp/Calcutta/backend/internal/app/reveal"
 	appschool "github.com/andrewcopp/Calcutta/backend/internal/app/school"
+	appscorefeed "github.com/andrewcopp/Calcutta/backend/internal/app/scorefeed"
 	apptournament "github.com/andrewcopp/Calcutta/backend/internal/app/tournament"
 	appusermgmt "github.com/andrewcopp/Calcutta/backend/internal/app/usermanagement"
 	coreauth "github.com/andrewcopp/Calcutta/backend/internal/auth"
@@ -27,10 +32,13 @@ func NewApp(pool *pgxpool.Pool, cfg platform.Config, authRepo *dbadapters.AuthRe
 	poolRepo := dbadapters.NewPoolRepository(pool)
 	invitationRepo := dbadapters.NewPoolInvitationRepository(pool)
 	snapshotRepo := dbadapters.NewInvestmentSnapshotRepository(pool)
+	biddingWindowRepo := dbadapters.NewBiddingWindowRepository(pool)
 	poolService := apppool.New(apppool.Ports{
 		Pools:               poolRepo,
 		Portfolios:          poolRepo,
 		Payouts:             poolRepo,
+		SidePots:            dbadapters.NewSidePotRepository(pool),
+		BiddingWindows:      biddingWindowRepo,
 		OwnershipReader:     poolRepo,
 		ScoringRules:        poolRepo,
 		TeamReader:          poolRepo,
@@ -49,16 +57,45 @@ func NewApp(pool *pgxpool.Pool, cfg platform.Config, authRepo *dbadapters.AuthRe
 
 	predictionRepo := dbadapters.NewPredictionRepository(pool)
 
-	a := &app.App{Bracket: appbracket.New(dbTournamentRepo)}
+	gameResultRepo := dbadapters.NewGameResultRepository(pool)
+
+	a := &app.App{Bracket: appbracket.New(dbTournamentRepo, gameResultRepo, gameResultRepo)}
 	a.Pool = poolService
+	changeFeed := dbadapters.NewChangeFeed(pool)
+	a.Changes = appchangefeed.New(changeFeed, changeFeed)
+	a.Auction = appauction.New(appauction.Ports{
+		Auctions:       dbadapters.NewAuctionRepository(pool),
+		Pools:          poolRepo,
+		Portfolios:     poolRepo,
+		Teams:          dbTournamentRepo,
+		Tournaments:    dbTournamentRepo,
+		BiddingWindows: biddingWindowRepo,
+		Changes:        changeFeed,
+	})
+	a.Ledger = appledger.New(appledger.Ports{
+		Ledger:     dbadapters.NewLedgerRepository(pool),
+		Portfolios: poolRepo,
+	})
 	a.Prediction = appprediction.New(appprediction.Ports{
-		Batches:    predictionRepo,
-		Tournament: predictionRepo,
+		Batches:           predictionRepo,
+		Tournament:        predictionRepo,
+		ProbabilityTables: dbadapters.NewMatchupProbabilityRepository(pool),
+	})
+	a.Reveal = appreveal.New(appreveal.Ports{
+		Reveals:     dbadapters.NewMarketRevealRepository(pool),
+		Pools:       poolRepo,
+		Portfolios:  poolRepo,
+		Predictions: predictionRepo,
 	})
 	a.Analytics = analyticsService
 	a.Lab = labService
 	a.Auth = appauth.New(dbUserRepo, authRepo, tm, time.Duration(cfg.RefreshTokenTTLHours)*time.Hour)
 	a.School = appschool.New(dbSchoolRepo)
+	a.ScoreFeed = appscorefeed.New(appscorefeed.Ports{
+		Feeds:       dbadapters.NewScoreFeedRepository(pool),
+		Tournaments: dbadapters.NewTournamentQueryRepository(pool),
+		Bracket:     a.Bracket,
+	})
 	a.Tournament = apptournament.New(dbTournamentRepo)
 
 	userMergeRepo := dbadapters.NewUserMergeRepository(pool)
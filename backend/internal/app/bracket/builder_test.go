@@ -389,3 +389,15 @@ func createTeam(tournamentID, region string, seed int, suffix string) *models.To
 		School:       &models.School{ID: schoolID, Name: name},
 	}
 }
+
+func buildRegionalBracket(bracket *models.BracketStructure, region string, teams []*models.TournamentTeam) (string, error) {
+	tmpl := &models.BracketTemplate{
+		Regions: []string{region},
+		PlayIns: models.PlayInDuplicateSeeds,
+		Games:   ncaaRegionGames(region, regionIndex(Regions, region)),
+	}
+	if err := instantiateGames(bracket, tmpl, teams); err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s-%s-1", region, models.RoundElite8), nil
+}
@@ -2,6 +2,8 @@ package bracket
 
 import "github.com/andrewcopp/Calcutta/backend/internal/models"
 
+// NCAA layout constants. Other bracket shapes are described by a
+// models.BracketTemplate; see NCAATemplate.
 const TotalTournamentTeams = 68
 const TeamsPerRegion = 16
 const MaxTeamsPerSeed = 2
@@ -18,10 +20,6 @@ var Regions = []string{"East", "West", "South", "Midwest"}
 
 const RegionSortMultiplier = 1000
 
-var regionSortOrder = map[string]int{
-	"East": 0, "West": 1, "South": 2, "Midwest": 3,
-}
-
 var roundSortOffset = map[models.BracketRound]int{
 	models.RoundFirstFour:    0,
 	models.RoundOf64:         100,
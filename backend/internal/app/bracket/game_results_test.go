@@ -0,0 +1,132 @@
+package bracket
+
+import (
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func newTwoGameBracket() *models.BracketStructure {
+	return &models.BracketStructure{
+		TournamentID: "t",
+		Games: map[string]*models.BracketGame{
+			"game1": {
+				GameID:       "game1",
+				Round:        models.RoundOf64,
+				Team1:        &models.BracketTeam{TeamID: "team-a", Seed: 1, LowestSeedSeen: 1},
+				Team2:        &models.BracketTeam{TeamID: "team-b", Seed: 16, LowestSeedSeen: 16},
+				NextGameID:   "game2",
+				NextGameSlot: 1,
+			},
+			"game2": {
+				GameID: "game2",
+				Round:  models.RoundOf32,
+				Team2:  &models.BracketTeam{TeamID: "team-c", Seed: 8, LowestSeedSeen: 8},
+			},
+		},
+	}
+}
+
+func TestThatApplyGameResultsSetsWinnerFromStoredResult(t *testing.T) {
+	// GIVEN a bracket and a stored result for game1 won by team-b
+	bracket := newTwoGameBracket()
+	results := []*models.GameResult{{GameID: "game1", WinnerTeamID: "team-b", LoserTeamID: "team-a"}}
+
+	// WHEN applying game results
+	applyGameResults(bracket, results)
+
+	// THEN team-b is the winner of game1
+	if w := bracket.Games["game1"].Winner; w == nil || w.TeamID != "team-b" {
+		t.Errorf("expected winner team-b, got %+v", w)
+	}
+}
+
+func TestThatApplyGameResultsAdvancesWinnerIntoNextGameSlot(t *testing.T) {
+	// GIVEN a bracket and a stored result for game1 won by team-b
+	bracket := newTwoGameBracket()
+	results := []*models.GameResult{{GameID: "game1", WinnerTeamID: "team-b", LoserTeamID: "team-a"}}
+
+	// WHEN applying game results
+	applyGameResults(bracket, results)
+
+	// THEN team-b occupies slot 1 of game2
+	if team := bracket.Games["game2"].Team1; team == nil || team.TeamID != "team-b" {
+		t.Errorf("expected team-b in game2 slot 1, got %+v", team)
+	}
+}
+
+func TestThatApplyGameResultsIgnoresResultForTeamNotInGame(t *testing.T) {
+	// GIVEN a stored result naming a winner that is not playing in game1
+	bracket := newTwoGameBracket()
+	results := []*models.GameResult{{GameID: "game1", WinnerTeamID: "team-z", LoserTeamID: "team-a"}}
+
+	// WHEN applying game results
+	applyGameResults(bracket, results)
+
+	// THEN game1 has no winner
+	if w := bracket.Games["game1"].Winner; w != nil {
+		t.Errorf("expected no winner, got %s", w.TeamID)
+	}
+}
+
+func TestThatUnrecordedResultsSkipsGamesWithStoredResults(t *testing.T) {
+	// GIVEN a bracket where game1 is decided and already has a stored result
+	bracket := newTwoGameBracket()
+	stored := []*models.GameResult{{GameID: "game1", WinnerTeamID: "team-a", LoserTeamID: "team-b"}}
+	applyGameResults(bracket, stored)
+
+	// WHEN collecting unrecorded results
+	pending := unrecordedResults(bracket, stored)
+
+	// THEN nothing needs to be recorded
+	if len(pending) != 0 {
+		t.Errorf("expected no pending results, got %d", len(pending))
+	}
+}
+
+func TestThatUnrecordedResultsCapturesCounterDerivedWinnerWithLoser(t *testing.T) {
+	// GIVEN a bracket whose game1 winner was inferred from team counters
+	bracket := newTwoGameBracket()
+	bracket.Games["game1"].Winner = bracket.Games["game1"].Team1
+
+	// WHEN collecting unrecorded results
+	pending := unrecordedResults(bracket, nil)
+
+	// THEN a result for game1 with team-b as the loser is produced
+	if len(pending) != 1 || pending[0].LoserTeamID != "team-b" {
+		t.Errorf("expected one pending result with loser team-b, got %+v", pending)
+	}
+}
+
+func TestThatDownstreamDecidedGamesIncludesLaterGamesTheWinnerReached(t *testing.T) {
+	// GIVEN game1 decided and game2, which its winner advanced into, also decided
+	bracket := newTwoGameBracket()
+	applyGameResults(bracket, []*models.GameResult{
+		{GameID: "game1", WinnerTeamID: "team-a", LoserTeamID: "team-b"},
+		{GameID: "game2", WinnerTeamID: "team-c", LoserTeamID: "team-a"},
+	})
+
+	// WHEN collecting the games downstream of game1
+	games := downstreamDecidedGames(bracket, "game1")
+
+	// THEN both game1 and game2 are returned
+	if len(games) != 2 || games[1].GameID != "game2" {
+		t.Errorf("expected [game1 game2], got %d games", len(games))
+	}
+}
+
+func TestThatDownstreamDecidedGamesStopsAtUndecidedGame(t *testing.T) {
+	// GIVEN only game1 decided
+	bracket := newTwoGameBracket()
+	applyGameResults(bracket, []*models.GameResult{
+		{GameID: "game1", WinnerTeamID: "team-a", LoserTeamID: "team-b"},
+	})
+
+	// WHEN collecting the games downstream of game1
+	games := downstreamDecidedGames(bracket, "game1")
+
+	// THEN only game1 is returned
+	if len(games) != 1 {
+		t.Errorf("expected 1 game, got %d", len(games))
+	}
+}
@@ -0,0 +1,107 @@
+package bracket
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/winprob"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// defaultLiveSource labels live states posted without a source.
+const defaultLiveSource = "manual"
+
+// LiveGameUpdate reports the state of a game in progress. Scores are in the
+// bracket game's team order; Team1ID and Team2ID, when set, must match it.
+type LiveGameUpdate struct {
+	Team1ID          string
+	Team2ID          string
+	Team1Score       int
+	Team2Score       int
+	SecondsRemaining int
+	PossessionTeamID string
+	Source           string
+}
+
+// UpdateLiveGame stores the latest state of a game in progress. The game must
+// have both teams and no result.
+func (s *Service) UpdateLiveGame(ctx context.Context, tournamentID, gameID string, update LiveGameUpdate) (*models.LiveGameState, error) {
+	bracket, _, err := s.loadBracket(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+
+	game, exists := bracket.Games[gameID]
+	if !exists {
+		return nil, &apperrors.NotFoundError{Resource: "game", ID: gameID}
+	}
+	if err := validateLiveGameUpdate(game, update); err != nil {
+		return nil, err
+	}
+
+	state := &models.LiveGameState{
+		TournamentID:     tournamentID,
+		GameID:           gameID,
+		Team1ID:          game.Team1.TeamID,
+		Team2ID:          game.Team2.TeamID,
+		Team1Score:       update.Team1Score,
+		Team2Score:       update.Team2Score,
+		SecondsRemaining: update.SecondsRemaining,
+		Source:           update.Source,
+	}
+	if update.PossessionTeamID != "" {
+		possession := update.PossessionTeamID
+		state.PossessionTeamID = &possession
+	}
+	if state.Source == "" {
+		state.Source = defaultLiveSource
+	}
+
+	if err := s.liveGames.UpsertLiveGameState(ctx, state); err != nil {
+		return nil, fmt.Errorf("failed to store live game state: %w", err)
+	}
+	return state, nil
+}
+
+// ListLiveGames returns the tournament's games in progress.
+func (s *Service) ListLiveGames(ctx context.Context, tournamentID string) ([]*models.LiveGameState, error) {
+	states, err := s.liveGames.ListLiveGameStates(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list live game states: %w", err)
+	}
+	return states, nil
+}
+
+// ClearLiveGame removes the live state of a game, such as one posted in error.
+func (s *Service) ClearLiveGame(ctx context.Context, tournamentID, gameID string) error {
+	if err := s.liveGames.DeleteLiveGameState(ctx, tournamentID, gameID); err != nil {
+		return fmt.Errorf("failed to clear live game state: %w", err)
+	}
+	return nil
+}
+
+func validateLiveGameUpdate(game *models.BracketGame, update LiveGameUpdate) error {
+	if game.Team1 == nil || game.Team2 == nil {
+		return &apperrors.InvalidArgumentError{Field: "gameId", Message: fmt.Sprintf("game %s does not have both teams yet", game.GameID)}
+	}
+	if game.Winner != nil {
+		return &apperrors.InvalidArgumentError{Field: "gameId", Message: fmt.Sprintf("game %s already has a result", game.GameID)}
+	}
+	if update.Team1ID != "" && update.Team1ID != game.Team1.TeamID {
+		return &apperrors.InvalidArgumentError{Field: "team1Id", Message: fmt.Sprintf("team %s is not team 1 of game %s", update.Team1ID, game.GameID)}
+	}
+	if update.Team2ID != "" && update.Team2ID != game.Team2.TeamID {
+		return &apperrors.InvalidArgumentError{Field: "team2Id", Message: fmt.Sprintf("team %s is not team 2 of game %s", update.Team2ID, game.GameID)}
+	}
+	if update.Team1Score < 0 || update.Team2Score < 0 {
+		return &apperrors.InvalidArgumentError{Field: "score", Message: "scores must not be negative"}
+	}
+	if update.SecondsRemaining < 0 || update.SecondsRemaining > winprob.RegulationSeconds {
+		return &apperrors.InvalidArgumentError{Field: "secondsRemaining", Message: fmt.Sprintf("secondsRemaining must be between 0 and %d", winprob.RegulationSeconds)}
+	}
+	if p := update.PossessionTeamID; p != "" && p != game.Team1.TeamID && p != game.Team2.TeamID {
+		return &apperrors.InvalidArgumentError{Field: "possessionTeamId", Message: fmt.Sprintf("team %s is not playing in game %s", p, game.GameID)}
+	}
+	return nil
+}
@@ -0,0 +1,99 @@
+package bracket
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+)
+
+func TestThatValidateLiveGameUpdateAcceptsGameInProgress(t *testing.T) {
+	// GIVEN a game with both teams and a valid update
+	bracket := newTwoGameBracket()
+	update := LiveGameUpdate{Team1Score: 30, Team2Score: 28, SecondsRemaining: 1200, PossessionTeamID: "team-b"}
+
+	// WHEN validating the update
+	err := validateLiveGameUpdate(bracket.Games["game1"], update)
+
+	// THEN no error is returned
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestThatValidateLiveGameUpdateRejectsGameWithoutBothTeams(t *testing.T) {
+	// GIVEN a game still waiting on a feeder game
+	bracket := newTwoGameBracket()
+	update := LiveGameUpdate{SecondsRemaining: 2400}
+
+	// WHEN validating the update
+	err := validateLiveGameUpdate(bracket.Games["game2"], update)
+
+	// THEN an invalid argument error is returned
+	var invalid *apperrors.InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidArgumentError, got %v", err)
+	}
+}
+
+func TestThatValidateLiveGameUpdateRejectsDecidedGame(t *testing.T) {
+	// GIVEN a game that already has a winner
+	bracket := newTwoGameBracket()
+	game := bracket.Games["game1"]
+	game.Winner = game.Team1
+	update := LiveGameUpdate{SecondsRemaining: 0}
+
+	// WHEN validating the update
+	err := validateLiveGameUpdate(game, update)
+
+	// THEN an invalid argument error is returned
+	var invalid *apperrors.InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidArgumentError, got %v", err)
+	}
+}
+
+func TestThatValidateLiveGameUpdateRejectsTeamsInWrongOrder(t *testing.T) {
+	// GIVEN an update naming the game's teams in reverse order
+	bracket := newTwoGameBracket()
+	update := LiveGameUpdate{Team1ID: "team-b", Team2ID: "team-a", SecondsRemaining: 600}
+
+	// WHEN validating the update
+	err := validateLiveGameUpdate(bracket.Games["game1"], update)
+
+	// THEN an invalid argument error is returned
+	var invalid *apperrors.InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidArgumentError, got %v", err)
+	}
+}
+
+func TestThatValidateLiveGameUpdateRejectsPossessionForTeamNotPlaying(t *testing.T) {
+	// GIVEN an update giving possession to a team not in the game
+	bracket := newTwoGameBracket()
+	update := LiveGameUpdate{SecondsRemaining: 600, PossessionTeamID: "team-c"}
+
+	// WHEN validating the update
+	err := validateLiveGameUpdate(bracket.Games["game1"], update)
+
+	// THEN an invalid argument error is returned
+	var invalid *apperrors.InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidArgumentError, got %v", err)
+	}
+}
+
+func TestThatValidateLiveGameUpdateRejectsTimeBeyondRegulation(t *testing.T) {
+	// GIVEN an update with more time left than a regulation game
+	bracket := newTwoGameBracket()
+	update := LiveGameUpdate{SecondsRemaining: 2401}
+
+	// WHEN validating the update
+	err := validateLiveGameUpdate(bracket.Games["game1"], update)
+
+	// THEN an invalid argument error is returned
+	var invalid *apperrors.InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidArgumentError, got %v", err)
+	}
+}
@@ -6,23 +6,25 @@ import (
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
+var roundsInOrder = []models.BracketRound{
+	models.RoundFirstFour,
+	models.RoundOf64,
+	models.RoundOf32,
+	models.RoundSweet16,
+	models.RoundElite8,
+	models.RoundFinalFour,
+	models.RoundChampionship,
+}
+
+// applyCurrentResults infers winners from the legacy per-team wins/byes
+// counters. It is used for tournaments that have no stored game results.
 func (s *Service) applyCurrentResults(ctx context.Context, bracket *models.BracketStructure, teams []*models.TournamentTeam) error {
 	teamMap := make(map[string]*models.TournamentTeam)
 	for _, team := range teams {
 		teamMap[team.ID] = team
 	}
 
-	rounds := []models.BracketRound{
-		models.RoundFirstFour,
-		models.RoundOf64,
-		models.RoundOf32,
-		models.RoundSweet16,
-		models.RoundElite8,
-		models.RoundFinalFour,
-		models.RoundChampionship,
-	}
-
-	for _, round := range rounds {
+	for _, round := range roundsInOrder {
 		for _, game := range bracket.Games {
 			if game.Round != round {
 				continue
@@ -48,37 +50,132 @@ func (s *Service) applyCurrentResults(ctx context.Context, bracket *models.Brack
 				winner = game.Team2
 			}
 
-			if game.NextGameID != "" {
-				nextGame := bracket.Games[game.NextGameID]
-				if nextGame != nil {
-					if winner != nil {
-						game.Winner = winner
-
-						winnerCopy := *winner
-						lowestInGame := game.Team1.LowestSeedSeen
-						if game.Team2.LowestSeedSeen < lowestInGame {
-							lowestInGame = game.Team2.LowestSeedSeen
-						}
-						winnerCopy.LowestSeedSeen = lowestInGame
-
-						if game.NextGameSlot == 1 {
-							nextGame.Team1 = &winnerCopy
-						} else if game.NextGameSlot == 2 {
-							nextGame.Team2 = &winnerCopy
-						}
-					} else {
-						if game.NextGameSlot == 1 {
-							nextGame.Team1 = nil
-						} else if game.NextGameSlot == 2 {
-							nextGame.Team2 = nil
-						}
-					}
+			advanceWinner(bracket, game, winner)
+		}
+	}
+
+	return nil
+}
+
+// applyGameResults sets winners from stored game results, advancing each
+// winner into its next game. A result whose winner is not currently playing
+// in that game is ignored.
+func applyGameResults(bracket *models.BracketStructure, results []*models.GameResult) {
+	byGame := make(map[string]*models.GameResult, len(results))
+	for _, result := range results {
+		byGame[result.GameID] = result
+	}
+
+	for _, round := range roundsInOrder {
+		for _, game := range bracket.Games {
+			if game.Round != round {
+				continue
+			}
+			if game.Team1 == nil || game.Team2 == nil {
+				continue
+			}
+
+			var winner *models.BracketTeam
+			if result := byGame[game.GameID]; result != nil {
+				switch result.WinnerTeamID {
+				case game.Team1.TeamID:
+					winner = game.Team1
+				case game.Team2.TeamID:
+					winner = game.Team2
 				}
-			} else if winner != nil {
-				game.Winner = winner
 			}
+
+			advanceWinner(bracket, game, winner)
 		}
 	}
+}
 
-	return nil
+// advanceWinner records winner on game and slots a copy into the next game,
+// or clears that slot when the game is undecided.
+func advanceWinner(bracket *models.BracketStructure, game *models.BracketGame, winner *models.BracketTeam) {
+	if game.NextGameID == "" {
+		if winner != nil {
+			game.Winner = winner
+		}
+		return
+	}
+
+	nextGame := bracket.Games[game.NextGameID]
+	if nextGame == nil {
+		return
+	}
+
+	if winner == nil {
+		setSlot(nextGame, game.NextGameSlot, nil)
+		return
+	}
+
+	game.Winner = winner
+
+	winnerCopy := *winner
+	lowestInGame := game.Team1.LowestSeedSeen
+	if game.Team2.LowestSeedSeen < lowestInGame {
+		lowestInGame = game.Team2.LowestSeedSeen
+	}
+	winnerCopy.LowestSeedSeen = lowestInGame
+	setSlot(nextGame, game.NextGameSlot, &winnerCopy)
+}
+
+func setSlot(game *models.BracketGame, slot int, team *models.BracketTeam) {
+	if slot == 1 {
+		game.Team1 = team
+	} else if slot == 2 {
+		game.Team2 = team
+	}
+}
+
+// unrecordedResults returns a result for every decided game in the bracket
+// that has no stored result. It lets tournaments scored with the legacy
+// counters migrate to stored results on their first write.
+func unrecordedResults(bracket *models.BracketStructure, stored []*models.GameResult) []*models.GameResult {
+	recorded := make(map[string]bool, len(stored))
+	for _, result := range stored {
+		recorded[result.GameID] = true
+	}
+
+	var out []*models.GameResult
+	for _, round := range roundsInOrder {
+		for _, game := range bracket.Games {
+			if game.Round != round || game.Winner == nil || recorded[game.GameID] {
+				continue
+			}
+			loserID := loserTeamID(game, game.Winner.TeamID)
+			if loserID == "" {
+				continue
+			}
+			out = append(out, &models.GameResult{
+				TournamentID: bracket.TournamentID,
+				GameID:       game.GameID,
+				WinnerTeamID: game.Winner.TeamID,
+				LoserTeamID:  loserID,
+			})
+		}
+	}
+	return out
+}
+
+func loserTeamID(game *models.BracketGame, winnerTeamID string) string {
+	if game.Team1 != nil && game.Team1.TeamID != winnerTeamID {
+		return game.Team1.TeamID
+	}
+	if game.Team2 != nil && game.Team2.TeamID != winnerTeamID {
+		return game.Team2.TeamID
+	}
+	return ""
+}
+
+// downstreamDecidedGames returns the game and every decided game after it on
+// the path to the championship. Undoing the first invalidates the rest, since
+// each one's participant arrived through the game before it.
+func downstreamDecidedGames(bracket *models.BracketStructure, gameID string) []*models.BracketGame {
+	var out []*models.BracketGame
+	for game := bracket.Games[gameID]; game != nil && game.Winner != nil; game = bracket.Games[game.NextGameID] {
+		out = append(out, game)
+	}
+	return out
 }
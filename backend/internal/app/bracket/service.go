@@ -6,42 +6,108 @@ import (
 
 	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
 )
 
 type TournamentRepo interface {
 	GetByID(ctx context.Context, id string) (*models.Tournament, error)
 	GetTeams(ctx context.Context, tournamentID string) ([]*models.TournamentTeam, error)
-	GetTournamentTeam(ctx context.Context, id string) (*models.TournamentTeam, error)
-	UpdateTournamentTeam(ctx context.Context, team *models.TournamentTeam) error
+	GetBracketTemplate(ctx context.Context, tournamentID string) (*models.BracketTemplate, error)
+	UpdateBracketTemplate(ctx context.Context, tournamentID string, tmpl *models.BracketTemplate) error
+	ListGameSites(ctx context.Context, tournamentID string) ([]*models.GameSite, error)
+	ReplaceGameSites(ctx context.Context, tournamentID string, sites []*models.GameSite) error
 }
 
 type Service struct {
 	tournamentRepo TournamentRepo
+	gameResults    ports.GameResultRepository
+	liveGames      ports.LiveGameRepository
 }
 
-func New(tournamentRepo TournamentRepo) *Service {
+func New(tournamentRepo TournamentRepo, gameResults ports.GameResultRepository, liveGames ports.LiveGameRepository) *Service {
 	return &Service{
 		tournamentRepo: tournamentRepo,
+		gameResults:    gameResults,
+		liveGames:      liveGames,
 	}
 }
 
+// WinnerSelection describes the outcome of a game as entered by an admin.
+type WinnerSelection struct {
+	WinnerTeamID string
+	WinnerScore  *int
+	LoserScore   *int
+	EnteredBy    string
+}
+
 func (s *Service) GetBracket(ctx context.Context, tournamentID string) (*models.BracketStructure, error) {
+	bracket, _, err := s.loadBracket(ctx, tournamentID)
+	if err != nil {
+		return nil, err
+	}
+	return bracket, nil
+}
+
+// ListGameResults returns the stored results for a tournament.
+func (s *Service) ListGameResults(ctx context.Context, tournamentID string) ([]*models.GameResult, error) {
+	results, err := s.gameResults.ListGameResults(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("listing game results: %w", err)
+	}
+	return results, nil
+}
+
+// loadBracket builds the bracket and applies results. Stored game results are
+// authoritative; tournaments without any fall back to the team counters.
+func (s *Service) loadBracket(ctx context.Context, tournamentID string) (*models.BracketStructure, []*models.GameResult, error) {
 	tournament, err := s.tournamentRepo.GetByID(ctx, tournamentID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get tournament: %w", err)
+		return nil, nil, fmt.Errorf("failed to get tournament: %w", err)
 	}
 	if tournament == nil {
-		return nil, &apperrors.NotFoundError{Resource: "tournament", ID: tournamentID}
+		return nil, nil, &apperrors.NotFoundError{Resource: "tournament", ID: tournamentID}
 	}
 
 	teams, err := s.tournamentRepo.GetTeams(ctx, tournamentID)
 	if err != nil {
-		return nil, fmt.Errorf("failed to get teams: %w", err)
+		return nil, nil, fmt.Errorf("failed to get teams: %w", err)
 	}
 	if len(teams) == 0 {
-		return nil, fmt.Errorf("tournament has no teams")
+		return nil, nil, fmt.Errorf("tournament has no teams")
+	}
+
+	finalFour, err := finalFourConfig(tournament)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	stored, err := s.tournamentRepo.GetBracketTemplate(ctx, tournamentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get bracket template: %w", err)
 	}
 
+	bracket, err := BuildBracket(tournamentID, teams, stored, finalFour)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to build bracket: %w", err)
+	}
+
+	results, err := s.gameResults.ListGameResults(ctx, tournamentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get game results: %w", err)
+	}
+
+	if len(results) == 0 {
+		if err := s.applyCurrentResults(ctx, bracket, teams); err != nil {
+			return nil, nil, fmt.Errorf("failed to apply results: %w", err)
+		}
+	} else {
+		applyGameResults(bracket, results)
+	}
+
+	return bracket, results, nil
+}
+
+func finalFourConfig(tournament *models.Tournament) (*models.FinalFourConfig, error) {
 	finalFour := &models.FinalFourConfig{
 		TopLeftRegion:     tournament.FinalFourTopLeft,
 		BottomLeftRegion:  tournament.FinalFourBottomLeft,
@@ -51,21 +117,61 @@ func (s *Service) GetBracket(ctx context.Context, tournamentID string) (*models.
 	if err := finalFour.ApplyDefaults(); err != nil {
 		return nil, fmt.Errorf("failed to apply final four defaults: %w", err)
 	}
+	return finalFour, nil
+}
+
+// GetBracketTemplate returns the template a tournament's bracket is built
+// from: its stored template, or the NCAA layout for its Final Four pairing.
+func (s *Service) GetBracketTemplate(ctx context.Context, tournamentID string) (*models.BracketTemplate, error) {
+	tournament, err := s.tournamentRepo.GetByID(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get tournament: %w", err)
+	}
+	if tournament == nil {
+		return nil, &apperrors.NotFoundError{Resource: "tournament", ID: tournamentID}
+	}
+
+	stored, err := s.tournamentRepo.GetBracketTemplate(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bracket template: %w", err)
+	}
+	if stored != nil {
+		return stored, nil
+	}
 
-	bracket, err := BuildBracketStructure(tournamentID, teams, finalFour)
+	finalFour, err := finalFourConfig(tournament)
 	if err != nil {
-		return nil, fmt.Errorf("failed to build bracket: %w", err)
+		return nil, err
 	}
+	return NCAATemplate(finalFour), nil
+}
 
-	if err := s.applyCurrentResults(ctx, bracket, teams); err != nil {
-		return nil, fmt.Errorf("failed to apply results: %w", err)
+// UpdateBracketTemplate replaces a tournament's bracket template. A nil
+// template restores the NCAA layout. Templates cannot change once results
+// have been recorded, since stored game IDs would no longer line up.
+func (s *Service) UpdateBracketTemplate(ctx context.Context, tournamentID string, tmpl *models.BracketTemplate) error {
+	if tmpl != nil {
+		if err := tmpl.Validate(); err != nil {
+			return &apperrors.InvalidArgumentError{Field: "template", Message: err.Error()}
+		}
 	}
 
-	return bracket, nil
+	results, err := s.gameResults.ListGameResults(ctx, tournamentID)
+	if err != nil {
+		return fmt.Errorf("failed to get game results: %w", err)
+	}
+	if len(results) > 0 {
+		return &apperrors.InvalidArgumentError{Field: "template", Message: "bracket template cannot change after results are recorded"}
+	}
+
+	if err := s.tournamentRepo.UpdateBracketTemplate(ctx, tournamentID, tmpl); err != nil {
+		return fmt.Errorf("failed to update bracket template: %w", err)
+	}
+	return nil
 }
 
-func (s *Service) SelectWinner(ctx context.Context, tournamentID, gameID, winnerTeamID string) (*models.BracketStructure, error) {
-	bracket, err := s.GetBracket(ctx, tournamentID)
+func (s *Service) SelectWinner(ctx context.Context, tournamentID, gameID string, selection WinnerSelection) (*models.BracketStructure, error) {
+	bracket, stored, err := s.loadBracket(ctx, tournamentID)
 	if err != nil {
 		return nil, fmt.Errorf("getting bracket: %w", err)
 	}
@@ -75,41 +181,33 @@ func (s *Service) SelectWinner(ctx context.Context, tournamentID, gameID, winner
 		return nil, &apperrors.NotFoundError{Resource: "game", ID: gameID}
 	}
 
-	if err := models.ValidateWinnerSelection(game, winnerTeamID); err != nil {
+	if err := models.ValidateWinnerSelection(game, selection.WinnerTeamID); err != nil {
 		return nil, err
 	}
-
-	winningTeam, err := s.tournamentRepo.GetTournamentTeam(ctx, winnerTeamID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get team: %w", err)
-	}
-	if winningTeam == nil {
-		return nil, &apperrors.NotFoundError{Resource: "team", ID: winnerTeamID}
+	if game.Winner != nil && game.Winner.TeamID != selection.WinnerTeamID {
+		return nil, &apperrors.InvalidArgumentError{
+			Field:   "winnerTeamId",
+			Message: fmt.Sprintf("game %s already has a winner; undo it before selecting a different team", gameID),
+		}
 	}
 
-	winningTeam.Wins++
-	if err := s.tournamentRepo.UpdateTournamentTeam(ctx, winningTeam); err != nil {
-		return nil, fmt.Errorf("failed to update team wins: %w", err)
+	result := &models.GameResult{
+		TournamentID: tournamentID,
+		GameID:       gameID,
+		WinnerTeamID: selection.WinnerTeamID,
+		LoserTeamID:  loserTeamID(game, selection.WinnerTeamID),
+		WinnerScore:  selection.WinnerScore,
+		LoserScore:   selection.LoserScore,
 	}
-
-	var losingTeamID string
-	if game.Team1 != nil && game.Team1.TeamID != winnerTeamID {
-		losingTeamID = game.Team1.TeamID
-	} else if game.Team2 != nil && game.Team2.TeamID != winnerTeamID {
-		losingTeamID = game.Team2.TeamID
+	if selection.EnteredBy != "" {
+		enteredBy := selection.EnteredBy
+		result.EnteredBy = &enteredBy
 	}
 
-	if losingTeamID != "" {
-		losingTeam, err := s.tournamentRepo.GetTournamentTeam(ctx, losingTeamID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get losing team: %w", err)
-		}
-		if losingTeam != nil {
-			losingTeam.IsEliminated = true
-			if err := s.tournamentRepo.UpdateTournamentTeam(ctx, losingTeam); err != nil {
-				return nil, fmt.Errorf("failed to mark losing team as eliminated: %w", err)
-			}
-		}
+	pending := unrecordedResults(bracket, stored)
+	pending = append(pending, result)
+	if err := s.gameResults.RecordGameResults(ctx, tournamentID, pending); err != nil {
+		return nil, fmt.Errorf("failed to record game result: %w", err)
 	}
 
 	bracket, err = s.GetBracket(ctx, tournamentID)
@@ -120,8 +218,20 @@ func (s *Service) SelectWinner(ctx context.Context, tournamentID, gameID, winner
 	return bracket, nil
 }
 
-func (s *Service) UnselectWinner(ctx context.Context, tournamentID, gameID string) (*models.BracketStructure, error) {
-	bracket, err := s.GetBracket(ctx, tournamentID)
+// UnselectResult reports what UnselectWinner changed.
+type UnselectResult struct {
+	Bracket *models.BracketStructure
+	// RolledBack lists every result removed, starting with the requested game
+	// and followed by the downstream games it fed into.
+	RolledBack    []*models.GameResult
+	Invalidations models.RollbackInvalidations
+}
+
+// UnselectWinner removes the result of a game along with every downstream
+// result that depended on it. The removal, the re-derived team progress and
+// the invalidation of stale predictions and simulations commit atomically.
+func (s *Service) UnselectWinner(ctx context.Context, tournamentID, gameID string) (*UnselectResult, error) {
+	bracket, stored, err := s.loadBracket(ctx, tournamentID)
 	if err != nil {
 		return nil, fmt.Errorf("getting bracket: %w", err)
 	}
@@ -132,42 +242,36 @@ func (s *Service) UnselectWinner(ctx context.Context, tournamentID, gameID strin
 	}
 
 	if game.Winner == nil {
-		return bracket, nil
+		return &UnselectResult{Bracket: bracket}, nil
 	}
 
-	winningTeam, err := s.tournamentRepo.GetTournamentTeam(ctx, game.Winner.TeamID)
-	if err != nil {
-		return nil, fmt.Errorf("failed to get team: %w", err)
+	pending := unrecordedResults(bracket, stored)
+	resultsByGame := make(map[string]*models.GameResult, len(stored)+len(pending))
+	for _, result := range stored {
+		resultsByGame[result.GameID] = result
 	}
-	if winningTeam == nil {
-		return nil, &apperrors.NotFoundError{Resource: "team", ID: game.Winner.TeamID}
+	for _, result := range pending {
+		resultsByGame[result.GameID] = result
 	}
 
-	if winningTeam.Wins > 0 {
-		winningTeam.Wins--
-	}
-	if err := s.tournamentRepo.UpdateTournamentTeam(ctx, winningTeam); err != nil {
-		return nil, fmt.Errorf("failed to update team wins: %w", err)
-	}
-
-	var losingTeamID string
-	if game.Team1 != nil && game.Team1.TeamID != game.Winner.TeamID {
-		losingTeamID = game.Team1.TeamID
-	} else if game.Team2 != nil && game.Team2.TeamID != game.Winner.TeamID {
-		losingTeamID = game.Team2.TeamID
+	cascade := downstreamDecidedGames(bracket, gameID)
+	rolledBack := make([]*models.GameResult, 0, len(cascade))
+	gameIDs := make([]string, 0, len(cascade))
+	for _, g := range cascade {
+		gameIDs = append(gameIDs, g.GameID)
+		if result := resultsByGame[g.GameID]; result != nil {
+			rolledBack = append(rolledBack, result)
+		}
 	}
 
-	if losingTeamID != "" {
-		losingTeam, err := s.tournamentRepo.GetTournamentTeam(ctx, losingTeamID)
-		if err != nil {
-			return nil, fmt.Errorf("failed to get losing team: %w", err)
-		}
-		if losingTeam != nil {
-			losingTeam.IsEliminated = false
-			if err := s.tournamentRepo.UpdateTournamentTeam(ctx, losingTeam); err != nil {
-				return nil, fmt.Errorf("failed to reactivate losing team: %w", err)
-			}
-		}
+	invalidations, err := s.gameResults.RollbackGameResults(ctx, models.GameResultRollback{
+		TournamentID:   tournamentID,
+		GameIDs:        gameIDs,
+		Backfill:       pending,
+		FromCheckpoint: game.Round.MinProgressRequired() + 1,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to roll back game results: %w", err)
 	}
 
 	bracket, err = s.GetBracket(ctx, tournamentID)
@@ -175,7 +279,11 @@ func (s *Service) UnselectWinner(ctx context.Context, tournamentID, gameID strin
 		return nil, fmt.Errorf("failed to rebuild bracket: %w", err)
 	}
 
-	return bracket, nil
+	return &UnselectResult{
+		Bracket:       bracket,
+		RolledBack:    rolledBack,
+		Invalidations: *invalidations,
+	}, nil
 }
 
 func (s *Service) ValidateBracketSetup(ctx context.Context, tournamentID string) error {
@@ -184,6 +292,14 @@ func (s *Service) ValidateBracketSetup(ctx context.Context, tournamentID string)
 		return fmt.Errorf("getting teams: %w", err)
 	}
 
+	stored, err := s.tournamentRepo.GetBracketTemplate(ctx, tournamentID)
+	if err != nil {
+		return fmt.Errorf("getting bracket template: %w", err)
+	}
+	if stored != nil {
+		return ValidateTeamsForTemplate(teams, stored)
+	}
+
 	return ValidateBracketSetupTeams(teams)
 }
 
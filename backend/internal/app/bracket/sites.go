@@ -0,0 +1,56 @@
+package bracket
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// GetGameSites returns where the tournament's games are played. Games without
+// a site are neutral.
+func (s *Service) GetGameSites(ctx context.Context, tournamentID string) ([]*models.GameSite, error) {
+	sites, err := s.tournamentRepo.ListGameSites(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list game sites: %w", err)
+	}
+	return sites, nil
+}
+
+// ReplaceGameSites replaces the tournament's game sites. Every site must name
+// a game in the tournament's bracket.
+func (s *Service) ReplaceGameSites(ctx context.Context, tournamentID string, sites []*models.GameSite) error {
+	bracket, _, err := s.loadBracket(ctx, tournamentID)
+	if err != nil {
+		return err
+	}
+	if err := validateGameSites(bracket, sites); err != nil {
+		return err
+	}
+	if err := s.tournamentRepo.ReplaceGameSites(ctx, tournamentID, sites); err != nil {
+		return fmt.Errorf("failed to replace game sites: %w", err)
+	}
+	return nil
+}
+
+func validateGameSites(bracket *models.BracketStructure, sites []*models.GameSite) error {
+	seen := make(map[string]bool, len(sites))
+	for i, gs := range sites {
+		if _, ok := bracket.Games[gs.GameID]; !ok {
+			return &apperrors.InvalidArgumentError{Field: "sites", Message: fmt.Sprintf("sites[%d]: game %q is not in the bracket", i, gs.GameID)}
+		}
+		if seen[gs.GameID] {
+			return &apperrors.InvalidArgumentError{Field: "sites", Message: fmt.Sprintf("sites[%d]: game %q appears more than once", i, gs.GameID)}
+		}
+		seen[gs.GameID] = true
+		if strings.TrimSpace(gs.Name) == "" {
+			return &apperrors.InvalidArgumentError{Field: "sites", Message: fmt.Sprintf("sites[%d]: name is required", i)}
+		}
+		if gs.Latitude < -90 || gs.Latitude > 90 || gs.Longitude < -180 || gs.Longitude > 180 {
+			return &apperrors.InvalidArgumentError{Field: "sites", Message: fmt.Sprintf("sites[%d]: location is out of range", i)}
+		}
+	}
+	return nil
+}
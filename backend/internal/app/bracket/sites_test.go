@@ -0,0 +1,56 @@
+package bracket
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func TestThatValidateGameSitesAcceptsSitesForBracketGames(t *testing.T) {
+	// GIVEN a site for a game in the bracket
+	bracket := newTwoGameBracket()
+	sites := []*models.GameSite{{GameID: "game1", Name: "Dean Smith Center", Latitude: 35.9, Longitude: -79.04}}
+
+	// WHEN validating the sites
+	err := validateGameSites(bracket, sites)
+
+	// THEN no error is returned
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestThatValidateGameSitesRejectsUnknownGame(t *testing.T) {
+	// GIVEN a site for a game that is not in the bracket
+	bracket := newTwoGameBracket()
+	sites := []*models.GameSite{{GameID: "game9", Name: "Arena", Latitude: 35.9, Longitude: -79.04}}
+
+	// WHEN validating the sites
+	err := validateGameSites(bracket, sites)
+
+	// THEN an invalid argument error is returned
+	var invalid *apperrors.InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidArgumentError, got %v", err)
+	}
+}
+
+func TestThatValidateGameSitesRejectsDuplicateGame(t *testing.T) {
+	// GIVEN two sites for the same game
+	bracket := newTwoGameBracket()
+	sites := []*models.GameSite{
+		{GameID: "game1", Name: "Arena", Latitude: 35.9, Longitude: -79.04},
+		{GameID: "game1", Name: "Other Arena", Latitude: 36.0, Longitude: -78.9},
+	}
+
+	// WHEN validating the sites
+	err := validateGameSites(bracket, sites)
+
+	// THEN an invalid argument error is returned
+	var invalid *apperrors.InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidArgumentError, got %v", err)
+	}
+}
@@ -0,0 +1,207 @@
+package bracket
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/winprob"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// NCAATemplateKey identifies the built-in 68-team NCAA layout.
+const NCAATemplateKey = "ncaa_68"
+
+// NCAATemplate returns the 68-team NCAA layout: four 16-seed regions, First
+// Four games for any duplicated seed, and semifinals paired by finalFour. A nil
+// finalFour uses the default pairing.
+func NCAATemplate(finalFour *models.FinalFourConfig) *models.BracketTemplate {
+	if finalFour == nil {
+		finalFour = &models.FinalFourConfig{}
+		_ = finalFour.ApplyDefaults()
+	}
+	tmpl := &models.BracketTemplate{
+		Key:     NCAATemplateKey,
+		Name:    "NCAA Tournament (68 teams)",
+		Regions: append([]string{}, Regions...),
+		PlayIns: models.PlayInDuplicateSeeds,
+	}
+	for i, region := range Regions {
+		tmpl.Games = append(tmpl.Games, ncaaRegionGames(region, i)...)
+	}
+
+	regionalFinal := func(region string) models.TemplateSlot {
+		return models.TemplateSlot{FeederGameID: fmt.Sprintf("%s-%s-1", region, models.RoundElite8)}
+	}
+	tmpl.Games = append(tmpl.Games,
+		models.TemplateGame{
+			GameID:    "final_four-1",
+			Round:     models.RoundFinalFour,
+			Region:    "Final Four",
+			SortOrder: 1,
+			Slots:     [2]models.TemplateSlot{regionalFinal(finalFour.TopLeftRegion), regionalFinal(finalFour.BottomLeftRegion)},
+		},
+		models.TemplateGame{
+			GameID:    "final_four-2",
+			Round:     models.RoundFinalFour,
+			Region:    "Final Four",
+			SortOrder: 2,
+			Slots:     [2]models.TemplateSlot{regionalFinal(finalFour.TopRightRegion), regionalFinal(finalFour.BottomRightRegion)},
+		},
+		models.TemplateGame{
+			GameID:    "championship",
+			Round:     models.RoundChampionship,
+			Region:    "Championship",
+			SortOrder: 1,
+			Slots:     [2]models.TemplateSlot{{FeederGameID: "final_four-1"}, {FeederGameID: "final_four-2"}},
+		},
+	)
+	return tmpl
+}
+
+// ncaaRegionGames lays out one 16-seed region. Games are keyed by the lowest
+// seed that can reach them, and seeds meeting in each round sum to the
+// SeedPairSum for that round.
+func ncaaRegionGames(region string, regionIndex int) []models.TemplateGame {
+	gameID := func(round models.BracketRound, lowestSeed int) string {
+		return fmt.Sprintf("%s-%s-%d", region, round, lowestSeed)
+	}
+
+	var games []models.TemplateGame
+	for low := 1; low <= SeedPairSumR64/2; low++ {
+		games = append(games, models.TemplateGame{
+			GameID:    gameID(models.RoundOf64, low),
+			Round:     models.RoundOf64,
+			Region:    region,
+			SortOrder: getSortOrder(models.RoundOf64, regionIndex, low),
+			Slots:     [2]models.TemplateSlot{{Seed: low}, {Seed: SeedPairSumR64 - low}},
+		})
+	}
+
+	regional := []struct {
+		round    models.BracketRound
+		previous models.BracketRound
+		pairSum  int
+	}{
+		{models.RoundOf32, models.RoundOf64, SeedPairSumR32},
+		{models.RoundSweet16, models.RoundOf32, SeedPairSumS16},
+		{models.RoundElite8, models.RoundSweet16, SeedPairSumE8},
+	}
+	for _, r := range regional {
+		for low := 1; low <= r.pairSum/2; low++ {
+			games = append(games, models.TemplateGame{
+				GameID:    gameID(r.round, low),
+				Round:     r.round,
+				Region:    region,
+				SortOrder: getSortOrder(r.round, regionIndex, low-1),
+				Slots: [2]models.TemplateSlot{
+					{FeederGameID: gameID(r.previous, low)},
+					{FeederGameID: gameID(r.previous, r.pairSum-low)},
+				},
+			})
+		}
+	}
+	return games
+}
+
+// ResolveTemplate returns the tournament's stored template, or the NCAA layout
+// for the given Final Four pairing when none is stored.
+func ResolveTemplate(stored *models.BracketTemplate, finalFour *models.FinalFourConfig) *models.BracketTemplate {
+	if stored != nil {
+		return stored
+	}
+	return NCAATemplate(finalFour)
+}
+
+// HistoryThroughRound returns the recorded results for games in rounds up to
+// throughRound, preserving their decision order. Results for games outside the
+// template are skipped, except First Four games which the template implies.
+func HistoryThroughRound(results []*models.GameResult, tmpl *models.BracketTemplate, throughRound int) []winprob.Game {
+	if len(results) == 0 {
+		return nil
+	}
+	roundByGameID := make(map[string]int, len(tmpl.Games))
+	for _, g := range tmpl.Games {
+		roundByGameID[g.GameID] = g.Round.Order()
+	}
+
+	var history []winprob.Game
+	for _, r := range results {
+		round, ok := roundByGameID[r.GameID]
+		if !ok && strings.Contains(r.GameID, "-"+string(models.RoundFirstFour)+"-") {
+			round, ok = models.RoundFirstFour.Order(), true
+		}
+		if !ok || round > throughRound {
+			continue
+		}
+		history = append(history, winprob.Game{WinnerID: r.WinnerTeamID, LoserID: r.LoserTeamID})
+	}
+	return history
+}
+
+// ValidateTeamsForTemplate checks that teams fill the template exactly: every
+// seeded slot has one team (or two when play-ins are allowed), every team has
+// a slot, and byes match the round each team enters.
+func ValidateTeamsForTemplate(teams []*models.TournamentTeam, tmpl *models.BracketTemplate) error {
+	if err := tmpl.Validate(); err != nil {
+		return fmt.Errorf("invalid bracket template: %w", err)
+	}
+
+	bySlot := groupTeamsBySlot(teams)
+	placed := 0
+	for _, g := range tmpl.Games {
+		for _, slot := range g.Slots {
+			if slot.IsFeeder() {
+				continue
+			}
+			key := slotKeyFor(g, slot)
+			slotTeams := bySlot[key]
+			switch {
+			case len(slotTeams) == 0:
+				return fmt.Errorf("region %s is missing seed %d", key.region, key.seed)
+			case len(slotTeams) == 2 && !tmpl.AllowsPlayIns():
+				return fmt.Errorf("region %s has 2 teams with seed %d but play-ins are disabled", key.region, key.seed)
+			case len(slotTeams) == 2 && g.Round != models.RoundOf64:
+				return fmt.Errorf("region %s seed %d enters in %s; play-in games only feed the round of 64", key.region, key.seed, g.Round)
+			case len(slotTeams) > MaxTeamsPerSeed:
+				return fmt.Errorf("region %s has %d teams with seed %d (max 2 for play-in game)", key.region, len(slotTeams), key.seed)
+			}
+
+			wantByes := g.Round.MinProgressRequired()
+			if len(slotTeams) == 2 {
+				wantByes = models.RoundFirstFour.MinProgressRequired()
+			}
+			for _, team := range slotTeams {
+				if team.Byes != wantByes {
+					return fmt.Errorf("team must have byes=%d (region=%s seed=%d team_id=%s byes=%d)", wantByes, team.Region, team.Seed, team.ID, team.Byes)
+				}
+			}
+			placed += len(slotTeams)
+		}
+	}
+
+	if placed != len(teams) {
+		return fmt.Errorf("bracket template has slots for %d of %d teams", placed, len(teams))
+	}
+	return nil
+}
+
+// AssignTemplateByes sets each team's byes to match the round it enters in
+// tmpl. Teams sharing a seeded slot play in and enter with no byes.
+func AssignTemplateByes(teams []*models.TournamentTeam, tmpl *models.BracketTemplate) {
+	bySlot := groupTeamsBySlot(teams)
+	for _, g := range tmpl.Games {
+		for _, slot := range g.Slots {
+			if slot.IsFeeder() {
+				continue
+			}
+			slotTeams := bySlot[slotKeyFor(g, slot)]
+			byes := g.Round.MinProgressRequired()
+			if len(slotTeams) > 1 {
+				byes = models.RoundFirstFour.MinProgressRequired()
+			}
+			for _, team := range slotTeams {
+				team.Byes = byes
+			}
+		}
+	}
+}
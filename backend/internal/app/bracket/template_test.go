@@ -0,0 +1,189 @@
+package bracket
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// newFourTeamTemplate returns a single-region template where seed 1 skips the
+// opening round and meets the winner of 2 vs 3, with seed 4 waiting in the
+// final.
+func newFourTeamTemplate() *models.BracketTemplate {
+	return &models.BracketTemplate{
+		Key:     "four_team",
+		Regions: []string{"A"},
+		PlayIns: models.PlayInNone,
+		Games: []models.TemplateGame{
+			{GameID: "A-r64", Round: models.RoundOf64, Region: "A", Slots: [2]models.TemplateSlot{{Seed: 2}, {Seed: 3}}},
+			{GameID: "A-r32", Round: models.RoundOf32, Region: "A", Slots: [2]models.TemplateSlot{{Seed: 1}, {FeederGameID: "A-r64"}}},
+			{GameID: "A-s16", Round: models.RoundSweet16, Region: "A", Slots: [2]models.TemplateSlot{{FeederGameID: "A-r32"}, {Seed: 4}}},
+		},
+	}
+}
+
+func newFourTeams() []*models.TournamentTeam {
+	teams := []*models.TournamentTeam{
+		createTeam("t", "A", 1, ""),
+		createTeam("t", "A", 2, ""),
+		createTeam("t", "A", 3, ""),
+		createTeam("t", "A", 4, ""),
+	}
+	teams[0].Byes = 2
+	teams[1].Byes = 1
+	teams[2].Byes = 1
+	teams[3].Byes = 3
+	return teams
+}
+
+func TestThatNCAATemplateIsValid(t *testing.T) {
+	// GIVEN the NCAA template with default Final Four pairing
+	tmpl := NCAATemplate(nil)
+
+	// WHEN validating it
+	err := tmpl.Validate()
+
+	// THEN it is valid
+	if err != nil {
+		t.Errorf("expected NCAA template to be valid, got %v", err)
+	}
+}
+
+func TestThatTemplateWithTwoFinalGamesIsInvalid(t *testing.T) {
+	// GIVEN a template whose last game is dropped, leaving two unfed games
+	tmpl := newFourTeamTemplate()
+	tmpl.Games = tmpl.Games[:2]
+	tmpl.Games = append(tmpl.Games, models.TemplateGame{GameID: "A-extra", Round: models.RoundOf32, Region: "A", Slots: [2]models.TemplateSlot{{Seed: 4}, {Seed: 5}}})
+
+	// WHEN validating it
+	err := tmpl.Validate()
+
+	// THEN it is rejected
+	if err == nil {
+		t.Errorf("expected error for template with two final games")
+	}
+}
+
+func TestThatTemplateFeederMustBeInEarlierRound(t *testing.T) {
+	// GIVEN a template where a game is fed by a game in the same round
+	tmpl := newFourTeamTemplate()
+	tmpl.Games[0].Round = models.RoundOf32
+
+	// WHEN validating it
+	err := tmpl.Validate()
+
+	// THEN it is rejected
+	if err == nil {
+		t.Errorf("expected error for feeder in same round")
+	}
+}
+
+func TestThatBuildBracketFromTemplateSeatsTeamInDeclaredSlot(t *testing.T) {
+	// GIVEN a four-team template where seed 1 enters in the round of 32
+	tmpl := newFourTeamTemplate()
+
+	// WHEN building the bracket
+	bracket, err := BuildBracketFromTemplate("t", newFourTeams(), tmpl)
+	if err != nil {
+		t.Fatalf("failed to build bracket: %v", err)
+	}
+
+	// THEN seed 1 is seated in slot 1 of the round of 32 game
+	got := ""
+	if team := bracket.Games["A-r32"].Team1; team != nil {
+		got = team.TeamID
+	}
+	if got != "A-01" {
+		t.Errorf("expected A-01 in A-r32 slot 1, got %q", got)
+	}
+}
+
+func TestThatBuildBracketFromTemplateLinksFeederToSlot(t *testing.T) {
+	// GIVEN a four-team template
+	tmpl := newFourTeamTemplate()
+
+	// WHEN building the bracket
+	bracket, err := BuildBracketFromTemplate("t", newFourTeams(), tmpl)
+	if err != nil {
+		t.Fatalf("failed to build bracket: %v", err)
+	}
+
+	// THEN the opening game feeds slot 2 of the round of 32 game
+	game := bracket.Games["A-r64"]
+	got := bracketLink{NextGameID: game.NextGameID, NextGameSlot: game.NextGameSlot}
+	want := bracketLink{NextGameID: "A-r32", NextGameSlot: 2}
+	if got != want {
+		t.Errorf("expected link %+v, got %+v", want, got)
+	}
+}
+
+func TestThatBuildBracketFromTemplateRejectsSharedSlotWhenPlayInsDisabled(t *testing.T) {
+	// GIVEN a template without play-ins and two teams sharing seed 3
+	tmpl := newFourTeamTemplate()
+	teams := append(newFourTeams(), createTeam("t", "A", 3, "b"))
+
+	// WHEN building the bracket
+	_, err := BuildBracketFromTemplate("t", teams, tmpl)
+
+	// THEN it is rejected
+	if err == nil {
+		t.Errorf("expected error for shared slot without play-ins")
+	}
+}
+
+func TestThatValidateTeamsForTemplateRequiresByesForSkippedRounds(t *testing.T) {
+	// GIVEN a seed 1 team entering the round of 32 with only one bye
+	teams := newFourTeams()
+	teams[0].Byes = 1
+
+	// WHEN validating the teams against the template
+	err := ValidateTeamsForTemplate(teams, newFourTeamTemplate())
+
+	// THEN it is rejected
+	if err == nil {
+		t.Errorf("expected error for team with too few byes")
+	}
+}
+
+func TestThatAssignTemplateByesCreditsSkippedRounds(t *testing.T) {
+	// GIVEN four teams with no byes assigned
+	teams := newFourTeams()
+	for _, team := range teams {
+		team.Byes = 0
+	}
+
+	// WHEN assigning byes from the template
+	AssignTemplateByes(teams, newFourTeamTemplate())
+
+	// THEN each team's byes match the round it enters
+	got := []int{teams[0].Byes, teams[1].Byes, teams[2].Byes, teams[3].Byes}
+	want := []int{2, 1, 1, 3}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected byes %v, got %v", want, got)
+	}
+}
+
+func TestThatHistoryThroughRoundExcludesGamesAfterCheckpoint(t *testing.T) {
+	// GIVEN a First Four result and a Round of 32 result
+	tmpl := NCAATemplate(nil)
+	var r32GameID string
+	for _, g := range tmpl.Games {
+		if g.Round == models.RoundOf32 {
+			r32GameID = g.GameID
+			break
+		}
+	}
+	results := []*models.GameResult{
+		{GameID: "East-first_four-11", WinnerTeamID: "a", LoserTeamID: "b"},
+		{GameID: r32GameID, WinnerTeamID: "c", LoserTeamID: "d"},
+	}
+
+	// WHEN building history through the Round of 64 checkpoint
+	history := HistoryThroughRound(results, tmpl, models.RoundOf64.Order())
+
+	// THEN only the First Four game is included
+	if len(history) != 1 || history[0].WinnerID != "a" {
+		t.Errorf("expected only the first four game, got %+v", history)
+	}
+}
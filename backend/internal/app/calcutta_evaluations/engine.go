@@ -1,10 +1,14 @@
 package calcutta_evaluations
 
 import (
+	"math"
 	"sort"
+	"time"
 
+	"github.com/andrewcopp/Calcutta/backend/internal/app/ownership"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/scoring"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/simulation"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
 // SimulationResult represents the outcome of one simulation
@@ -25,57 +29,108 @@ type EntryPerformance struct {
 	PTop1        float64
 	PInMoney     float64
 	TotalSims    int
+	// Standard errors of MeanPayout, PTop1 and PInMoney, and their 95%
+	// confidence intervals.
+	MeanPayoutSE float64
+	PTop1SE      float64
+	PInMoneySE   float64
+	MeanPayoutCI Interval
+	PTop1CI      Interval
+	PInMoneyCI   Interval
+}
+
+// Interval is a confidence interval.
+type Interval struct {
+	Low  float64
+	High float64
 }
 
 // Entry represents an entry with their team bids
 type Entry struct {
 	Name  string
 	Teams map[string]int // team_id -> bid_points
+	// SubmittedAt is when the entry's bids last changed. Hypothetical
+	// entries leave it zero and lose earliest-submission tie-breaks.
+	SubmittedAt time.Time
 }
 
 // TeamSimResult represents a team's result in one simulation
 type TeamSimResult struct {
-	TeamID string
-	Points int
+	TeamID   string
+	Points   int
+	Champion bool
 }
 
 // CalculateSimulationOutcomes computes each entry's total points, rank, and
-// payout for a single simulation. It is a pure function with no side effects.
-func CalculateSimulationOutcomes(simID int, entries map[string]*Entry, teamResults []TeamSimResult, payouts map[int]int, firstPlacePayout int) ([]SimulationResult, error) {
+// payout for a single simulation, splitting each team among its bidders by
+// the pool's ownership rule. Teams nobody bid on are handed out by the rule's
+// unclaimed-team mode, or score for no one. Entries tied on points are
+// ordered by the pool's tie-breaker, then by name; entries the tie-breaker
+// cannot separate split the payouts for their positions evenly. It is a pure
+// function with no side effects.
+func CalculateSimulationOutcomes(simID int, entries map[string]*Entry, rule ownership.Rule, tieBreaker string, teamResults []TeamSimResult, payouts map[int]int, firstPlacePayout int) ([]SimulationResult, error) {
 
 	// Build team points map for this simulation
 	teamPoints := make(map[string]int)
+	champions := make(map[string]bool)
 	for _, tr := range teamResults {
 		teamPoints[tr.TeamID] = tr.Points
+		if tr.Champion {
+			champions[tr.TeamID] = true
+		}
 	}
 
-	// Calculate total bids per team across all entries
-	totalBidsPerTeam := make(map[string]int)
-	for _, entry := range entries {
+	// Collect each team's bids across all entries
+	bidsByTeam := make(map[string]map[string]int)
+	for key, entry := range entries {
 		for teamID, bidPoints := range entry.Teams {
-			totalBidsPerTeam[teamID] += bidPoints
+			if bidsByTeam[teamID] == nil {
+				bidsByTeam[teamID] = make(map[string]int)
+			}
+			bidsByTeam[teamID][key] = bidPoints
 		}
 	}
+	sharesByTeam := make(map[string]map[string]float64, len(bidsByTeam))
+	for teamID, bids := range bidsByTeam {
+		sharesByTeam[teamID] = ownership.Shares(rule, bids)
+	}
 
-	// Calculate total points for each entry
-	type entryScore struct {
-		name   string
-		points float64
+	// Hand out the points of teams nobody bid on
+	entryCredits := make(map[string]int, len(entries))
+	for key, entry := range entries {
+		for _, bidPoints := range entry.Teams {
+			entryCredits[key] += bidPoints
+		}
+	}
+	unclaimedShares := ownership.UnclaimedShares(rule, entryCredits)
+	unclaimedPoints := 0
+	for teamID, points := range teamPoints {
+		if len(sharesByTeam[teamID]) == 0 {
+			unclaimedPoints += points
+		}
 	}
 
+	// Calculate total points for each entry
 	var scores []entryScore
-	for _, entry := range entries {
+	for key, entry := range entries {
 		totalPoints := 0.0
-		for teamID, bidPoints := range entry.Teams {
+		championPoints := 0.0
+		for teamID := range entry.Teams {
 			if points, ok := teamPoints[teamID]; ok {
-				totalBids := totalBidsPerTeam[teamID]
-				if totalBids > 0 {
-					// Proportional ownership: (my_bid / total_bids) * team_points
-					totalPoints += float64(points) * float64(bidPoints) / float64(totalBids)
+				totalPoints += float64(points) * sharesByTeam[teamID][key]
+				if champions[teamID] {
+					championPoints += float64(points) * sharesByTeam[teamID][key]
 				}
 			}
 		}
-		scores = append(scores, entryScore{name: entry.Name, points: totalPoints})
+		totalPoints += float64(unclaimedPoints) * unclaimedShares[key]
+		scores = append(scores, entryScore{
+			name:           entry.Name,
+			points:         totalPoints,
+			championPoints: championPoints,
+			credits:        entryCredits[key],
+			submittedAt:    entry.SubmittedAt,
+		})
 	}
 
 	// Sort by points descending to determine ranks
@@ -87,16 +142,44 @@ func CalculateSimulationOutcomes(simID int, entries map[string]*Entry, teamResul
 		return scores[i].name < scores[j].name
 	})
 
+	// Order entries tied on points by the tie-breaker, and split payouts
+	// among those it cannot separate
+	payoutByIndex := make([]int, len(scores))
+	for i := 0; i < len(scores); {
+		j := i + 1
+		for j < len(scores) && math.Abs(scores[j].points-scores[i].points) < scoreEpsilon {
+			j++
+		}
+		group := scores[i:j]
+		sort.SliceStable(group, func(a, b int) bool {
+			return compareTied(tieBreaker, group[a], group[b]) < 0
+		})
+		for start := 0; start < len(group); {
+			end := start + 1
+			for end < len(group) && compareTied(tieBreaker, group[start], group[end]) == 0 {
+				end++
+			}
+			total := 0
+			for k := start; k < end; k++ {
+				total += payouts[i+k+1]
+			}
+			n := end - start
+			for k := start; k < end; k++ {
+				payoutByIndex[i+k] = total / n
+				if k-start < total%n {
+					payoutByIndex[i+k]++
+				}
+			}
+			start = end
+		}
+		i = j
+	}
+
 	// Assign ranks and payouts
 	results := make([]SimulationResult, len(scores))
 	for i, score := range scores {
 		rank := i + 1
-
-		// Get payout from actual payout structure
-		payoutCents := 0
-		if amount, ok := payouts[rank]; ok {
-			payoutCents = amount
-		}
+		payoutCents := payoutByIndex[i]
 
 		// Normalize by first place payout
 		normalizedPayout := 0.0
@@ -117,9 +200,51 @@ func CalculateSimulationOutcomes(simID int, entries map[string]*Entry, teamResul
 	return results, nil
 }
 
+// scoreEpsilon is how close two entries' points must be to count as tied.
+const scoreEpsilon = 0.0001
+
+type entryScore struct {
+	name           string
+	points         float64
+	championPoints float64
+	credits        int
+	submittedAt    time.Time
+}
+
+// compareTied orders two entries tied on points under the tie-breaker:
+// negative when a ranks ahead of b, zero when the tie-breaker cannot separate
+// them. The split rule never separates entries.
+func compareTied(tieBreaker string, a, b entryScore) int {
+	switch tieBreaker {
+	case models.TieBreakerChampionPoints:
+		if math.Abs(a.championPoints-b.championPoints) < scoreEpsilon {
+			return 0
+		}
+		if a.championPoints > b.championPoints {
+			return -1
+		}
+		return 1
+	case models.TieBreakerFewestCredits:
+		return a.credits - b.credits
+	case models.TieBreakerEarliestSubmission:
+		switch {
+		case a.submittedAt.Equal(b.submittedAt):
+			return 0
+		case a.submittedAt.IsZero():
+			return 1
+		case b.submittedAt.IsZero():
+			return -1
+		}
+		return a.submittedAt.Compare(b.submittedAt)
+	default:
+		return 0
+	}
+}
+
 // ConvertSimulationResults bridges simulation output to evaluation input by
 // grouping TeamSimulationResult records by SimID and converting each team's
-// wins/byes into points using the provided scoring rules.
+// run into points using the provided scoring rules. The team that won the
+// championship is marked as the simulation's champion.
 func ConvertSimulationResults(
 	simResults []simulation.TeamSimulationResult,
 	nTeams int,
@@ -127,54 +252,25 @@ func ConvertSimulationResults(
 ) map[int][]TeamSimResult {
 	out := make(map[int][]TeamSimResult)
 	for _, sr := range simResults {
-		points := scoring.PointsForProgress(rules, sr.Wins, sr.Byes)
-		out[sr.SimID] = append(out[sr.SimID], TeamSimResult{
-			TeamID: sr.TeamID,
-			Points: points,
-		})
+		out[sr.SimID] = append(out[sr.SimID], convertTeamSimulationResult(sr, rules))
 	}
 	return out
 }
 
-// CalculatePerformanceMetrics aggregates simulation results into per-entry
-// performance statistics (mean/median payout, P(top1), P(in money)).
-func CalculatePerformanceMetrics(results []SimulationResult) map[string]*EntryPerformance {
-	entryPayouts := make(map[string][]float64)
-
-	for _, r := range results {
-		entryPayouts[r.EntryName] = append(entryPayouts[r.EntryName], r.NormalizedPayout)
-	}
-
-	performance := make(map[string]*EntryPerformance)
-	for entryName, payouts := range entryPayouts {
-		sort.Float64s(payouts)
-
-		// Calculate metrics
-		var sum float64
-		var top1Count, inMoneyCount int
-		for _, payout := range payouts {
-			sum += payout
-			if payout > 0 {
-				inMoneyCount++
-			}
-			// Top 1 is when normalized payout is 1.0 (first place)
-			if payout >= 1.0 {
-				top1Count++
-			}
-		}
-
-		mean := sum / float64(len(payouts))
-		median := payouts[len(payouts)/2]
-
-		performance[entryName] = &EntryPerformance{
-			EntryName:    entryName,
-			MeanPayout:   mean,
-			MedianPayout: median,
-			PTop1:        float64(top1Count) / float64(len(payouts)),
-			PInMoney:     float64(inMoneyCount) / float64(len(payouts)),
-			TotalSims:    len(payouts),
-		}
+func convertTeamSimulationResult(sr simulation.TeamSimulationResult, rules []scoring.Rule) TeamSimResult {
+	run := scoring.Run{Seed: sr.Seed, Wins: sr.Wins, Byes: sr.Byes, BeatenSeeds: sr.BeatenSeeds}
+	return TeamSimResult{
+		TeamID:   sr.TeamID,
+		Points:   scoring.PointsForRun(rules, run),
+		Champion: sr.Wins+sr.Byes > models.RoundChampionship.MinProgressRequired(),
 	}
+}
 
-	return performance
+// CalculatePerformanceMetrics aggregates simulation results into per-entry
+// performance statistics (mean/median payout, P(top1), P(in money)), each
+// result an independent, equally weighted sample.
+func CalculatePerformanceMetrics(results []SimulationResult) map[string]*EntryPerformance {
+	dist := NewPayoutDistribution()
+	dist.Add(results)
+	return dist.Metrics()
 }
@@ -3,11 +3,16 @@ package calcutta_evaluations
 import (
 	"math"
 	"testing"
+	"time"
 
+	"github.com/andrewcopp/Calcutta/backend/internal/app/ownership"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/scoring"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/simulation"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
+var proportional = ownership.Rule{Mode: models.OwnershipModeProportional}
+
 // --- calculateSimulationOutcomes tests ---
 
 func TestThatPointsAreDistributedByProportionalOwnership(t *testing.T) {
@@ -19,7 +24,7 @@ func TestThatPointsAreDistributedByProportionalOwnership(t *testing.T) {
 	teamResults := []TeamSimResult{{TeamID: "teamA", Points: 100}}
 
 	// WHEN calculating simulation outcomes
-	results, err := CalculateSimulationOutcomes(1, entries, teamResults, map[int]int{1: 1000, 2: 500}, 1000)
+	results, err := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerSplit, teamResults, map[int]int{1: 1000, 2: 500}, 1000)
 
 	// THEN Alice gets 60 points and Bob gets 40 points
 	if err != nil {
@@ -31,6 +36,72 @@ func TestThatPointsAreDistributedByProportionalOwnership(t *testing.T) {
 	}
 }
 
+func TestThatWinnerTakeAllGivesTopBidderAllPoints(t *testing.T) {
+	// GIVEN a winner-take-all pool with a 60/40 bid split on one team that scored 100
+	entries := map[string]*Entry{
+		"alice": {Name: "Alice", Teams: map[string]int{"teamA": 60}},
+		"bob":   {Name: "Bob", Teams: map[string]int{"teamA": 40}},
+	}
+	teamResults := []TeamSimResult{{TeamID: "teamA", Points: 100}}
+	rule := ownership.Rule{Mode: models.OwnershipModeWinnerTakeAll}
+
+	// WHEN calculating simulation outcomes
+	results, err := CalculateSimulationOutcomes(1, entries, rule, models.TieBreakerSplit, teamResults, map[int]int{1: 1000}, 1000)
+
+	// THEN Alice gets all 100 points
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	alicePoints := findResult(results, "Alice").TotalPoints
+	if alicePoints != 100.0 {
+		t.Errorf("expected Alice to have 100 points, got %v", alicePoints)
+	}
+}
+
+func TestThatCappedShareLimitsTopBidderPoints(t *testing.T) {
+	// GIVEN a pool capped at 50% with an 80/20 bid split on one team that scored 100
+	entries := map[string]*Entry{
+		"alice": {Name: "Alice", Teams: map[string]int{"teamA": 80}},
+		"bob":   {Name: "Bob", Teams: map[string]int{"teamA": 20}},
+	}
+	teamResults := []TeamSimResult{{TeamID: "teamA", Points: 100}}
+	rule := ownership.Rule{Mode: models.OwnershipModeCappedShare, CapPercent: 50}
+
+	// WHEN calculating simulation outcomes
+	results, err := CalculateSimulationOutcomes(1, entries, rule, models.TieBreakerSplit, teamResults, map[int]int{1: 1000}, 1000)
+
+	// THEN Bob gets the 50 points Alice's cap leaves
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	bobPoints := findResult(results, "Bob").TotalPoints
+	if math.Abs(bobPoints-50.0) > 1e-9 {
+		t.Errorf("expected Bob to have 50 points, got %v", bobPoints)
+	}
+}
+
+func TestThatProRataSplitsUnclaimedTeamByEntryCredits(t *testing.T) {
+	// GIVEN a pro-rata pool where Alice invested 75 and Bob 25, and an unclaimed team scored 100
+	entries := map[string]*Entry{
+		"alice": {Name: "Alice", Teams: map[string]int{"teamA": 75}},
+		"bob":   {Name: "Bob", Teams: map[string]int{"teamB": 25}},
+	}
+	teamResults := []TeamSimResult{{TeamID: "teamA", Points: 0}, {TeamID: "teamB", Points: 0}, {TeamID: "teamC", Points: 100}}
+	rule := ownership.Rule{Mode: models.OwnershipModeProportional, Unclaimed: models.UnclaimedModeProRata}
+
+	// WHEN calculating simulation outcomes
+	results, err := CalculateSimulationOutcomes(1, entries, rule, models.TieBreakerSplit, teamResults, map[int]int{1: 1000}, 1000)
+
+	// THEN Alice gets three quarters of the unclaimed team's points
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	alicePoints := findResult(results, "Alice").TotalPoints
+	if math.Abs(alicePoints-75.0) > 1e-9 {
+		t.Errorf("expected Alice to have 75 points, got %v", alicePoints)
+	}
+}
+
 func TestThatRank1IsAssignedToHighestScorer(t *testing.T) {
 	// GIVEN two entries where Alice scores higher
 	entries := map[string]*Entry{
@@ -43,7 +114,7 @@ func TestThatRank1IsAssignedToHighestScorer(t *testing.T) {
 	}
 
 	// WHEN calculating simulation outcomes
-	results, _ := CalculateSimulationOutcomes(1, entries, teamResults, map[int]int{1: 1000}, 1000)
+	results, _ := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerSplit, teamResults, map[int]int{1: 1000}, 1000)
 
 	// THEN Alice is rank 1
 	if findResult(results, "Alice").Rank != 1 {
@@ -60,7 +131,7 @@ func TestThatTiesAreBrokenByNameAlphabetically(t *testing.T) {
 	teamResults := []TeamSimResult{{TeamID: "teamA", Points: 100}}
 
 	// WHEN calculating simulation outcomes
-	results, _ := CalculateSimulationOutcomes(1, entries, teamResults, map[int]int{1: 1000, 2: 500}, 1000)
+	results, _ := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerSplit, teamResults, map[int]int{1: 1000, 2: 500}, 1000)
 
 	// THEN Alice (alphabetically first) gets rank 1
 	if findResult(results, "Alice").Rank != 1 {
@@ -68,6 +139,80 @@ func TestThatTiesAreBrokenByNameAlphabetically(t *testing.T) {
 	}
 }
 
+func TestThatSplitTieBreakerSharesTiedPayouts(t *testing.T) {
+	// GIVEN two entries with identical scores under the split tie-breaker
+	entries := map[string]*Entry{
+		"bob":   {Name: "Bob", Teams: map[string]int{"teamA": 50}},
+		"alice": {Name: "Alice", Teams: map[string]int{"teamA": 50}},
+	}
+	teamResults := []TeamSimResult{{TeamID: "teamA", Points: 100}}
+
+	// WHEN calculating simulation outcomes
+	results, _ := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerSplit, teamResults, map[int]int{1: 1000, 2: 500}, 1000)
+
+	// THEN Bob receives half of first and second place
+	if findResult(results, "Bob").PayoutCents != 750 {
+		t.Errorf("expected Bob to be paid 750, got %d", findResult(results, "Bob").PayoutCents)
+	}
+}
+
+func TestThatFewestCreditsTieBreakerPaysCheaperEntryFirstPlace(t *testing.T) {
+	// GIVEN two entries tied on points where Bob spent fewer credits
+	entries := map[string]*Entry{
+		"alice": {Name: "Alice", Teams: map[string]int{"teamA": 60}},
+		"bob":   {Name: "Bob", Teams: map[string]int{"teamB": 30}},
+	}
+	teamResults := []TeamSimResult{
+		{TeamID: "teamA", Points: 100},
+		{TeamID: "teamB", Points: 100},
+	}
+
+	// WHEN calculating simulation outcomes
+	results, _ := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerFewestCredits, teamResults, map[int]int{1: 1000, 2: 500}, 1000)
+
+	// THEN Bob takes the whole first-place payout
+	if findResult(results, "Bob").PayoutCents != 1000 {
+		t.Errorf("expected Bob to be paid 1000, got %d", findResult(results, "Bob").PayoutCents)
+	}
+}
+
+func TestThatChampionPointsTieBreakerRanksChampionOwnerFirst(t *testing.T) {
+	// GIVEN two entries tied on points where Bob owns the champion
+	entries := map[string]*Entry{
+		"alice": {Name: "Alice", Teams: map[string]int{"teamA": 50}},
+		"bob":   {Name: "Bob", Teams: map[string]int{"teamB": 50}},
+	}
+	teamResults := []TeamSimResult{
+		{TeamID: "teamA", Points: 100},
+		{TeamID: "teamB", Points: 100, Champion: true},
+	}
+
+	// WHEN calculating simulation outcomes
+	results, _ := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerChampionPoints, teamResults, map[int]int{1: 1000}, 1000)
+
+	// THEN Bob is rank 1
+	if findResult(results, "Bob").Rank != 1 {
+		t.Errorf("expected Bob to be rank 1, got %d", findResult(results, "Bob").Rank)
+	}
+}
+
+func TestThatEarliestSubmissionTieBreakerRanksUnsubmittedEntryLast(t *testing.T) {
+	// GIVEN a submitted entry tied with a hypothetical one
+	entries := map[string]*Entry{
+		"alice": {Name: "Alice", Teams: map[string]int{"teamA": 50}},
+		"bob":   {Name: "Bob", Teams: map[string]int{"teamA": 50}, SubmittedAt: time.Unix(100, 0)},
+	}
+	teamResults := []TeamSimResult{{TeamID: "teamA", Points: 100}}
+
+	// WHEN calculating simulation outcomes
+	results, _ := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerEarliestSubmission, teamResults, map[int]int{1: 1000}, 1000)
+
+	// THEN Bob is rank 1
+	if findResult(results, "Bob").Rank != 1 {
+		t.Errorf("expected Bob to be rank 1, got %d", findResult(results, "Bob").Rank)
+	}
+}
+
 func TestThatNormalizedPayoutIsDividedByFirstPlacePayout(t *testing.T) {
 	// GIVEN one entry that wins first place with payout 1000 and firstPlacePayout 2000
 	entries := map[string]*Entry{
@@ -76,7 +221,7 @@ func TestThatNormalizedPayoutIsDividedByFirstPlacePayout(t *testing.T) {
 	teamResults := []TeamSimResult{{TeamID: "teamA", Points: 100}}
 
 	// WHEN calculating with firstPlacePayout = 2000
-	results, _ := CalculateSimulationOutcomes(1, entries, teamResults, map[int]int{1: 1000}, 2000)
+	results, _ := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerSplit, teamResults, map[int]int{1: 1000}, 2000)
 
 	// THEN normalized payout is 0.5 (1000/2000)
 	if results[0].NormalizedPayout != 0.5 {
@@ -92,7 +237,7 @@ func TestThatNormalizedPayoutIsZeroWhenFirstPlacePayoutIsZero(t *testing.T) {
 	teamResults := []TeamSimResult{{TeamID: "teamA", Points: 100}}
 
 	// WHEN firstPlacePayout is zero
-	results, _ := CalculateSimulationOutcomes(1, entries, teamResults, map[int]int{1: 500}, 0)
+	results, _ := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerSplit, teamResults, map[int]int{1: 500}, 0)
 
 	// THEN normalized payout is 0.0
 	if results[0].NormalizedPayout != 0.0 {
@@ -111,7 +256,7 @@ func TestThatTeamWithZeroTotalBidsDoesNotPanic(t *testing.T) {
 	}
 
 	// WHEN calculating simulation outcomes
-	results, err := CalculateSimulationOutcomes(1, entries, teamResults, map[int]int{1: 1000}, 1000)
+	results, err := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerSplit, teamResults, map[int]int{1: 1000}, 1000)
 
 	// THEN no panic and Alice gets points only from teamB
 	if err != nil {
@@ -130,7 +275,7 @@ func TestThatSingleEntryGetsRankOne(t *testing.T) {
 	teamResults := []TeamSimResult{{TeamID: "teamA", Points: 50}}
 
 	// WHEN calculating simulation outcomes
-	results, _ := CalculateSimulationOutcomes(1, entries, teamResults, map[int]int{1: 1000}, 1000)
+	results, _ := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerSplit, teamResults, map[int]int{1: 1000}, 1000)
 
 	// THEN Alice is rank 1
 	if results[0].Rank != 1 {
@@ -144,7 +289,7 @@ func TestThatEmptyEntriesReturnsEmptySlice(t *testing.T) {
 	teamResults := []TeamSimResult{{TeamID: "teamA", Points: 100}}
 
 	// WHEN calculating simulation outcomes
-	results, err := CalculateSimulationOutcomes(1, entries, teamResults, map[int]int{1: 1000}, 1000)
+	results, err := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerSplit, teamResults, map[int]int{1: 1000}, 1000)
 
 	// THEN result slice is empty
 	if err != nil {
@@ -279,6 +424,21 @@ func TestThatConvertSimulationResultsConvertsWinsAndByesToPoints(t *testing.T) {
 	}
 }
 
+func TestThatConvertSimulationResultsMarksTheChampion(t *testing.T) {
+	// GIVEN a team that won every game after a first-round bye
+	simResults := []simulation.TeamSimulationResult{
+		{SimID: 0, TeamID: "teamA", Wins: 6, Byes: 1},
+	}
+
+	// WHEN converting simulation results
+	result := ConvertSimulationResults(simResults, 1, nil)
+
+	// THEN the team is marked as the champion
+	if !result[0][0].Champion {
+		t.Errorf("expected teamA to be marked champion")
+	}
+}
+
 func TestThatConvertSimulationResultsGroupsBySimID(t *testing.T) {
 	// GIVEN results from two different simulations
 	simResults := []simulation.TeamSimulationResult{
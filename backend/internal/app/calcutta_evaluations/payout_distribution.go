@@ -0,0 +1,271 @@
+package calcutta_evaluations
+
+import (
+	"math"
+	"sort"
+)
+
+// confidenceZ is the normal quantile for the 95% confidence intervals.
+const confidenceZ = 1.96
+
+// PayoutDistribution folds simulation results into per-entry histograms of
+// normalized payouts as they are produced, so evaluating a large batch never
+// holds every SimulationResult at once. Workers each fold into their own and
+// Merge at the end.
+//
+// Estimates are self-normalized: each simulation counts by its weight, and
+// standard errors come from the spread of independent samples within each
+// stratum, combined by the strata's share of the total weight. Without
+// weights, blocks or strata that is the familiar sample mean and its
+// standard error.
+type PayoutDistribution struct {
+	entries map[string]*entryDistribution
+}
+
+// PayoutBucket is the number of simulations in which an entry earned a
+// normalized payout, and their total weight.
+type PayoutBucket struct {
+	NormalizedPayout float64
+	Sims             int
+	Weight           float64
+}
+
+type entryDistribution struct {
+	counts  map[float64]int
+	weights map[float64]float64
+	strata  map[int]*stratumSums
+}
+
+// The metrics each sample contributes to, in stratumSums.metrics order.
+const (
+	metricMeanPayout = iota
+	metricTop1
+	metricInMoney
+	numMetrics
+)
+
+// stratumSums accumulates a stratum's independent samples, each with total
+// weight w and weighted mean f per metric.
+type stratumSums struct {
+	w, w2   float64
+	metrics [numMetrics]struct{ wf, w2f, w2f2 float64 }
+}
+
+func (s *stratumSums) add(w float64, f [numMetrics]float64) {
+	s.w += w
+	s.w2 += w * w
+	for m := range f {
+		s.metrics[m].wf += w * f[m]
+		s.metrics[m].w2f += w * w * f[m]
+		s.metrics[m].w2f2 += w * w * f[m] * f[m]
+	}
+}
+
+func (s *stratumSums) merge(other *stratumSums) {
+	s.w += other.w
+	s.w2 += other.w2
+	for m := range s.metrics {
+		s.metrics[m].wf += other.metrics[m].wf
+		s.metrics[m].w2f += other.metrics[m].w2f
+		s.metrics[m].w2f2 += other.metrics[m].w2f2
+	}
+}
+
+func NewPayoutDistribution() *PayoutDistribution {
+	return &PayoutDistribution{entries: make(map[string]*entryDistribution)}
+}
+
+func (d *PayoutDistribution) entry(name string) *entryDistribution {
+	e := d.entries[name]
+	if e == nil {
+		e = &entryDistribution{counts: make(map[float64]int), weights: make(map[float64]float64), strata: make(map[int]*stratumSums)}
+		d.entries[name] = e
+	}
+	return e
+}
+
+func (e *entryDistribution) stratum(k int) *stratumSums {
+	s := e.strata[k]
+	if s == nil {
+		s = &stratumSums{}
+		e.strata[k] = s
+	}
+	return s
+}
+
+func payoutMetrics(payout float64) [numMetrics]float64 {
+	var f [numMetrics]float64
+	f[metricMeanPayout] = payout
+	if payout >= 1.0 {
+		f[metricTop1] = 1
+	}
+	if payout > 0 {
+		f[metricInMoney] = 1
+	}
+	return f
+}
+
+// Add folds results as independent, equally weighted samples.
+func (d *PayoutDistribution) Add(results []SimulationResult) {
+	for _, r := range results {
+		e := d.entry(r.EntryName)
+		e.counts[r.NormalizedPayout]++
+		e.weights[r.NormalizedPayout]++
+		e.stratum(0).add(1, payoutMetrics(r.NormalizedPayout))
+	}
+}
+
+// AddSample folds one independent sample in a stratum: the results of each
+// simulation in it, such as an antithetic pair, with each simulation's
+// weight.
+func (d *PayoutDistribution) AddSample(sims [][]SimulationResult, weights []float64, stratum int) {
+	type blockSums struct {
+		w  float64
+		wf [numMetrics]float64
+	}
+	blocks := make(map[string]*blockSums)
+	for i, results := range sims {
+		w := weights[i]
+		for _, r := range results {
+			e := d.entry(r.EntryName)
+			e.counts[r.NormalizedPayout]++
+			e.weights[r.NormalizedPayout] += w
+
+			b := blocks[r.EntryName]
+			if b == nil {
+				b = &blockSums{}
+				blocks[r.EntryName] = b
+			}
+			b.w += w
+			for m, f := range payoutMetrics(r.NormalizedPayout) {
+				b.wf[m] += w * f
+			}
+		}
+	}
+	for name, b := range blocks {
+		if b.w == 0 {
+			continue
+		}
+		var f [numMetrics]float64
+		for m := range f {
+			f[m] = b.wf[m] / b.w
+		}
+		d.entries[name].stratum(stratum).add(b.w, f)
+	}
+}
+
+// Merge adds other's samples into d.
+func (d *PayoutDistribution) Merge(other *PayoutDistribution) {
+	for name, theirs := range other.entries {
+		mine := d.entry(name)
+		for payout, n := range theirs.counts {
+			mine.counts[payout] += n
+		}
+		for payout, w := range theirs.weights {
+			mine.weights[payout] += w
+		}
+		for k, s := range theirs.strata {
+			mine.stratum(k).merge(s)
+		}
+	}
+}
+
+// Buckets returns an entry's distribution, lowest payout first.
+func (d *PayoutDistribution) Buckets(entryName string) []PayoutBucket {
+	e := d.entries[entryName]
+	if e == nil {
+		return []PayoutBucket{}
+	}
+	buckets := make([]PayoutBucket, 0, len(e.counts))
+	for payout, n := range e.counts {
+		buckets = append(buckets, PayoutBucket{NormalizedPayout: payout, Sims: n, Weight: e.weights[payout]})
+	}
+	sort.Slice(buckets, func(i, j int) bool { return buckets[i].NormalizedPayout < buckets[j].NormalizedPayout })
+	return buckets
+}
+
+// estimate returns a metric's estimate and standard error for an entry.
+func (e *entryDistribution) estimate(metric int) (float64, float64) {
+	total := 0.0
+	for _, s := range e.strata {
+		total += s.w
+	}
+	if total == 0 {
+		return 0, 0
+	}
+	mean, variance := 0.0, 0.0
+	for _, s := range e.strata {
+		if s.w == 0 {
+			continue
+		}
+		m := s.metrics[metric]
+		mu := m.wf / s.w
+		v := (m.w2f2 - 2*mu*m.w2f + mu*mu*s.w2) / (s.w * s.w)
+		if v < 0 {
+			v = 0
+		}
+		share := s.w / total
+		mean += m.wf / total
+		variance += share * share * v
+	}
+	return mean, math.Sqrt(variance)
+}
+
+// Metrics computes each entry's performance from its distribution.
+func (d *PayoutDistribution) Metrics() map[string]*EntryPerformance {
+	performance := make(map[string]*EntryPerformance, len(d.entries))
+	for name, e := range d.entries {
+		buckets := d.Buckets(name)
+		total, totalWeight := 0, 0.0
+		for _, b := range buckets {
+			total += b.Sims
+			totalWeight += b.Weight
+		}
+		if total == 0 || totalWeight == 0 {
+			continue
+		}
+
+		median := 0.0
+		seen := 0.0
+		for _, b := range buckets {
+			seen += b.Weight
+			if seen > totalWeight/2 {
+				median = b.NormalizedPayout
+				break
+			}
+		}
+
+		perf := &EntryPerformance{EntryName: name, MedianPayout: median, TotalSims: total}
+		perf.MeanPayout, perf.MeanPayoutSE = e.estimate(metricMeanPayout)
+		perf.PTop1, perf.PTop1SE = e.estimate(metricTop1)
+		perf.PInMoney, perf.PInMoneySE = e.estimate(metricInMoney)
+		perf.MeanPayoutCI = confidenceInterval(perf.MeanPayout, perf.MeanPayoutSE, math.Inf(1))
+		perf.PTop1CI = confidenceInterval(perf.PTop1, perf.PTop1SE, 1)
+		perf.PInMoneyCI = confidenceInterval(perf.PInMoney, perf.PInMoneySE, 1)
+		performance[name] = perf
+	}
+	return performance
+}
+
+// Precise reports whether every entry's MeanPayout, PTop1 and PInMoney have
+// a standard error of at most target.
+func (d *PayoutDistribution) Precise(target float64) bool {
+	if len(d.entries) == 0 {
+		return false
+	}
+	for _, e := range d.entries {
+		for m := 0; m < numMetrics; m++ {
+			if _, se := e.estimate(m); se > target {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func confidenceInterval(estimate, se, upper float64) Interval {
+	return Interval{
+		Low:  math.Max(0, estimate-confidenceZ*se),
+		High: math.Min(upper, estimate+confidenceZ*se),
+	}
+}
@@ -0,0 +1,256 @@
+package calcutta_evaluations
+
+import (
+	"context"
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/scoring"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/simulation"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func mixedPayoutResults() []SimulationResult {
+	return []SimulationResult{
+		{SimID: 1, EntryName: "Alice", NormalizedPayout: 1.0},
+		{SimID: 1, EntryName: "Bob", NormalizedPayout: 0.0},
+		{SimID: 2, EntryName: "Alice", NormalizedPayout: 0.3},
+		{SimID: 2, EntryName: "Bob", NormalizedPayout: 1.0},
+		{SimID: 3, EntryName: "Alice", NormalizedPayout: 0.1},
+		{SimID: 3, EntryName: "Bob", NormalizedPayout: 0.3},
+		{SimID: 4, EntryName: "Alice", NormalizedPayout: 0.1},
+		{SimID: 4, EntryName: "Bob", NormalizedPayout: 0.0},
+	}
+}
+
+// metricsMatch compares performance up to the rounding of summing in a
+// different order.
+func metricsMatch(a, b map[string]*EntryPerformance) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	close := func(x, y float64) bool { return math.Abs(x-y) < 1e-9 }
+	for name, pa := range a {
+		pb, ok := b[name]
+		if !ok || pa.TotalSims != pb.TotalSims || pa.MedianPayout != pb.MedianPayout {
+			return false
+		}
+		for _, pair := range [][2]float64{
+			{pa.MeanPayout, pb.MeanPayout}, {pa.PTop1, pb.PTop1}, {pa.PInMoney, pb.PInMoney},
+			{pa.MeanPayoutSE, pb.MeanPayoutSE}, {pa.PTop1SE, pb.PTop1SE}, {pa.PInMoneySE, pb.PInMoneySE},
+		} {
+			if !close(pair[0], pair[1]) {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func TestThatPayoutDistributionMetricsMatchPerformanceMetrics(t *testing.T) {
+	// GIVEN results for two entries across four simulations
+	results := mixedPayoutResults()
+
+	// WHEN folding them into a distribution
+	dist := NewPayoutDistribution()
+	dist.Add(results)
+
+	// THEN its metrics match those computed from the full results
+	if !reflect.DeepEqual(dist.Metrics(), CalculatePerformanceMetrics(results)) {
+		t.Errorf("expected %+v, got %+v", CalculatePerformanceMetrics(results), dist.Metrics())
+	}
+}
+
+func TestThatMergedPayoutDistributionsMatchOneDistribution(t *testing.T) {
+	// GIVEN results split across two workers
+	results := mixedPayoutResults()
+	whole := NewPayoutDistribution()
+	whole.Add(results)
+
+	// WHEN each worker folds its half and they merge
+	merged := NewPayoutDistribution()
+	half := NewPayoutDistribution()
+	merged.Add(results[:4])
+	half.Add(results[4:])
+	merged.Merge(half)
+
+	// THEN the merged distribution has the same metrics
+	if !metricsMatch(merged.Metrics(), whole.Metrics()) {
+		t.Errorf("expected %+v, got %+v", whole.Metrics(), merged.Metrics())
+	}
+}
+
+func TestThatPayoutBucketsAreOrderedLowestFirst(t *testing.T) {
+	// GIVEN an entry paid 1.0, 0.3, 0.1 and 0.1
+	dist := NewPayoutDistribution()
+	dist.Add(mixedPayoutResults())
+
+	// WHEN listing its buckets
+	got := dist.Buckets("Alice")
+
+	// THEN they count each payout lowest first
+	want := []PayoutBucket{{NormalizedPayout: 0.1, Sims: 2, Weight: 2}, {NormalizedPayout: 0.3, Sims: 1, Weight: 1}, {NormalizedPayout: 1.0, Sims: 1, Weight: 1}}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected %+v, got %+v", want, got)
+	}
+}
+
+func fourTeamBracket() *models.BracketStructure {
+	b := &models.BracketStructure{
+		TournamentID: "t",
+		Games:        make(map[string]*models.BracketGame),
+		FinalFour:    &models.FinalFourConfig{},
+	}
+	b.Games["g1"] = &models.BracketGame{GameID: "g1", Round: models.RoundOf64, Team1: &models.BracketTeam{TeamID: "teamA", Seed: 1}, Team2: &models.BracketTeam{TeamID: "teamB", Seed: 16}, NextGameID: "g3", NextGameSlot: 1, SortOrder: 1}
+	b.Games["g2"] = &models.BracketGame{GameID: "g2", Round: models.RoundOf64, Team1: &models.BracketTeam{TeamID: "teamC", Seed: 8}, Team2: &models.BracketTeam{TeamID: "teamD", Seed: 9}, NextGameID: "g3", NextGameSlot: 2, SortOrder: 2}
+	b.Games["g3"] = &models.BracketGame{GameID: "g3", Round: models.RoundOf32, SortOrder: 1}
+	return b
+}
+
+func TestThatPackedEvaluationMatchesRowEvaluation(t *testing.T) {
+	// GIVEN packed simulations of a small bracket and two competing entries
+	agg, err := simulation.SimulateAggregated(fourTeamBracket(), nil, 200, 42, simulation.Options{Workers: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	entries := map[string]*Entry{
+		"alice": {Name: "Alice", Teams: map[string]int{"teamA": 60, "teamC": 40}},
+		"bob":   {Name: "Bob", Teams: map[string]int{"teamB": 50, "teamD": 50}},
+	}
+	rules := []scoring.Rule{{WinIndex: 2, PointsAwarded: 10}, {WinIndex: 3, PointsAwarded: 20}}
+	payouts := map[int]int{1: 1000, 2: 400}
+	var rows []simulation.TeamSimulationResult
+	for simID := 0; simID < agg.Outcomes.NSims; simID++ {
+		rows = append(rows, agg.Layout.Decode(simID, agg.Outcomes.Sim(simID))...)
+	}
+	var allResults []SimulationResult
+	for simID, teamResults := range ConvertSimulationResults(rows, len(agg.Layout.TeamIDs), rules) {
+		simResults, err := CalculateSimulationOutcomes(simID, entries, proportional, models.TieBreakerSplit, teamResults, payouts, 1000)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		allResults = append(allResults, simResults...)
+	}
+
+	// WHEN evaluating the packed simulations directly
+	batch := &packedBatch{layout: agg.Layout, chunks: []*simulation.PackedOutcomes{agg.Outcomes}}
+	dist, _, err := evaluatePackedSimulations(context.Background(), entries, proportional, models.TieBreakerSplit, rules, batch, payouts, 1000, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the metrics match evaluating the decoded rows
+	if !metricsMatch(dist.Metrics(), CalculatePerformanceMetrics(allResults)) {
+		t.Errorf("expected %+v, got %+v", CalculatePerformanceMetrics(allResults), dist.Metrics())
+	}
+}
+
+func TestThatPayoutStandardErrorIsTheSampleStandardError(t *testing.T) {
+	// GIVEN an entry that finishes first in one of four simulations
+	dist := NewPayoutDistribution()
+	dist.Add(mixedPayoutResults())
+
+	// WHEN computing its metrics
+	got := dist.Metrics()["Alice"].PTop1SE
+
+	// THEN P(top1) has standard error sqrt(p(1-p)/n)
+	if want := math.Sqrt(0.25 * 0.75 / 4); math.Abs(got-want) > 1e-12 {
+		t.Errorf("expected %v, got %v", want, got)
+	}
+}
+
+func TestThatConfidenceIntervalsStayWithinProbabilities(t *testing.T) {
+	// GIVEN an entry that finishes first in one of four simulations
+	dist := NewPayoutDistribution()
+	dist.Add(mixedPayoutResults())
+
+	// WHEN computing its metrics
+	got := dist.Metrics()["Alice"].PTop1CI
+
+	// THEN the interval is clamped at zero
+	if got.Low != 0 {
+		t.Errorf("expected a low of 0, got %+v", got)
+	}
+}
+
+func TestThatWeightedSamplesWeightTheEstimate(t *testing.T) {
+	// GIVEN a first-place finish weighted three times a shutout
+	dist := NewPayoutDistribution()
+	dist.AddSample([][]SimulationResult{{{EntryName: "Alice", NormalizedPayout: 1}}}, []float64{1.5}, 0)
+	dist.AddSample([][]SimulationResult{{{EntryName: "Alice", NormalizedPayout: 0}}}, []float64{0.5}, 0)
+
+	// WHEN computing its metrics
+	got := dist.Metrics()["Alice"].PTop1
+
+	// THEN P(top1) is the weighted share
+	if got != 0.75 {
+		t.Errorf("expected 0.75, got %v", got)
+	}
+}
+
+func TestThatStratifiedSamplesHaveNoVarianceBetweenStrata(t *testing.T) {
+	// GIVEN strata in which the entry always or never finishes first
+	dist := NewPayoutDistribution()
+	for i := 0; i < 3; i++ {
+		dist.AddSample([][]SimulationResult{{{EntryName: "Alice", NormalizedPayout: 1}}}, []float64{1}, 0)
+		dist.AddSample([][]SimulationResult{{{EntryName: "Alice", NormalizedPayout: 0}}}, []float64{1}, 1)
+	}
+
+	// WHEN computing its metrics
+	got := dist.Metrics()["Alice"].PTop1SE
+
+	// THEN P(top1) is known exactly
+	if got != 0 {
+		t.Errorf("expected 0, got %v", got)
+	}
+}
+
+func TestThatAntitheticPairsCountAsOneSample(t *testing.T) {
+	// GIVEN two pairs, each an upset and a favorite's win
+	dist := NewPayoutDistribution()
+	for i := 0; i < 2; i++ {
+		dist.AddSample([][]SimulationResult{
+			{{EntryName: "Alice", NormalizedPayout: 1}},
+			{{EntryName: "Alice", NormalizedPayout: 0}},
+		}, []float64{1, 1}, 0)
+	}
+
+	// WHEN computing its metrics
+	got := dist.Metrics()["Alice"].PTop1SE
+
+	// THEN the pairs agree, so the estimate has no error
+	if got != 0 {
+		t.Errorf("expected 0, got %v", got)
+	}
+}
+
+func TestThatAdaptiveEvaluationStopsAtTargetPrecision(t *testing.T) {
+	// GIVEN ten chunks of simulations of a small bracket
+	var chunks []*simulation.PackedOutcomes
+	var layout *simulation.OutcomeLayout
+	for c := 0; c < 10; c++ {
+		agg, err := simulation.SimulateAggregated(fourTeamBracket(), nil, 100, int64(c), simulation.Options{Workers: 1})
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		layout = agg.Layout
+		chunks = append(chunks, agg.Outcomes)
+	}
+	entries := map[string]*Entry{
+		"alice": {Name: "Alice", Teams: map[string]int{"teamA": 60, "teamC": 40}},
+		"bob":   {Name: "Bob", Teams: map[string]int{"teamB": 50, "teamD": 50}},
+	}
+	rules := []scoring.Rule{{WinIndex: 2, PointsAwarded: 10}, {WinIndex: 3, PointsAwarded: 20}}
+
+	// WHEN evaluating them to a loose target
+	_, nSims, err := evaluatePackedSimulations(context.Background(), entries, proportional, models.TieBreakerSplit, rules, &packedBatch{layout: layout, chunks: chunks}, map[int]int{1: 1000}, 1000, 0.1)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN it stops before the last chunk
+	if nSims >= 1000 {
+		t.Errorf("expected fewer than 1000 simulations, got %d", nSims)
+	}
+}
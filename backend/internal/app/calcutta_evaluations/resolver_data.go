@@ -2,10 +2,14 @@ package calcutta_evaluations
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
+	"github.com/andrewcopp/Calcutta/backend/internal/app/ownership"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/scoring"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/simulation"
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 	"github.com/jackc/pgx/v5"
 )
@@ -13,6 +17,8 @@ import (
 type calcuttaContext struct {
 	CalcuttaID   string
 	TournamentID string
+	Ownership    ownership.Rule
+	TieBreaker   string
 }
 
 func (s *Service) getLatestTournamentSimulationBatchID(ctx context.Context, coreTournamentID string) (string, bool, error) {
@@ -22,12 +28,19 @@ func (s *Service) getLatestTournamentSimulationBatchID(ctx context.Context, core
 		FROM compute.simulated_tournaments b
 		WHERE b.tournament_id = $1
 			AND b.deleted_at IS NULL
-			AND EXISTS (
-				SELECT 1
-				FROM compute.simulated_teams st
-				WHERE st.tournament_id = $1
-					AND st.simulated_tournament_id = b.id
-					AND st.deleted_at IS NULL
+			AND (
+				EXISTS (
+					SELECT 1
+					FROM compute.simulated_teams st
+					WHERE st.tournament_id = $1
+						AND st.simulated_tournament_id = b.id
+						AND st.deleted_at IS NULL
+				)
+				OR EXISTS (
+					SELECT 1
+					FROM compute.simulated_outcome_chunks oc
+					WHERE oc.simulated_tournament_id = b.id
+				)
 			)
 		ORDER BY b.created_at DESC
 		LIMIT 1
@@ -43,18 +56,20 @@ func (s *Service) getLatestTournamentSimulationBatchID(ctx context.Context, core
 
 func (s *Service) getCalcuttaContext(ctx context.Context, calcuttaID string) (*calcuttaContext, error) {
 	query := `
-		SELECT c.id, c.tournament_id
+		SELECT c.id, c.tournament_id, c.ownership_mode, c.ownership_cap_percent, c.unclaimed_mode, c.tie_breaker
 		FROM core.pools c
 		WHERE c.id = $1::uuid
 			AND c.deleted_at IS NULL
 		LIMIT 1
 	`
 
-	var resolvedCalcuttaID, tournamentID string
-	if err := s.pool.QueryRow(ctx, query, calcuttaID).Scan(&resolvedCalcuttaID, &tournamentID); err != nil {
+	var resolvedCalcuttaID, tournamentID, ownershipMode, unclaimedMode, tieBreaker string
+	var ownershipCapPercent *int
+	if err := s.pool.QueryRow(ctx, query, calcuttaID).Scan(&resolvedCalcuttaID, &tournamentID, &ownershipMode, &ownershipCapPercent, &unclaimedMode, &tieBreaker); err != nil {
 		return nil, err
 	}
-	return &calcuttaContext{CalcuttaID: resolvedCalcuttaID, TournamentID: tournamentID}, nil
+	rule := ownership.RuleForPool(&models.Pool{OwnershipMode: ownershipMode, OwnershipCapPercent: ownershipCapPercent, UnclaimedMode: unclaimedMode})
+	return &calcuttaContext{CalcuttaID: resolvedCalcuttaID, TournamentID: tournamentID, Ownership: rule, TieBreaker: tieBreaker}, nil
 }
 
 func (s *Service) getSimulations(ctx context.Context, cc *calcuttaContext, tournamentSimulationBatchID string) (map[int][]TeamSimResult, error) {
@@ -71,8 +86,11 @@ func (s *Service) getSimulationsWithRules(ctx context.Context, tournamentID stri
 			sst.sim_id,
 			sst.team_id,
 			sst.wins::int,
-			sst.byes::int
+			sst.byes::int,
+			t.seed::int,
+			COALESCE(sst.beaten_seeds, '{}')::int[]
 		FROM compute.simulated_teams sst
+		JOIN core.teams t ON t.id = sst.team_id
 		WHERE sst.tournament_id = $1
 			AND sst.simulated_tournament_id = $2
 			AND sst.deleted_at IS NULL
@@ -90,12 +108,15 @@ func (s *Service) getSimulationsWithRules(ctx context.Context, tournamentID stri
 	for rows.Next() {
 		var simID int
 		var teamID string
-		var wins int
-		var byes int
-		if err := rows.Scan(&simID, &teamID, &wins, &byes); err != nil {
+		var run scoring.Run
+		var beaten []int32
+		if err := rows.Scan(&simID, &teamID, &run.Wins, &run.Byes, &run.Seed, &beaten); err != nil {
 			return nil, err
 		}
-		points := scoring.PointsForProgress(rules, wins, byes)
+		for _, b := range beaten {
+			run.BeatenSeeds = append(run.BeatenSeeds, int(b))
+		}
+		points := scoring.PointsForRun(rules, run)
 		simulations[simID] = append(simulations[simID], TeamSimResult{
 			TeamID: teamID,
 			Points: points,
@@ -105,13 +126,84 @@ func (s *Service) getSimulationsWithRules(ctx context.Context, tournamentID stri
 	return simulations, nil
 }
 
+// packedBatch is a simulation batch stored as packed outcomes, in the chunks
+// it was simulated in.
+type packedBatch struct {
+	layout   *simulation.OutcomeLayout
+	sampling *simulation.Sampling
+	chunks   []*simulation.PackedOutcomes
+}
+
+func (b *packedBatch) nSims() int {
+	n := 0
+	for _, c := range b.chunks {
+		n += c.NSims
+	}
+	return n
+}
+
+// getPackedSimulations loads a batch stored as packed outcomes, chunks in
+// simulation order. It returns nil when the batch is stored as rows.
+func (s *Service) getPackedSimulations(ctx context.Context, tournamentSimulationBatchID string) (*packedBatch, error) {
+	var storage string
+	var layoutJSON, samplingJSON []byte
+	if err := s.pool.QueryRow(ctx, `
+		SELECT storage, outcome_layout, sampling
+		FROM compute.simulated_tournaments
+		WHERE id = $1
+			AND deleted_at IS NULL
+	`, tournamentSimulationBatchID).Scan(&storage, &layoutJSON, &samplingJSON); err != nil {
+		return nil, fmt.Errorf("querying simulation batch %s: %w", tournamentSimulationBatchID, err)
+	}
+	if storage != simulation.StoragePacked {
+		return nil, nil
+	}
+
+	batch := &packedBatch{layout: &simulation.OutcomeLayout{}}
+	if err := json.Unmarshal(layoutJSON, batch.layout); err != nil {
+		return nil, fmt.Errorf("decoding outcome layout: %w", err)
+	}
+	if samplingJSON != nil {
+		batch.sampling = &simulation.Sampling{}
+		if err := json.Unmarshal(samplingJSON, batch.sampling); err != nil {
+			return nil, fmt.Errorf("decoding sampling: %w", err)
+		}
+	}
+
+	rows, err := s.pool.Query(ctx, `
+		SELECT n_sims, bytes_per_sim, outcomes, weights
+		FROM compute.simulated_outcome_chunks
+		WHERE simulated_tournament_id = $1
+		ORDER BY sim_offset
+	`, tournamentSimulationBatchID)
+	if err != nil {
+		return nil, err
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		chunk := &simulation.PackedOutcomes{}
+		if err := rows.Scan(&chunk.NSims, &chunk.BytesPerSim, &chunk.Data, &chunk.Weights); err != nil {
+			return nil, err
+		}
+		if chunk.BytesPerSim != batch.layout.BytesPerSim() {
+			return nil, fmt.Errorf("chunk of %d bytes per sim for a layout of %d", chunk.BytesPerSim, batch.layout.BytesPerSim())
+		}
+		batch.chunks = append(batch.chunks, chunk)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, err
+	}
+	return batch, nil
+}
+
 func (s *Service) loadCoreScoringRules(ctx context.Context, calcuttaID string) ([]scoring.Rule, error) {
 	rows, err := s.pool.Query(ctx, `
-		SELECT win_index::int, points_awarded::int
+		SELECT kind, win_index::int, points_awarded::int
 		FROM core.pool_scoring_rules
 		WHERE pool_id = $1::uuid
 			AND deleted_at IS NULL
-		ORDER BY win_index ASC
+		ORDER BY win_index ASC, kind ASC
 	`, calcuttaID)
 	if err != nil {
 		return nil, err
@@ -121,7 +213,7 @@ func (s *Service) loadCoreScoringRules(ctx context.Context, calcuttaID string) (
 	rules := make([]scoring.Rule, 0)
 	for rows.Next() {
 		var r scoring.Rule
-		if err := rows.Scan(&r.WinIndex, &r.PointsAwarded); err != nil {
+		if err := rows.Scan(&r.Kind, &r.WinIndex, &r.PointsAwarded); err != nil {
 			return nil, err
 		}
 		rules = append(rules, r)
@@ -180,7 +272,8 @@ func (s *Service) getEntriesForLabEvaluation(
 		SELECT
 			p.name as entry_name,
 			inv.team_id,
-			inv.credits as bid_points
+			inv.credits as bid_points,
+			inv.updated_at
 		FROM core.investments inv
 		JOIN core.portfolios p ON inv.portfolio_id = p.id
 		WHERE p.pool_id = $1
@@ -199,7 +292,8 @@ func (s *Service) getEntriesForLabEvaluation(
 	for rows.Next() {
 		var entryName, teamID string
 		var bidPoints int
-		if err := rows.Scan(&entryName, &teamID, &bidPoints); err != nil {
+		var updatedAt time.Time
+		if err := rows.Scan(&entryName, &teamID, &bidPoints, &updatedAt); err != nil {
 			return nil, err
 		}
 
@@ -210,6 +304,9 @@ func (s *Service) getEntriesForLabEvaluation(
 			}
 		}
 		entries[entryName].Teams[teamID] = bidPoints
+		if updatedAt.After(entries[entryName].SubmittedAt) {
+			entries[entryName].SubmittedAt = updatedAt
+		}
 	}
 
 	// Add lab entry
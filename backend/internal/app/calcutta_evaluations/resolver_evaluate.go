@@ -9,6 +9,8 @@ import (
 	"sync"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/app/jobqueue"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/ownership"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/scoring"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/simulation"
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 	"golang.org/x/sync/errgroup"
@@ -29,6 +31,12 @@ type LabEvaluationResult struct {
 	MedianNormalizedPayout float64
 	PTop1                  float64
 	PInMoney               float64
+	MeanPayoutSE           float64
+	PTop1SE                float64
+	PInMoneySE             float64
+	MeanPayoutCI           Interval
+	PTop1CI                Interval
+	PInMoneyCI             Interval
 	NSims                  int
 	AllEntryResults        []LabEntryPerformance
 }
@@ -61,6 +69,25 @@ func (s *Service) EvaluateLabEntry(
 		return nil, fmt.Errorf("failed to get entries: %w", err)
 	}
 
+	batch, err := s.getPackedSimulations(ctx, batchID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get packed simulations: %w", err)
+	}
+	if batch != nil {
+		if batch.nSims() == 0 {
+			return nil, fmt.Errorf("no simulations available for tournament %s", cc.TournamentID)
+		}
+		rules, err := s.loadCoreScoringRules(ctx, cc.CalcuttaID)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get scoring rules: %w", err)
+		}
+		dist, nSims, err := evaluatePackedSimulations(ctx, entries, cc.Ownership, cc.TieBreaker, rules, batch, payouts, firstPlacePayout, s.targetStandardError)
+		if err != nil {
+			return nil, err
+		}
+		return labEvaluationResultFromPerformance(dist.Metrics(), nSims)
+	}
+
 	simulations, err := s.getSimulations(ctx, cc, batchID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to get simulations: %w", err)
@@ -69,7 +96,7 @@ func (s *Service) EvaluateLabEntry(
 		return nil, fmt.Errorf("no simulations available for tournament %s", cc.TournamentID)
 	}
 
-	allResults, err := s.runConcurrentEvaluations(ctx, entries, simulations, payouts, firstPlacePayout)
+	allResults, err := s.runConcurrentEvaluations(ctx, entries, cc.Ownership, cc.TieBreaker, simulations, payouts, firstPlacePayout)
 	if err != nil {
 		return nil, err
 	}
@@ -77,6 +104,10 @@ func (s *Service) EvaluateLabEntry(
 	return buildLabEvaluationResult(allResults, len(simulations))
 }
 
+// labSampling pairs lab simulations antithetically, which tightens the
+// payout estimates at no extra cost.
+var labSampling = simulation.Sampling{Method: simulation.SamplingAntithetic}
+
 // resolveSimulationBatchID returns the latest simulation batch ID for the
 // tournament. If none exists and an enqueuer is configured, it enqueues a
 // simulation job and returns ErrSimulationPending. If no enqueuer is set
@@ -103,6 +134,8 @@ func (s *Service) resolveSimulationBatchID(ctx context.Context, tournamentID str
 			"seed":                 42,
 			"startingStateKey":     "current",
 			"probabilitySourceKey": "lab_pipeline",
+			"storage":              simulation.StoragePacked,
+			"sampling":             labSampling,
 		})
 		dedupKey := fmt.Sprintf("simulation:%s:current", tournamentID)
 		_, err := s.enqueuer.Enqueue(ctx, jobqueue.KindRunSimulation, params, jobqueue.PriorityLab, dedupKey)
@@ -122,6 +155,8 @@ func (s *Service) resolveSimulationBatchID(ctx context.Context, tournamentID str
 		BatchSize:            1000,
 		ProbabilitySourceKey: "lab_pipeline",
 		StartingStateKey:     "current",
+		Storage:              simulation.StoragePacked,
+		Sampling:             &labSampling,
 	})
 	if err != nil {
 		return "", fmt.Errorf("failed to run simulations: %w", err)
@@ -134,6 +169,8 @@ func (s *Service) resolveSimulationBatchID(ctx context.Context, tournamentID str
 func (s *Service) runConcurrentEvaluations(
 	ctx context.Context,
 	entries map[string]*Entry,
+	rule ownership.Rule,
+	tieBreaker string,
 	simulations map[int][]TeamSimResult,
 	payouts map[int]int,
 	firstPlacePayout int,
@@ -148,7 +185,7 @@ func (s *Service) runConcurrentEvaluations(
 	for simID := range simulations {
 		sid := simID
 		g.Go(func() error {
-			simResults, err := CalculateSimulationOutcomes(sid, entries, simulations[sid], payouts, firstPlacePayout)
+			simResults, err := CalculateSimulationOutcomes(sid, entries, rule, tieBreaker, simulations[sid], payouts, firstPlacePayout)
 			if err != nil {
 				return fmt.Errorf("simulation %d: %w", sid, err)
 			}
@@ -164,11 +201,95 @@ func (s *Service) runConcurrentEvaluations(
 	return allResults, nil
 }
 
+// evaluatePackedSimulations replays each packed simulation, scores it, and
+// folds the outcomes into a payout distribution, with workers each folding
+// their own share of a chunk's samples and merging at the end. Each sample
+// is a block of the batch's sampling, in its champion's stratum when
+// stratified. With a positive targetSE it stops after the first chunk that
+// brings every entry's standard errors within it. It returns the number of
+// simulations evaluated.
+func evaluatePackedSimulations(
+	ctx context.Context,
+	entries map[string]*Entry,
+	rule ownership.Rule,
+	tieBreaker string,
+	rules []scoring.Rule,
+	batch *packedBatch,
+	payouts map[int]int,
+	firstPlacePayout int,
+	targetSE float64,
+) (*PayoutDistribution, int, error) {
+	layout := batch.layout
+	blockSize := batch.sampling.BlockSize()
+	stratified := batch.sampling.Stratified()
+
+	dist := NewPayoutDistribution()
+	evaluated := 0
+	for _, chunk := range batch.chunks {
+		nBlocks := (chunk.NSims + blockSize - 1) / blockSize
+		workers := runtime.GOMAXPROCS(0)
+		if workers > nBlocks {
+			workers = nBlocks
+		}
+
+		var mu sync.Mutex
+		g, gctx := errgroup.WithContext(ctx)
+		for w := 0; w < workers; w++ {
+			start := w
+			g.Go(func() error {
+				local := NewPayoutDistribution()
+				teamResults := make([]TeamSimResult, len(layout.TeamIDs))
+				winners := make([]int, len(layout.Games))
+				sims := make([][]SimulationResult, 0, blockSize)
+				weights := make([]float64, 0, blockSize)
+				for block := start; block < nBlocks; block += workers {
+					if err := gctx.Err(); err != nil {
+						return err
+					}
+					sims, weights = sims[:0], weights[:0]
+					stratum := 0
+					for simID := block * blockSize; simID < min((block+1)*blockSize, chunk.NSims); simID++ {
+						outcome := chunk.Sim(simID)
+						for i, sr := range layout.Decode(simID, outcome) {
+							teamResults[i] = convertTeamSimulationResult(sr, rules)
+						}
+						simResults, err := CalculateSimulationOutcomes(simID, entries, rule, tieBreaker, teamResults, payouts, firstPlacePayout)
+						if err != nil {
+							return fmt.Errorf("simulation %d: %w", simID, err)
+						}
+						if stratified {
+							stratum = layout.Champion(outcome, winners)
+						}
+						sims = append(sims, simResults)
+						weights = append(weights, chunk.Weight(simID))
+					}
+					local.AddSample(sims, weights, stratum)
+				}
+				mu.Lock()
+				dist.Merge(local)
+				mu.Unlock()
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return nil, 0, err
+		}
+
+		evaluated += chunk.NSims
+		if targetSE > 0 && dist.Precise(targetSE) {
+			break
+		}
+	}
+	return dist, evaluated, nil
+}
+
 // buildLabEvaluationResult aggregates simulation results into performance
 // metrics, extracts the lab entry's metrics, and ranks all entries.
 func buildLabEvaluationResult(allResults []SimulationResult, nSims int) (*LabEvaluationResult, error) {
-	performance := CalculatePerformanceMetrics(allResults)
+	return labEvaluationResultFromPerformance(CalculatePerformanceMetrics(allResults), nSims)
+}
 
+func labEvaluationResultFromPerformance(performance map[string]*EntryPerformance, nSims int) (*LabEvaluationResult, error) {
 	ourPerformance, ok := performance[models.LabStrategyEntryName]
 	if !ok {
 		return nil, fmt.Errorf("failed to find performance for lab entry")
@@ -181,6 +302,12 @@ func buildLabEvaluationResult(allResults []SimulationResult, nSims int) (*LabEva
 		MedianNormalizedPayout: ourPerformance.MedianPayout,
 		PTop1:                  ourPerformance.PTop1,
 		PInMoney:               ourPerformance.PInMoney,
+		MeanPayoutSE:           ourPerformance.MeanPayoutSE,
+		PTop1SE:                ourPerformance.PTop1SE,
+		PInMoneySE:             ourPerformance.PInMoneySE,
+		MeanPayoutCI:           ourPerformance.MeanPayoutCI,
+		PTop1CI:                ourPerformance.PTop1CI,
+		PInMoneyCI:             ourPerformance.PInMoneyCI,
 		NSims:                  nSims,
 		AllEntryResults:        allEntryResults,
 	}, nil
@@ -5,7 +5,7 @@ import (
 	"errors"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/app/jobqueue"
-	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/simulation"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -14,12 +14,11 @@ import (
 var ErrSimulationPending = errors.New("simulation pending: job enqueued, retry later")
 
 // TournamentResolver resolves tournament metadata without importing adapters.
-// Includes all methods needed by both this service and the simulation service
-// it creates internally.
+// It is also handed to the simulation service this service creates
+// internally, so it embeds that service's resolver.
 type TournamentResolver interface {
-	ResolveCoreTournamentID(ctx context.Context, season int) (string, error)
+	simulation.TournamentResolver
 	ResolveSeasonFromTournamentID(ctx context.Context, tournamentID string) (int, error)
-	LoadFinalFourConfig(ctx context.Context, coreTournamentID string) (*models.FinalFourConfig, error)
 }
 
 // Service handles simulated calcutta analysis
@@ -27,6 +26,9 @@ type Service struct {
 	pool               *pgxpool.Pool
 	tournamentResolver TournamentResolver
 	enqueuer           *jobqueue.Enqueuer
+	// targetStandardError, when positive, stops evaluating a packed batch
+	// once every entry's estimates are this precise.
+	targetStandardError float64
 }
 
 // New creates a new simulated calcutta service
@@ -50,3 +52,10 @@ func WithTournamentResolver(r TournamentResolver) Option {
 func WithEnqueuer(e *jobqueue.Enqueuer) Option {
 	return func(s *Service) { s.enqueuer = e }
 }
+
+// WithTargetStandardError makes evaluations adaptive: they stop once the
+// standard errors of every entry's mean payout, P(top1) and P(in money) are
+// at most se, rather than evaluating the whole simulation batch.
+func WithTargetStandardError(se float64) Option {
+	return func(s *Service) { s.targetStandardError = se }
+}
@@ -3,6 +3,9 @@ package calcutta_evaluations
 import (
 	"math"
 	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/ownership"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
 // addHouseEntry creates a "house" entry bidding 1 point on every unclaimed team.
@@ -65,7 +68,7 @@ func TestThatEntryPointsEqualTeamPointsWhenAllTeamsClaimed(t *testing.T) {
 	}
 
 	// WHEN calculating simulation outcomes
-	results, err := CalculateSimulationOutcomes(1, entries, teamResults, map[int]int{}, 0)
+	results, err := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerSplit, teamResults, map[int]int{}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -94,7 +97,7 @@ func TestThatHouseEntryAbsorbsUnclaimedTeamPoints(t *testing.T) {
 
 	// WHEN adding a house entry and calculating outcomes
 	withHouse := addHouseEntry(entries, allTeamIDs)
-	results, err := CalculateSimulationOutcomes(1, withHouse, teamResults, map[int]int{}, 0)
+	results, err := CalculateSimulationOutcomes(1, withHouse, proportional, models.TieBreakerSplit, teamResults, map[int]int{}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -121,7 +124,7 @@ func TestThatUnclaimedTeamPointsLeakWithoutHouse(t *testing.T) {
 	}
 
 	// WHEN calculating without a house entry
-	results, err := CalculateSimulationOutcomes(1, entries, teamResults, map[int]int{}, 0)
+	results, err := CalculateSimulationOutcomes(1, entries, proportional, models.TieBreakerSplit, teamResults, map[int]int{}, 0)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -134,3 +137,31 @@ func TestThatUnclaimedTeamPointsLeakWithoutHouse(t *testing.T) {
 			entrySum, teamSum)
 	}
 }
+
+func TestThatProRataPoolAwardsUnclaimedTeamPoints(t *testing.T) {
+	// GIVEN a pro-rata pool whose entries do NOT bid on teamC or teamD
+	entries := map[string]*Entry{
+		"alice": {Name: "Alice", Teams: map[string]int{"teamA": 100}},
+		"bob":   {Name: "Bob", Teams: map[string]int{"teamB": 100}},
+	}
+	teamResults := []TeamSimResult{
+		{TeamID: "teamA", Points: 100},
+		{TeamID: "teamB", Points: 50},
+		{TeamID: "teamC", Points: 200},
+		{TeamID: "teamD", Points: 30},
+	}
+	rule := ownership.Rule{Mode: models.OwnershipModeProportional, Unclaimed: models.UnclaimedModeProRata}
+
+	// WHEN calculating simulation outcomes
+	results, err := CalculateSimulationOutcomes(1, entries, rule, models.TieBreakerSplit, teamResults, map[int]int{}, 0)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN entry sum equals team sum
+	entrySum := sumEntryPoints(results)
+	teamSum := sumTeamPoints(teamResults)
+	if math.Abs(entrySum-teamSum) > 1e-9 {
+		t.Errorf("entry sum = %.4f, team sum = %.4f", entrySum, teamSum)
+	}
+}
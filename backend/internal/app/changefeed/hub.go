@@ -0,0 +1,144 @@
+// Package changefeed fans change events out to the live streams on this API
+// instance. One listener per instance hears every change announced through
+// the database, whichever instance or worker made it.
+package changefeed
+
+import (
+	"context"
+	"log/slog"
+	"sync"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+)
+
+const (
+	subscriberBuffer = 16
+	minRetryDelay    = time.Second
+	maxRetryDelay    = 30 * time.Second
+)
+
+// Filter selects the events a subscriber receives: those for its pool, and
+// those for its tournament that name no pool. Resync events reach everyone.
+type Filter struct {
+	TournamentID string
+	PoolID       string
+}
+
+func (f Filter) matches(ev models.ChangeEvent) bool {
+	if ev.Kind == models.ChangeKindResync {
+		return true
+	}
+	if ev.PoolID != "" {
+		return ev.PoolID == f.PoolID
+	}
+	return ev.TournamentID != "" && ev.TournamentID == f.TournamentID
+}
+
+type subscription struct {
+	filter Filter
+	ch     chan models.ChangeEvent
+}
+
+// Hub delivers change events to subscribers. A subscriber that falls behind
+// misses events rather than holding up the others; since events only say
+// what changed, the next one it gets brings it up to date.
+type Hub struct {
+	publisher ports.ChangePublisher
+	listener  ports.ChangeListener
+
+	mu      sync.Mutex
+	subs    map[*subscription]struct{}
+	stopped bool
+}
+
+func New(publisher ports.ChangePublisher, listener ports.ChangeListener) *Hub {
+	return &Hub{publisher: publisher, listener: listener, subs: make(map[*subscription]struct{})}
+}
+
+// Publish announces a change to every instance, this one included.
+func (h *Hub) Publish(ctx context.Context, ev models.ChangeEvent) error {
+	return h.publisher.PublishChange(ctx, ev)
+}
+
+// Subscribe returns a channel of matching events and a func that ends the
+// subscription. The channel is closed when the subscription ends or the hub
+// stops running.
+func (h *Hub) Subscribe(filter Filter) (<-chan models.ChangeEvent, func()) {
+	sub := &subscription{filter: filter, ch: make(chan models.ChangeEvent, subscriberBuffer)}
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if h.stopped {
+		close(sub.ch)
+		return sub.ch, func() {}
+	}
+	h.subs[sub] = struct{}{}
+	return sub.ch, func() { h.remove(sub) }
+}
+
+func (h *Hub) remove(sub *subscription) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	if _, ok := h.subs[sub]; ok {
+		delete(h.subs, sub)
+		close(sub.ch)
+	}
+}
+
+// Run listens for changes until ctx is done, reconnecting with backoff when
+// the connection drops. Subscribers get a resync event after each reconnect.
+// When Run returns every subscription is closed, so open streams end rather
+// than holding up a graceful shutdown.
+func (h *Hub) Run(ctx context.Context) {
+	defer h.stop()
+	delay := minRetryDelay
+	connected := false
+	for {
+		err := h.listener.ListenChanges(ctx, func() {
+			if connected {
+				h.Dispatch(models.ChangeEvent{Kind: models.ChangeKindResync})
+			}
+			connected = true
+			delay = minRetryDelay
+		}, h.Dispatch)
+		if ctx.Err() != nil {
+			return
+		}
+		slog.Warn("change_feed_listen_failed", "error", err, "retry_in", delay.String())
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+		delay *= 2
+		if delay > maxRetryDelay {
+			delay = maxRetryDelay
+		}
+	}
+}
+
+func (h *Hub) stop() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	h.stopped = true
+	for sub := range h.subs {
+		delete(h.subs, sub)
+		close(sub.ch)
+	}
+}
+
+// Dispatch delivers an event to this instance's matching subscribers.
+func (h *Hub) Dispatch(ev models.ChangeEvent) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+	for sub := range h.subs {
+		if !sub.filter.matches(ev) {
+			continue
+		}
+		select {
+		case sub.ch <- ev:
+		default:
+		}
+	}
+}
@@ -0,0 +1,134 @@
+package changefeed
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// fakeListener connects once per entry in sessions, announcing the events of
+// that entry, then drops the connection. After the last session it blocks
+// until ctx is done.
+type fakeListener struct {
+	sessions [][]models.ChangeEvent
+	calls    int
+}
+
+func (f *fakeListener) ListenChanges(ctx context.Context, onListening func(), handle func(models.ChangeEvent)) error {
+	if f.calls >= len(f.sessions) {
+		<-ctx.Done()
+		return ctx.Err()
+	}
+	events := f.sessions[f.calls]
+	f.calls++
+	onListening()
+	for _, ev := range events {
+		handle(ev)
+	}
+	return errors.New("connection lost")
+}
+
+func receive(ch <-chan models.ChangeEvent) (models.ChangeEvent, bool) {
+	select {
+	case ev, ok := <-ch:
+		return ev, ok
+	case <-time.After(3 * minRetryDelay):
+		return models.ChangeEvent{}, false
+	}
+}
+
+func TestThatPoolSubscriberReceivesTournamentWideChange(t *testing.T) {
+	// GIVEN a subscriber to a pool in tournament-1
+	hub := New(nil, nil)
+	events, unsubscribe := hub.Subscribe(Filter{TournamentID: "tournament-1", PoolID: "pool-1"})
+	defer unsubscribe()
+
+	// WHEN a standings change is dispatched for tournament-1
+	hub.Dispatch(models.ChangeEvent{Kind: models.ChangeKindStandings, TournamentID: "tournament-1"})
+
+	// THEN the subscriber receives it
+	if ev, ok := receive(events); !ok || ev.Kind != models.ChangeKindStandings {
+		t.Errorf("expected standings change, got %+v", ev)
+	}
+}
+
+func TestThatPoolSubscriberIgnoresOtherPoolsChange(t *testing.T) {
+	// GIVEN a subscriber to pool-1 in tournament-1
+	hub := New(nil, nil)
+	events, unsubscribe := hub.Subscribe(Filter{TournamentID: "tournament-1", PoolID: "pool-1"})
+	defer unsubscribe()
+
+	// WHEN an auction change is dispatched for another pool in the same tournament
+	hub.Dispatch(models.ChangeEvent{Kind: models.ChangeKindAuction, TournamentID: "tournament-1", PoolID: "pool-2"})
+
+	// THEN nothing is delivered
+	if len(events) != 0 {
+		t.Errorf("expected no events, got %d", len(events))
+	}
+}
+
+func TestThatSlowSubscriberDoesNotBlockDispatch(t *testing.T) {
+	// GIVEN a subscriber that never reads
+	hub := New(nil, nil)
+	_, unsubscribe := hub.Subscribe(Filter{PoolID: "pool-1"})
+	defer unsubscribe()
+
+	// WHEN more events are dispatched than its buffer holds
+	done := make(chan struct{})
+	go func() {
+		for i := 0; i < subscriberBuffer*2; i++ {
+			hub.Dispatch(models.ChangeEvent{Kind: models.ChangeKindAuction, PoolID: "pool-1"})
+		}
+		close(done)
+	}()
+
+	// THEN dispatch still completes
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Error("expected dispatch to drop events for a full subscriber")
+	}
+}
+
+func TestThatSubscribersAreResyncedAfterReconnect(t *testing.T) {
+	// GIVEN a listener whose first connection drops
+	listener := &fakeListener{sessions: [][]models.ChangeEvent{nil, nil}}
+	hub := New(nil, listener)
+	events, unsubscribe := hub.Subscribe(Filter{PoolID: "pool-1"})
+	defer unsubscribe()
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// WHEN the hub reconnects
+	go hub.Run(ctx)
+
+	// THEN the subscriber is told to resync
+	if ev, ok := receive(events); !ok || ev.Kind != models.ChangeKindResync {
+		t.Errorf("expected resync, got %+v", ev)
+	}
+}
+
+func TestThatStoppingHubClosesSubscriptions(t *testing.T) {
+	// GIVEN a running hub with a subscriber
+	hub := New(nil, &fakeListener{})
+	events, unsubscribe := hub.Subscribe(Filter{PoolID: "pool-1"})
+	defer unsubscribe()
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		hub.Run(ctx)
+		close(stopped)
+	}()
+
+	// WHEN the hub stops
+	cancel()
+	<-stopped
+
+	// THEN the subscription's channel is closed
+	if _, ok := <-events; ok {
+		t.Error("expected the subscription to be closed")
+	}
+}
@@ -0,0 +1,125 @@
+package ledger
+
+import "github.com/andrewcopp/Calcutta/backend/internal/models"
+
+// SettlementLine is one portfolio's position in a pool's books. A positive
+// balance is owed to the portfolio's owner; a negative one is owed to the
+// pool.
+type SettlementLine struct {
+	Portfolio       *models.Portfolio
+	EntryFeeCents   int
+	PaidCents       int
+	PayoutOwedCents int
+	PayoutSentCents int
+	BalanceCents    int
+}
+
+// SettlementReport squares a pool's ledger against its entry fee and the
+// payouts its standings earned. Totals count every live entry, including
+// those of portfolios deleted since.
+type SettlementReport struct {
+	Lines          []*SettlementLine
+	CollectedCents int
+	PaidOutCents   int
+	// OutstandingFeesCents is entry fees still to be collected.
+	OutstandingFeesCents int
+	// OutstandingPayoutsCents is winnings still to be sent.
+	OutstandingPayoutsCents int
+	// Settlement is nil while the pool is unsettled.
+	Settlement *models.PoolSettlement
+}
+
+// BuildSettlementReport lines up each portfolio's entry fee and owed payouts
+// against what the ledger records as paid and sent. payoutsOwed holds every
+// portfolio's winnings from the main pot and any side pots.
+func BuildSettlementReport(
+	pool *models.Pool,
+	portfolios []*models.Portfolio,
+	payoutsOwed map[string]int,
+	entries []*models.LedgerEntry,
+	settlement *models.PoolSettlement,
+) *SettlementReport {
+	report := &SettlementReport{Lines: make([]*SettlementLine, 0, len(portfolios)), Settlement: settlement}
+
+	paid := make(map[string]int)
+	sent := make(map[string]int)
+	for _, e := range entries {
+		if e == nil {
+			continue
+		}
+		switch e.Kind {
+		case models.LedgerEntryKindPaymentReceived:
+			paid[e.PortfolioID] += e.AmountCents
+			report.CollectedCents += e.AmountCents
+		case models.LedgerEntryKindPayoutSent:
+			sent[e.PortfolioID] += e.AmountCents
+			report.PaidOutCents += e.AmountCents
+		}
+	}
+
+	for _, p := range portfolios {
+		if p == nil {
+			continue
+		}
+		line := &SettlementLine{
+			Portfolio:       p,
+			EntryFeeCents:   pool.EntryFeeCents,
+			PaidCents:       paid[p.ID],
+			PayoutOwedCents: payoutsOwed[p.ID],
+			PayoutSentCents: sent[p.ID],
+		}
+		line.BalanceCents = (line.PayoutOwedCents - line.PayoutSentCents) - (line.EntryFeeCents - line.PaidCents)
+		report.OutstandingFeesCents += max(0, line.EntryFeeCents-line.PaidCents)
+		report.OutstandingPayoutsCents += max(0, line.PayoutOwedCents-line.PayoutSentCents)
+		report.Lines = append(report.Lines, line)
+	}
+	return report
+}
+
+// PoolPosition is what a user paid into and received from one pool.
+type PoolPosition struct {
+	PoolID        string
+	PaidCents     int
+	ReceivedCents int
+	NetCents      int
+}
+
+// NetPosition is a user's lifetime position across every pool they have
+// played in. NetCents is positive when they have received more than they
+// paid in.
+type NetPosition struct {
+	UserID        string
+	PaidCents     int
+	ReceivedCents int
+	NetCents      int
+	Pools         []*PoolPosition
+}
+
+// ComputeNetPosition totals a user's ledger entries, per pool in the order
+// the pools first appear and overall.
+func ComputeNetPosition(userID string, entries []*models.LedgerEntry) *NetPosition {
+	out := &NetPosition{UserID: userID, Pools: []*PoolPosition{}}
+	byPool := make(map[string]*PoolPosition)
+	for _, e := range entries {
+		if e == nil {
+			continue
+		}
+		pos, ok := byPool[e.PoolID]
+		if !ok {
+			pos = &PoolPosition{PoolID: e.PoolID}
+			byPool[e.PoolID] = pos
+			out.Pools = append(out.Pools, pos)
+		}
+		switch e.Kind {
+		case models.LedgerEntryKindPaymentReceived:
+			pos.PaidCents += e.AmountCents
+			out.PaidCents += e.AmountCents
+		case models.LedgerEntryKindPayoutSent:
+			pos.ReceivedCents += e.AmountCents
+			out.ReceivedCents += e.AmountCents
+		}
+		pos.NetCents = pos.ReceivedCents - pos.PaidCents
+	}
+	out.NetCents = out.ReceivedCents - out.PaidCents
+	return out
+}
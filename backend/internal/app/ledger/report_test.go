@@ -0,0 +1,115 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func reportLine(report *SettlementReport, portfolioID string) *SettlementLine {
+	for _, line := range report.Lines {
+		if line.Portfolio.ID == portfolioID {
+			return line
+		}
+	}
+	return nil
+}
+
+func TestThatSettlementBalanceNetsUnpaidFeeAgainstUnsentPayout(t *testing.T) {
+	// GIVEN a $20 pool where p1 paid nothing and is owed $50
+	pool := &models.Pool{EntryFeeCents: 2000}
+	portfolios := []*models.Portfolio{{ID: "p1"}}
+
+	// WHEN building the settlement report
+	report := BuildSettlementReport(pool, portfolios, map[string]int{"p1": 5000}, nil, nil)
+
+	// THEN the pool owes p1 $30
+	if got := reportLine(report, "p1").BalanceCents; got != 3000 {
+		t.Fatalf("expected balance 3000, got %d", got)
+	}
+}
+
+func TestThatSettlementReportCountsOutstandingFees(t *testing.T) {
+	// GIVEN a $20 pool where p1 paid and p2 paid half
+	pool := &models.Pool{EntryFeeCents: 2000}
+	portfolios := []*models.Portfolio{{ID: "p1"}, {ID: "p2"}}
+	entries := []*models.LedgerEntry{
+		{PortfolioID: "p1", Kind: models.LedgerEntryKindPaymentReceived, AmountCents: 2000},
+		{PortfolioID: "p2", Kind: models.LedgerEntryKindPaymentReceived, AmountCents: 1000},
+	}
+
+	// WHEN building the settlement report
+	report := BuildSettlementReport(pool, portfolios, nil, entries, nil)
+
+	// THEN $10 is still to be collected
+	if report.OutstandingFeesCents != 1000 {
+		t.Fatalf("expected outstanding fees 1000, got %d", report.OutstandingFeesCents)
+	}
+}
+
+func TestThatOverpaidFeeDoesNotOffsetAnotherPortfoliosDebt(t *testing.T) {
+	// GIVEN a $20 pool where p1 paid $30 and p2 paid nothing
+	pool := &models.Pool{EntryFeeCents: 2000}
+	portfolios := []*models.Portfolio{{ID: "p1"}, {ID: "p2"}}
+	entries := []*models.LedgerEntry{{PortfolioID: "p1", Kind: models.LedgerEntryKindPaymentReceived, AmountCents: 3000}}
+
+	// WHEN building the settlement report
+	report := BuildSettlementReport(pool, portfolios, nil, entries, nil)
+
+	// THEN p2's full fee is outstanding
+	if report.OutstandingFeesCents != 2000 {
+		t.Fatalf("expected outstanding fees 2000, got %d", report.OutstandingFeesCents)
+	}
+}
+
+func TestThatSettlementReportTotalsPayoutsSent(t *testing.T) {
+	// GIVEN two payouts sent to p1
+	pool := &models.Pool{}
+	portfolios := []*models.Portfolio{{ID: "p1"}}
+	entries := []*models.LedgerEntry{
+		{PortfolioID: "p1", Kind: models.LedgerEntryKindPayoutSent, AmountCents: 3000},
+		{PortfolioID: "p1", Kind: models.LedgerEntryKindPayoutSent, AmountCents: 2000},
+	}
+
+	// WHEN building the settlement report
+	report := BuildSettlementReport(pool, portfolios, map[string]int{"p1": 5000}, entries, nil)
+
+	// THEN nothing is left to pay out
+	if report.OutstandingPayoutsCents != 0 {
+		t.Fatalf("expected no outstanding payouts, got %d", report.OutstandingPayoutsCents)
+	}
+}
+
+func TestThatNetPositionSumsAcrossPools(t *testing.T) {
+	// GIVEN $20 paid into each of two pools and $50 won in one
+	entries := []*models.LedgerEntry{
+		{PoolID: "c1", Kind: models.LedgerEntryKindPaymentReceived, AmountCents: 2000},
+		{PoolID: "c2", Kind: models.LedgerEntryKindPaymentReceived, AmountCents: 2000},
+		{PoolID: "c2", Kind: models.LedgerEntryKindPayoutSent, AmountCents: 5000},
+	}
+
+	// WHEN computing the user's net position
+	pos := ComputeNetPosition("u1", entries)
+
+	// THEN they are up $10
+	if pos.NetCents != 1000 {
+		t.Fatalf("expected net 1000, got %d", pos.NetCents)
+	}
+}
+
+func TestThatNetPositionBreaksDownByPool(t *testing.T) {
+	// GIVEN $20 paid into each of two pools and $50 won in the second
+	entries := []*models.LedgerEntry{
+		{PoolID: "c1", Kind: models.LedgerEntryKindPaymentReceived, AmountCents: 2000},
+		{PoolID: "c2", Kind: models.LedgerEntryKindPaymentReceived, AmountCents: 2000},
+		{PoolID: "c2", Kind: models.LedgerEntryKindPayoutSent, AmountCents: 5000},
+	}
+
+	// WHEN computing the user's net position
+	pos := ComputeNetPosition("u1", entries)
+
+	// THEN the second pool nets $30
+	if pos.Pools[1].NetCents != 3000 {
+		t.Fatalf("expected pool net 3000, got %d", pos.Pools[1].NetCents)
+	}
+}
@@ -0,0 +1,107 @@
+// Package ledger tracks the money behind a pool: entry fees collected from
+// each portfolio's owner and winnings paid out to them. A pool's books are
+// settled once everything is squared up, after which the ledger is frozen
+// until the settlement is reopened.
+package ledger
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+)
+
+type Ports struct {
+	Ledger     ports.LedgerRepository
+	Portfolios ports.PortfolioReader
+}
+
+type Service struct {
+	ports Ports
+}
+
+func New(ports Ports) *Service {
+	return &Service{ports: ports}
+}
+
+func (s *Service) ListEntries(ctx context.Context, poolID string) ([]*models.LedgerEntry, error) {
+	return s.ports.Ledger.ListLedgerEntries(ctx, poolID)
+}
+
+// RecordEntry adds a payment or payout for one of the pool's portfolios.
+func (s *Service) RecordEntry(ctx context.Context, entry *models.LedgerEntry) error {
+	switch entry.Kind {
+	case models.LedgerEntryKindPaymentReceived, models.LedgerEntryKindPayoutSent:
+	default:
+		return &apperrors.InvalidArgumentError{Field: "kind", Message: "must be payment_received or payout_sent"}
+	}
+	if entry.AmountCents <= 0 {
+		return &apperrors.InvalidArgumentError{Field: "amountCents", Message: "must be positive"}
+	}
+	if err := s.ensureOpen(ctx, entry.PoolID); err != nil {
+		return err
+	}
+
+	portfolio, err := s.ports.Portfolios.GetPortfolio(ctx, entry.PortfolioID)
+	if err != nil {
+		return err
+	}
+	if portfolio.PoolID != entry.PoolID {
+		return &apperrors.NotFoundError{Resource: "portfolio", ID: entry.PortfolioID}
+	}
+
+	entry.Note = strings.TrimSpace(entry.Note)
+	return s.ports.Ledger.CreateLedgerEntry(ctx, entry)
+}
+
+// VoidEntry removes a mistaken entry from the pool's books.
+func (s *Service) VoidEntry(ctx context.Context, poolID, entryID string) error {
+	if err := s.ensureOpen(ctx, poolID); err != nil {
+		return err
+	}
+	return s.ports.Ledger.VoidLedgerEntry(ctx, poolID, entryID)
+}
+
+// GetSettlement returns nil while the pool is unsettled.
+func (s *Service) GetSettlement(ctx context.Context, poolID string) (*models.PoolSettlement, error) {
+	return s.ports.Ledger.GetPoolSettlement(ctx, poolID)
+}
+
+// Settle closes the pool's books. Outstanding balances do not block settling;
+// the settlement report shows what was left unpaid.
+func (s *Service) Settle(ctx context.Context, poolID, userID string) (*models.PoolSettlement, error) {
+	settlement := &models.PoolSettlement{PoolID: poolID, SettledBy: userID}
+	if err := s.ports.Ledger.SettlePool(ctx, settlement); err != nil {
+		return nil, err
+	}
+	return settlement, nil
+}
+
+// Reopen lifts a pool's settlement so its ledger can be corrected.
+func (s *Service) Reopen(ctx context.Context, poolID string) error {
+	return s.ports.Ledger.ReopenPool(ctx, poolID)
+}
+
+// GetNetPosition totals everything the user has paid into and received from
+// pools across every season.
+func (s *Service) GetNetPosition(ctx context.Context, userID string) (*NetPosition, error) {
+	entries, err := s.ports.Ledger.ListLedgerEntriesByUser(ctx, userID)
+	if err != nil {
+		return nil, fmt.Errorf("listing ledger entries for user %s: %w", userID, err)
+	}
+	return ComputeNetPosition(userID, entries), nil
+}
+
+func (s *Service) ensureOpen(ctx context.Context, poolID string) error {
+	settlement, err := s.ports.Ledger.GetPoolSettlement(ctx, poolID)
+	if err != nil {
+		return err
+	}
+	if settlement != nil {
+		return &apperrors.InvalidArgumentError{Field: "poolId", Message: "pool is settled; reopen it to change the ledger"}
+	}
+	return nil
+}
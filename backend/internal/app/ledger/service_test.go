@@ -0,0 +1,87 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+)
+
+type fakeLedgerRepo struct {
+	ports.LedgerRepository
+	settlement *models.PoolSettlement
+	created    []*models.LedgerEntry
+}
+
+func (f *fakeLedgerRepo) GetPoolSettlement(context.Context, string) (*models.PoolSettlement, error) {
+	return f.settlement, nil
+}
+
+func (f *fakeLedgerRepo) CreateLedgerEntry(_ context.Context, entry *models.LedgerEntry) error {
+	f.created = append(f.created, entry)
+	return nil
+}
+
+type fakePortfolios struct {
+	ports.PortfolioReader
+	portfolio *models.Portfolio
+}
+
+func (f *fakePortfolios) GetPortfolio(context.Context, string) (*models.Portfolio, error) {
+	return f.portfolio, nil
+}
+
+func newTestService(settlement *models.PoolSettlement, portfolio *models.Portfolio) (*Service, *fakeLedgerRepo) {
+	repo := &fakeLedgerRepo{settlement: settlement}
+	return New(Ports{Ledger: repo, Portfolios: &fakePortfolios{portfolio: portfolio}}), repo
+}
+
+func newTestEntry() *models.LedgerEntry {
+	return &models.LedgerEntry{PoolID: "c1", PortfolioID: "p1", Kind: models.LedgerEntryKindPaymentReceived, AmountCents: 2000}
+}
+
+func TestThatRecordEntryRejectsSettledPool(t *testing.T) {
+	// GIVEN a settled pool
+	svc, _ := newTestService(&models.PoolSettlement{PoolID: "c1"}, &models.Portfolio{ID: "p1", PoolID: "c1"})
+
+	// WHEN recording an entry fee
+	err := svc.RecordEntry(context.Background(), newTestEntry())
+
+	// THEN an InvalidArgumentError is returned
+	var invalid *apperrors.InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("expected *apperrors.InvalidArgumentError, got %T: %v", err, err)
+	}
+}
+
+func TestThatRecordEntryRejectsPortfolioFromAnotherPool(t *testing.T) {
+	// GIVEN a portfolio in a different pool
+	svc, _ := newTestService(nil, &models.Portfolio{ID: "p1", PoolID: "c2"})
+
+	// WHEN recording an entry fee against it
+	err := svc.RecordEntry(context.Background(), newTestEntry())
+
+	// THEN a NotFoundError is returned
+	var notFound *apperrors.NotFoundError
+	if !errors.As(err, &notFound) {
+		t.Fatalf("expected *apperrors.NotFoundError, got %T: %v", err, err)
+	}
+}
+
+func TestThatRecordEntryStoresEntryForOpenPool(t *testing.T) {
+	// GIVEN an unsettled pool
+	svc, repo := newTestService(nil, &models.Portfolio{ID: "p1", PoolID: "c1"})
+
+	// WHEN recording an entry fee
+	if err := svc.RecordEntry(context.Background(), newTestEntry()); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the entry is stored
+	if len(repo.created) != 1 {
+		t.Fatalf("expected 1 stored entry, got %d", len(repo.created))
+	}
+}
@@ -0,0 +1,145 @@
+// Package ownership splits a team among the portfolios that bid on it,
+// according to the pool's ownership mode, and hands out teams nobody bid on
+// according to its unclaimed-team mode. derived.investment_shares and
+// derived.ownership_details apply the same rules in the database; both are
+// tested against testutil.OwnershipCases to keep them in step.
+package ownership
+
+import (
+	"sort"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// Rule is a pool's ownership mode. CapPercent only applies to capped pools.
+// Unclaimed is the pool's unclaimed-team mode.
+type Rule struct {
+	Mode       string
+	CapPercent int
+	Unclaimed  string
+}
+
+// RuleForPool returns the pool's ownership rule, treating an unset mode as
+// proportional and unset unclaimed-team handling as forfeit.
+func RuleForPool(pool *models.Pool) Rule {
+	if pool == nil {
+		return Rule{Mode: models.OwnershipModeProportional, Unclaimed: models.UnclaimedModeForfeit}
+	}
+	rule := Rule{Mode: pool.OwnershipMode, Unclaimed: pool.UnclaimedMode}
+	if rule.Mode == "" {
+		rule.Mode = models.OwnershipModeProportional
+	}
+	if rule.Unclaimed == "" {
+		rule.Unclaimed = models.UnclaimedModeForfeit
+	}
+	if pool.OwnershipCapPercent != nil {
+		rule.CapPercent = *pool.OwnershipCapPercent
+	}
+	return rule
+}
+
+// Shares maps each owner of one team to its share of the team's points,
+// given the credits each bid. Owners with no share are omitted. Shares sum to
+// one unless a cap leaves part of the team unowned.
+func Shares(rule Rule, credits map[string]int) map[string]float64 {
+	total := 0
+	for _, c := range credits {
+		total += c
+	}
+	if total <= 0 {
+		return map[string]float64{}
+	}
+
+	switch rule.Mode {
+	case models.OwnershipModeWinnerTakeAll:
+		return winnerTakeAll(credits)
+	case models.OwnershipModeCappedShare:
+		return capped(credits, total, float64(rule.CapPercent)/100)
+	default:
+		shares := make(map[string]float64, len(credits))
+		for owner, c := range credits {
+			if c > 0 {
+				shares[owner] = float64(c) / float64(total)
+			}
+		}
+		return shares
+	}
+}
+
+// UnclaimedShares maps each portfolio to its share of a team nobody bid on,
+// given the credits each portfolio invested across the pool. Only pro-rata
+// pools hand unclaimed teams out; under forfeit and leftovers the result is
+// empty and the team's points go to no one.
+func UnclaimedShares(rule Rule, credits map[string]int) map[string]float64 {
+	if rule.Unclaimed != models.UnclaimedModeProRata {
+		return map[string]float64{}
+	}
+	total := 0
+	for _, c := range credits {
+		total += c
+	}
+	shares := make(map[string]float64, len(credits))
+	if total <= 0 {
+		return shares
+	}
+	for owner, c := range credits {
+		if c > 0 {
+			shares[owner] = float64(c) / float64(total)
+		}
+	}
+	return shares
+}
+
+func winnerTakeAll(credits map[string]int) map[string]float64 {
+	top := 0
+	for _, c := range credits {
+		if c > top {
+			top = c
+		}
+	}
+	var winners []string
+	for owner, c := range credits {
+		if c == top {
+			winners = append(winners, owner)
+		}
+	}
+	shares := make(map[string]float64, len(winners))
+	for _, owner := range winners {
+		shares[owner] = 1 / float64(len(winners))
+	}
+	return shares
+}
+
+// capped ranks owners by bid and caps them largest first: an owner is capped
+// when, with every larger bid capped, its proportional share of what remains
+// would still exceed the cap. The rest split what the capped owners leave.
+func capped(credits map[string]int, total int, limit float64) map[string]float64 {
+	owners := make([]string, 0, len(credits))
+	for owner, c := range credits {
+		if c > 0 {
+			owners = append(owners, owner)
+		}
+	}
+	sort.Slice(owners, func(i, j int) bool {
+		if credits[owners[i]] != credits[owners[j]] {
+			return credits[owners[i]] > credits[owners[j]]
+		}
+		return owners[i] < owners[j]
+	})
+
+	shares := make(map[string]float64, len(owners))
+	creditsAhead := 0
+	for i, owner := range owners {
+		remaining := 1 - float64(i)*limit
+		c := credits[owner]
+		if remaining*float64(c) <= limit*float64(total-creditsAhead) {
+			for _, rest := range owners[i:] {
+				shares[rest] = remaining * float64(credits[rest]) / float64(total-creditsAhead)
+			}
+			return shares
+		}
+		shares[owner] = limit
+		creditsAhead += c
+	}
+	return shares
+}
@@ -0,0 +1,160 @@
+package ownership
+
+import (
+	"math"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/testutil"
+)
+
+func approxEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestThatProportionalShareFollowsBids(t *testing.T) {
+	// GIVEN a proportional pool where a bids 30 and b bids 10 on a team
+	rule := Rule{Mode: models.OwnershipModeProportional}
+
+	// WHEN computing shares
+	shares := Shares(rule, map[string]int{"a": 30, "b": 10})
+
+	// THEN a owns three quarters
+	if !approxEqual(shares["a"], 0.75) {
+		t.Errorf("expected 0.75, got %v", shares["a"])
+	}
+}
+
+func TestThatWinnerTakeAllGivesTeamToTopBid(t *testing.T) {
+	// GIVEN a winner-take-all pool where a bids 30 and b bids 10
+	rule := Rule{Mode: models.OwnershipModeWinnerTakeAll}
+
+	// WHEN computing shares
+	shares := Shares(rule, map[string]int{"a": 30, "b": 10})
+
+	// THEN b owns nothing
+	if _, ok := shares["b"]; ok {
+		t.Errorf("expected b to own nothing, got %v", shares["b"])
+	}
+}
+
+func TestThatWinnerTakeAllSplitsTiedTopBids(t *testing.T) {
+	// GIVEN a winner-take-all pool where a and b both bid 20 and c bids 5
+	rule := Rule{Mode: models.OwnershipModeWinnerTakeAll}
+
+	// WHEN computing shares
+	shares := Shares(rule, map[string]int{"a": 20, "b": 20, "c": 5})
+
+	// THEN a and b split the team evenly
+	if !approxEqual(shares["a"], 0.5) || !approxEqual(shares["b"], 0.5) {
+		t.Errorf("expected an even split, got %v", shares)
+	}
+}
+
+func TestThatCappedShareHoldsLargestOwnerAtCap(t *testing.T) {
+	// GIVEN a pool capped at 50% where a bids 80 and b bids 20
+	rule := Rule{Mode: models.OwnershipModeCappedShare, CapPercent: 50}
+
+	// WHEN computing shares
+	shares := Shares(rule, map[string]int{"a": 80, "b": 20})
+
+	// THEN a holds exactly the cap
+	if !approxEqual(shares["a"], 0.5) {
+		t.Errorf("expected 0.5, got %v", shares["a"])
+	}
+}
+
+func TestThatCappedShareGivesExcessToOtherOwners(t *testing.T) {
+	// GIVEN a pool capped at 50% where a bids 60, b bids 30 and c bids 10
+	rule := Rule{Mode: models.OwnershipModeCappedShare, CapPercent: 50}
+
+	// WHEN computing shares
+	shares := Shares(rule, map[string]int{"a": 60, "b": 30, "c": 10})
+
+	// THEN b and c split the remaining half three to one
+	if !approxEqual(shares["b"], 0.375) || !approxEqual(shares["c"], 0.125) {
+		t.Errorf("expected b=0.375 c=0.125, got %v", shares)
+	}
+}
+
+func TestThatCappedShareLeavesSoleOwnerAtCap(t *testing.T) {
+	// GIVEN a pool capped at 50% where only a bids
+	rule := Rule{Mode: models.OwnershipModeCappedShare, CapPercent: 50}
+
+	// WHEN computing shares
+	shares := Shares(rule, map[string]int{"a": 25})
+
+	// THEN half the team goes unowned
+	if !approxEqual(shares["a"], 0.5) {
+		t.Errorf("expected 0.5, got %v", shares["a"])
+	}
+}
+
+func TestThatUnsetModeIsProportional(t *testing.T) {
+	// GIVEN a pool without an ownership mode
+	pool := &models.Pool{}
+
+	// WHEN reading its rule
+	rule := RuleForPool(pool)
+
+	// THEN it is proportional
+	if rule.Mode != models.OwnershipModeProportional {
+		t.Errorf("expected proportional, got %q", rule.Mode)
+	}
+}
+
+func TestThatProRataSplitsUnclaimedTeamByPoolCredits(t *testing.T) {
+	// GIVEN a pro-rata pool where a invested 75 credits and b invested 25
+	rule := Rule{Mode: models.OwnershipModeProportional, Unclaimed: models.UnclaimedModeProRata}
+
+	// WHEN computing shares of an unclaimed team
+	shares := UnclaimedShares(rule, map[string]int{"a": 75, "b": 25})
+
+	// THEN a owns three quarters
+	if !approxEqual(shares["a"], 0.75) {
+		t.Errorf("expected 0.75, got %v", shares["a"])
+	}
+}
+
+func TestThatForfeitLeavesUnclaimedTeamUnowned(t *testing.T) {
+	// GIVEN a forfeit pool where a invested 75 credits and b invested 25
+	rule := Rule{Mode: models.OwnershipModeProportional, Unclaimed: models.UnclaimedModeForfeit}
+
+	// WHEN computing shares of an unclaimed team
+	shares := UnclaimedShares(rule, map[string]int{"a": 75, "b": 25})
+
+	// THEN nobody owns it
+	if len(shares) != 0 {
+		t.Errorf("expected no owners, got %v", shares)
+	}
+}
+
+func TestThatUnsetUnclaimedModeIsForfeit(t *testing.T) {
+	// GIVEN a pool without an unclaimed-team mode
+	pool := &models.Pool{}
+
+	// WHEN reading its rule
+	rule := RuleForPool(pool)
+
+	// THEN unclaimed teams are forfeit
+	if rule.Unclaimed != models.UnclaimedModeForfeit {
+		t.Errorf("expected forfeit, got %q", rule.Unclaimed)
+	}
+}
+
+func TestThatSharesMatchTheSharedOwnershipCases(t *testing.T) {
+	// GIVEN the ownership cases the database views are checked against too
+	for _, c := range testutil.OwnershipCases() {
+		rule := Rule{Mode: c.Mode, CapPercent: c.CapPercent}
+
+		// WHEN computing shares
+		shares := Shares(rule, c.Credits)
+
+		// THEN every owner gets the case's share
+		for owner := range c.Credits {
+			if !approxEqual(shares[owner], c.Want[owner]) {
+				t.Errorf("%s: expected %s to own %v, got %v", c.Name, owner, c.Want[owner], shares[owner])
+			}
+		}
+	}
+}
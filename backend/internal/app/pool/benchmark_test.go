@@ -0,0 +1,92 @@
+package pool
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// sweet16Pool is a pool entering the Sweet 16: fifteen games left, forty
+// portfolios each holding shares of eight teams.
+type sweet16Pool struct {
+	bracket    *models.BracketStructure
+	portfolios []*models.Portfolio
+	summaries  []*models.OwnershipSummary
+	details    []*models.OwnershipDetail
+	teams      []*models.TournamentTeam
+	rules      []*models.ScoringRule
+	payouts    []*models.PoolPayout
+	tieBreak   *TieBreak
+}
+
+func newSweet16Pool() *sweet16Pool {
+	regions := []string{"East", "West", "South", "Midwest"}
+	games := make(map[string]*models.BracketGame)
+	games["championship"] = &models.BracketGame{GameID: "championship", Round: models.RoundChampionship}
+	for semi := 0; semi < 2; semi++ {
+		id := fmt.Sprintf("final_four-%d", semi+1)
+		games[id] = &models.BracketGame{GameID: id, Round: models.RoundFinalFour, NextGameID: "championship", NextGameSlot: semi + 1, SortOrder: semi}
+	}
+
+	p := &sweet16Pool{}
+	for r, region := range regions {
+		elite8 := fmt.Sprintf("elite_8-%d", r+1)
+		games[elite8] = &models.BracketGame{GameID: elite8, Round: models.RoundElite8, Region: region, NextGameID: fmt.Sprintf("final_four-%d", r/2+1), NextGameSlot: r%2 + 1, SortOrder: r}
+		for g := 0; g < 2; g++ {
+			id := fmt.Sprintf("sweet_16-%d", 2*r+g+1)
+			top := bracketTeam(fmt.Sprintf("%s-%d", region, g+1), "", g+1, region)
+			bottom := bracketTeam(fmt.Sprintf("%s-%d", region, 4-g), "", 4-g, region)
+			games[id] = &models.BracketGame{GameID: id, Round: models.RoundSweet16, Region: region, Team1: top, Team2: bottom, NextGameID: elite8, NextGameSlot: g + 1, SortOrder: 2*r + g}
+			p.teams = append(p.teams, tournamentTeam(top.TeamID, 2, 0, false), tournamentTeam(bottom.TeamID, 2, 0, false))
+		}
+		for seed := 5; seed <= 16; seed++ {
+			p.teams = append(p.teams, tournamentTeam(fmt.Sprintf("%s-%d", region, seed), seed%2, 0, true))
+		}
+	}
+	p.bracket = &models.BracketStructure{TournamentID: "tournament-1", Regions: regions, Games: games}
+
+	for i := 0; i < 40; i++ {
+		portfolio := testPortfolio(fmt.Sprintf("p%d", i))
+		summary := ownershipSummary(fmt.Sprintf("os%d", i), portfolio.ID)
+		p.portfolios = append(p.portfolios, portfolio)
+		p.summaries = append(p.summaries, summary)
+		for k := 0; k < 8; k++ {
+			team := p.teams[(i*7+k*13)%len(p.teams)]
+			p.details = append(p.details, ownershipDetail(summary.ID, team.ID, 0.1+0.05*float64(k)))
+		}
+	}
+	for winIndex, points := range []int{10, 20, 40, 80, 160, 320} {
+		p.rules = append(p.rules, scoringRule(winIndex+1, points))
+	}
+	p.payouts = []*models.PoolPayout{poolPayout(1, 50000), poolPayout(2, 25000), poolPayout(3, 10000)}
+	p.tieBreak = &TieBreak{Rule: models.TieBreakerChampionPoints}
+	return p
+}
+
+func (p *sweet16Pool) outcomeTable() *OutcomeTable {
+	return ComputeOutcomeTable(p.bracket, p.portfolios, p.summaries, p.details, p.teams, nil, p.rules, p.payouts, p.tieBreak, nil, DefaultMaxExactRemainingGames)
+}
+
+func BenchmarkComputeOutcomeTableFromTheSweet16(b *testing.B) {
+	p := newSweet16Pool()
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		if p.outcomeTable() == nil {
+			b.Fatal("expected an outcome table")
+		}
+	}
+}
+
+func BenchmarkWinScenariosFromTheSweet16(b *testing.B) {
+	table := newSweet16Pool().outcomeTable()
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if table.WinScenarios("p0") == nil {
+			b.Fatal("expected win scenarios")
+		}
+	}
+}
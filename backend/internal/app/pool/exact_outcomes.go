@@ -0,0 +1,91 @@
+package pool
+
+import (
+	"sort"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// DefaultMaxExactRemainingGames is the default most games left for which
+// outcomes are enumerated exactly: from the Sweet 16 on, 2^15 ways the
+// tournament can end.
+const DefaultMaxExactRemainingGames = 15
+
+// ExactOutcomes is every portfolio's finish over every way the remaining
+// games can go, each weighted by its probability.
+type ExactOutcomes struct {
+	RemainingGames int
+	// Outcomes is the number of outcomes enumerated; those with no chance of
+	// happening are skipped.
+	Outcomes   int
+	Portfolios []*ExactPortfolioOutcome
+}
+
+// ExactPortfolioOutcome is one portfolio's exact finish distribution.
+// FinishProbabilities[k] is the probability it finishes in position k+1,
+// tied or not.
+type ExactPortfolioOutcome struct {
+	PortfolioID         string
+	FinishProbabilities []float64
+	ExpectedReturns     float64
+	ExpectedPayoutCents float64
+	PInTheMoney         float64
+}
+
+// exactGame is an unplayed game: the teams already in its slots, and for an
+// empty slot, the index of the unplayed game that fills it, or -1.
+type exactGame struct {
+	game  *models.BracketGame
+	teams [2]*models.BracketTeam
+	prev  [2]int
+}
+
+// remainingGames returns the bracket's unplayed games in play order.
+func remainingGames(bracket *models.BracketStructure) []exactGame {
+	var unplayed []*models.BracketGame
+	for _, g := range bracket.Games {
+		if g != nil && g.Winner == nil {
+			unplayed = append(unplayed, g)
+		}
+	}
+	sort.Slice(unplayed, func(i, j int) bool {
+		a, b := unplayed[i], unplayed[j]
+		if a.Round.Order() != b.Round.Order() {
+			return a.Round.Order() < b.Round.Order()
+		}
+		if a.SortOrder != b.SortOrder {
+			return a.SortOrder < b.SortOrder
+		}
+		return a.GameID < b.GameID
+	})
+
+	index := make(map[string]int, len(unplayed))
+	games := make([]exactGame, len(unplayed))
+	for i, g := range unplayed {
+		index[g.GameID] = i
+		games[i] = exactGame{game: g, teams: [2]*models.BracketTeam{g.Team1, g.Team2}, prev: [2]int{-1, -1}}
+	}
+	for i, g := range unplayed {
+		next, ok := index[g.NextGameID]
+		if !ok || g.NextGameSlot < 1 || g.NextGameSlot > 2 {
+			continue
+		}
+		// A feeder plays in an earlier round, so it precedes the game it
+		// feeds.
+		if next > i && games[next].teams[g.NextGameSlot-1] == nil {
+			games[next].prev[g.NextGameSlot-1] = i
+		}
+	}
+	return games
+}
+
+// exactWinProb returns the chance team1 beats team2.
+func exactWinProb(winProbs map[models.TeamPair]float64, team1ID, team2ID string) float64 {
+	if p, ok := winProbs[models.TeamPair{Team1ID: team1ID, Team2ID: team2ID}]; ok {
+		return p
+	}
+	if p, ok := winProbs[models.TeamPair{Team1ID: team2ID, Team2ID: team1ID}]; ok {
+		return 1 - p
+	}
+	return 0.5
+}
@@ -0,0 +1,106 @@
+package pool
+
+import (
+	"math"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// buildFinalFourWithChampionship is a Final Four whose semifinals feed an
+// unplayed championship game.
+func buildFinalFourWithChampionship() *models.BracketStructure {
+	b := buildFinalFourBracket(
+		bracketTeam("A", "sa", 1, "East"),
+		bracketTeam("B", "sb", 2, "West"),
+		bracketTeam("C", "sc", 1, "South"),
+		bracketTeam("D", "sd", 2, "Midwest"),
+	)
+	b.Games["final_four-1"].NextGameID = "championship"
+	b.Games["final_four-1"].NextGameSlot = 1
+	b.Games["final_four-2"].NextGameID = "championship"
+	b.Games["final_four-2"].NextGameSlot = 2
+	b.Games["championship"] = &models.BracketGame{GameID: "championship", Round: models.RoundChampionship}
+	return b
+}
+
+func exactOutcomesFor(winProbs map[models.TeamPair]float64, maxRemainingGames int) *ExactOutcomes {
+	portfolios := []*models.Portfolio{testPortfolio("p1"), testPortfolio("p2")}
+	summaries := []*models.OwnershipSummary{ownershipSummary("os1", "p1"), ownershipSummary("os2", "p2")}
+	details := []*models.OwnershipDetail{ownershipDetail("os1", "A", 1.0), ownershipDetail("os2", "C", 1.0)}
+	tts := []*models.TournamentTeam{
+		tournamentTeam("A", 4, 1, false),
+		tournamentTeam("B", 4, 1, false),
+		tournamentTeam("C", 4, 1, false),
+		tournamentTeam("D", 4, 1, false),
+	}
+	rules := []*models.ScoringRule{scoringRule(6, 10), scoringRule(7, 20)}
+	payouts := []*models.PoolPayout{poolPayout(1, 1000)}
+	return ComputeOutcomeTable(buildFinalFourWithChampionship(), portfolios, summaries, details, tts, nil, rules, payouts, nil, winProbs, maxRemainingGames).Exact()
+}
+
+func TestThatExactOutcomesAreNilWhenTooManyGamesRemain(t *testing.T) {
+	// GIVEN a Final Four with three games left
+	// WHEN enumerating at most two remaining games
+	result := exactOutcomesFor(nil, 2)
+
+	// THEN nothing is enumerated
+	if result != nil {
+		t.Errorf("expected nil, got %+v", result)
+	}
+}
+
+func TestThatExactOutcomesEnumerateEveryWayTheFinalFourCanGo(t *testing.T) {
+	// GIVEN a Final Four of coin flips
+	// WHEN enumerating its outcomes
+	result := exactOutcomesFor(nil, DefaultMaxExactRemainingGames)
+
+	// THEN all 8 are counted
+	if result.Outcomes != 8 {
+		t.Errorf("expected 8 outcomes, got %d", result.Outcomes)
+	}
+}
+
+func TestThatExactFinishProbabilitiesSumToOne(t *testing.T) {
+	// GIVEN a Final Four with uneven matchups
+	winProbs := map[models.TeamPair]float64{{Team1ID: "A", Team2ID: "B"}: 0.7, {Team1ID: "C", Team2ID: "D"}: 0.4}
+
+	// WHEN enumerating its outcomes
+	result := exactOutcomesFor(winProbs, DefaultMaxExactRemainingGames)
+
+	// THEN each portfolio finishes somewhere with certainty
+	sum := 0.0
+	for _, p := range result.Portfolios[0].FinishProbabilities {
+		sum += p
+	}
+	if math.Abs(sum-1) > 1e-9 {
+		t.Errorf("expected finish probabilities to sum to 1, got %f", sum)
+	}
+}
+
+func TestThatExactOutcomesUseMatchupProbabilities(t *testing.T) {
+	// GIVEN A wins its semifinal 70% of the time and C 40%, and a coin-flip
+	// final
+	winProbs := map[models.TeamPair]float64{{Team1ID: "A", Team2ID: "B"}: 0.7, {Team1ID: "C", Team2ID: "D"}: 0.4}
+
+	// WHEN enumerating its outcomes
+	result := exactOutcomesFor(winProbs, DefaultMaxExactRemainingGames)
+
+	// THEN A's owner scores 10 per semifinal win and 20 per title
+	if want := 0.7*10 + 0.35*20; math.Abs(result.Portfolios[0].ExpectedReturns-want) > 1e-9 {
+		t.Errorf("expected %f, got %f", want, result.Portfolios[0].ExpectedReturns)
+	}
+}
+
+func TestThatExactExpectedPayoutWeighsEachFinish(t *testing.T) {
+	// GIVEN A always reaches and wins the final
+	winProbs := map[models.TeamPair]float64{{Team1ID: "A", Team2ID: "B"}: 1, {Team1ID: "A", Team2ID: "C"}: 1, {Team1ID: "A", Team2ID: "D"}: 1}
+
+	// WHEN enumerating its outcomes
+	result := exactOutcomesFor(winProbs, DefaultMaxExactRemainingGames)
+
+	// THEN A's owner takes first place every time
+	if result.Portfolios[0].ExpectedPayoutCents != 1000 {
+		t.Errorf("expected 1000, got %f", result.Portfolios[0].ExpectedPayoutCents)
+	}
+}
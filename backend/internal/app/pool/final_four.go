@@ -16,15 +16,21 @@ type FinalFourOutcome struct {
 
 // ComputeFinalFourOutcomes computes standings for all 8 possible championship
 // outcomes. Returns nil if the Final Four field is not yet set (i.e. both
-// semifinal games don't have both teams populated).
+// semifinal games don't have both teams populated). Ownership percentages are
+// read from the ownership details, which already apply the pool's ownership
+// mode and, in pro-rata pools, hand out shares of unclaimed teams. Results supply the seeds each team has beaten so far, for upset
+// bonuses. Ties are broken by the pool's tie-breaker, scoring champion points
+// against each outcome's hypothetical champion.
 func ComputeFinalFourOutcomes(
 	bracket *models.BracketStructure,
 	portfolios []*models.Portfolio,
 	ownershipSummaries []*models.OwnershipSummary,
 	ownershipDetails []*models.OwnershipDetail,
 	tournamentTeams []*models.TournamentTeam,
+	results []*models.GameResult,
 	scoringRules []*models.ScoringRule,
 	payouts []*models.PoolPayout,
+	tieBreak *TieBreak,
 ) []*FinalFourOutcome {
 	if bracket == nil {
 		return nil
@@ -44,15 +50,8 @@ func ComputeFinalFourOutcomes(
 		{semi2.Team1, semi2.Team2},
 	}
 
-	rules := make([]scoring.Rule, len(scoringRules))
-	for i, sr := range scoringRules {
-		rules[i] = scoring.Rule{WinIndex: sr.WinIndex, PointsAwarded: sr.PointsAwarded}
-	}
-
-	teamByID := make(map[string]*models.TournamentTeam, len(tournamentTeams))
-	for _, tt := range tournamentTeams {
-		teamByID[tt.ID] = tt
-	}
+	rules := scoring.FromModels(scoringRules)
+	runs := capRuns(scoring.RunsFromResults(tournamentTeams, results), eliteEightCap)
 
 	summaryToPortfolio := buildSummaryToPortfolioMap(ownershipSummaries)
 
@@ -66,9 +65,10 @@ func ComputeFinalFourOutcomes(
 					runnerUp = s1Winner
 				}
 
-				hypotheticalWins := buildHypotheticalWins(semis, s1Winner, s2Winner, champion, teamByID)
-				returnsByPortfolio := computeReturnsByPortfolio(ownershipDetails, teamByID, hypotheticalWins, rules, summaryToPortfolio)
-				standings := ComputeStandings(portfolios, returnsByPortfolio, payouts)
+				hypotheticalWins := buildHypotheticalWins(semis, s1Winner, s2Winner, champion, runnerUp)
+				returnsByPortfolio := computeReturnsByPortfolio(ownershipDetails, runs, hypotheticalWins, rules, summaryToPortfolio, "")
+				championPoints := computeReturnsByPortfolio(ownershipDetails, runs, hypotheticalWins, rules, summaryToPortfolio, champion.TeamID)
+				standings := ComputeStandings(portfolios, returnsByPortfolio, payouts, tieBreak.WithChampionPoints(championPoints))
 
 				outcomes = append(outcomes, &FinalFourOutcome{
 					Semifinal1Winner: s1Winner,
@@ -93,52 +93,66 @@ func buildSummaryToPortfolioMap(summaries []*models.OwnershipSummary) map[string
 	return m
 }
 
-// buildHypotheticalWins returns a map of teamID -> additional wins beyond current state
-// for the 3 remaining games in a given Final Four outcome.
+// buildHypotheticalWins returns a map of teamID -> seeds beaten beyond current
+// state for the 3 remaining games in a given Final Four outcome; its length
+// is the number of additional wins.
 func buildHypotheticalWins(
 	semis [2][2]*models.BracketTeam,
-	s1Winner, s2Winner, champion *models.BracketTeam,
-	teamByID map[string]*models.TournamentTeam,
-) map[string]int {
-	extra := make(map[string]int)
+	s1Winner, s2Winner, champion, runnerUp *models.BracketTeam,
+) map[string][]int {
+	extra := make(map[string][]int)
 
 	// Both semifinal winners get +1 win (for winning the semifinal)
-	extra[s1Winner.TeamID]++
-	extra[s2Winner.TeamID]++
+	extra[s1Winner.TeamID] = append(extra[s1Winner.TeamID], semiOpponent(semis[0], s1Winner).Seed)
+	extra[s2Winner.TeamID] = append(extra[s2Winner.TeamID], semiOpponent(semis[1], s2Winner).Seed)
 
 	// Champion gets another +1 win (for winning the final)
-	extra[champion.TeamID]++
+	extra[champion.TeamID] = append(extra[champion.TeamID], runnerUp.Seed)
 
 	return extra
 }
 
+// semiOpponent returns the other team in a semifinal.
+func semiOpponent(semi [2]*models.BracketTeam, winner *models.BracketTeam) *models.BracketTeam {
+	if semi[0] == winner {
+		return semi[1]
+	}
+	return semi[0]
+}
+
 // eliteEightCap is the maximum progress (wins + byes) before the Final Four.
 // We cap here so hypothetical wins are added on top of pre-Final-Four state,
 // not on top of actual results that may already include FF/Championship wins.
 const eliteEightCap = 5
 
-// progressAtRound caps progress (wins + byes) at a given round threshold.
-func progressAtRound(wins, byes, round int) int {
-	total := wins + byes
-	if total > round {
-		return round
+// capRuns caps every run at the given progress.
+func capRuns(runs map[string]scoring.Run, progress int) map[string]scoring.Run {
+	out := make(map[string]scoring.Run, len(runs))
+	for teamID, run := range runs {
+		out[teamID] = run.Capped(progress)
 	}
-	return total
+	return out
 }
 
-// computeReturnsByPortfolio computes total returns per portfolio for a hypothetical outcome.
+// computeReturnsByPortfolio computes total returns per portfolio for a
+// hypothetical outcome, adding hypothetical wins on top of each team's run.
+// A non-empty onlyTeamID counts that team's returns alone.
 func computeReturnsByPortfolio(
 	ownershipDetails []*models.OwnershipDetail,
-	teamByID map[string]*models.TournamentTeam,
-	hypotheticalWins map[string]int,
+	runs map[string]scoring.Run,
+	hypotheticalWins map[string][]int,
 	rules []scoring.Rule,
 	summaryToPortfolio map[string]string,
+	onlyTeamID string,
 ) map[string]float64 {
 	returnsByPortfolio := make(map[string]float64)
 
 	for _, od := range ownershipDetails {
-		team := teamByID[od.TeamID]
-		if team == nil {
+		if onlyTeamID != "" && od.TeamID != onlyTeamID {
+			continue
+		}
+		run, ok := runs[od.TeamID]
+		if !ok {
 			continue
 		}
 		portfolioID := summaryToPortfolio[od.PortfolioID]
@@ -146,11 +160,23 @@ func computeReturnsByPortfolio(
 			continue
 		}
 
-		capped := progressAtRound(team.Wins, team.Byes, eliteEightCap)
-		wins := capped + hypotheticalWins[od.TeamID]
-		teamReturns := scoring.PointsForProgress(rules, wins, 0)
+		run = extendRun(run, hypotheticalWins[od.TeamID])
+		teamReturns := scoring.PointsForRun(rules, run)
 		returnsByPortfolio[portfolioID] += od.OwnershipPercentage * float64(teamReturns)
 	}
 
 	return returnsByPortfolio
 }
+
+// extendRun adds wins over the given seeds to a run. Wins the run has no
+// beaten seed for are padded so the new seeds line up with the new wins.
+func extendRun(run scoring.Run, beatenSeeds []int) scoring.Run {
+	if len(beatenSeeds) == 0 {
+		return run
+	}
+	seeds := make([]int, run.Wins, run.Wins+len(beatenSeeds))
+	copy(seeds, run.BeatenSeeds)
+	run.BeatenSeeds = append(seeds, beatenSeeds...)
+	run.Wins += len(beatenSeeds)
+	return run
+}
@@ -78,7 +78,7 @@ func poolPayout(position, amountCents int) *models.PoolPayout {
 func TestThatNilReturnedWhenBracketIsNil(t *testing.T) {
 	// GIVEN a nil bracket
 	// WHEN computing Final Four outcomes
-	result := ComputeFinalFourOutcomes(nil, nil, nil, nil, nil, nil, nil)
+	result := ComputeFinalFourOutcomes(nil, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	// THEN nil is returned
 	if result != nil {
@@ -99,7 +99,7 @@ func TestThatNilReturnedWhenSemifinalGameMissing(t *testing.T) {
 	}
 
 	// WHEN computing Final Four outcomes
-	result := ComputeFinalFourOutcomes(bracket, nil, nil, nil, nil, nil, nil)
+	result := ComputeFinalFourOutcomes(bracket, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	// THEN nil is returned
 	if result != nil {
@@ -117,7 +117,7 @@ func TestThatNilReturnedWhenSemifinalTeamNotSet(t *testing.T) {
 	)
 
 	// WHEN computing Final Four outcomes
-	result := ComputeFinalFourOutcomes(bracket, nil, nil, nil, nil, nil, nil)
+	result := ComputeFinalFourOutcomes(bracket, nil, nil, nil, nil, nil, nil, nil, nil)
 
 	// THEN nil is returned
 	if result != nil {
@@ -147,7 +147,7 @@ func TestThatEightOutcomesReturnedForCompleteFinalFour(t *testing.T) {
 	payouts := []*models.PoolPayout{}
 
 	// WHEN computing Final Four outcomes
-	result := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, tts, rounds, payouts)
+	result := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, tts, nil, rounds, payouts, nil)
 
 	// THEN 8 outcomes are returned
 	if len(result) != 8 {
@@ -181,7 +181,7 @@ func TestThatChampionGetsExtraWinsInScoring(t *testing.T) {
 	}
 	payouts := []*models.PoolPayout{}
 
-	result := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, tts, rounds, payouts)
+	result := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, tts, nil, rounds, payouts, nil)
 
 	// Find outcomes where A is champion vs where A only wins semifinal
 	var championReturns, semiOnlyReturns float64
@@ -239,8 +239,8 @@ func TestThatCompletedTournamentProducesSameResultsAsPreFinalFour(t *testing.T)
 		tournamentTeam("D", 4, 1, true),
 	}
 
-	preResult := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, preTTs, rounds, payouts)
-	completedResult := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, completedTTs, rounds, payouts)
+	preResult := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, preTTs, nil, rounds, payouts, nil)
+	completedResult := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, completedTTs, nil, rounds, payouts, nil)
 
 	// THEN both produce identical standings for each outcome
 	for i := range preResult {
@@ -280,7 +280,7 @@ func TestThatStandingsIncludePayouts(t *testing.T) {
 	}
 	payoutsSlice := []*models.PoolPayout{poolPayout(1, 10000)}
 
-	result := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, tts, rounds, payoutsSlice)
+	result := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, tts, nil, rounds, payoutsSlice, nil)
 
 	// THEN first place in each outcome gets the payout
 	for _, o := range result {
@@ -311,7 +311,7 @@ func TestThatAllFourTeamsAppearAsChampion(t *testing.T) {
 	rounds := []*models.ScoringRule{scoringRule(1, 1)}
 	payouts := []*models.PoolPayout{}
 
-	result := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, tts, rounds, payouts)
+	result := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, tts, nil, rounds, payouts, nil)
 
 	// THEN each of the 4 teams appears as champion exactly twice
 	championCounts := map[string]int{}
@@ -346,7 +346,7 @@ func TestThatRunnerUpIsOpposingSemifinalWinner(t *testing.T) {
 	rounds := []*models.ScoringRule{scoringRule(1, 1)}
 	payouts := []*models.PoolPayout{}
 
-	result := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, tts, rounds, payouts)
+	result := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, tts, nil, rounds, payouts, nil)
 
 	// THEN in every outcome, the runner-up is the opposing semifinal winner
 	for _, o := range result {
@@ -363,3 +363,76 @@ func TestThatRunnerUpIsOpposingSemifinalWinner(t *testing.T) {
 		}
 	}
 }
+
+func TestThatUnderdogChampionEarnsUpsetBonus(t *testing.T) {
+	// GIVEN a portfolio owning 2-seed B outright under a title-game upset bonus
+	bracket := buildFinalFourBracket(
+		bracketTeam("A", "sa", 1, "East"),
+		bracketTeam("B", "sb", 2, "West"),
+		bracketTeam("C", "sc", 1, "South"),
+		bracketTeam("D", "sd", 2, "Midwest"),
+	)
+	portfolios := []*models.Portfolio{testPortfolio("p1")}
+	summaries := []*models.OwnershipSummary{ownershipSummary("os1", "p1")}
+	details := []*models.OwnershipDetail{ownershipDetail("os1", "B", 1.0)}
+	tts := []*models.TournamentTeam{
+		tournamentTeam("A", 4, 1, false),
+		{ID: "B", Seed: 2, Wins: 4, Byes: 1},
+		tournamentTeam("C", 4, 1, false),
+		tournamentTeam("D", 4, 1, false),
+	}
+	rounds := []*models.ScoringRule{{Kind: models.ScoringRuleKindUpsetBonus, WinIndex: 7, PointsAwarded: 10}}
+
+	// WHEN computing Final Four outcomes
+	result := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, tts, nil, rounds, nil, nil)
+
+	// THEN B beating 1-seed C for the title is worth one seed line
+	var returns float64
+	for _, o := range result {
+		if o.Champion.TeamID == "B" && o.RunnerUp.TeamID == "C" {
+			returns = o.Standings[0].TotalReturns
+		}
+	}
+	if returns != 10 {
+		t.Errorf("expected 10, got %v", returns)
+	}
+}
+
+func TestThatChampionPointsTieBreakerUsesHypotheticalChampion(t *testing.T) {
+	// GIVEN p1 owns A and p2 owns C, and the title game is worth no points
+	bracket := buildFinalFourBracket(
+		bracketTeam("A", "sa", 1, "East"),
+		bracketTeam("B", "sb", 2, "West"),
+		bracketTeam("C", "sc", 1, "South"),
+		bracketTeam("D", "sd", 2, "Midwest"),
+	)
+	portfolios := []*models.Portfolio{testPortfolio("p1"), testPortfolio("p2")}
+	summaries := []*models.OwnershipSummary{ownershipSummary("p1", "p1"), ownershipSummary("p2", "p2")}
+	details := []*models.OwnershipDetail{ownershipDetail("p1", "A", 1.0), ownershipDetail("p2", "C", 1.0)}
+	tts := []*models.TournamentTeam{
+		tournamentTeam("A", 4, 1, false),
+		tournamentTeam("B", 4, 1, false),
+		tournamentTeam("C", 4, 1, false),
+		tournamentTeam("D", 4, 1, false),
+	}
+	rounds := []*models.ScoringRule{
+		scoringRule(1, 10), scoringRule(2, 10), scoringRule(3, 10),
+		scoringRule(4, 10), scoringRule(5, 10), scoringRule(6, 10),
+	}
+	payouts := []*models.PoolPayout{poolPayout(1, 1000)}
+	tieBreak := NewTieBreak(&models.Pool{TieBreaker: models.TieBreakerChampionPoints}, nil, nil)
+
+	// WHEN computing Final Four outcomes
+	result := ComputeFinalFourOutcomes(bracket, portfolios, summaries, details, tts, nil, rounds, payouts, tieBreak)
+
+	// THEN when C beats A for the title, p2 takes first place
+	var winner string
+	for _, o := range result {
+		if o.Semifinal1Winner.TeamID == "A" && o.Semifinal2Winner.TeamID == "C" && o.Champion.TeamID == "C" {
+			winner = o.Standings[0].PortfolioID
+		}
+	}
+	if winner != "p2" {
+		t.Errorf("expected p2 to finish first, got %q", winner)
+	}
+}
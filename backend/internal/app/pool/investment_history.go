@@ -0,0 +1,64 @@
+package pool
+
+import (
+	"context"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// InvestmentHistoryEntry is one recorded change to a portfolio's bids: the
+// bids as they stood afterwards and what moved since the entry before.
+type InvestmentHistoryEntry struct {
+	Snapshot *models.InvestmentSnapshot
+	Changes  []models.InvestmentSnapshotChange
+}
+
+// BuildInvestmentHistory pairs each snapshot, oldest first, with its
+// changes from the one before. The first is diffed against no bids.
+func BuildInvestmentHistory(snapshots []*models.InvestmentSnapshot) []*InvestmentHistoryEntry {
+	history := make([]*InvestmentHistoryEntry, 0, len(snapshots))
+	var prev *models.InvestmentSnapshot
+	for _, snapshot := range snapshots {
+		if snapshot == nil {
+			continue
+		}
+		history = append(history, &InvestmentHistoryEntry{
+			Snapshot: snapshot,
+			Changes:  models.DiffInvestmentSnapshots(prev, snapshot),
+		})
+		prev = snapshot
+	}
+	return history
+}
+
+// GetInvestmentHistory returns every recorded change to the portfolio's
+// bids, oldest first.
+func (s *Service) GetInvestmentHistory(ctx context.Context, portfolioID string) ([]*InvestmentHistoryEntry, error) {
+	if s.ports.InvestmentSnapshots == nil {
+		return nil, nil
+	}
+	snapshots, err := s.ports.InvestmentSnapshots.ListInvestmentSnapshots(ctx, portfolioID)
+	if err != nil {
+		return nil, err
+	}
+	return BuildInvestmentHistory(snapshots), nil
+}
+
+// GetInvestmentsAsOf reconstructs the portfolio's bids as they stood at at,
+// from the last snapshot taken at or before it. It returns a NotFoundError
+// when no bids had been recorded by then.
+func (s *Service) GetInvestmentsAsOf(ctx context.Context, portfolioID string, at time.Time) (*models.InvestmentSnapshot, error) {
+	var snapshot *models.InvestmentSnapshot
+	if s.ports.InvestmentSnapshots != nil {
+		var err error
+		if snapshot, err = s.ports.InvestmentSnapshots.GetInvestmentSnapshotAsOf(ctx, portfolioID, at); err != nil {
+			return nil, err
+		}
+	}
+	if snapshot == nil {
+		return nil, &apperrors.NotFoundError{Resource: "investment snapshot", ID: portfolioID}
+	}
+	return snapshot, nil
+}
@@ -0,0 +1,43 @@
+package pool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func twoBidSnapshots() []*models.InvestmentSnapshot {
+	return []*models.InvestmentSnapshot{
+		{ID: "s1", CreatedAt: time.Unix(1, 0), Investments: []models.InvestmentSnapshotEntry{{TeamID: "duke", Credits: 20}}},
+		{ID: "s2", CreatedAt: time.Unix(2, 0), Investments: []models.InvestmentSnapshotEntry{{TeamID: "duke", Credits: 30}}},
+	}
+}
+
+func TestThatFirstHistoryEntryListsOpeningBids(t *testing.T) {
+	// GIVEN a portfolio whose first snapshot bid 20 on duke
+	snapshots := twoBidSnapshots()
+
+	// WHEN building its history
+	history := BuildInvestmentHistory(snapshots)
+
+	// THEN the first entry adds duke from nothing
+	want := models.InvestmentSnapshotChange{TeamID: "duke", ToCredits: 20}
+	if len(history[0].Changes) != 1 || history[0].Changes[0] != want {
+		t.Fatalf("expected %+v, got %+v", want, history[0].Changes)
+	}
+}
+
+func TestThatLaterHistoryEntryDiffsAgainstPreviousSnapshot(t *testing.T) {
+	// GIVEN a portfolio that raised its duke bid from 20 to 30
+	snapshots := twoBidSnapshots()
+
+	// WHEN building its history
+	history := BuildInvestmentHistory(snapshots)
+
+	// THEN the second entry records the raise
+	want := models.InvestmentSnapshotChange{TeamID: "duke", FromCredits: 20, ToCredits: 30}
+	if len(history[1].Changes) != 1 || history[1].Changes[0] != want {
+		t.Fatalf("expected %+v, got %+v", want, history[1].Changes)
+	}
+}
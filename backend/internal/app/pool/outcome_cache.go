@@ -0,0 +1,164 @@
+package pool
+
+import (
+	"fmt"
+	"hash/fnv"
+	"io"
+	"sort"
+	"sync"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// outcomeCache holds each pool's latest outcome table, so the remaining
+// games are enumerated once per change to what the table is computed from
+// rather than once per dashboard.
+type outcomeCache struct {
+	mu      sync.Mutex
+	entries map[string]*outcomeCacheEntry
+}
+
+// outcomeCacheEntry is a table being computed, or computed once done is
+// closed, for the inputs hashed to key.
+type outcomeCacheEntry struct {
+	key   string
+	done  chan struct{}
+	table *OutcomeTable
+}
+
+func newOutcomeCache() *outcomeCache {
+	return &outcomeCache{entries: make(map[string]*outcomeCacheEntry)}
+}
+
+// get returns the pool's table for key, computing it when the pool has no
+// table for key yet. Callers asking for a table being computed wait for it
+// rather than computing it again.
+func (c *outcomeCache) get(poolID, key string, compute func() *OutcomeTable) *OutcomeTable {
+	c.mu.Lock()
+	entry, ok := c.entries[poolID]
+	if ok && entry.key == key {
+		c.mu.Unlock()
+		<-entry.done
+		return entry.table
+	}
+	entry = &outcomeCacheEntry{key: key, done: make(chan struct{})}
+	c.entries[poolID] = entry
+	c.mu.Unlock()
+
+	defer close(entry.done)
+	entry.table = compute()
+	return entry.table
+}
+
+// GetOutcomeTable returns the pool's outcome table as ComputeOutcomeTable
+// computes it, enumerating the remaining games only when the results,
+// holdings, rules or prediction batch differ from the last time it was asked
+// for. predictionBatchID names the batch winProbs come from.
+func (s *Service) GetOutcomeTable(
+	poolID string,
+	predictionBatchID string,
+	bracket *models.BracketStructure,
+	portfolios []*models.Portfolio,
+	ownershipSummaries []*models.OwnershipSummary,
+	ownershipDetails []*models.OwnershipDetail,
+	tournamentTeams []*models.TournamentTeam,
+	results []*models.GameResult,
+	scoringRules []*models.ScoringRule,
+	payouts []*models.PoolPayout,
+	tieBreak *TieBreak,
+	winProbs map[models.TeamPair]float64,
+	maxRemainingGames int,
+) *OutcomeTable {
+	if bracket == nil {
+		return nil
+	}
+	key := outcomeTableKey(predictionBatchID, bracket, portfolios, ownershipSummaries, ownershipDetails, tournamentTeams, results, scoringRules, payouts, tieBreak, maxRemainingGames)
+	return s.outcomes.get(poolID, key, func() *OutcomeTable {
+		return ComputeOutcomeTable(bracket, portfolios, ownershipSummaries, ownershipDetails, tournamentTeams, results, scoringRules, payouts, tieBreak, winProbs, maxRemainingGames)
+	})
+}
+
+// outcomeTableKey identifies what an outcome table is computed from: the
+// tournament and prediction batch, and a hash of the rest. Only the fields
+// the table depends on are hashed, so rereading unchanged rows keeps the key.
+// Holdings are hashed in order, as their order decides how returns add up.
+func outcomeTableKey(
+	predictionBatchID string,
+	bracket *models.BracketStructure,
+	portfolios []*models.Portfolio,
+	ownershipSummaries []*models.OwnershipSummary,
+	ownershipDetails []*models.OwnershipDetail,
+	tournamentTeams []*models.TournamentTeam,
+	results []*models.GameResult,
+	scoringRules []*models.ScoringRule,
+	payouts []*models.PoolPayout,
+	tieBreak *TieBreak,
+	maxRemainingGames int,
+) string {
+	h := fnv.New64a()
+	fmt.Fprintln(h, maxRemainingGames, len(bracket.Games), len(portfolios), len(ownershipSummaries), len(ownershipDetails), len(tournamentTeams), len(results), len(scoringRules), len(payouts))
+
+	gameIDs := make([]string, 0, len(bracket.Games))
+	for id := range bracket.Games {
+		gameIDs = append(gameIDs, id)
+	}
+	sort.Strings(gameIDs)
+	for _, id := range gameIDs {
+		g := bracket.Games[id]
+		if g == nil {
+			continue
+		}
+		fmt.Fprintln(h, g.GameID, g.Round, g.NextGameID, g.NextGameSlot, g.SortOrder)
+		for _, team := range []*models.BracketTeam{g.Team1, g.Team2, g.Winner} {
+			if team != nil {
+				fmt.Fprintln(h, team.TeamID, team.Seed)
+			} else {
+				fmt.Fprintln(h, "-")
+			}
+		}
+	}
+	for _, p := range portfolios {
+		if p != nil {
+			fmt.Fprintln(h, p.ID, p.CreatedAt.UnixNano())
+		}
+	}
+	for _, s := range ownershipSummaries {
+		fmt.Fprintln(h, s.ID, s.PortfolioID)
+	}
+	for _, od := range ownershipDetails {
+		fmt.Fprintln(h, od.PortfolioID, od.TeamID, od.OwnershipPercentage)
+	}
+	for _, tt := range tournamentTeams {
+		fmt.Fprintln(h, tt.ID, tt.Seed, tt.Wins, tt.Byes)
+	}
+	for _, r := range results {
+		fmt.Fprintln(h, r.GameID, r.WinnerTeamID, r.LoserTeamID)
+	}
+	for _, r := range scoringRules {
+		fmt.Fprintln(h, r.Kind, r.WinIndex, r.PointsAwarded)
+	}
+	for _, p := range payouts {
+		if p != nil {
+			fmt.Fprintln(h, p.Position, p.AmountCents)
+		}
+	}
+	if tieBreak != nil {
+		fmt.Fprintln(h, tieBreak.Rule)
+		hashSorted(h, tieBreak.ChampionPoints)
+		hashSorted(h, tieBreak.CreditsSpent)
+		hashSorted(h, tieBreak.SubmittedAt)
+	}
+
+	return fmt.Sprintf("%s:%s:%x", bracket.TournamentID, predictionBatchID, h.Sum64())
+}
+
+func hashSorted[V any](w io.Writer, m map[string]V) {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		fmt.Fprintln(w, k, m[k])
+	}
+}
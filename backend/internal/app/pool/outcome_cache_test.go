@@ -0,0 +1,64 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func outcomeTableFor(s *Service, predictionBatchID string, results []*models.GameResult) *OutcomeTable {
+	portfolios := []*models.Portfolio{testPortfolio("p1"), testPortfolio("p2")}
+	summaries := []*models.OwnershipSummary{ownershipSummary("os1", "p1"), ownershipSummary("os2", "p2")}
+	details := []*models.OwnershipDetail{ownershipDetail("os1", "A", 1.0), ownershipDetail("os2", "C", 1.0)}
+	tts := []*models.TournamentTeam{
+		tournamentTeam("A", 4, 1, false),
+		tournamentTeam("B", 4, 1, false),
+		tournamentTeam("C", 4, 1, false),
+		tournamentTeam("D", 4, 1, false),
+	}
+	rules := []*models.ScoringRule{scoringRule(6, 10), scoringRule(7, 20)}
+	payouts := []*models.PoolPayout{poolPayout(1, 1000)}
+	return s.GetOutcomeTable("pool-1", predictionBatchID, buildFinalFourWithChampionship(), portfolios, summaries, details, tts, results, rules, payouts, nil, nil, DefaultMaxExactRemainingGames)
+}
+
+func TestThatOutcomeTableIsReusedWhileItsInputsAreUnchanged(t *testing.T) {
+	// GIVEN a pool whose outcomes have been enumerated
+	s := New(Ports{})
+	first := outcomeTableFor(s, "batch-1", nil)
+
+	// WHEN asking again with the same results and predictions
+	second := outcomeTableFor(s, "batch-1", nil)
+
+	// THEN the same table is returned
+	if first != second {
+		t.Errorf("expected the cached table to be reused")
+	}
+}
+
+func TestThatOutcomeTableIsRecomputedForANewPredictionBatch(t *testing.T) {
+	// GIVEN a pool whose outcomes have been enumerated
+	s := New(Ports{})
+	first := outcomeTableFor(s, "batch-1", nil)
+
+	// WHEN a new prediction batch is loaded
+	second := outcomeTableFor(s, "batch-2", nil)
+
+	// THEN the table is computed again
+	if first == second {
+		t.Errorf("expected a new table for a new prediction batch")
+	}
+}
+
+func TestThatOutcomeTableIsRecomputedWhenAResultIsRecorded(t *testing.T) {
+	// GIVEN a pool whose outcomes have been enumerated
+	s := New(Ports{})
+	first := outcomeTableFor(s, "batch-1", nil)
+
+	// WHEN a result is recorded
+	second := outcomeTableFor(s, "batch-1", []*models.GameResult{{GameID: "final_four-1", WinnerTeamID: "A", LoserTeamID: "B"}})
+
+	// THEN the table is computed again
+	if first == second {
+		t.Errorf("expected a new table once a result is recorded")
+	}
+}
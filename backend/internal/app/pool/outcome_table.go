@@ -0,0 +1,430 @@
+package pool
+
+import (
+	"sort"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/scoring"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// OutcomeTable is every way a bracket's unplayed games can go, enumerated
+// once and summed up for each portfolio: its exact finish distribution, and
+// the outcomes and matchups behind its win scenarios. A table is not changed
+// once computed, so one can serve every viewer of a pool until the results,
+// predictions or holdings it was computed from change.
+type OutcomeTable struct {
+	games []exactGame
+	// slots are the teams that can reach each slot of each game.
+	slots [][2][]*models.BracketTeam
+	// matchups are the pairings that can happen, in slot order, and
+	// matchupOrder the order the enumeration first met them in.
+	matchups     []tableMatchup
+	matchupOrder []int
+
+	exact          *ExactOutcomes
+	portfolioIndex map[string]int
+	scenarios      []portfolioScenarios
+}
+
+// tableMatchup is a pairing of two teams in one remaining game.
+type tableMatchup struct {
+	game  int
+	teams [2]*models.BracketTeam
+}
+
+// portfolioScenarios is what a portfolio's win scenarios are built from.
+type portfolioScenarios struct {
+	// matchups are indexed like OutcomeTable.matchups.
+	matchups []matchupSums
+	// top are the likeliest outcomes that pay the portfolio, at most
+	// MaxWinScenarios; count is how many pay it in all.
+	top   []scenarioCandidate
+	count int
+}
+
+// scenarioCandidate is an outcome that pays a portfolio. Bit i of winners
+// is set when the team in game i's second slot won it.
+type scenarioCandidate struct {
+	prob           float64
+	outcome        int
+	winners        uint64
+	finishPosition int
+	isTied         bool
+	payoutCents    int
+}
+
+// maxTableGames is the most remaining games a scenario's winners fit in.
+const maxTableGames = 64
+
+// ComputeOutcomeTable enumerates every way the bracket's unplayed games can
+// go and scores each on top of each team's results so far. winProbs holds
+// the chance the first team of a pair beats the second; a pair missing from
+// it is a coin flip. It returns nil when no games remain or more than
+// maxRemainingGames do. Ties are broken as in ComputeFinalFourOutcomes.
+func ComputeOutcomeTable(
+	bracket *models.BracketStructure,
+	portfolios []*models.Portfolio,
+	ownershipSummaries []*models.OwnershipSummary,
+	ownershipDetails []*models.OwnershipDetail,
+	tournamentTeams []*models.TournamentTeam,
+	results []*models.GameResult,
+	scoringRules []*models.ScoringRule,
+	payouts []*models.PoolPayout,
+	tieBreak *TieBreak,
+	winProbs map[models.TeamPair]float64,
+	maxRemainingGames int,
+) *OutcomeTable {
+	if bracket == nil {
+		return nil
+	}
+	games := remainingGames(bracket)
+	if len(games) == 0 || len(games) > maxRemainingGames || len(games) > maxTableGames {
+		return nil
+	}
+
+	e := newOutcomeEnumerator(games, portfolios, ownershipSummaries, ownershipDetails, tournamentTeams, results, scoringRules, payouts, tieBreak, winProbs)
+	e.enumerate(0, 1)
+	return e.table
+}
+
+// Exact returns each portfolio's exact finish distribution.
+func (t *OutcomeTable) Exact() *ExactOutcomes {
+	if t == nil {
+		return nil
+	}
+	return t.exact
+}
+
+// slotTeams returns the teams playing game i given the winners so far, nil
+// for a slot not yet filled.
+func (t *OutcomeTable) slotTeams(i int, winners []*models.BracketTeam) (*models.BracketTeam, *models.BracketTeam) {
+	g := t.games[i]
+	team1, team2 := g.teams[0], g.teams[1]
+	if team1 == nil && g.prev[0] >= 0 {
+		team1 = winners[g.prev[0]]
+	}
+	if team2 == nil && g.prev[1] >= 0 {
+		team2 = winners[g.prev[1]]
+	}
+	return team1, team2
+}
+
+// winners returns the winner of each game in a scenario, nil for a game
+// that cannot be played.
+func (t *OutcomeTable) winners(c scenarioCandidate) []*models.BracketTeam {
+	winners := make([]*models.BracketTeam, len(t.games))
+	for i := range t.games {
+		team1, team2 := t.slotTeams(i, winners)
+		if team1 == nil || team2 == nil {
+			continue
+		}
+		winners[i] = team1
+		if c.winners&(1<<i) != 0 {
+			winners[i] = team2
+		}
+	}
+	return winners
+}
+
+// holding is a portfolio's share of a team, both by index.
+type holding struct {
+	team      int
+	portfolio int
+	share     float64
+}
+
+// outcomeEnumerator walks every way the remaining games can go. Each team's
+// points are kept up to date as games are decided, so scoring an outcome is
+// a sum over the holdings rather than a fresh scoring of every run.
+type outcomeEnumerator struct {
+	table    *OutcomeTable
+	final    int
+	rules    []scoring.Rule
+	winProbs map[models.TeamPair]float64
+
+	// seeds, progress and points are by team index: each team's own seed,
+	// the rounds it has advanced in this outcome and the points that earns.
+	seeds    []int
+	progress []int
+	points   []int
+	// slotTeams are the team indexes of OutcomeTable.slots, -1 for a team
+	// without a run, and matchupBase is the index of each game's first
+	// matchup.
+	slotTeams   [][2][]int
+	matchupBase []int
+
+	// holdings are in ownership details order, so returns add up exactly as
+	// computeReturnsByPortfolio adds them; holdingsByTeam indexes them.
+	holdings       []holding
+	holdingsByTeam [][]int
+	returns        []float64
+
+	ranker   *standingsRanker
+	tieBreak *TieBreak
+	// championTieBreak is set when ties are broken by champion points,
+	// which are then recounted for each champion.
+	championTieBreak *TieBreak
+	championPoints   map[string]float64
+
+	// The outcome being enumerated: each game's winner, its team index, the
+	// slot it won from, its position among the teams that could reach the
+	// next slot and the matchup it was, or -1 when it cannot be played.
+	winners      []*models.BracketTeam
+	winnerTeam   []int
+	winnerSlot   []int
+	winnerPos    []int
+	matchup      []int
+	winnerBits   uint64
+	matchupsSeen []bool
+}
+
+func newOutcomeEnumerator(
+	games []exactGame,
+	portfolios []*models.Portfolio,
+	ownershipSummaries []*models.OwnershipSummary,
+	ownershipDetails []*models.OwnershipDetail,
+	tournamentTeams []*models.TournamentTeam,
+	results []*models.GameResult,
+	scoringRules []*models.ScoringRule,
+	payouts []*models.PoolPayout,
+	tieBreak *TieBreak,
+	winProbs map[models.TeamPair]float64,
+) *outcomeEnumerator {
+	ranker := newStandingsRanker(portfolios, payouts, false)
+	t := &OutcomeTable{
+		games:          games,
+		slots:          make([][2][]*models.BracketTeam, len(games)),
+		exact:          &ExactOutcomes{RemainingGames: len(games)},
+		portfolioIndex: make(map[string]int, len(ranker.portfolios)),
+		scenarios:      make([]portfolioScenarios, len(ranker.portfolios)),
+	}
+	for i, p := range ranker.portfolios {
+		t.portfolioIndex[p.ID] = i
+		t.exact.Portfolios = append(t.exact.Portfolios, &ExactPortfolioOutcome{PortfolioID: p.ID, FinishProbabilities: make([]float64, len(ranker.portfolios))})
+	}
+
+	e := &outcomeEnumerator{
+		table:       t,
+		final:       -1,
+		rules:       scoring.FromModels(scoringRules),
+		winProbs:    winProbs,
+		slotTeams:   make([][2][]int, len(games)),
+		matchupBase: make([]int, len(games)),
+		returns:     make([]float64, len(ranker.portfolios)),
+		ranker:      ranker,
+		tieBreak:    tieBreak,
+		winners:     make([]*models.BracketTeam, len(games)),
+		winnerTeam:  make([]int, len(games)),
+		winnerSlot:  make([]int, len(games)),
+		winnerPos:   make([]int, len(games)),
+		matchup:     make([]int, len(games)),
+	}
+	if tieBreak.rule() == models.TieBreakerChampionPoints {
+		e.championPoints = make(map[string]float64)
+		e.championTieBreak = tieBreak.WithChampionPoints(e.championPoints)
+	}
+
+	runs := scoring.RunsFromResults(tournamentTeams, results)
+	teamIndex := make(map[string]int, len(runs))
+	for _, tt := range tournamentTeams {
+		run, ok := runs[tt.ID]
+		if !ok {
+			continue
+		}
+		if _, ok := teamIndex[tt.ID]; ok {
+			continue
+		}
+		teamIndex[tt.ID] = len(e.seeds)
+		e.seeds = append(e.seeds, run.Seed)
+		e.progress = append(e.progress, run.Wins+run.Byes)
+		e.points = append(e.points, scoring.PointsForRun(e.rules, run))
+	}
+	e.holdingsByTeam = make([][]int, len(e.seeds))
+
+	summaryToPortfolio := buildSummaryToPortfolioMap(ownershipSummaries)
+	for _, od := range ownershipDetails {
+		team, ok := teamIndex[od.TeamID]
+		if !ok {
+			continue
+		}
+		portfolio, ok := t.portfolioIndex[summaryToPortfolio[od.PortfolioID]]
+		if !ok {
+			continue
+		}
+		e.holdingsByTeam[team] = append(e.holdingsByTeam[team], len(e.holdings))
+		e.holdings = append(e.holdings, holding{team: team, portfolio: portfolio, share: od.OwnershipPercentage})
+	}
+
+	// A feeder precedes the game it feeds, so its slots are known by then.
+	for i, g := range games {
+		if g.game.NextGameID == "" {
+			e.final = i
+		}
+		for s := 0; s < 2; s++ {
+			switch {
+			case g.teams[s] != nil:
+				t.slots[i][s] = []*models.BracketTeam{g.teams[s]}
+			case g.prev[s] >= 0:
+				prev := t.slots[g.prev[s]]
+				t.slots[i][s] = append(append([]*models.BracketTeam(nil), prev[0]...), prev[1]...)
+			}
+			for _, team := range t.slots[i][s] {
+				idx, ok := teamIndex[team.TeamID]
+				if !ok {
+					idx = -1
+				}
+				e.slotTeams[i][s] = append(e.slotTeams[i][s], idx)
+			}
+		}
+		e.matchupBase[i] = len(t.matchups)
+		for _, team1 := range t.slots[i][0] {
+			for _, team2 := range t.slots[i][1] {
+				t.matchups = append(t.matchups, tableMatchup{game: i, teams: [2]*models.BracketTeam{team1, team2}})
+			}
+		}
+	}
+	e.matchupsSeen = make([]bool, len(t.matchups))
+	for p := range t.scenarios {
+		t.scenarios[p].matchups = make([]matchupSums, len(t.matchups))
+	}
+	return e
+}
+
+func (e *outcomeEnumerator) enumerate(i int, prob float64) {
+	t := e.table
+	if i == len(t.games) {
+		e.visit(prob)
+		return
+	}
+
+	// pos is each slot's team by its position among those that can reach it.
+	g := t.games[i]
+	pos := [2]int{-1, -1}
+	for s := 0; s < 2; s++ {
+		if g.teams[s] != nil {
+			pos[s] = 0
+		} else if prev := g.prev[s]; prev >= 0 && e.winners[prev] != nil {
+			pos[s] = e.winnerPos[prev]
+		}
+	}
+	if pos[0] < 0 || pos[1] < 0 {
+		e.winners[i] = nil
+		e.matchup[i] = -1
+		e.enumerate(i+1, prob)
+		return
+	}
+
+	teams := [2]*models.BracketTeam{t.slots[i][0][pos[0]], t.slots[i][1][pos[1]]}
+	e.matchup[i] = e.matchupBase[i] + pos[0]*len(t.slots[i][1]) + pos[1]
+	p1 := exactWinProb(e.winProbs, teams[0].TeamID, teams[1].TeamID)
+	for side, p := range [2]float64{p1, 1 - p1} {
+		if p <= 0 {
+			continue
+		}
+		e.winners[i] = teams[side]
+		e.winnerSlot[i] = side
+		e.winnerPos[i] = pos[side]
+		if side == 1 {
+			e.winnerPos[i] += len(t.slots[i][0])
+			e.winnerBits |= 1 << i
+		} else {
+			e.winnerBits &^= 1 << i
+		}
+
+		team := e.slotTeams[i][side][pos[side]]
+		e.winnerTeam[i] = team
+		delta := 0
+		if team >= 0 {
+			e.progress[team]++
+			delta = scoring.WinPoints(e.rules, e.progress[team], e.seeds[team], teams[1-side].Seed)
+			e.points[team] += delta
+		}
+		e.enumerate(i+1, prob*p)
+		if team >= 0 {
+			e.points[team] -= delta
+			e.progress[team]--
+		}
+	}
+}
+
+// visit scores the outcome enumerated and adds it to the table.
+func (e *outcomeEnumerator) visit(prob float64) {
+	t := e.table
+	t.exact.Outcomes++
+
+	clear(e.returns)
+	for _, h := range e.holdings {
+		e.returns[h.portfolio] += h.share * float64(e.points[h.team])
+	}
+	tb := e.tieBreak
+	if e.championTieBreak != nil && e.final >= 0 && e.winners[e.final] != nil {
+		clear(e.championPoints)
+		if champion := e.winnerTeam[e.final]; champion >= 0 {
+			for _, k := range e.holdingsByTeam[champion] {
+				h := e.holdings[k]
+				e.championPoints[e.ranker.portfolios[h.portfolio].ID] += h.share * float64(e.points[h.team])
+			}
+		}
+		tb = e.championTieBreak
+	}
+	e.ranker.rank(e.returns, tb)
+
+	for _, m := range e.matchup {
+		if m >= 0 && !e.matchupsSeen[m] {
+			e.matchupsSeen[m] = true
+			t.matchupOrder = append(t.matchupOrder, m)
+		}
+	}
+
+	for p, s := range e.ranker.byPortfolio {
+		payout := float64(s.PayoutCents)
+		inMoney := 0.0
+		if s.InTheMoney {
+			inMoney = 1
+		}
+
+		o := t.exact.Portfolios[p]
+		o.FinishProbabilities[s.FinishPosition-1] += prob
+		o.ExpectedReturns += prob * s.TotalReturns
+		o.ExpectedPayoutCents += prob * payout
+		o.PInTheMoney += prob * inMoney
+
+		sc := &t.scenarios[p]
+		for i, m := range e.matchup {
+			if m < 0 {
+				continue
+			}
+			sums := &sc.matchups[m]
+			slot := e.winnerSlot[i]
+			sums.prob[slot] += prob
+			sums.payout[slot] += prob * payout
+			sums.inMoney[slot] += prob * inMoney
+		}
+
+		if s.InTheMoney {
+			sc.count++
+			sc.add(scenarioCandidate{
+				prob:           prob,
+				outcome:        t.exact.Outcomes,
+				winners:        e.winnerBits,
+				finishPosition: s.FinishPosition,
+				isTied:         s.IsTied,
+				payoutCents:    s.PayoutCents,
+			})
+		}
+	}
+}
+
+// add keeps c if it is among the MaxWinScenarios likeliest outcomes so far.
+// Of equally likely outcomes, the ones enumerated first are kept.
+func (sc *portfolioScenarios) add(c scenarioCandidate) {
+	if len(sc.top) == MaxWinScenarios && c.prob <= sc.top[len(sc.top)-1].prob {
+		return
+	}
+	k := sort.Search(len(sc.top), func(k int) bool { return sc.top[k].prob < c.prob })
+	if len(sc.top) < MaxWinScenarios {
+		sc.top = append(sc.top, scenarioCandidate{})
+	}
+	copy(sc.top[k+1:], sc.top[k:])
+	sc.top[k] = c
+}
@@ -4,7 +4,6 @@ import (
 	"context"
 	"fmt"
 	"math"
-	"sort"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
@@ -32,52 +31,154 @@ func (s *Service) GetPortfolios(ctx context.Context, poolID string) ([]*models.P
 		return nil, nil, fmt.Errorf("getting payouts: %w", err)
 	}
 
-	standings := ComputeStandings(portfolios, returnsByPortfolio, payouts)
+	tieBreak, err := s.GetTieBreak(ctx, poolID, portfolios)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	standings := ComputeStandings(portfolios, returnsByPortfolio, payouts, tieBreak)
 	return portfolios, standings, nil
 }
 
+// GetTieBreak loads what the pool's tie-breaker needs to rank the given
+// portfolios. Split pools need nothing beyond the rule.
+func (s *Service) GetTieBreak(ctx context.Context, poolID string, portfolios []*models.Portfolio) (*TieBreak, error) {
+	pool, err := s.ports.Pools.GetByID(ctx, poolID)
+	if err != nil {
+		return nil, fmt.Errorf("getting pool: %w", err)
+	}
+	if pool.TieBreaker == "" || pool.TieBreaker == models.TieBreakerSplit {
+		return NewTieBreak(pool, nil, nil), nil
+	}
+
+	portfolioIDs := make([]string, 0, len(portfolios))
+	for _, p := range portfolios {
+		if p != nil {
+			portfolioIDs = append(portfolioIDs, p.ID)
+		}
+	}
+
+	investmentsByPortfolio, err := s.ports.Portfolios.GetInvestmentsByPortfolioIDs(ctx, portfolioIDs)
+	if err != nil {
+		return nil, fmt.Errorf("getting investments: %w", err)
+	}
+	var investments []*models.Investment
+	for _, id := range portfolioIDs {
+		investments = append(investments, investmentsByPortfolio[id]...)
+	}
+
+	var championPoints map[string]float64
+	if pool.TieBreaker == models.TieBreakerChampionPoints {
+		detailsByPortfolio, err := s.ports.OwnershipReader.GetOwnershipDetailsByPortfolioIDs(ctx, portfolioIDs)
+		if err != nil {
+			return nil, fmt.Errorf("getting ownership details: %w", err)
+		}
+		var details []*models.OwnershipDetail
+		for _, id := range portfolioIDs {
+			details = append(details, detailsByPortfolio[id]...)
+		}
+		championPoints = ChampionPointsFromDetails(details)
+	}
+
+	return NewTieBreak(pool, investments, championPoints), nil
+}
+
+// rankedPortfolio is a portfolio with the returns it is ranked by.
+type rankedPortfolio struct {
+	portfolio *models.Portfolio
+	returns   float64
+	// index is the portfolio's place in the ranker's portfolios.
+	index int
+}
+
 // ComputeStandings computes finish positions and payouts from portfolios, their returns, and payout rules.
 // Returns standings sorted by returns descending. Does not mutate portfolios.
+// Returns come from derived.ownership_details, so they already reflect the
+// pool's ownership mode and its handling of unclaimed teams.
+// Portfolios tied on returns are ordered by the tie-breaker; those it cannot
+// separate share a finish position and split its payouts evenly. A nil
+// tie-breaker splits every tie. Each tied portfolio carries the resolution.
 func ComputeStandings(
 	portfolios []*models.Portfolio,
 	returnsByPortfolio map[string]float64,
 	payouts []*models.PoolPayout,
+	tieBreak *TieBreak,
 ) []*models.PortfolioStanding {
 	if portfolios == nil {
 		return nil
 	}
 
-	type portfolioWithReturns struct {
-		portfolio *models.Portfolio
-		returns   float64
+	r := newStandingsRanker(portfolios, payouts, true)
+	returns := make([]float64, len(r.portfolios))
+	for i, p := range r.portfolios {
+		returns[i] = returnsByPortfolio[p.ID]
 	}
+	return r.rank(returns, tieBreak)
+}
+
+// standingsRanker ranks a fixed set of portfolios as ComputeStandings does,
+// reusing its buffers from one ranking to the next so that enumerating
+// outcomes does not allocate standings for each one.
+type standingsRanker struct {
+	portfolios       []*models.Portfolio
+	payoutByPosition []int
+	// explain attaches the tie resolution to tied standings.
+	explain bool
+
+	sorted    []rankedPortfolio
+	subgroups [][]rankedPortfolio
+	standings []models.PortfolioStanding
+	ranked    []*models.PortfolioStanding
+	// byPortfolio holds each portfolio's standing, in portfolios order.
+	byPortfolio []*models.PortfolioStanding
+}
 
-	sorted := make([]portfolioWithReturns, 0, len(portfolios))
+func newStandingsRanker(portfolios []*models.Portfolio, payouts []*models.PoolPayout, explain bool) *standingsRanker {
+	r := &standingsRanker{explain: explain}
 	for _, p := range portfolios {
-		if p == nil {
-			continue
+		if p != nil {
+			r.portfolios = append(r.portfolios, p)
 		}
-		sorted = append(sorted, portfolioWithReturns{portfolio: p, returns: returnsByPortfolio[p.ID]})
 	}
+	n := len(r.portfolios)
 
-	sort.SliceStable(sorted, func(i, j int) bool {
-		if sorted[i].returns == sorted[j].returns {
-			return sorted[i].portfolio.CreatedAt.After(sorted[j].portfolio.CreatedAt)
-		}
-		return sorted[i].returns > sorted[j].returns
-	})
-
-	payoutByPosition := map[int]int{}
+	// Positions past the last portfolio can never be paid.
+	r.payoutByPosition = make([]int, n+1)
 	for _, p := range payouts {
-		if p == nil {
+		if p == nil || p.Position < 1 || p.Position > n {
 			continue
 		}
-		payoutByPosition[p.Position] = p.AmountCents
+		r.payoutByPosition[p.Position] = p.AmountCents
 	}
 
-	const epsilon = 0.0001
-	standings := make([]*models.PortfolioStanding, len(sorted))
+	r.sorted = make([]rankedPortfolio, n)
+	for i, p := range r.portfolios {
+		r.sorted[i] = rankedPortfolio{portfolio: p, index: i}
+	}
+	r.standings = make([]models.PortfolioStanding, n)
+	r.ranked = make([]*models.PortfolioStanding, n)
+	r.byPortfolio = make([]*models.PortfolioStanding, n)
+	return r
+}
 
+// rank ranks the portfolios by returns, given in portfolios order. The
+// standings it returns are overwritten by the next call.
+func (r *standingsRanker) rank(returns []float64, tieBreak *TieBreak) []*models.PortfolioStanding {
+	// The portfolios are still in the last ranking's order, which is close
+	// to this one's when outcomes are ranked one after another, so an
+	// insertion sort has little to move. Falling back on the portfolios'
+	// order makes it rank as a stable sort would.
+	sorted := r.sorted
+	for k := range sorted {
+		sorted[k].returns = returns[sorted[k].index]
+	}
+	for k := 1; k < len(sorted); k++ {
+		for m := k; m > 0 && ranksAhead(sorted[m], sorted[m-1]); m-- {
+			sorted[m], sorted[m-1] = sorted[m-1], sorted[m]
+		}
+	}
+
+	const epsilon = 0.0001
 	position := 1
 	for i := 0; i < len(sorted); {
 		j := i + 1
@@ -88,42 +189,67 @@ func ComputeStandings(
 			j++
 		}
 
-		groupSize := j - i
-		isTied := groupSize > 1
-
-		totalGroupPayout := 0
-		for pos := position; pos < position+groupSize; pos++ {
-			totalGroupPayout += payoutByPosition[pos]
+		if j-i == 1 {
+			r.place(sorted[i:j], position, nil)
+			position++
+			i = j
+			continue
 		}
 
-		base := 0
-		remainder := 0
-		if groupSize > 0 {
-			base = totalGroupPayout / groupSize
-			remainder = totalGroupPayout % groupSize
+		subgroups := tieBreak.order(sorted[i:j], r.subgroups[:0])
+		r.subgroups = subgroups
+		var resolution *models.TieResolution
+		if r.explain {
+			resolution = tieBreak.resolution(subgroups, position, r.payoutForPositions(position, j-i))
 		}
-
-		for k := 0; k < groupSize; k++ {
-			payoutCents := base
-			if remainder > 0 {
-				payoutCents++
-				remainder--
-			}
-			standings[i+k] = &models.PortfolioStanding{
-				PortfolioID:    sorted[i+k].portfolio.ID,
-				TotalReturns:   sorted[i+k].returns,
-				FinishPosition: position,
-				IsTied:         isTied,
-				PayoutCents:    payoutCents,
-				InTheMoney:     payoutCents > 0,
-			}
+		for _, sub := range subgroups {
+			r.place(sub, position, resolution)
+			position += len(sub)
 		}
-
-		position += groupSize
 		i = j
 	}
 
-	return standings
+	return r.ranked
+}
+
+// ranksAhead orders portfolios by returns, then the most recently created
+// first, then their order in the ranker.
+func ranksAhead(a, b rankedPortfolio) bool {
+	if a.returns != b.returns {
+		return a.returns > b.returns
+	}
+	if !a.portfolio.CreatedAt.Equal(b.portfolio.CreatedAt) {
+		return a.portfolio.CreatedAt.After(b.portfolio.CreatedAt)
+	}
+	return a.index < b.index
+}
+
+// place records the standings of portfolios sharing a finish position.
+func (r *standingsRanker) place(sub []rankedPortfolio, position int, resolution *models.TieResolution) {
+	total := r.payoutForPositions(position, len(sub))
+	for k, rp := range sub {
+		share := splitCents(total, len(sub), k)
+		s := &r.standings[position-1+k]
+		*s = models.PortfolioStanding{
+			PortfolioID:    rp.portfolio.ID,
+			TotalReturns:   rp.returns,
+			FinishPosition: position,
+			IsTied:         len(sub) > 1,
+			PayoutCents:    share,
+			InTheMoney:     share > 0,
+			TieResolution:  resolution,
+		}
+		r.ranked[position-1+k] = s
+		r.byPortfolio[rp.index] = s
+	}
+}
+
+func (r *standingsRanker) payoutForPositions(first, count int) int {
+	total := 0
+	for pos := first; pos < first+count && pos < len(r.payoutByPosition); pos++ {
+		total += r.payoutByPosition[pos]
+	}
+	return total
 }
 
 func (s *Service) GetInvestments(ctx context.Context, portfolioID string) ([]*models.Investment, error) {
@@ -186,6 +312,10 @@ func (s *Service) GetDistinctUserIDsByPool(ctx context.Context, poolID string) (
 	return s.ports.Portfolios.GetDistinctUserIDsByPool(ctx, poolID)
 }
 
+func (s *Service) CountPortfoliosByUser(ctx context.Context, poolID, userID string) (int, error) {
+	return s.ports.Portfolios.CountPortfoliosByUser(ctx, poolID, userID)
+}
+
 func (s *Service) GetPayouts(ctx context.Context, poolID string) ([]*models.PoolPayout, error) {
 	return s.ports.Payouts.GetPayouts(ctx, poolID)
 }
@@ -194,6 +324,26 @@ func (s *Service) ReplacePayouts(ctx context.Context, poolID string, payouts []*
 	return s.ports.Payouts.ReplacePayouts(ctx, poolID, payouts)
 }
 
+func (s *Service) GetSidePots(ctx context.Context, poolID string) ([]*models.SidePot, error) {
+	return s.ports.SidePots.ListSidePots(ctx, poolID)
+}
+
+func (s *Service) ReplaceSidePots(ctx context.Context, poolID string, pots []*models.SidePot) error {
+	return s.ports.SidePots.ReplaceSidePots(ctx, poolID, pots)
+}
+
+// GetBiddingWindows returns the pool's bidding windows, earliest first.
+func (s *Service) GetBiddingWindows(ctx context.Context, poolID string) ([]*models.BiddingWindow, error) {
+	if s.ports.BiddingWindows == nil {
+		return nil, nil
+	}
+	return s.ports.BiddingWindows.ListBiddingWindows(ctx, poolID)
+}
+
+func (s *Service) ReplaceBiddingWindows(ctx context.Context, poolID string, windows []*models.BiddingWindow) error {
+	return s.ports.BiddingWindows.ReplaceBiddingWindows(ctx, poolID, windows)
+}
+
 func (s *Service) CreateInvestmentSnapshot(ctx context.Context, snapshot *models.InvestmentSnapshot) error {
 	if s.ports.InvestmentSnapshots == nil {
 		return nil
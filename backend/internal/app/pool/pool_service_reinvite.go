@@ -24,6 +24,22 @@ func (s *Service) ReinviteFromPool(ctx context.Context, sourcePoolID string, new
 	if newPool.MaxInvestmentCredits == 0 {
 		newPool.MaxInvestmentCredits = source.MaxInvestmentCredits
 	}
+	if newPool.OwnershipMode == "" {
+		newPool.OwnershipMode = source.OwnershipMode
+		newPool.OwnershipCapPercent = source.OwnershipCapPercent
+	}
+	if newPool.UnclaimedMode == "" {
+		newPool.UnclaimedMode = source.UnclaimedMode
+	}
+	if newPool.TieBreaker == "" {
+		newPool.TieBreaker = source.TieBreaker
+	}
+	if newPool.EntryFeeCents == 0 {
+		newPool.EntryFeeCents = source.EntryFeeCents
+	}
+	if newPool.MaxPortfoliosPerUser == 0 {
+		newPool.MaxPortfoliosPerUser = source.MaxPortfoliosPerUser
+	}
 
 	sourceScoringRules, err := s.ports.ScoringRules.GetScoringRules(ctx, sourcePoolID)
 	if err != nil {
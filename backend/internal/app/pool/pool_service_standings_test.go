@@ -35,7 +35,7 @@ func standingsByID(standings []*models.PortfolioStanding) map[string]*models.Por
 }
 
 func TestThatComputeStandingsReturnsNilWhenPortfoliosNil(t *testing.T) {
-	standings := ComputeStandings(nil, nil, nil)
+	standings := ComputeStandings(nil, nil, nil, nil)
 	if standings != nil {
 		t.Fatalf("expected nil output")
 	}
@@ -46,7 +46,7 @@ func TestThatComputeStandingsSortsByTotalReturnsDescending(t *testing.T) {
 	p2 := newTestPortfolio("p2", time.Unix(2, 0))
 
 	returns := map[string]float64{"p1": 10, "p2": 20}
-	standings := ComputeStandings([]*models.Portfolio{p1, p2}, returns, nil)
+	standings := ComputeStandings([]*models.Portfolio{p1, p2}, returns, nil, nil)
 
 	if standings[0].PortfolioID != "p2" {
 		t.Fatalf("expected first standing to be p2, got %q", standings[0].PortfolioID)
@@ -58,7 +58,7 @@ func TestThatComputeStandingsSortsTiesByCreatedDescending(t *testing.T) {
 	pNew := newTestPortfolio("new", time.Unix(2, 0))
 
 	returns := map[string]float64{"old": 10, "new": 10}
-	standings := ComputeStandings([]*models.Portfolio{pOld, pNew}, returns, nil)
+	standings := ComputeStandings([]*models.Portfolio{pOld, pNew}, returns, nil, nil)
 
 	if standings[0].PortfolioID != "new" {
 		t.Fatalf("expected first standing to be new, got %q", standings[0].PortfolioID)
@@ -70,7 +70,7 @@ func TestThatComputeStandingsMarksTiesWithinEpsilon(t *testing.T) {
 	p2 := newTestPortfolio("p2", time.Unix(1, 0))
 
 	returns := map[string]float64{"p1": 10.00000, "p2": 10.00001}
-	byID := standingsByID(ComputeStandings([]*models.Portfolio{p1, p2}, returns, nil))
+	byID := standingsByID(ComputeStandings([]*models.Portfolio{p1, p2}, returns, nil, nil))
 
 	if !byID["p1"].IsTied {
 		t.Fatalf("expected p1 to be tied")
@@ -82,7 +82,7 @@ func TestThatComputeStandingsSetsFinishPositionOneForTopPortfolio(t *testing.T)
 	p2 := newTestPortfolio("p2", time.Unix(1, 0))
 
 	returns := map[string]float64{"p1": 20, "p2": 10}
-	byID := standingsByID(ComputeStandings([]*models.Portfolio{p2, p1}, returns, nil))
+	byID := standingsByID(ComputeStandings([]*models.Portfolio{p2, p1}, returns, nil, nil))
 
 	if byID["p1"].FinishPosition != 1 {
 		t.Fatalf("expected finish position 1, got %d", byID["p1"].FinishPosition)
@@ -98,7 +98,7 @@ func TestThatComputeStandingsSplitsPayoutAcrossTieGroup(t *testing.T) {
 	pay1 := newTestPayout(1, 100)
 	pay2 := newTestPayout(2, 50)
 
-	byID := standingsByID(ComputeStandings([]*models.Portfolio{p1, p2}, returns, []*models.PoolPayout{pay1, pay2}))
+	byID := standingsByID(ComputeStandings([]*models.Portfolio{p1, p2}, returns, []*models.PoolPayout{pay1, pay2}, nil))
 
 	if byID["p1"].PayoutCents != 75 {
 		t.Fatalf("expected payout 75, got %d", byID["p1"].PayoutCents)
@@ -114,7 +114,7 @@ func TestThatComputeStandingsDistributesRemainderToEarlierPortfolioInSortedOrder
 	pay1 := newTestPayout(1, 100)
 	pay2 := newTestPayout(2, 99)
 
-	byID := standingsByID(ComputeStandings([]*models.Portfolio{p1, p2}, returns, []*models.PoolPayout{pay1, pay2}))
+	byID := standingsByID(ComputeStandings([]*models.Portfolio{p1, p2}, returns, []*models.PoolPayout{pay1, pay2}, nil))
 
 	if byID["p1"].PayoutCents != 100 {
 		t.Fatalf("expected payout 100, got %d", byID["p1"].PayoutCents)
@@ -128,7 +128,7 @@ func TestThatComputeStandingsSetsInTheMoneyWhenPayoutPositive(t *testing.T) {
 
 	pay1 := newTestPayout(1, 1)
 
-	byID := standingsByID(ComputeStandings([]*models.Portfolio{p1}, returns, []*models.PoolPayout{pay1}))
+	byID := standingsByID(ComputeStandings([]*models.Portfolio{p1}, returns, []*models.PoolPayout{pay1}, nil))
 
 	if !byID["p1"].InTheMoney {
 		t.Fatalf("expected in the money")
@@ -140,7 +140,7 @@ func TestThatComputeStandingsDoesNotMutateInputPortfolios(t *testing.T) {
 	origName := p1.Name
 
 	returns := map[string]float64{"p1": 10}
-	_ = ComputeStandings([]*models.Portfolio{p1}, returns, nil)
+	_ = ComputeStandings([]*models.Portfolio{p1}, returns, nil, nil)
 
 	if p1.Name != origName {
 		t.Fatalf("expected input portfolio to remain unmodified")
@@ -164,6 +164,7 @@ func TestThatThreeWayTiePoolsAndSplitsPayoutsEvenly(t *testing.T) {
 		[]*models.Portfolio{p1, p2, p3},
 		returns,
 		[]*models.PoolPayout{pay1, pay2, pay3},
+		nil,
 	))
 
 	// THEN each portfolio receives (300+150+150)/3 = 200 cents
@@ -189,6 +190,7 @@ func threeWayTieRemainderScenario() map[string]*models.PortfolioStanding {
 		[]*models.Portfolio{p1, p2, p3},
 		returns,
 		[]*models.PoolPayout{pay1, pay2, pay3odd},
+		nil,
 	))
 }
 
@@ -229,6 +231,7 @@ func tieOutsidePayoutScenario() map[string]*models.PortfolioStanding {
 		[]*models.Portfolio{p1, p2, p3, p4},
 		returns,
 		[]*models.PoolPayout{pay1, pay2},
+		nil,
 	))
 }
 
@@ -271,6 +274,7 @@ func TestThatAllPortfoliosTiedResultsInEvenPayoutSplit(t *testing.T) {
 		[]*models.Portfolio{p1, p2, p3, p4},
 		returns,
 		[]*models.PoolPayout{pay1, pay2, pay3, pay4},
+		nil,
 	))
 
 	// THEN total pool is 1000, each gets 1000/4 = 250
@@ -291,6 +295,7 @@ func singlePortfolioPoolScenario() map[string]*models.PortfolioStanding {
 		[]*models.Portfolio{p1},
 		returns,
 		[]*models.PoolPayout{pay1},
+		nil,
 	))
 }
 
@@ -332,6 +337,7 @@ func tieSpanningPayoutBoundaryScenario() map[string]*models.PortfolioStanding {
 		[]*models.Portfolio{p1, p2, p3, p4},
 		returns,
 		[]*models.PoolPayout{pay1, pay2, pay3},
+		nil,
 	))
 }
 
@@ -369,6 +375,7 @@ func TestThatNonTiedPortfolioAfterTieGroupGetsCorrectFinishPosition(t *testing.T
 		[]*models.Portfolio{p1, p2, p3},
 		returns,
 		nil,
+		nil,
 	))
 
 	// THEN the portfolio after the tie group gets finish position 3 (skips position 2)
@@ -382,7 +389,7 @@ func TestThatEmptyPortfoliosSliceReturnsEmptyResults(t *testing.T) {
 	portfolios := []*models.Portfolio{}
 
 	// WHEN computing standings
-	standings := ComputeStandings(portfolios, nil, nil)
+	standings := ComputeStandings(portfolios, nil, nil, nil)
 
 	// THEN returns empty non-nil output
 	if len(standings) != 0 {
@@ -12,21 +12,24 @@ type Ports struct {
 	Pools                ports.PoolRepository
 	Portfolios           ports.PortfolioRepository
 	Payouts              ports.PayoutRepository
+	SidePots             ports.SidePotRepository
+	BiddingWindows       ports.BiddingWindowRepository
 	OwnershipReader      ports.OwnershipReader
 	ScoringRules         ports.ScoringRuleRepository
 	TeamReader           ports.TournamentTeamReader
 	PoolInvitations      ports.PoolInvitationRepository
-	InvestmentSnapshots  ports.InvestmentSnapshotWriter
+	InvestmentSnapshots  ports.InvestmentSnapshotRepository
 }
 
 // Service handles business logic for investment pools
 type Service struct {
-	ports Ports
+	ports    Ports
+	outcomes *outcomeCache
 }
 
 // New creates a new Service
 func New(ports Ports) *Service {
-	return &Service{ports: ports}
+	return &Service{ports: ports, outcomes: newOutcomeCache()}
 }
 
 // CreatePoolWithScoringRules creates a new pool and its associated scoring rules.
@@ -38,6 +41,7 @@ func (s *Service) CreatePoolWithScoringRules(ctx context.Context, pool *models.P
 	for _, r := range rules {
 		rule := &models.ScoringRule{
 			PoolID:        pool.ID,
+			Kind:          r.Kind,
 			WinIndex:      r.WinIndex,
 			PointsAwarded: r.PointsAwarded,
 		}
@@ -0,0 +1,121 @@
+package pool
+
+import (
+	"github.com/andrewcopp/Calcutta/backend/internal/app/scoring"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// SidePotStandings is one side pot's leaderboard. TotalReturns on each
+// standing holds the pot's metric, not the portfolio's points.
+type SidePotStandings struct {
+	Pot       *models.SidePot
+	Standings []*models.PortfolioStanding
+}
+
+// ComputeSidePotStandings ranks the pool's portfolios for each side pot and
+// pays out its position table, breaking ties with the pool's tie-breaker, the
+// same way ComputeStandings does for the main pot. Ownership percentages come from the
+// ownership details, so every metric follows the pool's ownership and
+// unclaimed-team modes.
+func ComputeSidePotStandings(
+	pots []*models.SidePot,
+	portfolios []*models.Portfolio,
+	investments []*models.Investment,
+	ownershipDetails []*models.OwnershipDetail,
+	tournamentTeams []*models.TournamentTeam,
+	results []*models.GameResult,
+	scoringRules []*models.ScoringRule,
+	tieBreak *TieBreak,
+) []*SidePotStandings {
+	rules := scoring.FromModels(scoringRules)
+	runs := scoring.RunsFromResults(tournamentTeams, results)
+
+	out := make([]*SidePotStandings, 0, len(pots))
+	for _, pot := range pots {
+		if pot == nil {
+			continue
+		}
+		values := sidePotValues(pot, investments, ownershipDetails, runs, rules)
+
+		// ComputeStandings ranks highest first, so rank fewest points by
+		// their negation and restore the points afterwards.
+		ranked := values
+		if pot.Metric == models.SidePotMetricFewestPoints {
+			ranked = make(map[string]float64, len(portfolios))
+			for _, p := range portfolios {
+				if p != nil {
+					ranked[p.ID] = -values[p.ID]
+				}
+			}
+		}
+
+		standings := ComputeStandings(portfolios, ranked, sidePotPayouts(pot), tieBreak)
+		for _, s := range standings {
+			s.TotalReturns = values[s.PortfolioID]
+		}
+		out = append(out, &SidePotStandings{Pot: pot, Standings: standings})
+	}
+	return out
+}
+
+// sidePotValues returns each portfolio's value for the pot's metric.
+func sidePotValues(
+	pot *models.SidePot,
+	investments []*models.Investment,
+	ownershipDetails []*models.OwnershipDetail,
+	runs map[string]scoring.Run,
+	rules []scoring.Rule,
+) map[string]float64 {
+	teamPoints := func(teamID string) float64 {
+		run := runs[teamID]
+		if pot.Metric == models.SidePotMetricPointsThroughRound && pot.ThroughWinIndex != nil {
+			run = run.Capped(*pot.ThroughWinIndex)
+		}
+		return float64(scoring.PointsForRun(rules, run))
+	}
+
+	values := make(map[string]float64)
+	if pot.Metric != models.SidePotMetricBestTeamROI {
+		for _, od := range ownershipDetails {
+			if od == nil {
+				continue
+			}
+			values[od.PortfolioID] += od.OwnershipPercentage * teamPoints(od.TeamID)
+		}
+		return values
+	}
+
+	type holding struct {
+		portfolioID string
+		teamID      string
+	}
+	shares := make(map[holding]float64, len(ownershipDetails))
+	for _, od := range ownershipDetails {
+		if od == nil {
+			continue
+		}
+		shares[holding{portfolioID: od.PortfolioID, teamID: od.TeamID}] = od.OwnershipPercentage
+	}
+	for _, inv := range investments {
+		if inv == nil || inv.Credits <= 0 {
+			continue
+		}
+		share := shares[holding{portfolioID: inv.PortfolioID, teamID: inv.TeamID}]
+		roi := share * teamPoints(inv.TeamID) / float64(inv.Credits)
+		if current, ok := values[inv.PortfolioID]; !ok || roi > current {
+			values[inv.PortfolioID] = roi
+		}
+	}
+	return values
+}
+
+func sidePotPayouts(pot *models.SidePot) []*models.PoolPayout {
+	payouts := make([]*models.PoolPayout, 0, len(pot.Payouts))
+	for _, p := range pot.Payouts {
+		if p == nil {
+			continue
+		}
+		payouts = append(payouts, &models.PoolPayout{PoolID: pot.PoolID, Position: p.Position, AmountCents: p.AmountCents})
+	}
+	return payouts
+}
@@ -0,0 +1,72 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func newSidePotFixture() ([]*models.Portfolio, []*models.Investment, []*models.OwnershipDetail, []*models.TournamentTeam, []*models.ScoringRule) {
+	portfolios := []*models.Portfolio{{ID: "p1"}, {ID: "p2"}}
+	investments := []*models.Investment{
+		newTestInvestment("i1", "p1", "team1", 10),
+		newTestInvestment("i2", "p1", "team2", 40),
+		newTestInvestment("i3", "p2", "team3", 50),
+	}
+	details := []*models.OwnershipDetail{
+		{PortfolioID: "p1", TeamID: "team1", OwnershipPercentage: 1},
+		{PortfolioID: "p1", TeamID: "team2", OwnershipPercentage: 1},
+		{PortfolioID: "p2", TeamID: "team3", OwnershipPercentage: 1},
+	}
+	// team1 wins once, team2 never, team3 three times
+	teams := []*models.TournamentTeam{{ID: "team1", Wins: 1}, {ID: "team2"}, {ID: "team3", Wins: 3}}
+	rules := []*models.ScoringRule{
+		{WinIndex: 1, PointsAwarded: 10},
+		{WinIndex: 2, PointsAwarded: 20},
+		{WinIndex: 3, PointsAwarded: 40},
+	}
+	return portfolios, investments, details, teams, rules
+}
+
+func TestThatBestTeamROIRanksByPointsPerCreditOnOneTeam(t *testing.T) {
+	// GIVEN p1 earned 10 points on 10 credits and p2 earned 70 points on 50 credits
+	portfolios, investments, details, teams, rules := newSidePotFixture()
+	pot := &models.SidePot{Name: "Best ROI", Metric: models.SidePotMetricBestTeamROI, Payouts: []*models.SidePotPayout{{Position: 1, AmountCents: 2000}}}
+
+	// WHEN computing side pot standings
+	got := ComputeSidePotStandings([]*models.SidePot{pot}, portfolios, investments, details, teams, nil, rules, nil)
+
+	// THEN p2 wins the pot
+	if got[0].Standings[0].PortfolioID != "p2" {
+		t.Errorf("expected p2 first, got %s", got[0].Standings[0].PortfolioID)
+	}
+}
+
+func TestThatPointsThroughRoundIgnoresLaterWins(t *testing.T) {
+	// GIVEN a pot counting only the first win, where team1 and team3 both won once
+	portfolios, investments, details, teams, rules := newSidePotFixture()
+	through := 1
+	pot := &models.SidePot{Name: "First Round", Metric: models.SidePotMetricPointsThroughRound, ThroughWinIndex: &through}
+
+	// WHEN computing side pot standings
+	got := ComputeSidePotStandings([]*models.SidePot{pot}, portfolios, investments, details, teams, nil, rules, nil)
+
+	// THEN p1 and p2 tie on 10 points
+	if !got[0].Standings[0].IsTied || got[0].Standings[0].TotalReturns != 10 {
+		t.Errorf("expected a tie on 10 points, got %+v", *got[0].Standings[0])
+	}
+}
+
+func TestThatFewestPointsPaysLastPlace(t *testing.T) {
+	// GIVEN a last-place pot where p1 scored 10 points and p2 scored 70
+	portfolios, investments, details, teams, rules := newSidePotFixture()
+	pot := &models.SidePot{Name: "Last Place", Metric: models.SidePotMetricFewestPoints, Payouts: []*models.SidePotPayout{{Position: 1, AmountCents: 1000}}}
+
+	// WHEN computing side pot standings
+	got := ComputeSidePotStandings([]*models.SidePot{pot}, portfolios, investments, details, teams, nil, rules, nil)
+
+	// THEN p1 takes the pot
+	if got[0].Standings[0].PortfolioID != "p1" || got[0].Standings[0].PayoutCents != 1000 {
+		t.Errorf("expected p1 to be paid 1000, got %+v", *got[0].Standings[0])
+	}
+}
@@ -0,0 +1,205 @@
+package pool
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// TieBreak holds what a pool's tie-breaker ranks portfolios tied on points
+// by. A nil TieBreak, or one with the split rule, splits ties evenly.
+type TieBreak struct {
+	Rule string
+	// ChampionPoints is each portfolio's points from the champion.
+	ChampionPoints map[string]float64
+	// CreditsSpent is each portfolio's total investment.
+	CreditsSpent map[string]int
+	// SubmittedAt is when each portfolio's bids were last changed. Portfolios
+	// without an entry fall back to their creation time.
+	SubmittedAt map[string]time.Time
+}
+
+// NewTieBreak builds the pool's tie-breaker from its portfolios' investments
+// and the points each portfolio earned from the champion.
+func NewTieBreak(pool *models.Pool, investments []*models.Investment, championPoints map[string]float64) *TieBreak {
+	rule := models.TieBreakerSplit
+	if pool != nil && pool.TieBreaker != "" {
+		rule = pool.TieBreaker
+	}
+
+	tb := &TieBreak{
+		Rule:           rule,
+		ChampionPoints: championPoints,
+		CreditsSpent:   make(map[string]int),
+		SubmittedAt:    make(map[string]time.Time),
+	}
+	for _, inv := range investments {
+		if inv == nil {
+			continue
+		}
+		tb.CreditsSpent[inv.PortfolioID] += inv.Credits
+		submitted := inv.UpdatedAt
+		if submitted.IsZero() {
+			submitted = inv.CreatedAt
+		}
+		if submitted.After(tb.SubmittedAt[inv.PortfolioID]) {
+			tb.SubmittedAt[inv.PortfolioID] = submitted
+		}
+	}
+	return tb
+}
+
+// WithChampionPoints returns a copy of the tie-breaker that scores the given
+// champion points, for ranking hypothetical outcomes.
+func (tb *TieBreak) WithChampionPoints(championPoints map[string]float64) *TieBreak {
+	if tb == nil {
+		return nil
+	}
+	out := *tb
+	out.ChampionPoints = championPoints
+	return &out
+}
+
+// ChampionPointsFromDetails sums each portfolio's returns from the team that
+// won the championship. It is empty until the championship has been played.
+func ChampionPointsFromDetails(ownershipDetails []*models.OwnershipDetail) map[string]float64 {
+	championProgress := models.RoundChampionship.MinProgressRequired() + 1
+	out := make(map[string]float64)
+	for _, od := range ownershipDetails {
+		if od == nil || od.Team == nil {
+			continue
+		}
+		if od.Team.Wins+od.Team.Byes >= championProgress {
+			out[od.PortfolioID] += od.ActualReturns
+		}
+	}
+	return out
+}
+
+func (tb *TieBreak) rule() string {
+	if tb == nil || tb.Rule == "" {
+		return models.TieBreakerSplit
+	}
+	return tb.Rule
+}
+
+// compare orders two tied portfolios: negative when a ranks ahead of b, zero
+// when the tie-breaker cannot separate them.
+func (tb *TieBreak) compare(a, b *models.Portfolio) int {
+	const epsilon = 0.0001
+	switch tb.rule() {
+	case models.TieBreakerChampionPoints:
+		diff := tb.ChampionPoints[b.ID] - tb.ChampionPoints[a.ID]
+		if math.Abs(diff) < epsilon {
+			return 0
+		}
+		if diff < 0 {
+			return -1
+		}
+		return 1
+	case models.TieBreakerFewestCredits:
+		return tb.CreditsSpent[a.ID] - tb.CreditsSpent[b.ID]
+	case models.TieBreakerEarliestSubmission:
+		return tb.submittedAt(a).Compare(tb.submittedAt(b))
+	default:
+		return 0
+	}
+}
+
+func (tb *TieBreak) submittedAt(p *models.Portfolio) time.Time {
+	if t, ok := tb.SubmittedAt[p.ID]; ok {
+		return t
+	}
+	return p.CreatedAt
+}
+
+// describe is the value the tie-breaker ranked a portfolio by, for the
+// audit trail.
+func (tb *TieBreak) describe(p *models.Portfolio) string {
+	switch tb.rule() {
+	case models.TieBreakerChampionPoints:
+		return fmt.Sprintf("%.2f champion points", tb.ChampionPoints[p.ID])
+	case models.TieBreakerFewestCredits:
+		return fmt.Sprintf("%d credits spent", tb.CreditsSpent[p.ID])
+	case models.TieBreakerEarliestSubmission:
+		return "submitted " + tb.submittedAt(p).UTC().Format(time.RFC3339)
+	default:
+		return ""
+	}
+}
+
+// order splits a group tied on points into the tie-breaker's ranking,
+// appending the subgroups to subgroups. Each subgroup holds portfolios the
+// tie-breaker could not separate; they share a finish position and split its
+// payouts. The group is reordered in place, keeping its incoming order within
+// each subgroup.
+func (tb *TieBreak) order(group []rankedPortfolio, subgroups [][]rankedPortfolio) [][]rankedPortfolio {
+	if tb.rule() == models.TieBreakerSplit || len(group) < 2 {
+		return append(subgroups, group)
+	}
+
+	// Tied groups are small, and an insertion sort is stable.
+	for k := 1; k < len(group); k++ {
+		for m := k; m > 0 && tb.compare(group[m].portfolio, group[m-1].portfolio) < 0; m-- {
+			group[m], group[m-1] = group[m-1], group[m]
+		}
+	}
+
+	start := 0
+	for k := 1; k <= len(group); k++ {
+		if k == len(group) || tb.compare(group[start].portfolio, group[k].portfolio) != 0 {
+			subgroups = append(subgroups, group[start:k])
+			start = k
+		}
+	}
+	return subgroups
+}
+
+// resolution builds the audit trail for a group tied on points.
+func (tb *TieBreak) resolution(subgroups [][]rankedPortfolio, firstPosition, payoutCents int) *models.TieResolution {
+	res := &models.TieResolution{
+		Rule:          tb.rule(),
+		FirstPosition: firstPosition,
+		PayoutCents:   payoutCents,
+	}
+	var ranked []string
+	for _, sub := range subgroups {
+		names := make([]string, 0, len(sub))
+		for _, rp := range sub {
+			res.PortfolioIDs = append(res.PortfolioIDs, rp.portfolio.ID)
+			names = append(names, rp.portfolio.Name)
+		}
+		if res.Rule == models.TieBreakerSplit {
+			continue
+		}
+		entry := fmt.Sprintf("%s (%s)", strings.Join(names, " and "), tb.describe(sub[0].portfolio))
+		if len(sub) > 1 {
+			entry += " still tied, split evenly"
+		}
+		ranked = append(ranked, entry)
+	}
+	res.TiedOnReturns = subgroups[0][0].returns
+	res.LastPosition = firstPosition + len(res.PortfolioIDs) - 1
+
+	tied := fmt.Sprintf("%d portfolios tied on %.2f points for positions %d-%d",
+		len(res.PortfolioIDs), res.TiedOnReturns, res.FirstPosition, res.LastPosition)
+	if res.Rule == models.TieBreakerSplit {
+		res.Reason = fmt.Sprintf("%s; payouts split evenly", tied)
+	} else {
+		res.Reason = fmt.Sprintf("%s; ranked by %s: %s", tied, res.Rule, strings.Join(ranked, ", then "))
+	}
+	return res
+}
+
+// splitCents is the kth of n portfolios' even share of total, the leftover
+// cents going to the first ones.
+func splitCents(total, n, k int) int {
+	share := total / n
+	if k < total%n {
+		share++
+	}
+	return share
+}
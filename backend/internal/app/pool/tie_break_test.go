@@ -0,0 +1,159 @@
+package pool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func tiedPairStandings(tieBreak *TieBreak) map[string]*models.PortfolioStanding {
+	p1 := newTestPortfolio("p1", time.Unix(2, 0))
+	p2 := newTestPortfolio("p2", time.Unix(1, 0))
+	returns := map[string]float64{"p1": 10, "p2": 10}
+	payouts := []*models.PoolPayout{newTestPayout(1, 300), newTestPayout(2, 100)}
+	return standingsByID(ComputeStandings([]*models.Portfolio{p1, p2}, returns, payouts, tieBreak))
+}
+
+func TestThatFewestCreditsTieBreakerPaysFirstPlaceToCheaperPortfolio(t *testing.T) {
+	// GIVEN two portfolios tied on points where p2 spent fewer credits
+	investments := []*models.Investment{
+		{PortfolioID: "p1", TeamID: "t1", Credits: 60},
+		{PortfolioID: "p2", TeamID: "t2", Credits: 40},
+	}
+	tieBreak := NewTieBreak(&models.Pool{TieBreaker: models.TieBreakerFewestCredits}, investments, nil)
+
+	// WHEN computing standings
+	byID := tiedPairStandings(tieBreak)
+
+	// THEN p2 takes the whole first-place payout
+	if byID["p2"].PayoutCents != 300 {
+		t.Fatalf("expected payout 300, got %d", byID["p2"].PayoutCents)
+	}
+}
+
+func TestThatChampionPointsTieBreakerPlacesChampionOwnerFirst(t *testing.T) {
+	// GIVEN two portfolios tied on points where p1 earned more from the champion
+	tieBreak := NewTieBreak(&models.Pool{TieBreaker: models.TieBreakerChampionPoints}, nil, map[string]float64{"p1": 30, "p2": 10})
+
+	// WHEN computing standings
+	byID := tiedPairStandings(tieBreak)
+
+	// THEN p1 finishes first
+	if byID["p1"].FinishPosition != 1 {
+		t.Fatalf("expected finish position 1, got %d", byID["p1"].FinishPosition)
+	}
+}
+
+func TestThatEarliestSubmissionTieBreakerUsesLatestBidChange(t *testing.T) {
+	// GIVEN p2 created first but changed its bids after p1's last change
+	investments := []*models.Investment{
+		{PortfolioID: "p1", TeamID: "t1", Credits: 50, UpdatedAt: time.Unix(100, 0)},
+		{PortfolioID: "p2", TeamID: "t2", Credits: 50, UpdatedAt: time.Unix(50, 0)},
+		{PortfolioID: "p2", TeamID: "t3", Credits: 50, UpdatedAt: time.Unix(200, 0)},
+	}
+	tieBreak := NewTieBreak(&models.Pool{TieBreaker: models.TieBreakerEarliestSubmission}, investments, nil)
+
+	// WHEN computing standings
+	byID := tiedPairStandings(tieBreak)
+
+	// THEN p1 finishes first
+	if byID["p1"].FinishPosition != 1 {
+		t.Fatalf("expected finish position 1, got %d", byID["p1"].FinishPosition)
+	}
+}
+
+func TestThatTieBreakerThatCannotSeparatePortfoliosSplitsPayouts(t *testing.T) {
+	// GIVEN two portfolios tied on points that spent the same credits
+	investments := []*models.Investment{
+		{PortfolioID: "p1", TeamID: "t1", Credits: 50},
+		{PortfolioID: "p2", TeamID: "t2", Credits: 50},
+	}
+	tieBreak := NewTieBreak(&models.Pool{TieBreaker: models.TieBreakerFewestCredits}, investments, nil)
+
+	// WHEN computing standings
+	byID := tiedPairStandings(tieBreak)
+
+	// THEN they split positions 1 and 2
+	if byID["p2"].PayoutCents != 200 {
+		t.Fatalf("expected payout 200, got %d", byID["p2"].PayoutCents)
+	}
+}
+
+func TestThatResolvedTieIsNoLongerMarkedTied(t *testing.T) {
+	// GIVEN a tie the fewest-credits tie-breaker resolves
+	investments := []*models.Investment{
+		{PortfolioID: "p1", TeamID: "t1", Credits: 60},
+		{PortfolioID: "p2", TeamID: "t2", Credits: 40},
+	}
+	tieBreak := NewTieBreak(&models.Pool{TieBreaker: models.TieBreakerFewestCredits}, investments, nil)
+
+	// WHEN computing standings
+	byID := tiedPairStandings(tieBreak)
+
+	// THEN p1 no longer shares its position
+	if byID["p1"].IsTied {
+		t.Fatalf("expected p1 not to be tied")
+	}
+}
+
+func TestThatTieResolutionExplainsTheRuleApplied(t *testing.T) {
+	// GIVEN a tie the fewest-credits tie-breaker resolves
+	investments := []*models.Investment{
+		{PortfolioID: "p1", TeamID: "t1", Credits: 60},
+		{PortfolioID: "p2", TeamID: "t2", Credits: 40},
+	}
+	tieBreak := NewTieBreak(&models.Pool{TieBreaker: models.TieBreakerFewestCredits}, investments, nil)
+
+	// WHEN computing standings
+	byID := tiedPairStandings(tieBreak)
+
+	// THEN the audit trail names both portfolios in tie-breaker order
+	want := "2 portfolios tied on 10.00 points for positions 1-2; ranked by fewest_credits: p2 (40 credits spent), then p1 (60 credits spent)"
+	if byID["p1"].TieResolution.Reason != want {
+		t.Fatalf("expected reason %q, got %q", want, byID["p1"].TieResolution.Reason)
+	}
+}
+
+func TestThatSplitTieResolutionRecordsPooledPayout(t *testing.T) {
+	// GIVEN a tie under a nil tie-breaker
+
+	// WHEN computing standings
+	byID := tiedPairStandings(nil)
+
+	// THEN the audit trail records the payout the tied group shared
+	if byID["p1"].TieResolution.PayoutCents != 400 {
+		t.Fatalf("expected pooled payout 400, got %d", byID["p1"].TieResolution.PayoutCents)
+	}
+}
+
+func TestThatUntiedPortfolioHasNoTieResolution(t *testing.T) {
+	// GIVEN two portfolios with different points
+	p1 := newTestPortfolio("p1", time.Unix(1, 0))
+	p2 := newTestPortfolio("p2", time.Unix(2, 0))
+	returns := map[string]float64{"p1": 20, "p2": 10}
+
+	// WHEN computing standings
+	byID := standingsByID(ComputeStandings([]*models.Portfolio{p1, p2}, returns, nil, nil))
+
+	// THEN neither carries a tie resolution
+	if byID["p1"].TieResolution != nil {
+		t.Fatalf("expected no tie resolution, got %+v", byID["p1"].TieResolution)
+	}
+}
+
+func TestThatChampionPointsFromDetailsCountsOnlyTheChampion(t *testing.T) {
+	// GIVEN a portfolio owning the champion and the runner-up
+	details := []*models.OwnershipDetail{
+		{PortfolioID: "p1", TeamID: "champ", ActualReturns: 120, Team: &models.TournamentTeam{Wins: 6, Byes: 1}},
+		{PortfolioID: "p1", TeamID: "runner", ActualReturns: 80, Team: &models.TournamentTeam{Wins: 5, Byes: 1, IsEliminated: true}},
+	}
+
+	// WHEN summing champion points
+	got := ChampionPointsFromDetails(details)
+
+	// THEN only the champion's returns count
+	if got["p1"] != 120 {
+		t.Fatalf("expected 120 champion points, got %v", got["p1"])
+	}
+}
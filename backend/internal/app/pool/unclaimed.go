@@ -0,0 +1,45 @@
+package pool
+
+import (
+	"github.com/andrewcopp/Calcutta/backend/internal/app/scoring"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// UnclaimedTeam is a tournament team nobody bid on, with the points it has
+// scored so far. What happens to those points depends on the pool's
+// unclaimed-team mode.
+type UnclaimedTeam struct {
+	Team   *models.TournamentTeam
+	Points int
+}
+
+// ComputeUnclaimedTeams returns the tournament's teams that no investment
+// holds, in tournament order, scored against the pool's rules.
+func ComputeUnclaimedTeams(
+	tournamentTeams []*models.TournamentTeam,
+	investments []*models.Investment,
+	results []*models.GameResult,
+	scoringRules []*models.ScoringRule,
+) []*UnclaimedTeam {
+	claimed := make(map[string]bool, len(investments))
+	for _, inv := range investments {
+		if inv != nil && inv.Credits > 0 {
+			claimed[inv.TeamID] = true
+		}
+	}
+
+	rules := scoring.FromModels(scoringRules)
+	runs := scoring.RunsFromResults(tournamentTeams, results)
+
+	unclaimed := make([]*UnclaimedTeam, 0)
+	for _, team := range tournamentTeams {
+		if team == nil || claimed[team.ID] {
+			continue
+		}
+		unclaimed = append(unclaimed, &UnclaimedTeam{
+			Team:   team,
+			Points: scoring.PointsForRun(rules, runs[team.ID]),
+		})
+	}
+	return unclaimed
+}
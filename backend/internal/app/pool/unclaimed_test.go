@@ -0,0 +1,35 @@
+package pool
+
+import (
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func TestThatUnclaimedTeamsExcludeTeamsWithInvestments(t *testing.T) {
+	// GIVEN three teams where only team1 has an investment
+	teams := []*models.TournamentTeam{{ID: "team1"}, {ID: "team2"}, {ID: "team3"}}
+	investments := []*models.Investment{newTestInvestment("i1", "p1", "team1", 20)}
+
+	// WHEN computing unclaimed teams
+	unclaimed := ComputeUnclaimedTeams(teams, investments, nil, nil)
+
+	// THEN team2 and team3 are unclaimed
+	if len(unclaimed) != 2 || unclaimed[0].Team.ID != "team2" || unclaimed[1].Team.ID != "team3" {
+		t.Errorf("expected [team2 team3], got %d teams", len(unclaimed))
+	}
+}
+
+func TestThatUnclaimedTeamPointsFollowScoringRules(t *testing.T) {
+	// GIVEN an unclaimed team with two wins and rules paying 10 per win
+	teams := []*models.TournamentTeam{{ID: "team1", Wins: 2}}
+	rules := []*models.ScoringRule{{WinIndex: 1, PointsAwarded: 10}, {WinIndex: 2, PointsAwarded: 10}}
+
+	// WHEN computing unclaimed teams
+	unclaimed := ComputeUnclaimedTeams(teams, nil, nil, rules)
+
+	// THEN the team has scored 20 points
+	if len(unclaimed) != 1 || unclaimed[0].Points != 20 {
+		t.Errorf("expected 20 points, got %+v", unclaimed)
+	}
+}
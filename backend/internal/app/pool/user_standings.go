@@ -0,0 +1,45 @@
+package pool
+
+import "github.com/andrewcopp/Calcutta/backend/internal/models"
+
+// UserStanding rolls up every portfolio a user holds in a pool.
+type UserStanding struct {
+	UserID       string
+	PortfolioIDs []string
+	// BestRank is the leaderboard row of the user's highest-placed portfolio,
+	// counting from 1. It is 0 when none of the portfolios are ranked.
+	BestRank int
+	// BestReturns is the points of that portfolio.
+	BestReturns      float64
+	TotalPayoutCents int
+}
+
+// SummarizeUserStanding aggregates a user's portfolios against the pool's
+// standings, which must be sorted best first as ComputeStandings returns
+// them. It returns nil when the user holds no portfolio in the pool.
+func SummarizeUserStanding(userID string, portfolios []*models.Portfolio, standings []*models.PortfolioStanding) *UserStanding {
+	owned := make(map[string]bool)
+	out := &UserStanding{UserID: userID}
+	for _, p := range portfolios {
+		if p == nil || p.UserID == nil || *p.UserID != userID {
+			continue
+		}
+		owned[p.ID] = true
+		out.PortfolioIDs = append(out.PortfolioIDs, p.ID)
+	}
+	if len(out.PortfolioIDs) == 0 {
+		return nil
+	}
+
+	for i, s := range standings {
+		if s == nil || !owned[s.PortfolioID] {
+			continue
+		}
+		if out.BestRank == 0 {
+			out.BestRank = i + 1
+			out.BestReturns = s.TotalReturns
+		}
+		out.TotalPayoutCents += s.PayoutCents
+	}
+	return out
+}
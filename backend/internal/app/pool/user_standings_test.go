@@ -0,0 +1,64 @@
+package pool
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func ownedPortfolio(id, userID string) *models.Portfolio {
+	p := newTestPortfolio(id, time.Unix(1, 0))
+	p.UserID = &userID
+	return p
+}
+
+func twoEntryStandings() ([]*models.Portfolio, []*models.PortfolioStanding) {
+	portfolios := []*models.Portfolio{
+		ownedPortfolio("p1", "user-1"),
+		ownedPortfolio("p2", "user-2"),
+		ownedPortfolio("p3", "user-1"),
+	}
+	returns := map[string]float64{"p1": 10, "p2": 30, "p3": 20}
+	payouts := []*models.PoolPayout{newTestPayout(1, 500), newTestPayout(2, 300), newTestPayout(3, 100)}
+	return portfolios, ComputeStandings(portfolios, returns, payouts, nil)
+}
+
+func TestThatUserStandingUsesBestPlacedPortfolioForRank(t *testing.T) {
+	// GIVEN a user whose two portfolios finish second and third
+	portfolios, standings := twoEntryStandings()
+
+	// WHEN summarizing the user's standing
+	got := SummarizeUserStanding("user-1", portfolios, standings)
+
+	// THEN the user ranks second
+	if got.BestRank != 2 {
+		t.Fatalf("expected best rank 2, got %d", got.BestRank)
+	}
+}
+
+func TestThatUserStandingSumsPayoutsAcrossPortfolios(t *testing.T) {
+	// GIVEN a user whose two portfolios finish second and third
+	portfolios, standings := twoEntryStandings()
+
+	// WHEN summarizing the user's standing
+	got := SummarizeUserStanding("user-1", portfolios, standings)
+
+	// THEN both payouts count
+	if got.TotalPayoutCents != 400 {
+		t.Fatalf("expected total payout 400, got %d", got.TotalPayoutCents)
+	}
+}
+
+func TestThatUserStandingIsNilWithoutPortfolios(t *testing.T) {
+	// GIVEN a pool the user has no portfolio in
+	portfolios, standings := twoEntryStandings()
+
+	// WHEN summarizing the user's standing
+	got := SummarizeUserStanding("user-3", portfolios, standings)
+
+	// THEN there is no standing
+	if got != nil {
+		t.Fatalf("expected nil, got %+v", got)
+	}
+}
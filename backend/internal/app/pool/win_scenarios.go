@@ -0,0 +1,175 @@
+package pool
+
+import (
+	"sort"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// MaxWinScenarios caps the outcomes listed in WinScenarios.Scenarios.
+const MaxWinScenarios = 50
+
+// WinScenarios is what one portfolio needs from the remaining games: the
+// outcomes in which it finishes in the money, and the matchups whose result
+// moves its expected payout.
+type WinScenarios struct {
+	PortfolioID         string
+	RemainingGames      int
+	Outcomes            int
+	PFirst              float64
+	PInTheMoney         float64
+	ExpectedPayoutCents float64
+	// Scenarios are the likeliest outcomes in which the portfolio finishes
+	// in the money, at most MaxWinScenarios; ScenarioCount is how many there
+	// are in all.
+	Scenarios     []*WinScenario
+	ScenarioCount int
+	// KeyGames are the matchups whose result changes the portfolio's
+	// expected payout, biggest swing first.
+	KeyGames []*KeyGame
+}
+
+// WinScenario is one way the remaining games can go and where it leaves the
+// portfolio.
+type WinScenario struct {
+	Games          []*ScenarioGame
+	Probability    float64
+	FinishPosition int
+	IsTied         bool
+	PayoutCents    int
+}
+
+// ScenarioGame is the result of one remaining game in a scenario.
+type ScenarioGame struct {
+	GameID string
+	Round  models.BracketRound
+	Winner *models.BracketTeam
+	Loser  *models.BracketTeam
+}
+
+// KeyGame is a matchup a portfolio has a stake in: root for RootFor over
+// Against. The payouts and chances are conditional on the matchup happening
+// and RootFor winning or losing it. SwingPayoutCents is the difference in
+// expected payout. MustWin means the portfolio cannot finish in the money if
+// RootFor loses, yet can if it wins.
+type KeyGame struct {
+	GameID                string
+	Round                 models.BracketRound
+	RootFor               *models.BracketTeam
+	Against               *models.BracketTeam
+	PMatchup              float64
+	PRootForWins          float64
+	ExpectedPayoutIfWins  float64
+	ExpectedPayoutIfLoses float64
+	PInTheMoneyIfWins     float64
+	PInTheMoneyIfLoses    float64
+	SwingPayoutCents      float64
+	MustWin               bool
+}
+
+// matchupSums accumulates a portfolio's results in the outcomes where a
+// matchup happened, by which slot won.
+type matchupSums struct {
+	prob    [2]float64
+	payout  [2]float64
+	inMoney [2]float64
+}
+
+// WinScenarios explains what portfolioID needs from the remaining games. It
+// returns nil when the portfolio is not in the pool.
+func (t *OutcomeTable) WinScenarios(portfolioID string) *WinScenarios {
+	if t == nil {
+		return nil
+	}
+	p, ok := t.portfolioIndex[portfolioID]
+	if !ok {
+		return nil
+	}
+	exact := t.exact.Portfolios[p]
+	sc := &t.scenarios[p]
+
+	out := &WinScenarios{
+		PortfolioID:         portfolioID,
+		RemainingGames:      len(t.games),
+		Outcomes:            t.exact.Outcomes,
+		PFirst:              exact.FinishProbabilities[0],
+		PInTheMoney:         exact.PInTheMoney,
+		ExpectedPayoutCents: exact.ExpectedPayoutCents,
+		ScenarioCount:       sc.count,
+	}
+	for _, c := range sc.top {
+		out.Scenarios = append(out.Scenarios, t.scenario(c))
+	}
+
+	for _, m := range t.matchupOrder {
+		if kg := t.keyGame(t.matchups[m], &sc.matchups[m]); kg != nil {
+			out.KeyGames = append(out.KeyGames, kg)
+		}
+	}
+	sort.SliceStable(out.KeyGames, func(i, j int) bool {
+		a, b := out.KeyGames[i], out.KeyGames[j]
+		if a.SwingPayoutCents != b.SwingPayoutCents {
+			return a.SwingPayoutCents > b.SwingPayoutCents
+		}
+		return a.PMatchup > b.PMatchup
+	})
+
+	return out
+}
+
+func (t *OutcomeTable) scenario(c scenarioCandidate) *WinScenario {
+	s := &WinScenario{
+		Probability:    c.prob,
+		FinishPosition: c.finishPosition,
+		IsTied:         c.isTied,
+		PayoutCents:    c.payoutCents,
+	}
+	winners := t.winners(c)
+	for i, g := range t.games {
+		team1, team2 := t.slotTeams(i, winners)
+		if team1 == nil || team2 == nil {
+			continue
+		}
+		loser := team2
+		if winners[i] == team2 {
+			loser = team1
+		}
+		s.Games = append(s.Games, &ScenarioGame{GameID: g.game.GameID, Round: g.game.Round, Winner: winners[i], Loser: loser})
+	}
+	return s
+}
+
+// keyGame returns the matchup as a KeyGame, or nil when its result does not
+// change the portfolio's expected payout or one side cannot win.
+func (t *OutcomeTable) keyGame(matchup tableMatchup, m *matchupSums) *KeyGame {
+	if m.prob[0] <= 0 || m.prob[1] <= 0 {
+		return nil
+	}
+	payout := [2]float64{m.payout[0] / m.prob[0], m.payout[1] / m.prob[1]}
+	inMoney := [2]float64{m.inMoney[0] / m.prob[0], m.inMoney[1] / m.prob[1]}
+	root, against := 0, 1
+	if payout[1] > payout[0] || (payout[1] == payout[0] && inMoney[1] > inMoney[0]) {
+		root, against = 1, 0
+	}
+	swing := payout[root] - payout[against]
+	if swing <= 0 && inMoney[root] == inMoney[against] {
+		return nil
+	}
+
+	g := t.games[matchup.game].game
+	pMatchup := m.prob[0] + m.prob[1]
+	return &KeyGame{
+		GameID:                g.GameID,
+		Round:                 g.Round,
+		RootFor:               matchup.teams[root],
+		Against:               matchup.teams[against],
+		PMatchup:              pMatchup,
+		PRootForWins:          m.prob[root] / pMatchup,
+		ExpectedPayoutIfWins:  payout[root],
+		ExpectedPayoutIfLoses: payout[against],
+		PInTheMoneyIfWins:     inMoney[root],
+		PInTheMoneyIfLoses:    inMoney[against],
+		SwingPayoutCents:      swing,
+		MustWin:               inMoney[root] > 0 && inMoney[against] == 0,
+	}
+}
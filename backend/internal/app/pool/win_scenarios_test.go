@@ -0,0 +1,104 @@
+package pool
+
+import (
+	"math"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func winScenariosFor(portfolioID string, winProbs map[models.TeamPair]float64) *WinScenarios {
+	portfolios := []*models.Portfolio{testPortfolio("p1"), testPortfolio("p2")}
+	summaries := []*models.OwnershipSummary{ownershipSummary("os1", "p1"), ownershipSummary("os2", "p2")}
+	details := []*models.OwnershipDetail{ownershipDetail("os1", "A", 1.0), ownershipDetail("os2", "C", 1.0)}
+	tts := []*models.TournamentTeam{
+		tournamentTeam("A", 4, 1, false),
+		tournamentTeam("B", 4, 1, false),
+		tournamentTeam("C", 4, 1, false),
+		tournamentTeam("D", 4, 1, false),
+	}
+	rules := []*models.ScoringRule{scoringRule(6, 10), scoringRule(7, 20)}
+	payouts := []*models.PoolPayout{poolPayout(1, 1000)}
+	return ComputeOutcomeTable(buildFinalFourWithChampionship(), portfolios, summaries, details, tts, nil, rules, payouts, nil, winProbs, DefaultMaxExactRemainingGames).WinScenarios(portfolioID)
+}
+
+func TestThatWinScenariosAreNilForAPortfolioOutsideThePool(t *testing.T) {
+	// GIVEN a Final Four with two portfolios
+	// WHEN asking about a third
+	result := winScenariosFor("p3", nil)
+
+	// THEN there is nothing to explain
+	if result != nil {
+		t.Errorf("expected nil, got %+v", result)
+	}
+}
+
+func TestThatWinScenariosListEveryOutcomeThatPays(t *testing.T) {
+	// GIVEN a portfolio owning A in a Final Four of coin flips, paid only
+	// for first place
+	// WHEN exploring its scenarios
+	result := winScenariosFor("p1", nil)
+
+	// THEN every outcome is equally likely, so the share of outcomes that
+	// pay is its chance of being paid
+	if got := float64(result.ScenarioCount) / float64(result.Outcomes); math.Abs(got-result.PInTheMoney) > 1e-9 {
+		t.Errorf("expected %d of %d outcomes to match P(in the money) %f", result.ScenarioCount, result.Outcomes, result.PInTheMoney)
+	}
+}
+
+func TestThatWinScenariosListTheLikeliestFirst(t *testing.T) {
+	// GIVEN uneven semifinals
+	winProbs := map[models.TeamPair]float64{{Team1ID: "A", Team2ID: "B"}: 0.9, {Team1ID: "C", Team2ID: "D"}: 0.2}
+
+	// WHEN exploring A's owner's scenarios
+	result := winScenariosFor("p1", winProbs)
+
+	// THEN no scenario is likelier than the one before it
+	for i := 1; i < len(result.Scenarios); i++ {
+		if result.Scenarios[i].Probability > result.Scenarios[i-1].Probability {
+			t.Fatalf("scenario %d is likelier than scenario %d", i, i-1)
+		}
+	}
+}
+
+func TestThatTheBiggestSwingIsTheOwnedTeamsFinal(t *testing.T) {
+	// GIVEN a portfolio owning A in a Final Four of coin flips, against one
+	// owning C
+	// WHEN exploring its scenarios
+	result := winScenariosFor("p1", nil)
+
+	// THEN the top key game is rooting for A over C in the final
+	top := result.KeyGames[0]
+	if top.RootFor.TeamID != "A" || top.Against.TeamID != "C" {
+		t.Errorf("expected to root for A over C, got %s over %s", top.RootFor.TeamID, top.Against.TeamID)
+	}
+}
+
+func TestThatBeatingTheRivalsTeamInTheFinalIsAMustWin(t *testing.T) {
+	// GIVEN a portfolio owning A in a Final Four of coin flips, against one
+	// owning C
+	// WHEN exploring its scenarios
+	result := winScenariosFor("p1", nil)
+
+	// THEN losing a final to C leaves the portfolio unpaid
+	if !result.KeyGames[0].MustWin {
+		t.Errorf("expected A over C to be a must-win, got %+v", result.KeyGames[0])
+	}
+}
+
+func TestThatAnOwnedTeamsSemifinalIsNotAMustWinWhenATieCanStillPay(t *testing.T) {
+	// GIVEN a portfolio owning A, whose rival can also score nothing
+	// WHEN exploring its scenarios
+	result := winScenariosFor("p1", nil)
+
+	// THEN A losing its semifinal can still leave a share of first place
+	var semi *KeyGame
+	for _, kg := range result.KeyGames {
+		if kg.GameID == "final_four-1" {
+			semi = kg
+		}
+	}
+	if semi == nil || semi.MustWin {
+		t.Errorf("expected A over B to matter but not be a must-win, got %+v", semi)
+	}
+}
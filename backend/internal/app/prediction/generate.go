@@ -2,23 +2,44 @@ package prediction
 
 import (
 	"fmt"
+	"strings"
 
+	appbracket "github.com/andrewcopp/Calcutta/backend/internal/app/bracket"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/winprob"
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
 // generatePredictions is a pure computation pipeline: matchups -> tournament values.
-// No context, no repo, no side effects.
-func generatePredictions(state *TournamentState, spec *winprob.Model) ([]PredictedTeamValue, error) {
+// No context, no repo, no side effects. The matchups are returned alongside the
+// team values so they can be stored with the batch.
+func generatePredictions(state *TournamentState, spec *winprob.Model) ([]PredictedTeamValue, []PredictedMatchup, error) {
 	var matchups []PredictedMatchup
 	if state.ThroughRound < models.MaxRounds {
 		var err error
-		matchups, err = GenerateMatchups(state.Survivors, state.ThroughRound, spec, state.FFConfig)
+		tmpl := appbracket.ResolveTemplate(state.Template, state.FFConfig)
+		if spec != nil {
+			history := appbracket.HistoryThroughRound(state.Results, tmpl, state.ThroughRound)
+			spec = spec.WithHistory(winprobTeams(state.AllTeams), history)
+		}
+		matchups, err = GenerateMatchupsWithSources(state.Survivors, state.ThroughRound, spec, tmpl, MatchupSources{Table: state.Table, Sites: state.Sites, Live: state.Live})
 		if err != nil {
-			return nil, fmt.Errorf("failed to generate matchups: %w", err)
+			return nil, nil, fmt.Errorf("failed to generate matchups: %w", err)
 		}
 	}
 
 	teamValues := GenerateTournamentValues(state.AllTeams, matchups, state.ThroughRound, state.Rules)
-	return teamValues, nil
+	return teamValues, matchups, nil
+}
+
+// realMatchups drops matchups against phantom BYE opponents, which are a
+// modeling device rather than games that can be played.
+func realMatchups(matchups []PredictedMatchup) []PredictedMatchup {
+	out := make([]PredictedMatchup, 0, len(matchups))
+	for _, m := range matchups {
+		if strings.HasPrefix(m.Team1ID, byePrefix) || strings.HasPrefix(m.Team2ID, byePrefix) {
+			continue
+		}
+		out = append(out, m)
+	}
+	return out
 }
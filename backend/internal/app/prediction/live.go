@@ -0,0 +1,94 @@
+package prediction
+
+import (
+	"context"
+
+	appbracket "github.com/andrewcopp/Calcutta/backend/internal/app/bracket"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/winprob"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// LiveGameProbability is a game in progress priced from its posted state.
+type LiveGameProbability struct {
+	State             *models.LiveGameState
+	PregamePTeam1Wins float64
+	PTeam1Wins        float64
+}
+
+// LiveGames prices the tournament's games in progress with the run's model
+// and probability source.
+func (s *Service) LiveGames(ctx context.Context, p RunParams) ([]LiveGameProbability, error) {
+	p.applyDefaults()
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
+
+	data, err := s.loadTournamentData(ctx, p.TournamentID, p.ProbabilitySourceKey)
+	if err != nil {
+		return nil, err
+	}
+	if len(data.Live) == 0 {
+		return []LiveGameProbability{}, nil
+	}
+
+	tmpl := appbracket.ResolveTemplate(data.Template, data.FFConfig)
+	teamsByID := winprobTeams(data.Teams)
+	spec := p.GameOutcomeSpec.WithHistory(teamsByID, appbracket.HistoryThroughRound(data.Results, tmpl, models.MaxRounds))
+	sites := gameSiteLocations(data.Sites)
+
+	out := make([]LiveGameProbability, 0, len(data.Live))
+	for _, state := range data.Live {
+		site := sites[state.GameID]
+		pregame, ok := data.Table.Lookup(state.Team1ID, state.Team2ID)
+		if !ok {
+			pregame = spec.ProbAtSite(teamsByID[state.Team1ID], teamsByID[state.Team2ID], site)
+		}
+		out = append(out, LiveGameProbability{
+			State:             state,
+			PregamePTeam1Wins: pregame,
+			PTeam1Wins:        liveWinProb(state, state.Team1ID, state.Team2ID, spec, data.Table, teamsByID, site),
+		})
+	}
+	return out, nil
+}
+
+// liveGamesByID indexes live states by bracket game ID.
+func liveGamesByID(states []*models.LiveGameState) map[string]*models.LiveGameState {
+	out := make(map[string]*models.LiveGameState, len(states))
+	for _, st := range states {
+		out[st.GameID] = st
+	}
+	return out
+}
+
+// liveWinProb returns the probability that id1 beats id2 from the posted state
+// of their game. Pairs priced by an uploaded table start from the spread the
+// table's probability implies.
+func liveWinProb(state *models.LiveGameState, id1, id2 string, spec *winprob.Model, table *models.MatchupProbabilityTable, teamsByID map[string]winprob.Team, site *winprob.Location) float64 {
+	gs := winprob.GameState{SecondsRemaining: state.SecondsRemaining}
+	if id1 == state.Team1ID {
+		gs.ScoreA, gs.ScoreB = state.Team1Score, state.Team2Score
+	} else {
+		gs.ScoreA, gs.ScoreB = state.Team2Score, state.Team1Score
+	}
+	if state.PossessionTeamID != nil {
+		if *state.PossessionTeamID == id1 {
+			gs.Possession = 1
+		} else {
+			gs.Possession = -1
+		}
+	}
+
+	if p, ok := table.Lookup(id1, id2); ok {
+		return winprob.LiveProb(winprob.ImpliedSpread(p, winprob.DefaultGameSD), winprob.DefaultGameSD, gs)
+	}
+	return spec.LiveProb(teamsByID[id1], teamsByID[id2], site, gs)
+}
+
+// isLiveMatchup reports whether id1 and id2 are the two teams in the live state.
+func isLiveMatchup(state *models.LiveGameState, id1, id2 string) bool {
+	if state == nil {
+		return false
+	}
+	return (id1 == state.Team1ID && id2 == state.Team2ID) || (id1 == state.Team2ID && id2 == state.Team1ID)
+}
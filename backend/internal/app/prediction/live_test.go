@@ -0,0 +1,104 @@
+package prediction
+
+import (
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/winprob"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func TestThatLiveStatePricesChampionshipFromScore(t *testing.T) {
+	// GIVEN the championship underway with the South finalist up 15 with two minutes left
+	spec := &winprob.Model{Kind: winprob.KindKenPom, Sigma: 10.0}
+	live := []*models.LiveGameState{{
+		GameID: "championship", Team1ID: "t-east", Team2ID: "t-south",
+		Team1Score: 60, Team2Score: 75, SecondsRemaining: 120,
+	}}
+
+	// WHEN generating checkpoint matchups with the live state
+	matchups, err := GenerateMatchupsWithSources(championshipFinalists(), 6, spec, nil, MatchupSources{Live: live})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the pregame favorite is a long shot
+	if p := pEast(matchups); p > 0.05 {
+		t.Errorf("expected t-east below 5%%, got %f", p)
+	}
+}
+
+func TestThatLiveStateForOtherTeamsIsIgnored(t *testing.T) {
+	// GIVEN a stale live state naming teams that are not in the championship
+	spec := &winprob.Model{Kind: winprob.KindKenPom, Sigma: 10.0}
+	live := []*models.LiveGameState{{
+		GameID: "championship", Team1ID: "t-west", Team2ID: "t-south",
+		Team1Score: 60, Team2Score: 75, SecondsRemaining: 120,
+	}}
+	pregame, err := GenerateMatchups(championshipFinalists(), 6, spec, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// WHEN generating checkpoint matchups with the live state
+	matchups, err := GenerateMatchupsWithSources(championshipFinalists(), 6, spec, nil, MatchupSources{Live: live})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the matchup keeps its pregame probability
+	if pEast(matchups) != pEast(pregame) {
+		t.Errorf("expected %f, got %f", pEast(pregame), pEast(matchups))
+	}
+}
+
+func TestThatLiveWinProbReadsScoresFromRequestedTeamsPerspective(t *testing.T) {
+	// GIVEN team2 of the live game leading by 20 at the buzzer
+	spec := &winprob.Model{Kind: winprob.KindKenPom, Sigma: 10.0}
+	state := &models.LiveGameState{Team1ID: "a", Team2ID: "b", Team1Score: 50, Team2Score: 70}
+
+	// WHEN pricing the game with team2 first
+	p := liveWinProb(state, "b", "a", spec, nil, map[string]winprob.Team{}, nil)
+
+	// THEN team2 has won
+	if p != 1 {
+		t.Errorf("expected 1, got %f", p)
+	}
+}
+
+func TestThatEarlierCheckpointIgnoresLiveStates(t *testing.T) {
+	// GIVEN finalists at the championship checkpoint with the title game underway
+	data := &TournamentData{
+		Teams: championshipFinalists(),
+		Live: []*models.LiveGameState{{
+			GameID: "championship", Team1ID: "t-east", Team2ID: "t-south",
+			Team1Score: 60, Team2Score: 75, SecondsRemaining: 120,
+		}},
+	}
+
+	// WHEN snapshotting the Final Four checkpoint
+	state := NewTournamentState(data, 5)
+
+	// THEN the live state is left out
+	if len(state.Live) != 0 {
+		t.Errorf("expected no live states, got %d", len(state.Live))
+	}
+}
+
+func TestThatCurrentCheckpointKeepsLiveStates(t *testing.T) {
+	// GIVEN finalists at the championship checkpoint with the title game underway
+	data := &TournamentData{
+		Teams: championshipFinalists(),
+		Live: []*models.LiveGameState{{
+			GameID: "championship", Team1ID: "t-east", Team2ID: "t-south",
+			Team1Score: 60, Team2Score: 75, SecondsRemaining: 120,
+		}},
+	}
+
+	// WHEN snapshotting the championship checkpoint
+	state := NewTournamentState(data, 6)
+
+	// THEN the live state is kept
+	if len(state.Live) != 1 {
+		t.Errorf("expected one live state, got %d", len(state.Live))
+	}
+}
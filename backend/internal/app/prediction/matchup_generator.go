@@ -5,6 +5,7 @@ import (
 	"sort"
 	"strings"
 
+	appbracket "github.com/andrewcopp/Calcutta/backend/internal/app/bracket"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/winprob"
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
@@ -12,34 +13,13 @@ import (
 // gameSetup describes one bracket game slot and the candidate teams on each side.
 type gameSetup struct {
 	gameID string
-	side1  []TeamInput
-	side2  []TeamInput
+	// siteGameID is the bracket game ID whose site applies; empty for
+	// phantom BYE games.
+	siteGameID string
+	side1      []TeamInput
+	side2      []TeamInput
 }
 
-// Standard NCAA bracket seed pairings for the Round of 64.
-var r64Pairings = [][2]int{
-	{1, 16}, {8, 9}, {5, 12}, {4, 13},
-	{6, 11}, {3, 14}, {7, 10}, {2, 15},
-}
-
-// r32Groups defines which seed groups face off in the Round of 32.
-var r32Groups = [][2][]int{
-	{{1, 16}, {8, 9}},
-	{{5, 12}, {4, 13}},
-	{{6, 11}, {3, 14}},
-	{{7, 10}, {2, 15}},
-}
-
-// s16Groups defines which seed groups face off in the Sweet 16.
-var s16Groups = [][2][]int{
-	{{1, 16, 8, 9}, {5, 12, 4, 13}},
-	{{6, 11, 3, 14}, {7, 10, 2, 15}},
-}
-
-// topHalfSeeds and bottomHalfSeeds define the Elite 8 matchup within a region.
-var topHalfSeeds = []int{1, 16, 8, 9, 5, 12, 4, 13}
-var bottomHalfSeeds = []int{6, 11, 3, 14, 7, 10, 2, 15}
-
 // byePrefix is the sentinel prefix for phantom BYE opponents in the R128 model.
 const byePrefix = "BYE-"
 
@@ -48,7 +28,7 @@ const byePrefix = "BYE-"
 func computeRound(
 	games []gameSetup,
 	pAdvance map[string]float64,
-	calcWinProb func(id1, id2 string) float64,
+	calcWinProb func(g gameSetup, id1, id2 string) float64,
 	roundOrder int,
 ) ([]PredictedMatchup, map[string]float64) {
 	var matchups []PredictedMatchup
@@ -56,7 +36,7 @@ func computeRound(
 		for _, t1 := range g.side1 {
 			for _, t2 := range g.side2 {
 				pMatch := pAdvance[t1.ID] * pAdvance[t2.ID]
-				p1Wins := calcWinProb(t1.ID, t2.ID)
+				p1Wins := calcWinProb(g, t1.ID, t2.ID)
 				matchups = append(matchups, PredictedMatchup{
 					GameID:                 g.gameID,
 					RoundOrder:             roundOrder,
@@ -72,148 +52,228 @@ func computeRound(
 	return matchups, computeAdvanceProbs(matchups)
 }
 
-// buildRegionalGames creates game setups for a single region at a given round.
-// Rounds 2-5 correspond to R64, R32, S16, E8 in the 128-team symmetric model.
-func buildRegionalGames(region string, regionTeams []TeamInput, round int) []gameSetup {
-	teamsBySeed := func(seed int) []TeamInput {
-		var result []TeamInput
-		for _, t := range regionTeams {
-			if t.Seed == seed {
-				result = append(result, t)
-			}
-		}
-		return result
+// slotSource is one side of a template game in the 128-team symmetric model:
+// the teams that can reach it and the last round they play before it.
+type slotSource struct {
+	candidates []TeamInput
+	// playIn holds the two teams sharing a seeded slot; they meet in the
+	// round before the slot's game.
+	playIn []TeamInput
+	// readyAfter is the round order after which a team fills the slot without
+	// any further phantom BYE games.
+	readyAfter int
+	byeBase    string
+}
+
+// bracketModel maps a bracket template onto the 128-team symmetric model: each
+// template game plays in the round matching its BracketRound order, and teams
+// reach their slot through phantom BYE games in the rounds they skip.
+type bracketModel struct {
+	games   []models.TemplateGame
+	sources map[string][2]slotSource
+}
+
+func newBracketModel(tmpl *models.BracketTemplate, teams []TeamInput) (*bracketModel, []TeamInput) {
+	type slotKey struct {
+		region string
+		seed   int
+	}
+	bySlot := make(map[slotKey][]TeamInput)
+	for _, t := range teams {
+		key := slotKey{region: t.Region, seed: t.Seed}
+		bySlot[key] = append(bySlot[key], t)
+	}
+
+	byID := make(map[string]models.TemplateGame, len(tmpl.Games))
+	for _, g := range tmpl.Games {
+		byID[g.GameID] = g
 	}
 
-	teamsBySeeds := func(seeds []int) []TeamInput {
-		seedSet := make(map[int]bool, len(seeds))
-		for _, s := range seeds {
-			seedSet[s] = true
+	m := &bracketModel{games: append([]models.TemplateGame{}, tmpl.Games...), sources: make(map[string][2]slotSource)}
+	sort.SliceStable(m.games, func(i, j int) bool {
+		return m.games[i].Round.Order() < m.games[j].Round.Order()
+	})
+
+	var unplaced []TeamInput
+	placed := make(map[string]bool, len(teams))
+	var resolve func(g models.TemplateGame) [2]slotSource
+	resolve = func(g models.TemplateGame) [2]slotSource {
+		if src, ok := m.sources[g.GameID]; ok {
+			return src
 		}
-		var result []TeamInput
-		for _, t := range regionTeams {
-			if seedSet[t.Seed] {
-				result = append(result, t)
+		var out [2]slotSource
+		for i, slot := range g.Slots {
+			if slot.IsFeeder() {
+				feeder := byID[slot.FeederGameID]
+				fs := resolve(feeder)
+				out[i] = slotSource{
+					candidates: append(append([]TeamInput{}, fs[0].candidates...), fs[1].candidates...),
+					readyAfter: feeder.Round.Order(),
+					byeBase:    byePrefix + feeder.GameID,
+				}
+				continue
+			}
+			region := slot.Region
+			if region == "" {
+				region = g.Region
+			}
+			slotTeams := bySlot[slotKey{region: region, seed: slot.Seed}]
+			for _, t := range slotTeams {
+				placed[t.ID] = true
+			}
+			src := slotSource{
+				candidates: slotTeams,
+				byeBase:    fmt.Sprintf("%s%s-%d", byePrefix, region, slot.Seed),
+			}
+			if len(slotTeams) == 2 {
+				src.playIn = slotTeams
+				src.readyAfter = g.Round.Order() - 1
 			}
+			out[i] = src
 		}
-		return result
+		m.sources[g.GameID] = out
+		return out
+	}
+	for _, g := range m.games {
+		resolve(g)
 	}
 
-	switch round {
-	case 2: // R64
-		games := make([]gameSetup, 0, len(r64Pairings))
-		for idx, seeds := range r64Pairings {
-			games = append(games, gameSetup{
-				gameID: fmt.Sprintf("R2-%s-%d", region, idx+1),
-				side1:  teamsBySeed(seeds[0]),
-				side2:  teamsBySeed(seeds[1]),
-			})
-		}
-		return games
-	case 3: // R32
-		games := make([]gameSetup, 0, len(r32Groups))
-		for idx, group := range r32Groups {
-			games = append(games, gameSetup{
-				gameID: fmt.Sprintf("R3-%s-%d", region, idx+1),
-				side1:  teamsBySeeds(group[0]),
-				side2:  teamsBySeeds(group[1]),
-			})
-		}
-		return games
-	case 4: // S16
-		games := make([]gameSetup, 0, len(s16Groups))
-		for idx, group := range s16Groups {
-			games = append(games, gameSetup{
-				gameID: fmt.Sprintf("R4-%s-%d", region, idx+1),
-				side1:  teamsBySeeds(group[0]),
-				side2:  teamsBySeeds(group[1]),
-			})
+	for _, t := range teams {
+		if !placed[t.ID] {
+			unplaced = append(unplaced, t)
 		}
-		return games
-	case 5: // E8
-		return []gameSetup{{
-			gameID: fmt.Sprintf("R5-%s-1", region),
-			side1:  teamsBySeeds(topHalfSeeds),
-			side2:  teamsBySeeds(bottomHalfSeeds),
-		}}
 	}
-	return nil
+	return m, unplaced
 }
 
-// buildR128Games creates Round 1 game setups for the 128-team symmetric model.
-// Seeds with 1 team get a phantom BYE opponent (guaranteed win).
-// Seeds with 2 teams (First Four) play a real game.
-// Returns the game setups and any BYE sentinel TeamInputs that were created.
-func buildR128Games(region string, regionTeams []TeamInput) ([]gameSetup, []TeamInput) {
-	seedGroups := make(map[int][]TeamInput)
-	for _, t := range regionTeams {
-		seedGroups[t.Seed] = append(seedGroups[t.Seed], t)
-	}
-
+// gamesForRound returns the game setups played in the given round order,
+// including phantom BYE games for slots whose teams skip that round. Any BYE
+// sentinels created are returned so the caller can seed their advance odds.
+func (m *bracketModel) gamesForRound(round int) ([]gameSetup, []TeamInput) {
 	var games []gameSetup
-	var byeTeams []TeamInput
-	gameIdx := 1
-
-	// Process seeds in bracket order (matching r64Pairings) so each R128 game
-	// feeds into the correct R64 slot.
-	for _, pairing := range r64Pairings {
-		for _, seed := range []int{pairing[0], pairing[1]} {
-			group := seedGroups[seed]
-			if len(group) == 2 {
-				// Real First Four game.
+	var byes []TeamInput
+	for _, g := range m.games {
+		order := g.Round.Order()
+		if order == round {
+			src := m.sources[g.GameID]
+			games = append(games, gameSetup{
+				gameID:     fmt.Sprintf("R%d-%s", round, g.GameID),
+				siteGameID: g.GameID,
+				side1:      src[0].candidates,
+				side2:      src[1].candidates,
+			})
+			continue
+		}
+		if order < round {
+			continue
+		}
+		for _, src := range m.sources[g.GameID] {
+			switch {
+			case len(src.candidates) == 0:
+			case src.playIn != nil && round < src.readyAfter:
+				// Play-in teams skipping an earlier round each get their own BYE.
+				for _, t := range src.playIn {
+					bye := TeamInput{ID: fmt.Sprintf("%s%s-R%d", byePrefix, t.ID, round), Seed: t.Seed, Region: t.Region}
+					byes = append(byes, bye)
+					games = append(games, gameSetup{
+						gameID: fmt.Sprintf("R%d-%s", round, bye.ID[len(byePrefix):]),
+						side1:  []TeamInput{t},
+						side2:  []TeamInput{bye},
+					})
+				}
+			case src.playIn != nil && round == src.readyAfter:
+				t := src.playIn[0]
 				games = append(games, gameSetup{
-					gameID: fmt.Sprintf("R1-%s-%d", region, gameIdx),
-					side1:  []TeamInput{group[0]},
-					side2:  []TeamInput{group[1]},
+					gameID:     fmt.Sprintf("R%d-%s", round, src.byeBase[len(byePrefix):]),
+					siteGameID: fmt.Sprintf("%s-%s-%d", t.Region, models.RoundFirstFour, t.Seed),
+					side1:      []TeamInput{src.playIn[0]},
+					side2:      []TeamInput{src.playIn[1]},
 				})
-			} else if len(group) == 1 {
-				// Phantom BYE game: real team vs BYE sentinel.
-				byeID := fmt.Sprintf("%s%s-%d", byePrefix, region, seed)
-				bye := TeamInput{ID: byeID, Seed: seed, Region: region}
-				byeTeams = append(byeTeams, bye)
+			case round > src.readyAfter:
+				byeID := src.byeBase
+				if round > 1 {
+					byeID = fmt.Sprintf("%s-R%d", src.byeBase, round)
+				}
+				bye := TeamInput{ID: byeID, Seed: src.candidates[0].Seed, Region: src.candidates[0].Region}
+				byes = append(byes, bye)
 				games = append(games, gameSetup{
-					gameID: fmt.Sprintf("R1-%s-%d", region, gameIdx),
-					side1:  []TeamInput{group[0]},
+					gameID: fmt.Sprintf("R%d-%s", round, byeID[len(byePrefix):]),
+					side1:  src.candidates,
 					side2:  []TeamInput{bye},
 				})
 			}
-			gameIdx++
 		}
 	}
-
-	return games, byeTeams
+	return games, byes
 }
 
 // GenerateMatchups generates matchup predictions starting from a tournament checkpoint.
-// The tournament is modeled as a 128-team symmetric binary tree where 60 phantom BYE
-// opponents guarantee Round 1 wins, making all 7 rounds structurally identical.
+// The tournament is modeled as a 128-team symmetric binary tree where phantom BYE
+// opponents guarantee wins in the rounds a team skips, making all 7 rounds
+// structurally identical.
 //
-// For throughRound == 0 (pre-tournament), it requires exactly 68 teams and creates
-// BYE sentinels to fill the R128 bracket.
+// For throughRound == 0 (pre-tournament), every seeded slot in the template must
+// be filled and every team must have a slot.
 // For throughRound >= 1, all survivors start with pAdvance = 1.0.
 // Rounds already resolved (<= throughRound) are skipped.
-// ffConfig determines the Final Four region pairings; if nil, defaults are applied.
-func GenerateMatchups(teams []TeamInput, throughRound int, spec *winprob.Model, ffConfig *models.FinalFourConfig) ([]PredictedMatchup, error) {
-	if throughRound == 0 && len(teams) != 68 {
-		return nil, fmt.Errorf("expected 68 teams for pre-tournament predictions, got %d", len(teams))
+// tmpl describes the bracket shape; if nil, the NCAA layout with the default
+// Final Four pairing is used.
+func GenerateMatchups(teams []TeamInput, throughRound int, spec *winprob.Model, tmpl *models.BracketTemplate) ([]PredictedMatchup, error) {
+	return GenerateMatchupsWithSources(teams, throughRound, spec, tmpl, MatchupSources{})
+}
+
+// MatchupSources are optional per-tournament inputs to matchup pricing.
+type MatchupSources struct {
+	// Table prices the pairs it covers in place of the win-probability model.
+	Table *models.MatchupProbabilityTable
+	// Sites locate bracket games for venue-aware models; games without a
+	// site are neutral.
+	Sites []*models.GameSite
+	// Live prices games in progress from their posted state, in place of
+	// both the table and the pregame model.
+	Live []*models.LiveGameState
+}
+
+// GenerateMatchupsWithSources is GenerateMatchups with games in progress priced
+// from their live state, other matchups priced from an uploaded probability
+// table where it covers the pair, and from spec at the game's site otherwise. Model-priced matchups carry a projected spread when
+// spec projects scores.
+func GenerateMatchupsWithSources(teams []TeamInput, throughRound int, spec *winprob.Model, tmpl *models.BracketTemplate, sources MatchupSources) ([]PredictedMatchup, error) {
+	if tmpl == nil {
+		tmpl = appbracket.NCAATemplate(nil)
+	}
+	if err := tmpl.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid bracket template: %w", err)
+	}
+
+	model, unplaced := newBracketModel(tmpl, teams)
+	if throughRound == 0 {
+		if len(unplaced) > 0 {
+			return nil, fmt.Errorf("team %s (region=%s seed=%d) has no slot in bracket template %s", unplaced[0].ID, unplaced[0].Region, unplaced[0].Seed, tmpl.Key)
+		}
+		for _, g := range tmpl.Games {
+			for i, src := range model.sources[g.GameID] {
+				if !g.Slots[i].IsFeeder() && len(src.candidates) == 0 {
+					return nil, fmt.Errorf("expected a team for game %s slot %d in pre-tournament predictions, got %d teams", g.GameID, i+1, len(teams))
+				}
+			}
+		}
 	}
 
 	if spec == nil {
 		spec = &winprob.Model{Kind: "kenpom", Sigma: 10.0}
 	}
 	spec.Normalize()
-
-	if ffConfig == nil {
-		ffConfig = &models.FinalFourConfig{}
-		ffConfig.ApplyDefaults()
+	if err := spec.Validate(); err != nil {
+		return nil, fmt.Errorf("invalid game outcome spec: %w", err)
 	}
 
-	kenpomByID := make(map[string]float64, len(teams))
-	for _, t := range teams {
-		kenpomByID[t.ID] = t.KenPomNet
-	}
+	teamsByID := winprobTeams(teams)
+	sites := gameSiteLocations(sources.Sites)
+	table := sources.Table
+	live := liveGamesByID(sources.Live)
 
-	calcWinProb := func(id1, id2 string) float64 {
+	calcWinProb := func(g gameSetup, id1, id2 string) float64 {
 		// BYE opponents always lose.
 		if strings.HasPrefix(id2, byePrefix) {
 			return 1.0
@@ -221,19 +281,14 @@ func GenerateMatchups(teams []TeamInput, throughRound int, spec *winprob.Model,
 		if strings.HasPrefix(id1, byePrefix) {
 			return 0.0
 		}
-		return spec.WinProb(kenpomByID[id1], kenpomByID[id2])
-	}
-
-	teamsByRegion := make(map[string][]TeamInput)
-	for _, t := range teams {
-		teamsByRegion[t.Region] = append(teamsByRegion[t.Region], t)
-	}
-
-	regions := make([]string, 0, len(teamsByRegion))
-	for r := range teamsByRegion {
-		regions = append(regions, r)
+		if state := live[g.siteGameID]; isLiveMatchup(state, id1, id2) {
+			return liveWinProb(state, id1, id2, spec, table, teamsByID, sites[g.siteGameID])
+		}
+		if p, ok := table.Lookup(id1, id2); ok {
+			return p
+		}
+		return spec.ProbAtSite(teamsByID[id1], teamsByID[id2], sites[g.siteGameID])
 	}
-	sort.Strings(regions)
 
 	// Initialize pAdvance for all real teams.
 	pAdvance := make(map[string]float64, len(teams))
@@ -242,83 +297,67 @@ func GenerateMatchups(teams []TeamInput, throughRound int, spec *winprob.Model,
 	}
 
 	var matchups []PredictedMatchup
-
-	// Round 1 (R128): phantom BYE games + real First Four games.
-	if throughRound < 1 {
-		var r128Games []gameSetup
-		for _, region := range regions {
-			games, byeTeams := buildR128Games(region, teamsByRegion[region])
-			r128Games = append(r128Games, games...)
-			// Add BYE sentinels to pAdvance with probability 1.0.
-			for _, bye := range byeTeams {
-				pAdvance[bye.ID] = 1.0
-			}
-		}
-		var roundMatchups []PredictedMatchup
-		roundMatchups, pAdvance = computeRound(r128Games, pAdvance, calcWinProb, 1)
-		matchups = append(matchups, roundMatchups...)
-	}
-
-	// Rounds 2-5: regional rounds (R64, R32, S16, E8).
-	for round := 2; round <= 5; round++ {
-		if throughRound >= round {
+	for round := throughRound + 1; round <= models.MaxRounds; round++ {
+		games, byes := model.gamesForRound(round)
+		if len(games) == 0 {
 			continue
 		}
-		var games []gameSetup
-		for _, region := range regions {
-			games = append(games, buildRegionalGames(region, teamsByRegion[region], round)...)
+		for _, bye := range byes {
+			pAdvance[bye.ID] = 1.0
 		}
 		var roundMatchups []PredictedMatchup
 		roundMatchups, pAdvance = computeRound(games, pAdvance, calcWinProb, round)
+		projectSpreads(roundMatchups, games, spec, teamsByID, sites, table)
 		matchups = append(matchups, roundMatchups...)
 	}
 
-	// Round 6 (Final Four semifinals): use ffConfig for pairings.
-	if throughRound < 6 {
-		ffPairings := [][2]string{
-			{ffConfig.TopLeftRegion, ffConfig.BottomLeftRegion},
-			{ffConfig.TopRightRegion, ffConfig.BottomRightRegion},
+	return matchups, nil
+}
+
+// projectSpreads attaches the model's projected spread to every matchup it
+// priced between two real teams.
+func projectSpreads(matchups []PredictedMatchup, games []gameSetup, spec *winprob.Model, teamsByID map[string]winprob.Team, sites map[string]*winprob.Location, table *models.MatchupProbabilityTable) {
+	siteGameIDs := make(map[string]string, len(games))
+	for _, g := range games {
+		siteGameIDs[g.gameID] = g.siteGameID
+	}
+	for i := range matchups {
+		m := &matchups[i]
+		if strings.HasPrefix(m.Team1ID, byePrefix) || strings.HasPrefix(m.Team2ID, byePrefix) {
+			continue
 		}
-		var games []gameSetup
-		for gameNum, pairing := range ffPairings {
-			teams1 := teamsByRegion[pairing[0]]
-			teams2 := teamsByRegion[pairing[1]]
-			if len(teams1) > 0 && len(teams2) > 0 {
-				games = append(games, gameSetup{
-					gameID: fmt.Sprintf("R6-%d", gameNum+1),
-					side1:  teams1,
-					side2:  teams2,
-				})
-			}
+		if _, ok := table.Lookup(m.Team1ID, m.Team2ID); ok {
+			continue
 		}
-		if len(games) > 0 {
-			var roundMatchups []PredictedMatchup
-			roundMatchups, pAdvance = computeRound(games, pAdvance, calcWinProb, 6)
-			matchups = append(matchups, roundMatchups...)
+		spread, sd, ok := spec.Spread(teamsByID[m.Team1ID], teamsByID[m.Team2ID], sites[siteGameIDs[m.GameID]])
+		if !ok {
+			return
 		}
+		m.ProjectedSpread = &spread
+		m.SpreadSD = &sd
 	}
+}
 
-	// Round 7 (Championship): left bracket vs right bracket.
-	if throughRound < 7 {
-		var side1Teams, side2Teams []TeamInput
-		for _, r := range []string{ffConfig.TopLeftRegion, ffConfig.BottomLeftRegion} {
-			side1Teams = append(side1Teams, teamsByRegion[r]...)
-		}
-		for _, r := range []string{ffConfig.TopRightRegion, ffConfig.BottomRightRegion} {
-			side2Teams = append(side2Teams, teamsByRegion[r]...)
-		}
-		if len(side1Teams) > 0 && len(side2Teams) > 0 {
-			games := []gameSetup{{
-				gameID: "R7-1",
-				side1:  side1Teams,
-				side2:  side2Teams,
-			}}
-			roundMatchups, _ := computeRound(games, pAdvance, calcWinProb, 7)
-			matchups = append(matchups, roundMatchups...)
+// winprobTeams indexes the per-team model inputs by team ID.
+func winprobTeams(teams []TeamInput) map[string]winprob.Team {
+	out := make(map[string]winprob.Team, len(teams))
+	for _, t := range teams {
+		wt := winprob.Team{ID: t.ID, Seed: t.Seed, Net: t.KenPomNet, WinPct: t.WinPct, ORtg: t.ORtg, DRtg: t.DRtg, AdjT: t.AdjT}
+		if t.HomeLatitude != nil && t.HomeLongitude != nil {
+			wt.Home = &winprob.Location{Latitude: *t.HomeLatitude, Longitude: *t.HomeLongitude}
 		}
+		out[t.ID] = wt
 	}
+	return out
+}
 
-	return matchups, nil
+// gameSiteLocations indexes site locations by bracket game ID.
+func gameSiteLocations(sites []*models.GameSite) map[string]*winprob.Location {
+	out := make(map[string]*winprob.Location, len(sites))
+	for _, s := range sites {
+		out[s.GameID] = &winprob.Location{Latitude: s.Latitude, Longitude: s.Longitude}
+	}
+	return out
 }
 
 // computeAdvanceProbs computes each team's probability of advancing past the given round.
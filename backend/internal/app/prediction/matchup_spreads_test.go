@@ -0,0 +1,99 @@
+package prediction
+
+import (
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/winprob"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func championshipFinalists() []TeamInput {
+	return []TeamInput{
+		{ID: "t-east", Seed: 1, Region: "East", KenPomNet: 25.0, ORtg: 120, DRtg: 92, AdjT: 68, Wins: 5, Byes: 1},
+		{ID: "t-south", Seed: 2, Region: "South", KenPomNet: 20.0, ORtg: 116, DRtg: 95, AdjT: 70, Wins: 5, Byes: 1},
+	}
+}
+
+func TestThatTempoModelAttachesProjectedSpreadToMatchups(t *testing.T) {
+	// GIVEN two finalists and a tempo model
+	spec := &winprob.Model{Kind: winprob.KindTempo}
+
+	// WHEN generating checkpoint matchups
+	matchups, err := GenerateMatchups(championshipFinalists(), 6, spec, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the championship matchup carries a projected spread
+	if len(matchups) != 1 || matchups[0].ProjectedSpread == nil || matchups[0].SpreadSD == nil {
+		t.Errorf("expected one matchup with a projected spread, got %+v", matchups)
+	}
+}
+
+func TestThatKenPomModelLeavesProjectedSpreadUnset(t *testing.T) {
+	// GIVEN two finalists and a kenpom model
+	spec := &winprob.Model{Kind: winprob.KindKenPom, Sigma: 10.0}
+
+	// WHEN generating checkpoint matchups
+	matchups, err := GenerateMatchups(championshipFinalists(), 6, spec, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN no spread is projected
+	if len(matchups) != 1 || matchups[0].ProjectedSpread != nil {
+		t.Errorf("expected one matchup without a spread, got %+v", matchups)
+	}
+}
+
+func TestThatGameSiteNearTeamCampusRaisesItsWinProbability(t *testing.T) {
+	// GIVEN a home-court tempo model and the championship played on the East finalist's campus
+	spec := &winprob.Model{Kind: winprob.KindTempo, HomeCourt: 3.5}
+	teams := championshipFinalists()
+	lat, lon := 35.9, -79.0
+	teams[0].HomeLatitude, teams[0].HomeLongitude = &lat, &lon
+	sites := []*models.GameSite{{GameID: "championship", Name: "Campus Arena", Latitude: lat, Longitude: lon}}
+	neutral, err := GenerateMatchups(teams, 6, spec, nil)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// WHEN generating checkpoint matchups with the site
+	atSite, err := GenerateMatchupsWithSources(teams, 6, spec, nil, MatchupSources{Sites: sites})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the East finalist is more likely to win than on a neutral floor
+	if pEast(atSite) <= pEast(neutral) {
+		t.Errorf("expected site to favor t-east: neutral %f, at site %f", pEast(neutral), pEast(atSite))
+	}
+}
+
+func pEast(matchups []PredictedMatchup) float64 {
+	for _, m := range matchups {
+		if m.Team1ID == "t-east" {
+			return m.PTeam1WinsGivenMatchup
+		}
+		if m.Team2ID == "t-east" {
+			return m.PTeam2WinsGivenMatchup
+		}
+	}
+	return 0
+}
+
+func TestThatRealMatchupsDropsByeOpponents(t *testing.T) {
+	// GIVEN a real matchup and a matchup against a BYE
+	matchups := []PredictedMatchup{
+		{GameID: "R2-East-1", Team1ID: "a", Team2ID: "b"},
+		{GameID: "R1-East-1", Team1ID: "a", Team2ID: byePrefix + "East-1"},
+	}
+
+	// WHEN filtering
+	got := realMatchups(matchups)
+
+	// THEN only the real matchup remains
+	if len(got) != 1 || got[0].Team2ID != "b" {
+		t.Errorf("expected only the a vs b matchup, got %+v", got)
+	}
+}
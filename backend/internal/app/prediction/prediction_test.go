@@ -8,6 +8,7 @@ import (
 
 	"github.com/andrewcopp/Calcutta/backend/internal/app/scoring"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/winprob"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
 func TestThatGenerateMatchupsCreatesMatchupsForAllRounds(t *testing.T) {
@@ -731,7 +732,7 @@ func computeRoundScenario() ([]PredictedMatchup, map[string]float64) {
 		side2:  []TeamInput{{ID: "b", KenPomNet: 15.0}},
 	}}
 	pAdvance := map[string]float64{"a": 0.8, "b": 0.6}
-	calcWinProb := func(id1, id2 string) float64 { return 0.7 }
+	calcWinProb := func(_ gameSetup, id1, id2 string) float64 { return 0.7 }
 	return computeRound(games, pAdvance, calcWinProb, 1)
 }
 
@@ -778,7 +779,7 @@ func TestThatComputeRoundSetsRoundOrder(t *testing.T) {
 		side2:  []TeamInput{{ID: "b"}},
 	}}
 	pAdvance := map[string]float64{"a": 1.0, "b": 1.0}
-	calcWinProb := func(_, _ string) float64 { return 0.5 }
+	calcWinProb := func(_ gameSetup, _, _ string) float64 { return 0.5 }
 
 	// WHEN computing with roundOrder=4
 	matchups, _ := computeRound(games, pAdvance, calcWinProb, 4)
@@ -865,7 +866,7 @@ func TestThatGeneratePredictionsProducesValuesForAllTeams(t *testing.T) {
 	spec := &winprob.Model{Kind: "kenpom", Sigma: 10.0}
 
 	// WHEN generating predictions
-	values, err := generatePredictions(state, spec)
+	values, _, err := generatePredictions(state, spec)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -1281,3 +1282,76 @@ func generateTestTeams() []TeamInput {
 
 	return teams
 }
+
+func TestThatGenerateMatchupsFollowsCustomTemplate(t *testing.T) {
+	// GIVEN a four-team template where seed 1 skips the opening round
+	tmpl := &models.BracketTemplate{
+		Key:     "four_team",
+		Regions: []string{"A"},
+		Games: []models.TemplateGame{
+			{GameID: "A-r64", Round: models.RoundOf64, Region: "A", Slots: [2]models.TemplateSlot{{Seed: 2}, {Seed: 3}}},
+			{GameID: "A-r32", Round: models.RoundOf32, Region: "A", Slots: [2]models.TemplateSlot{{Seed: 1}, {FeederGameID: "A-r64"}}},
+			{GameID: "A-s16", Round: models.RoundSweet16, Region: "A", Slots: [2]models.TemplateSlot{{FeederGameID: "A-r32"}, {Seed: 4}}},
+		},
+	}
+	teams := []TeamInput{
+		{ID: "a1", Seed: 1, Region: "A", KenPomNet: 20.0},
+		{ID: "a2", Seed: 2, Region: "A", KenPomNet: 15.0},
+		{ID: "a3", Seed: 3, Region: "A", KenPomNet: 10.0},
+		{ID: "a4", Seed: 4, Region: "A", KenPomNet: 5.0},
+	}
+	spec := &winprob.Model{Kind: "kenpom", Sigma: 10.0}
+
+	// WHEN generating pre-tournament matchups
+	matchups, err := GenerateMatchups(teams, 0, spec, tmpl)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the final is the last round and every team reaches it with total probability 1
+	pFinal := 0.0
+	maxRound := 0
+	for _, m := range matchups {
+		if m.RoundOrder > maxRound {
+			maxRound = m.RoundOrder
+		}
+		if m.RoundOrder == 4 {
+			pFinal += m.PMatchup
+		}
+	}
+	if maxRound != 4 {
+		t.Errorf("expected final in round 4, got round %d", maxRound)
+	}
+	if math.Abs(pFinal-1.0) > 0.001 {
+		t.Errorf("final p_matchup sum = %.4f, expected 1.0", pFinal)
+	}
+}
+
+func TestThatGenerateMatchupsRejectsTeamWithoutTemplateSlot(t *testing.T) {
+	// GIVEN a 68-team field with one team in an unknown region
+	teams := generateTestTeams()
+	teams[0].Region = "Nowhere"
+	spec := &winprob.Model{Kind: "kenpom", Sigma: 10.0}
+
+	// WHEN generating pre-tournament matchups with the NCAA template
+	_, err := GenerateMatchups(teams, 0, spec, nil)
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for team without a slot")
+	}
+}
+
+func TestThatGenerateMatchupsRejectsInvalidSpec(t *testing.T) {
+	// GIVEN a 68-team field and a spec with an unsupported kind
+	teams := generateTestTeams()
+	spec := &winprob.Model{Kind: "unknown", Sigma: 10.0}
+
+	// WHEN generating pre-tournament matchups
+	_, err := GenerateMatchups(teams, 0, spec, nil)
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for unsupported kind")
+	}
+}
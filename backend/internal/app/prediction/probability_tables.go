@@ -0,0 +1,148 @@
+package prediction
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"regexp"
+	"strings"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// matchupSymmetryTolerance bounds |p + q - 1| for a pair supplied in both
+// orientations. It admits probabilities rounded to four decimal places.
+const matchupSymmetryTolerance = 1e-4
+
+var sourceKeyPattern = regexp.MustCompile(`^[a-z0-9][a-z0-9_\-]{0,63}$`)
+
+// reservedSourceKeys label model-based runs and cannot name an uploaded table.
+var reservedSourceKeys = map[string]bool{
+	"kenpom":       true,
+	"go_worker":    true,
+	"lab_pipeline": true,
+}
+
+// ProbabilityTableUpload is a pairwise win-probability matrix for one
+// tournament. Teams are identified by school slug.
+type ProbabilityTableUpload struct {
+	TournamentID string
+	SourceKey    string
+	Description  *string
+	UploadedBy   string
+	Matchups     []MatchupProbabilityInput
+}
+
+// MatchupProbabilityInput is one entry of an uploaded matrix: the probability
+// that the team from Team1Slug beats the team from Team2Slug.
+type MatchupProbabilityInput struct {
+	Team1Slug  string
+	Team2Slug  string
+	PTeam1Wins float64
+}
+
+// UploadProbabilityTable validates the matrix and stores it under the source
+// key, replacing any earlier upload with the same key. A pair may be supplied
+// in one orientation or both; when both are present they must sum to 1.
+func (s *Service) UploadProbabilityTable(ctx context.Context, in ProbabilityTableUpload) (*models.MatchupProbabilityTable, error) {
+	if in.TournamentID == "" {
+		return nil, errors.New("TournamentID is required")
+	}
+	key := strings.TrimSpace(in.SourceKey)
+	if !sourceKeyPattern.MatchString(key) {
+		return nil, &apperrors.InvalidArgumentError{Field: "source_key", Message: "source_key must be 1-64 lowercase letters, digits, '_' or '-'"}
+	}
+	if reservedSourceKeys[key] {
+		return nil, &apperrors.InvalidArgumentError{Field: "source_key", Message: fmt.Sprintf("source_key %q is reserved", key)}
+	}
+
+	teamIDBySlug, err := s.ports.ProbabilityTables.LoadTeamIDsBySchoolSlug(ctx, in.TournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load tournament teams: %w", err)
+	}
+	if len(teamIDBySlug) == 0 {
+		return nil, &apperrors.NotFoundError{Resource: "tournament teams", ID: in.TournamentID}
+	}
+
+	probs, err := buildMatchupProbabilities(in.Matchups, teamIDBySlug)
+	if err != nil {
+		return nil, err
+	}
+
+	var uploadedBy *string
+	if in.UploadedBy != "" {
+		uploadedBy = &in.UploadedBy
+	}
+	stored, err := s.ports.ProbabilityTables.ReplaceMatchupProbabilityTable(ctx, &models.MatchupProbabilityTable{
+		TournamentID:  in.TournamentID,
+		SourceKey:     key,
+		Description:   in.Description,
+		UploadedBy:    uploadedBy,
+		Probabilities: probs,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("failed to store matchup probability table: %w", err)
+	}
+	return stored, nil
+}
+
+// ListProbabilityTables returns the tournament's uploaded tables.
+func (s *Service) ListProbabilityTables(ctx context.Context, tournamentID string) ([]*models.MatchupProbabilityTable, error) {
+	return s.ports.ProbabilityTables.ListMatchupProbabilityTables(ctx, tournamentID)
+}
+
+// DeleteProbabilityTable removes the table uploaded under the source key.
+func (s *Service) DeleteProbabilityTable(ctx context.Context, tournamentID, sourceKey string) error {
+	return s.ports.ProbabilityTables.DeleteMatchupProbabilityTable(ctx, tournamentID, sourceKey)
+}
+
+// buildMatchupProbabilities resolves slugs to team IDs, checks each entry, and
+// returns both orientations of every pair.
+func buildMatchupProbabilities(matchups []MatchupProbabilityInput, teamIDBySlug map[string]string) (map[models.TeamPair]float64, error) {
+	if len(matchups) == 0 {
+		return nil, &apperrors.InvalidArgumentError{Field: "matchups", Message: "at least one matchup is required"}
+	}
+
+	slugByTeamID := make(map[string]string, len(teamIDBySlug))
+	for slug, id := range teamIDBySlug {
+		slugByTeamID[id] = slug
+	}
+
+	supplied := make(map[models.TeamPair]float64, len(matchups))
+	for i, m := range matchups {
+		team1, ok := teamIDBySlug[m.Team1Slug]
+		if !ok {
+			return nil, &apperrors.InvalidArgumentError{Field: "matchups", Message: fmt.Sprintf("matchups[%d]: school %q is not in this tournament", i, m.Team1Slug)}
+		}
+		team2, ok := teamIDBySlug[m.Team2Slug]
+		if !ok {
+			return nil, &apperrors.InvalidArgumentError{Field: "matchups", Message: fmt.Sprintf("matchups[%d]: school %q is not in this tournament", i, m.Team2Slug)}
+		}
+		if team1 == team2 {
+			return nil, &apperrors.InvalidArgumentError{Field: "matchups", Message: fmt.Sprintf("matchups[%d]: a team cannot play itself", i)}
+		}
+		if math.IsNaN(m.PTeam1Wins) || m.PTeam1Wins < 0 || m.PTeam1Wins > 1 {
+			return nil, &apperrors.InvalidArgumentError{Field: "matchups", Message: fmt.Sprintf("matchups[%d]: probability must be between 0 and 1", i)}
+		}
+		pair := models.TeamPair{Team1ID: team1, Team2ID: team2}
+		if _, dup := supplied[pair]; dup {
+			return nil, &apperrors.InvalidArgumentError{Field: "matchups", Message: fmt.Sprintf("matchups[%d]: %s vs %s appears more than once", i, m.Team1Slug, m.Team2Slug)}
+		}
+		supplied[pair] = m.PTeam1Wins
+	}
+
+	out := make(map[models.TeamPair]float64, len(supplied)*2)
+	for pair, p := range supplied {
+		reverse := models.TeamPair{Team1ID: pair.Team2ID, Team2ID: pair.Team1ID}
+		if q, ok := supplied[reverse]; ok && math.Abs(p+q-1) > matchupSymmetryTolerance {
+			return nil, &apperrors.InvalidArgumentError{Field: "matchups", Message: fmt.Sprintf("%s vs %s and its reverse sum to %.4f, expected 1", slugByTeamID[pair.Team1ID], slugByTeamID[pair.Team2ID], p+q)}
+		}
+		out[pair] = p
+		if _, ok := supplied[reverse]; !ok {
+			out[reverse] = 1 - p
+		}
+	}
+	return out, nil
+}
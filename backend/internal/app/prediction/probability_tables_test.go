@@ -0,0 +1,118 @@
+package prediction
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func newSlugIndex() map[string]string {
+	return map[string]string{"duke": "t-duke", "unc": "t-unc", "kansas": "t-kansas"}
+}
+
+func TestThatBuildMatchupProbabilitiesFillsMissingReverseOrientation(t *testing.T) {
+	// GIVEN a single-orientation entry
+	matchups := []MatchupProbabilityInput{{Team1Slug: "duke", Team2Slug: "unc", PTeam1Wins: 0.625}}
+
+	// WHEN building the table
+	probs, err := buildMatchupProbabilities(matchups, newSlugIndex())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the reverse orientation is the complement
+	if got := probs[models.TeamPair{Team1ID: "t-unc", Team2ID: "t-duke"}]; got != 0.375 {
+		t.Errorf("expected 0.375, got %v", got)
+	}
+}
+
+func TestThatBuildMatchupProbabilitiesRejectsAsymmetricPair(t *testing.T) {
+	// GIVEN both orientations of a pair summing to 1.1
+	matchups := []MatchupProbabilityInput{
+		{Team1Slug: "duke", Team2Slug: "unc", PTeam1Wins: 0.6},
+		{Team1Slug: "unc", Team2Slug: "duke", PTeam1Wins: 0.5},
+	}
+
+	// WHEN building the table
+	_, err := buildMatchupProbabilities(matchups, newSlugIndex())
+
+	// THEN an invalid argument error is returned
+	var invalid *apperrors.InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidArgumentError, got %v", err)
+	}
+}
+
+func TestThatBuildMatchupProbabilitiesAcceptsSymmetricPairWithinTolerance(t *testing.T) {
+	// GIVEN both orientations rounded to four decimal places
+	matchups := []MatchupProbabilityInput{
+		{Team1Slug: "duke", Team2Slug: "unc", PTeam1Wins: 0.6667},
+		{Team1Slug: "unc", Team2Slug: "duke", PTeam1Wins: 0.3333},
+	}
+
+	// WHEN building the table
+	_, err := buildMatchupProbabilities(matchups, newSlugIndex())
+
+	// THEN no error is returned
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
+func TestThatBuildMatchupProbabilitiesRejectsUnknownSchool(t *testing.T) {
+	// GIVEN an entry naming a school outside the tournament
+	matchups := []MatchupProbabilityInput{{Team1Slug: "duke", Team2Slug: "gonzaga", PTeam1Wins: 0.5}}
+
+	// WHEN building the table
+	_, err := buildMatchupProbabilities(matchups, newSlugIndex())
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for unknown school")
+	}
+}
+
+func TestThatBuildMatchupProbabilitiesRejectsProbabilityOutOfRange(t *testing.T) {
+	// GIVEN an entry with a probability above 1
+	matchups := []MatchupProbabilityInput{{Team1Slug: "duke", Team2Slug: "unc", PTeam1Wins: 1.2}}
+
+	// WHEN building the table
+	_, err := buildMatchupProbabilities(matchups, newSlugIndex())
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for probability out of range")
+	}
+}
+
+func TestThatBuildMatchupProbabilitiesRejectsDuplicateEntry(t *testing.T) {
+	// GIVEN the same orientation listed twice
+	matchups := []MatchupProbabilityInput{
+		{Team1Slug: "duke", Team2Slug: "unc", PTeam1Wins: 0.6},
+		{Team1Slug: "duke", Team2Slug: "unc", PTeam1Wins: 0.6},
+	}
+
+	// WHEN building the table
+	_, err := buildMatchupProbabilities(matchups, newSlugIndex())
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for duplicate entry")
+	}
+}
+
+func TestThatUploadProbabilityTableRejectsReservedSourceKey(t *testing.T) {
+	// GIVEN an upload under the kenpom source key
+	svc := New(Ports{})
+
+	// WHEN uploading
+	_, err := svc.UploadProbabilityTable(t.Context(), ProbabilityTableUpload{TournamentID: "t1", SourceKey: "kenpom"})
+
+	// THEN an invalid argument error is returned
+	var invalid *apperrors.InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidArgumentError, got %v", err)
+	}
+}
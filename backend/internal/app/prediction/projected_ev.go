@@ -7,9 +7,12 @@ import (
 
 // TeamProgress represents the current tournament progress of a team.
 type TeamProgress struct {
+	Seed         int
 	Wins         int
 	Byes         int
 	IsEliminated bool
+	// BeatenSeeds are the seeds of the teams it has beaten, in order.
+	BeatenSeeds []int
 }
 
 // ProjectedTeamEV computes the projected expected value for a team given its
@@ -19,22 +22,30 @@ type TeamProgress struct {
 // - Pre-tournament (0 wins, 0 byes): returns the full predicted expected points
 // - Alive mid-tournament: returns actual points + conditional expected remaining points
 //
+// When the rules pay by seed, a pre-tournament team is priced like an alive one,
+// since the predicted expected points may have used other rules. Upset bonuses
+// for remaining rounds are priced from the team's expected upset margins.
+//
 // When throughRound > 0 and progress == throughRound, pAlive = 1.0 so the
 // division is a no-op. When progress > throughRound, the division correctly
 // conditions on survival beyond the checkpoint.
 func ProjectedTeamEV(ptv PredictedTeamValue, rules []scoring.Rule, tp TeamProgress, throughRound int) float64 {
-	actualPoints := float64(scoring.PointsForProgress(rules, tp.Wins, tp.Byes))
+	run := scoring.Run{Seed: tp.Seed, Wins: tp.Wins, Byes: tp.Byes, BeatenSeeds: tp.BeatenSeeds}
+	actualPoints := float64(scoring.PointsForRun(rules, run))
 
 	if tp.IsEliminated {
 		return actualPoints
 	}
 
 	progress := tp.Wins + tp.Byes
+	pAlive := 1.0
 	if progress == 0 {
-		return ptv.ExpectedPoints
+		if !scoring.SeedDependent(rules) {
+			return ptv.ExpectedPoints
+		}
+	} else {
+		pAlive = ptv.PRoundByIndex(progress)
 	}
-
-	pAlive := ptv.PRoundByIndex(progress)
 	if pAlive <= 0 {
 		return actualPoints
 	}
@@ -44,8 +55,9 @@ func ProjectedTeamEV(ptv PredictedTeamValue, rules []scoring.Rule, tp TeamProgre
 	var conditionalRemaining float64
 	for r := progress + 1; r <= maxRound; r++ {
 		pReachRound := ptv.PRoundByIndex(r)
-		incPoints := float64(scoring.PointsForProgress(rules, r, 0) - scoring.PointsForProgress(rules, r-1, 0))
-		conditionalRemaining += (pReachRound / pAlive) * incPoints
+		incPoints := float64(scoring.WinPoints(rules, r, tp.Seed, 0))
+		upsetPoints := ptv.ExpectedUpsetMarginByIndex(r) * float64(scoring.UpsetPointsPerSeed(rules, r))
+		conditionalRemaining += (pReachRound*incPoints + upsetPoints) / pAlive
 	}
 
 	return actualPoints + conditionalRemaining
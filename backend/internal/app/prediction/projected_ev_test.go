@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/app/scoring"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
 func testRules() []scoring.Rule {
@@ -262,3 +263,49 @@ func TestThatProjectedTeamEVHandlesTeamWithBye(t *testing.T) {
 		t.Errorf("expected %.2f, got %.2f", expected, result)
 	}
 }
+
+func TestThatProjectedTeamEVPricesUpsetBonusFromExpectedMargins(t *testing.T) {
+	// GIVEN a 12 seed with one win that pays 5 per seed line for a round 2 upset,
+	// expected to beat its round 2 opponent by 0.5 seed lines
+	ptv := PredictedTeamValue{
+		TeamID:               "team-1",
+		PRound1:              1.0,
+		PRound2:              0.4,
+		ExpectedUpsetMargins: []float64{0, 0.5},
+	}
+	rules := []scoring.Rule{
+		{Kind: models.ScoringRuleKindRound, WinIndex: 1, PointsAwarded: 10},
+		{Kind: models.ScoringRuleKindUpsetBonus, WinIndex: 2, PointsAwarded: 5},
+	}
+	tp := TeamProgress{Seed: 12, Wins: 1, BeatenSeeds: []int{12}}
+
+	// WHEN computing projected EV
+	result := ProjectedTeamEV(ptv, rules, tp, 0)
+
+	// THEN result is actual 10 plus the expected bonus 0.5 * 5 = 12.5
+	expected := 12.5
+	if math.Abs(result-expected) > 0.001 {
+		t.Errorf("expected %.2f, got %.2f", expected, result)
+	}
+}
+
+func TestThatProjectedTeamEVPricesSeedMultiplierPreTournament(t *testing.T) {
+	// GIVEN a 3 seed before the tournament in a pool paying 2 points per seed
+	// for a first win, with stale expected points from other rules
+	ptv := PredictedTeamValue{
+		TeamID:         "team-1",
+		ExpectedPoints: 500.0,
+		PRound1:        0.5,
+	}
+	rules := []scoring.Rule{{Kind: models.ScoringRuleKindSeedMultiplier, WinIndex: 1, PointsAwarded: 2}}
+	tp := TeamProgress{Seed: 3}
+
+	// WHEN computing projected EV
+	result := ProjectedTeamEV(ptv, rules, tp, 0)
+
+	// THEN result is 0.5 * 2 * 3 = 3
+	expected := 3.0
+	if math.Abs(result-expected) > 0.001 {
+		t.Errorf("expected %.2f, got %.2f", expected, result)
+	}
+}
@@ -8,7 +8,9 @@ import (
 	"log/slog"
 	"time"
 
+	"github.com/andrewcopp/Calcutta/backend/internal/app/scoring"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/winprob"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
 	"github.com/andrewcopp/Calcutta/backend/internal/ports"
 )
 
@@ -16,6 +18,9 @@ import (
 type Ports struct {
 	Batches    ports.PredictionRepository
 	Tournament ports.TournamentDataLoader
+	// ProbabilityTables manages uploaded matchup probability tables; it is
+	// only required by the upload, list and delete methods.
+	ProbabilityTables ports.MatchupProbabilityTableRepository
 }
 
 // Service handles prediction generation and storage.
@@ -46,6 +51,16 @@ func (p *RunParams) applyDefaults() {
 	p.GameOutcomeSpec.Normalize()
 }
 
+func (p *RunParams) validate() error {
+	if p.TournamentID == "" {
+		return errors.New("TournamentID is required")
+	}
+	if err := p.GameOutcomeSpec.Validate(); err != nil {
+		return fmt.Errorf("invalid game outcome spec: %w", err)
+	}
+	return nil
+}
+
 // RunResult holds the output of a prediction run.
 type RunResult struct {
 	BatchID              string
@@ -55,8 +70,10 @@ type RunResult struct {
 	Duration             time.Duration
 }
 
-// loadTournamentData loads teams, scoring rules, and final four config from the database.
-func (s *Service) loadTournamentData(ctx context.Context, tournamentID string) (*TournamentData, error) {
+// loadTournamentData loads teams, scoring rules, final four config, bracket template,
+// game results, game sites, live game states and any matchup probability table
+// stored under probSourceKey.
+func (s *Service) loadTournamentData(ctx context.Context, tournamentID string, probSourceKey string) (*TournamentData, error) {
 	teams, err := s.ports.Tournament.LoadTeams(ctx, tournamentID)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load teams: %w", err)
@@ -75,26 +92,70 @@ func (s *Service) loadTournamentData(ctx context.Context, tournamentID string) (
 		return nil, fmt.Errorf("failed to load final four config: %w", err)
 	}
 
+	tmpl, err := s.ports.Tournament.LoadBracketTemplate(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load bracket template: %w", err)
+	}
+
+	results, err := s.ports.Tournament.LoadGameResults(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load game results: %w", err)
+	}
+	attachBeatenSeeds(teams, results)
+
+	table, err := s.ports.Tournament.LoadMatchupProbabilityTable(ctx, tournamentID, probSourceKey)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load matchup probability table: %w", err)
+	}
+
+	sites, err := s.ports.Tournament.LoadGameSites(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load game sites: %w", err)
+	}
+
+	live, err := s.ports.Tournament.LoadLiveGameStates(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load live game states: %w", err)
+	}
+
 	return &TournamentData{
 		Teams:    teams,
 		Rules:    rules,
 		FFConfig: ffConfig,
+		Template: tmpl,
+		Results:  results,
+		Table:    table,
+		Sites:    sites,
+		Live:     live,
 	}, nil
 }
 
+// attachBeatenSeeds records the seeds each team has beaten, which seed-based
+// scoring rules price.
+func attachBeatenSeeds(teams []TeamInput, results []*models.GameResult) {
+	seedByTeam := make(map[string]int, len(teams))
+	for _, t := range teams {
+		seedByTeam[t.ID] = t.Seed
+	}
+	beaten := scoring.BeatenSeeds(seedByTeam, results)
+	for i := range teams {
+		teams[i].BeatenSeeds = beaten[teams[i].ID]
+	}
+}
+
 // runForCheckpoint generates predictions for a single checkpoint and stores them.
 func (s *Service) runForCheckpoint(ctx context.Context, tournamentID string, data *TournamentData, spec *winprob.Model, probSourceKey string, throughRound int) (*RunResult, error) {
 	start := time.Now()
 
 	state := NewTournamentState(data, throughRound)
 
-	teamValues, err := generatePredictions(state, spec)
+	teamValues, matchups, err := generatePredictions(state, spec)
 	if err != nil {
 		return nil, fmt.Errorf("prediction run failed for checkpoint %d: %w", throughRound, err)
 	}
 
 	specJSON, _ := json.Marshal(spec)
-	batchID, err := s.ports.Batches.StorePredictions(ctx, tournamentID, probSourceKey, specJSON, teamValues, throughRound)
+	batchID, err := s.ports.Batches.StorePredictions(ctx, tournamentID, probSourceKey, specJSON, teamValues, realMatchups(matchups), throughRound)
 	if err != nil {
 		return nil, fmt.Errorf("failed to store predictions for checkpoint %d: %w", throughRound, err)
 	}
@@ -114,12 +175,12 @@ func (s *Service) runForCheckpoint(ctx context.Context, tournamentID string, dat
 
 // Run generates predictions for a tournament and stores them in the database.
 func (s *Service) Run(ctx context.Context, p RunParams) (*RunResult, error) {
-	if p.TournamentID == "" {
-		return nil, errors.New("TournamentID is required")
-	}
 	p.applyDefaults()
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
 
-	data, err := s.loadTournamentData(ctx, p.TournamentID)
+	data, err := s.loadTournamentData(ctx, p.TournamentID, p.ProbabilitySourceKey)
 	if err != nil {
 		return nil, err
 	}
@@ -136,12 +197,12 @@ func (s *Service) Run(ctx context.Context, p RunParams) (*RunResult, error) {
 // round 0 through the current tournament state. Loads tournament data once
 // and reuses it across all checkpoints.
 func (s *Service) RunAllCheckpoints(ctx context.Context, p RunParams) ([]RunResult, error) {
-	if p.TournamentID == "" {
-		return nil, errors.New("TournamentID is required")
-	}
 	p.applyDefaults()
+	if err := p.validate(); err != nil {
+		return nil, err
+	}
 
-	data, err := s.loadTournamentData(ctx, p.TournamentID)
+	data, err := s.loadTournamentData(ctx, p.TournamentID, p.ProbabilitySourceKey)
 	if err != nil {
 		return nil, err
 	}
@@ -216,6 +277,12 @@ func (s *Service) GetTeamValues(ctx context.Context, batchID string) ([]Predicte
 	return s.ports.Batches.GetTeamValues(ctx, batchID)
 }
 
+// GetMatchups returns every matchup a batch priced, with projected spreads
+// when its model produces them.
+func (s *Service) GetMatchups(ctx context.Context, batchID string) ([]PredictedMatchup, error) {
+	return s.ports.Batches.GetMatchups(ctx, batchID)
+}
+
 // GetExpectedPointsMap returns a map of team_id -> expected_points for a tournament.
 func (s *Service) GetExpectedPointsMap(ctx context.Context, tournamentID string) (map[string]float64, error) {
 	batchID, found, err := s.GetLatestBatchID(ctx, tournamentID)
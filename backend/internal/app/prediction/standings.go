@@ -50,6 +50,31 @@ func (s *Service) LoadCheckpointPredictions(ctx context.Context, tournamentID st
 	return result
 }
 
+// LoadLatestWinProbabilities returns the latest prediction batch's ID and its
+// chance of each team beating each opponent it could meet, keyed by that team
+// first. It returns no batch and nil when the tournament has no predictions.
+func (s *Service) LoadLatestWinProbabilities(ctx context.Context, tournamentID string) (string, map[models.TeamPair]float64) {
+	batchID, found, err := s.GetLatestBatchID(ctx, tournamentID)
+	if err != nil || !found {
+		return "", nil
+	}
+	matchups, err := s.GetMatchups(ctx, batchID)
+	if err != nil {
+		return "", nil
+	}
+	return batchID, WinProbabilities(matchups)
+}
+
+// WinProbabilities indexes matchups by pair, in both orders.
+func WinProbabilities(matchups []PredictedMatchup) map[models.TeamPair]float64 {
+	probs := make(map[models.TeamPair]float64, 2*len(matchups))
+	for _, m := range matchups {
+		probs[models.TeamPair{Team1ID: m.Team1ID, Team2ID: m.Team2ID}] = m.PTeam1WinsGivenMatchup
+		probs[models.TeamPair{Team1ID: m.Team2ID, Team2ID: m.Team1ID}] = m.PTeam2WinsGivenMatchup
+	}
+	return probs
+}
+
 // LatestCheckpoint returns the checkpoint with the highest ThroughRound.
 func LatestCheckpoint(checkpoints []CheckpointData) *CheckpointData {
 	if len(checkpoints) == 0 {
@@ -88,9 +113,12 @@ type PortfolioTeamInput struct {
 // TournamentTeamInput is the minimal data needed from a tournament team for projection math.
 type TournamentTeamInput struct {
 	ID           string
+	Seed         int
 	Wins         int
 	Byes         int
 	IsEliminated bool
+	// BeatenSeeds are the seeds of the teams it has beaten, in order.
+	BeatenSeeds []int
 }
 
 // EntryProjections holds projected EV and Favorites for each entry.
@@ -125,16 +153,20 @@ func ProgressAtRound(wins, byes, round int) int {
 }
 
 // snapshotTeamsAtRound returns a copy of teams with progress capped at roundCap.
-// Byes are folded into wins, and teams eliminated beyond the cap are treated as alive.
+// Byes are folded into wins, each beating seed 0 so that it earns no upset bonus,
+// and teams eliminated beyond the cap are treated as alive.
 func snapshotTeamsAtRound(teams []TournamentTeamInput, roundCap int) []TournamentTeamInput {
 	out := make([]TournamentTeamInput, len(teams))
 	for i, tt := range teams {
 		progress := tt.Wins + tt.Byes
+		run := scoring.Run{Seed: tt.Seed, Wins: tt.Wins, Byes: tt.Byes, BeatenSeeds: tt.BeatenSeeds}.Capped(roundCap)
 		out[i] = TournamentTeamInput{
 			ID:           tt.ID,
+			Seed:         tt.Seed,
 			Wins:         ProgressAtRound(tt.Wins, tt.Byes, roundCap),
 			Byes:         0,
 			IsEliminated: tt.IsEliminated && progress <= roundCap,
+			BeatenSeeds:  append(make([]int, run.Byes), run.BeatenSeeds...),
 		}
 	}
 	return out
@@ -186,9 +218,11 @@ func computeProjectionsForCheckpoint(
 			continue
 		}
 		tp := TeamProgress{
+			Seed:         tt.Seed,
 			Wins:         tt.Wins,
 			Byes:         tt.Byes,
 			IsEliminated: tt.IsEliminated,
+			BeatenSeeds:  tt.BeatenSeeds,
 		}
 		ev[entryID] += pt.OwnershipPercentage * ProjectedTeamEV(ptv, rules, tp, cp.ThroughRound)
 		fav[entryID] += pt.OwnershipPercentage * ptv.FavoritesTotalPoints
@@ -219,15 +253,19 @@ func ToPortfolioTeamInputs(pts []*models.OwnershipDetail) []PortfolioTeamInput {
 	return out
 }
 
-// ToTournamentTeamInputs converts domain tournament teams to the minimal input type.
-func ToTournamentTeamInputs(tts []*models.TournamentTeam) []TournamentTeamInput {
+// ToTournamentTeamInputs converts domain tournament teams to the minimal input
+// type, taking the seeds each team has beaten from the recorded results.
+func ToTournamentTeamInputs(tts []*models.TournamentTeam, results []*models.GameResult) []TournamentTeamInput {
+	runs := scoring.RunsFromResults(tts, results)
 	out := make([]TournamentTeamInput, len(tts))
 	for i, tt := range tts {
 		out[i] = TournamentTeamInput{
 			ID:           tt.ID,
+			Seed:         tt.Seed,
 			Wins:         tt.Wins,
 			Byes:         tt.Byes,
 			IsEliminated: tt.IsEliminated,
+			BeatenSeeds:  runs[tt.ID].BeatenSeeds,
 		}
 	}
 	return out
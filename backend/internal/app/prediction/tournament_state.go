@@ -10,6 +10,20 @@ type TournamentData struct {
 	Teams    []TeamInput
 	Rules    []scoring.Rule
 	FFConfig *models.FinalFourConfig
+	// Template is the tournament's stored bracket template; nil means the NCAA
+	// layout paired by FFConfig.
+	Template *models.BracketTemplate
+	// Results are the recorded game results, used to update history-aware
+	// win-probability models.
+	Results []*models.GameResult
+	// Table holds uploaded matchup probabilities for the run's source key;
+	// nil means every matchup is priced by the win-probability model.
+	Table *models.MatchupProbabilityTable
+	// Sites are where bracket games are played; games without one are neutral.
+	Sites []*models.GameSite
+	// Live holds the posted state of games in progress; their matchups are
+	// priced from the score and time remaining.
+	Live []*models.LiveGameState
 }
 
 // TournamentState is a checkpoint-specific snapshot with survivors partitioned from eliminated teams.
@@ -19,6 +33,11 @@ type TournamentState struct {
 	Survivors    []TeamInput
 	Rules        []scoring.Rule
 	FFConfig     *models.FinalFourConfig
+	Template     *models.BracketTemplate
+	Results      []*models.GameResult
+	Table        *models.MatchupProbabilityTable
+	Sites        []*models.GameSite
+	Live         []*models.LiveGameState
 }
 
 // snapshotTeamAtCheckpoint caps a team's progress (Wins + Byes) to throughRound.
@@ -39,6 +58,8 @@ func snapshotTeamAtCheckpoint(team TeamInput, throughRound int) TeamInput {
 
 // NewTournamentState creates a checkpoint snapshot by capping team progress to throughRound
 // and partitioning teams into survivors (wins + byes >= throughRound) and the full roster.
+// Live game states only carry into the current checkpoint; an earlier one is
+// priced as it stood before those games tipped off.
 func NewTournamentState(data *TournamentData, throughRound int) *TournamentState {
 	cappedTeams := make([]TeamInput, len(data.Teams))
 	for i, t := range data.Teams {
@@ -52,11 +73,21 @@ func NewTournamentState(data *TournamentData, throughRound int) *TournamentState
 		}
 	}
 
+	var live []*models.LiveGameState
+	if throughRound == detectThroughRoundFromTeams(data.Teams) {
+		live = data.Live
+	}
+
 	return &TournamentState{
 		ThroughRound: throughRound,
 		AllTeams:     cappedTeams,
 		Survivors:    survivors,
 		Rules:        data.Rules,
 		FFConfig:     data.FFConfig,
+		Template:     data.Template,
+		Results:      data.Results,
+		Table:        data.Table,
+		Sites:        data.Sites,
+		Live:         live,
 	}
 }
@@ -12,13 +12,12 @@ type teamRoundKey struct {
 	roundOrder int
 }
 
-// buildIncByRound computes the incremental points for reaching each progress level.
-func buildIncByRound(rules []scoring.Rule) map[int]float64 {
+// buildIncByRound computes the incremental points a team of the given seed
+// earns for reaching each progress level, before any upset bonus.
+func buildIncByRound(rules []scoring.Rule, seed int) map[int]float64 {
 	incByRound := make(map[int]float64)
 	for r := 1; r <= models.MaxRounds; r++ {
-		ptsR := float64(scoring.PointsForProgress(rules, r, 0))
-		ptsRMinus1 := float64(scoring.PointsForProgress(rules, r-1, 0))
-		incByRound[r] = ptsR - ptsRMinus1
+		incByRound[r] = float64(scoring.WinPoints(rules, r, seed, 0))
 	}
 	return incByRound
 }
@@ -33,6 +32,26 @@ func aggregatePWinByRound(matchups []PredictedMatchup) map[teamRoundKey]float64
 	return result
 }
 
+// aggregateUpsetMarginByRound sums pMatchup*pWin*(seed - opponent seed) by
+// (teamID, roundOrder) over the matchups a team would win as the worse seed.
+// Opponents without a seed, such as byes, never count as upsets.
+func aggregateUpsetMarginByRound(matchups []PredictedMatchup, seedByTeam map[string]int) map[teamRoundKey]float64 {
+	result := make(map[teamRoundKey]float64)
+	for _, m := range matchups {
+		s1, s2 := seedByTeam[m.Team1ID], seedByTeam[m.Team2ID]
+		if s1 == 0 || s2 == 0 {
+			continue
+		}
+		if s1 > s2 {
+			result[teamRoundKey{m.Team1ID, m.RoundOrder}] += m.PMatchup * m.PTeam1WinsGivenMatchup * float64(s1-s2)
+		}
+		if s2 > s1 {
+			result[teamRoundKey{m.Team2ID, m.RoundOrder}] += m.PMatchup * m.PTeam2WinsGivenMatchup * float64(s2-s1)
+		}
+	}
+	return result
+}
+
 // enforceMonotonicity ensures each probability is <= the previous one.
 func enforceMonotonicity(probs []float64) {
 	for i := 1; i < len(probs); i++ {
@@ -48,7 +67,10 @@ func enforceMonotonicity(probs []float64) {
 //   - ACTUAL: deterministic points from team progress at this checkpoint. No model needed.
 //   - PROJECTED: forward-looking probabilities and expected points for remaining rounds.
 //   - COMBINE: ExpectedPoints = actualPoints + projectedEV,
-//     FavoritesTotalPoints = PointsForProgress(progress + futureWins).
+//     FavoritesTotalPoints = actualPoints + points for the favorites' future wins.
+//
+// Upset bonuses are priced from the expected upset margin in each projected
+// round; the favorites path never earns one.
 //
 // PRound semantics (128-team symmetric model): PRound[r] maps directly to matchup round r.
 //   - PRound1 = P(wins R128): 1.0 for bye teams, <1.0 for FF teams
@@ -60,7 +82,11 @@ func GenerateTournamentValues(
 	rules []scoring.Rule,
 ) []PredictedTeamValue {
 	pWinByRound := aggregatePWinByRound(matchups)
-	incByRound := buildIncByRound(rules)
+	seedByTeam := make(map[string]int, len(allTeams))
+	for _, team := range allTeams {
+		seedByTeam[team.ID] = team.Seed
+	}
+	upsetMarginByRound := aggregateUpsetMarginByRound(matchups, seedByTeam)
 
 	futureWins := computeFavoritesFutureWins(allTeams, matchups, throughRound)
 
@@ -68,12 +94,15 @@ func GenerateTournamentValues(
 	for _, team := range allTeams {
 		progress := team.Wins + team.Byes
 		isEliminated := throughRound > 0 && progress < throughRound
+		incByRound := buildIncByRound(rules, team.Seed)
 
 		// ── ACTUAL (what has happened) ──
-		actualPoints := float64(scoring.PointsForProgress(rules, team.Wins, team.Byes))
+		run := scoring.Run{Seed: team.Seed, Wins: team.Wins, Byes: team.Byes, BeatenSeeds: team.BeatenSeeds}
+		actualPoints := float64(scoring.PointsForRun(rules, run))
 
 		// ── PROJECTED (what we think will happen) ──
 		var probs [models.MaxRounds]float64
+		upsetMargins := make([]float64, models.MaxRounds)
 		var projectedEV float64
 		var variancePoints float64
 
@@ -86,12 +115,15 @@ func GenerateTournamentValues(
 			// Fill projected rounds from matchup model.
 			for r := throughRound + 1; r <= models.MaxRounds; r++ {
 				probs[r-1] = pWinByRound[teamRoundKey{team.ID, r}]
+				upsetMargins[r-1] = upsetMarginByRound[teamRoundKey{team.ID, r}]
 			}
 			enforceMonotonicity(probs[:])
 
-			// Projected EV = sum of future probability * incremental points.
+			// Projected EV = sum of future probability * incremental points,
+			// plus the expected upset bonus.
 			for r := progress + 1; r <= models.MaxRounds; r++ {
 				projectedEV += probs[r-1] * incByRound[r]
+				projectedEV += upsetMargins[r-1] * float64(scoring.UpsetPointsPerSeed(rules, r))
 			}
 
 			// Variance (pre-tournament only).
@@ -106,8 +138,10 @@ func GenerateTournamentValues(
 
 		// ── COMBINE ──
 		expectedPoints := actualPoints + projectedEV
-		favoritesTotalPoints := float64(scoring.PointsForProgress(
-			rules, progress+futureWins[team.ID], 0))
+		favoritesTotalPoints := actualPoints
+		for r := progress + 1; r <= progress+futureWins[team.ID] && r <= models.MaxRounds; r++ {
+			favoritesTotalPoints += incByRound[r]
+		}
 
 		results = append(results, PredictedTeamValue{
 			TeamID:               team.ID,
@@ -123,6 +157,7 @@ func GenerateTournamentValues(
 			PRound6:              probs[5],
 			PRound7:              probs[6],
 			FavoritesTotalPoints: favoritesTotalPoints,
+			ExpectedUpsetMargins: upsetMargins,
 		})
 	}
 
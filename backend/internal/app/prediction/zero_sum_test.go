@@ -57,7 +57,7 @@ func fourTeamValues() ([]PredictedTeamValue, []scoring.Rule) {
 	}
 
 	strength := map[string]float64{"A": 20, "B": 10, "C": 15, "D": 5}
-	calcWinProb := func(id1, id2 string) float64 {
+	calcWinProb := func(_ gameSetup, id1, id2 string) float64 {
 		return strength[id1] / (strength[id1] + strength[id2])
 	}
 
@@ -129,7 +129,7 @@ func eightTeamValues() ([]PredictedTeamValue, []scoring.Rule) {
 		"A": 20, "B": 15, "C": 10, "D": 5,
 		"E": 18, "F": 12, "G": 8, "H": 3,
 	}
-	calcWinProb := func(id1, id2 string) float64 {
+	calcWinProb := func(_ gameSetup, id1, id2 string) float64 {
 		return strength[id1] / (strength[id1] + strength[id2])
 	}
 
@@ -283,7 +283,7 @@ func TestThatCompletedTournamentAtCheckpointZeroSumsToExpectedTotal(t *testing.T
 
 	// WHEN generating predictions at throughRound=0 (pre-tournament checkpoint)
 	state := NewTournamentState(data, 0)
-	values, err := generatePredictions(state, spec)
+	values, _, err := generatePredictions(state, spec)
 	if err != nil {
 		t.Fatalf("unexpected error: %v", err)
 	}
@@ -0,0 +1,145 @@
+// Package reveal lifts the seal on a pool's bids. Bids stay hidden until
+// bidding closes and the pool's reveal time passes, or a commissioner reveals
+// them early. At that moment the market is frozen into a summary of what the
+// pool paid for each team, set against what the predictions of the moment
+// expect each team to score.
+package reveal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+)
+
+type Ports struct {
+	Reveals     ports.MarketRevealRepository
+	Pools       ports.PoolWriter
+	Portfolios  ports.PortfolioReader
+	Predictions ports.PredictionBatchReader
+}
+
+type Service struct {
+	ports Ports
+}
+
+func New(ports Ports) *Service {
+	return &Service{ports: ports}
+}
+
+// Get returns the pool's frozen market reveal, or nil until it has been
+// frozen.
+func (s *Service) Get(ctx context.Context, poolID string) (*models.MarketReveal, error) {
+	return s.ports.Reveals.GetMarketReveal(ctx, poolID)
+}
+
+// FreezeDue freezes the pool's market once the schedule has revealed its
+// bids, stamped with the scheduled reveal time. The reveal worker calls it on
+// every tick, so the market is read within a tick of the reveal. It returns
+// the existing reveal when there is one, and nil while bids are sealed.
+func (s *Service) FreezeDue(ctx context.Context, pool *models.Pool, schedule models.BiddingSchedule, now time.Time) (*models.MarketReveal, error) {
+	existing, err := s.ports.Reveals.GetMarketReveal(ctx, pool.ID)
+	if err != nil || existing != nil {
+		return existing, err
+	}
+	if !schedule.IsRevealed(now) {
+		return nil, nil
+	}
+	revealedAt := now
+	if at := schedule.RevealsAt(); at != nil && at.Before(now) {
+		revealedAt = *at
+	}
+	return s.freeze(ctx, pool, revealedAt, nil)
+}
+
+// RevealNow reveals the pool's bids ahead of its reveal time. Bidding must
+// have finished.
+func (s *Service) RevealNow(ctx context.Context, pool *models.Pool, schedule models.BiddingSchedule, revealedBy string, now time.Time) (*models.MarketReveal, error) {
+	existing, err := s.ports.Reveals.GetMarketReveal(ctx, pool.ID)
+	if err != nil {
+		return nil, err
+	}
+	if existing != nil || schedule.IsRevealed(now) {
+		return nil, &apperrors.AlreadyExistsError{Resource: "market reveal", Field: "pool_id", Value: pool.ID}
+	}
+	if !schedule.IsFinished(now) {
+		return nil, &apperrors.InvalidArgumentError{Field: "pool", Message: "bids cannot be revealed before bidding closes"}
+	}
+
+	revealAt := now
+	pool.RevealAt = &revealAt
+	if err := s.ports.Pools.Update(ctx, pool); err != nil {
+		return nil, fmt.Errorf("moving reveal time for pool %s: %w", pool.ID, err)
+	}
+	return s.freeze(ctx, pool, now, &revealedBy)
+}
+
+func (s *Service) freeze(ctx context.Context, pool *models.Pool, revealedAt time.Time, revealedBy *string) (*models.MarketReveal, error) {
+	portfolios, _, err := s.ports.Portfolios.GetPortfolios(ctx, pool.ID)
+	if err != nil {
+		return nil, fmt.Errorf("getting portfolios for pool %s: %w", pool.ID, err)
+	}
+	portfolioIDs := make([]string, 0, len(portfolios))
+	for _, p := range portfolios {
+		portfolioIDs = append(portfolioIDs, p.ID)
+	}
+	investmentsByPortfolio, err := s.ports.Portfolios.GetInvestmentsByPortfolioIDs(ctx, portfolioIDs)
+	if err != nil {
+		return nil, fmt.Errorf("getting investments for pool %s: %w", pool.ID, err)
+	}
+	var investments []*models.Investment
+	for _, id := range portfolioIDs {
+		investments = append(investments, investmentsByPortfolio[id]...)
+	}
+
+	// Predictions written after the reveal were not what the market saw.
+	var batchID *string
+	var values []models.PredictedTeamValue
+	batches, err := s.ports.Predictions.ListBatches(ctx, pool.TournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("listing prediction batches for tournament %s: %w", pool.TournamentID, err)
+	}
+	if batch := latestBatchAsOf(batches, revealedAt); batch != nil {
+		batchID = &batch.ID
+		if values, err = s.ports.Predictions.GetTeamValues(ctx, batch.ID); err != nil {
+			return nil, fmt.Errorf("getting predicted team values for batch %s: %w", batch.ID, err)
+		}
+	}
+
+	reveal := BuildMarketSummary(investments, values)
+	reveal.PoolID = pool.ID
+	reveal.RevealedAt = revealedAt
+	reveal.RevealedBy = revealedBy
+	reveal.PredictionBatchID = batchID
+	reveal.PortfolioCount = len(portfolios)
+
+	err = s.ports.Reveals.CreateMarketReveal(ctx, reveal)
+	var alreadyExists *apperrors.AlreadyExistsError
+	if errors.As(err, &alreadyExists) {
+		// Another request froze the market first; its summary stands.
+		return s.ports.Reveals.GetMarketReveal(ctx, pool.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return reveal, nil
+}
+
+// latestBatchAsOf returns the newest batch created at or before at, or nil.
+func latestBatchAsOf(batches []models.PredictionBatch, at time.Time) *models.PredictionBatch {
+	var latest *models.PredictionBatch
+	for i := range batches {
+		b := &batches[i]
+		if b.CreatedAt.After(at) {
+			continue
+		}
+		if latest == nil || b.CreatedAt.After(latest.CreatedAt) {
+			latest = b
+		}
+	}
+	return latest
+}
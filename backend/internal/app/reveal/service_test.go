@@ -0,0 +1,193 @@
+package reveal
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+)
+
+type fakeRevealRepo struct {
+	ports.MarketRevealRepository
+	reveal *models.MarketReveal
+}
+
+func (f *fakeRevealRepo) GetMarketReveal(context.Context, string) (*models.MarketReveal, error) {
+	return f.reveal, nil
+}
+
+func (f *fakeRevealRepo) CreateMarketReveal(_ context.Context, reveal *models.MarketReveal) error {
+	f.reveal = reveal
+	return nil
+}
+
+type fakePools struct {
+	ports.PoolWriter
+}
+
+func (f *fakePools) Update(context.Context, *models.Pool) error {
+	return nil
+}
+
+type fakePortfolios struct {
+	ports.PortfolioReader
+}
+
+func (f *fakePortfolios) GetPortfolios(context.Context, string) ([]*models.Portfolio, map[string]float64, error) {
+	return []*models.Portfolio{{ID: "p1"}}, nil, nil
+}
+
+func (f *fakePortfolios) GetInvestmentsByPortfolioIDs(context.Context, []string) (map[string][]*models.Investment, error) {
+	return map[string][]*models.Investment{"p1": {{PortfolioID: "p1", TeamID: "duke", Credits: 100}}}, nil
+}
+
+type fakePredictions struct {
+	ports.PredictionBatchReader
+	batches []models.PredictionBatch
+}
+
+func (f *fakePredictions) ListBatches(context.Context, string) ([]models.PredictionBatch, error) {
+	return f.batches, nil
+}
+
+func (f *fakePredictions) GetTeamValues(context.Context, string) ([]models.PredictedTeamValue, error) {
+	return nil, nil
+}
+
+var (
+	tipOff   = time.Date(2026, 3, 19, 16, 0, 0, 0, time.UTC)
+	revealAt = tipOff.Add(24 * time.Hour)
+)
+
+func newTestService(batches ...models.PredictionBatch) (*Service, *fakeRevealRepo) {
+	repo := &fakeRevealRepo{}
+	return New(Ports{Reveals: repo, Pools: &fakePools{}, Portfolios: &fakePortfolios{}, Predictions: &fakePredictions{batches: batches}}), repo
+}
+
+func newTestSchedule() (*models.Pool, models.BiddingSchedule) {
+	pool := &models.Pool{ID: "c1", RevealAt: &revealAt}
+	startingAt := tipOff
+	return pool, models.NewPoolBiddingSchedule(pool, &models.Tournament{StartingAt: &startingAt}, nil)
+}
+
+func TestThatFreezeDueReturnsNothingWhileBidsAreSealed(t *testing.T) {
+	// GIVEN a pool whose bids stay sealed for a day after tip-off
+	svc, _ := newTestService()
+	pool, schedule := newTestSchedule()
+
+	// WHEN checking for a due reveal an hour after tip-off
+	got, err := svc.FreezeDue(context.Background(), pool, schedule, tipOff.Add(time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN there is no reveal yet
+	if got != nil {
+		t.Fatalf("expected no reveal, got %+v", got)
+	}
+}
+
+func TestThatFreezeDueStampsScheduledRevealTime(t *testing.T) {
+	// GIVEN a pool whose reveal time has passed
+	svc, _ := newTestService()
+	pool, schedule := newTestSchedule()
+
+	// WHEN the reveal worker freezes it two days after tip-off
+	got, err := svc.FreezeDue(context.Background(), pool, schedule, tipOff.Add(48*time.Hour))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN it is stamped with the scheduled reveal time
+	if !got.RevealedAt.Equal(revealAt) {
+		t.Fatalf("expected revealed at %v, got %v", revealAt, got.RevealedAt)
+	}
+}
+
+func TestThatFreezeDueIgnoresPredictionsMadeAfterTheReveal(t *testing.T) {
+	// GIVEN a pool whose reveal time has passed, with one prediction batch
+	// from before the reveal and one from after
+	svc, repo := newTestService(
+		models.PredictionBatch{ID: "after", CreatedAt: revealAt.Add(time.Hour)},
+		models.PredictionBatch{ID: "before", CreatedAt: revealAt.Add(-time.Hour)},
+	)
+	pool, schedule := newTestSchedule()
+
+	// WHEN the reveal worker freezes it two days after tip-off
+	if _, err := svc.FreezeDue(context.Background(), pool, schedule, tipOff.Add(48*time.Hour)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the summary is set against the batch from before the reveal
+	if repo.reveal.PredictionBatchID == nil || *repo.reveal.PredictionBatchID != "before" {
+		t.Fatalf("expected batch before, got %v", repo.reveal.PredictionBatchID)
+	}
+}
+
+func TestThatGetDoesNotFreezeTheMarket(t *testing.T) {
+	// GIVEN a pool whose reveal time has passed but whose market is not
+	// frozen yet
+	svc, _ := newTestService()
+
+	// WHEN getting its market reveal
+	got, err := svc.Get(context.Background(), "c1")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN there is none until the reveal worker freezes it
+	if got != nil {
+		t.Fatalf("expected no reveal, got %+v", got)
+	}
+}
+
+func TestThatRevealNowRejectsOpenBidding(t *testing.T) {
+	// GIVEN a pool still taking bids
+	svc, _ := newTestService()
+	pool, schedule := newTestSchedule()
+
+	// WHEN revealing it before tip-off
+	_, err := svc.RevealNow(context.Background(), pool, schedule, "u1", tipOff.Add(-time.Hour))
+
+	// THEN an InvalidArgumentError is returned
+	var invalid *apperrors.InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Fatalf("expected *apperrors.InvalidArgumentError, got %T: %v", err, err)
+	}
+}
+
+func TestThatRevealNowRecordsCommissioner(t *testing.T) {
+	// GIVEN a pool whose bids are sealed after tip-off
+	svc, repo := newTestService()
+	pool, schedule := newTestSchedule()
+
+	// WHEN the commissioner reveals it early
+	if _, err := svc.RevealNow(context.Background(), pool, schedule, "u1", tipOff.Add(time.Hour)); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the reveal names them
+	if repo.reveal.RevealedBy == nil || *repo.reveal.RevealedBy != "u1" {
+		t.Fatalf("expected revealed by u1, got %v", repo.reveal.RevealedBy)
+	}
+}
+
+func TestThatRevealNowRejectsRevealedPool(t *testing.T) {
+	// GIVEN a pool that has already been revealed
+	svc, repo := newTestService()
+	repo.reveal = &models.MarketReveal{PoolID: "c1"}
+	pool, schedule := newTestSchedule()
+
+	// WHEN revealing it again
+	_, err := svc.RevealNow(context.Background(), pool, schedule, "u1", tipOff.Add(time.Hour))
+
+	// THEN an AlreadyExistsError is returned
+	var alreadyExists *apperrors.AlreadyExistsError
+	if !errors.As(err, &alreadyExists) {
+		t.Fatalf("expected *apperrors.AlreadyExistsError, got %T: %v", err, err)
+	}
+}
@@ -0,0 +1,98 @@
+package reveal
+
+import (
+	"sort"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// BuildMarketSummary totals the pool's bids per team and sets them against
+// the predicted expected points. Every team with a bid or a prediction gets
+// a line, ordered by market rank. Teams with equal credits, or equal
+// expected points, share a rank. The expected fields are left nil when
+// values is empty.
+func BuildMarketSummary(investments []*models.Investment, values []models.PredictedTeamValue) *models.MarketReveal {
+	byTeam := make(map[string]*models.MarketRevealTeam)
+	team := func(teamID string) *models.MarketRevealTeam {
+		t, ok := byTeam[teamID]
+		if !ok {
+			t = &models.MarketRevealTeam{TeamID: teamID}
+			byTeam[teamID] = t
+		}
+		return t
+	}
+
+	out := &models.MarketReveal{}
+	for _, inv := range investments {
+		if inv == nil || inv.Credits <= 0 {
+			continue
+		}
+		t := team(inv.TeamID)
+		t.TotalCredits += inv.Credits
+		t.Bidders++
+		out.TotalCredits += inv.Credits
+	}
+
+	var totalExpected float64
+	for _, v := range values {
+		expected := v.ExpectedPoints
+		team(v.TeamID).ExpectedPoints = &expected
+		totalExpected += expected
+	}
+
+	teams := make([]*models.MarketRevealTeam, 0, len(byTeam))
+	for _, t := range byTeam {
+		if out.TotalCredits > 0 {
+			t.MarketShare = float64(t.TotalCredits) / float64(out.TotalCredits)
+		}
+		if len(values) > 0 {
+			expected := 0.0
+			if t.ExpectedPoints != nil {
+				expected = *t.ExpectedPoints
+			} else {
+				t.ExpectedPoints = &expected
+			}
+			share := 0.0
+			if totalExpected > 0 {
+				share = expected / totalExpected
+			}
+			t.ExpectedShare = &share
+		}
+		teams = append(teams, t)
+	}
+
+	if len(values) > 0 {
+		sort.Slice(teams, func(i, j int) bool {
+			if *teams[i].ExpectedPoints != *teams[j].ExpectedPoints {
+				return *teams[i].ExpectedPoints > *teams[j].ExpectedPoints
+			}
+			return teams[i].TeamID < teams[j].TeamID
+		})
+		for i, t := range teams {
+			rank := i + 1
+			if i > 0 && *t.ExpectedPoints == *teams[i-1].ExpectedPoints {
+				rank = *teams[i-1].ExpectedRank
+			}
+			t.ExpectedRank = &rank
+		}
+	}
+
+	sort.Slice(teams, func(i, j int) bool {
+		if teams[i].TotalCredits != teams[j].TotalCredits {
+			return teams[i].TotalCredits > teams[j].TotalCredits
+		}
+		return teams[i].TeamID < teams[j].TeamID
+	})
+	for i, t := range teams {
+		t.MarketRank = i + 1
+		if i > 0 && t.TotalCredits == teams[i-1].TotalCredits {
+			t.MarketRank = teams[i-1].MarketRank
+		}
+	}
+
+	out.Teams = make([]models.MarketRevealTeam, 0, len(teams))
+	for _, t := range teams {
+		out.Teams = append(out.Teams, *t)
+	}
+	return out
+}
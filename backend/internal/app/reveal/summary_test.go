@@ -0,0 +1,138 @@
+package reveal
+
+import (
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func teamsByID(reveal *models.MarketReveal) map[string]models.MarketRevealTeam {
+	out := make(map[string]models.MarketRevealTeam)
+	for _, t := range reveal.Teams {
+		out[t.TeamID] = t
+	}
+	return out
+}
+
+func threeTeamMarket() []*models.Investment {
+	return []*models.Investment{
+		{PortfolioID: "p1", TeamID: "duke", Credits: 60},
+		{PortfolioID: "p2", TeamID: "duke", Credits: 15},
+		{PortfolioID: "p2", TeamID: "gonzaga", Credits: 25},
+	}
+}
+
+func TestThatMarketSummaryTotalsCreditsPerTeam(t *testing.T) {
+	// GIVEN two portfolios that both bid on duke
+
+	// WHEN building the market summary
+	got := teamsByID(BuildMarketSummary(threeTeamMarket(), nil))
+
+	// THEN duke's credits are summed
+	if got["duke"].TotalCredits != 75 {
+		t.Fatalf("expected 75 credits, got %d", got["duke"].TotalCredits)
+	}
+}
+
+func TestThatMarketSummaryCountsBiddersPerTeam(t *testing.T) {
+	// GIVEN two portfolios that both bid on duke
+
+	// WHEN building the market summary
+	got := teamsByID(BuildMarketSummary(threeTeamMarket(), nil))
+
+	// THEN duke has two bidders
+	if got["duke"].Bidders != 2 {
+		t.Fatalf("expected 2 bidders, got %d", got["duke"].Bidders)
+	}
+}
+
+func TestThatMarketShareIsFractionOfAllCredits(t *testing.T) {
+	// GIVEN gonzaga drawing 25 of the pool's 100 credits
+
+	// WHEN building the market summary
+	got := teamsByID(BuildMarketSummary(threeTeamMarket(), nil))
+
+	// THEN gonzaga holds a quarter of the market
+	if got["gonzaga"].MarketShare != 0.25 {
+		t.Fatalf("expected market share 0.25, got %v", got["gonzaga"].MarketShare)
+	}
+}
+
+func TestThatMarketSummaryListsTeamsByMarketRank(t *testing.T) {
+	// GIVEN duke drawing more credits than gonzaga
+
+	// WHEN building the market summary
+	got := BuildMarketSummary(threeTeamMarket(), nil)
+
+	// THEN duke is listed first
+	if got.Teams[0].TeamID != "duke" {
+		t.Fatalf("expected duke first, got %s", got.Teams[0].TeamID)
+	}
+}
+
+func TestThatTeamsWithEqualCreditsShareMarketRank(t *testing.T) {
+	// GIVEN two teams drawing the same credits
+	investments := []*models.Investment{
+		{PortfolioID: "p1", TeamID: "duke", Credits: 50},
+		{PortfolioID: "p1", TeamID: "gonzaga", Credits: 50},
+	}
+
+	// WHEN building the market summary
+	got := teamsByID(BuildMarketSummary(investments, nil))
+
+	// THEN both rank first
+	if got["gonzaga"].MarketRank != 1 {
+		t.Fatalf("expected market rank 1, got %d", got["gonzaga"].MarketRank)
+	}
+}
+
+func TestThatPredictedTeamWithoutBidsRanksLastInMarket(t *testing.T) {
+	// GIVEN a predicted team nobody bid on
+	values := []models.PredictedTeamValue{{TeamID: "duke", ExpectedPoints: 30}, {TeamID: "houston", ExpectedPoints: 90}}
+
+	// WHEN building the market summary
+	got := teamsByID(BuildMarketSummary(threeTeamMarket(), values))
+
+	// THEN it ranks behind both bid-on teams
+	if got["houston"].MarketRank != 3 {
+		t.Fatalf("expected market rank 3, got %d", got["houston"].MarketRank)
+	}
+}
+
+func TestThatExpectedRankFollowsExpectedPoints(t *testing.T) {
+	// GIVEN predictions that favor gonzaga over duke
+	values := []models.PredictedTeamValue{{TeamID: "duke", ExpectedPoints: 30}, {TeamID: "gonzaga", ExpectedPoints: 90}}
+
+	// WHEN building the market summary
+	got := teamsByID(BuildMarketSummary(threeTeamMarket(), values))
+
+	// THEN gonzaga is expected to rank first
+	if *got["gonzaga"].ExpectedRank != 1 {
+		t.Fatalf("expected expected rank 1, got %d", *got["gonzaga"].ExpectedRank)
+	}
+}
+
+func TestThatExpectedShareIsFractionOfAllExpectedPoints(t *testing.T) {
+	// GIVEN predictions that give duke a quarter of the expected points
+	values := []models.PredictedTeamValue{{TeamID: "duke", ExpectedPoints: 30}, {TeamID: "gonzaga", ExpectedPoints: 90}}
+
+	// WHEN building the market summary
+	got := teamsByID(BuildMarketSummary(threeTeamMarket(), values))
+
+	// THEN duke's expected share is 0.25
+	if *got["duke"].ExpectedShare != 0.25 {
+		t.Fatalf("expected expected share 0.25, got %v", *got["duke"].ExpectedShare)
+	}
+}
+
+func TestThatMarketSummaryWithoutPredictionsLeavesExpectedRankUnset(t *testing.T) {
+	// GIVEN no predictions
+
+	// WHEN building the market summary
+	got := teamsByID(BuildMarketSummary(threeTeamMarket(), nil))
+
+	// THEN duke has no expected rank
+	if got["duke"].ExpectedRank != nil {
+		t.Fatalf("expected no expected rank, got %d", *got["duke"].ExpectedRank)
+	}
+}
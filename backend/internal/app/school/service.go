@@ -3,6 +3,7 @@ package school
 import (
 	"context"
 
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 	"github.com/andrewcopp/Calcutta/backend/internal/ports"
 )
@@ -22,3 +23,18 @@ func (s *Service) List(ctx context.Context) ([]models.School, error) {
 func (s *Service) GetByID(ctx context.Context, id string) (*models.School, error) {
 	return s.repo.GetByID(ctx, id)
 }
+
+// UpdateLocation sets the campus location used for home-court adjustments.
+// Passing nil for both coordinates clears it.
+func (s *Service) UpdateLocation(ctx context.Context, id string, latitude, longitude *float64) error {
+	if (latitude == nil) != (longitude == nil) {
+		return &apperrors.InvalidArgumentError{Field: "location", Message: "latitude and longitude must be set together"}
+	}
+	if latitude != nil && (*latitude < -90 || *latitude > 90) {
+		return &apperrors.InvalidArgumentError{Field: "latitude", Message: "latitude must be between -90 and 90"}
+	}
+	if longitude != nil && (*longitude < -180 || *longitude > 180) {
+		return &apperrors.InvalidArgumentError{Field: "longitude", Message: "longitude must be between -180 and 180"}
+	}
+	return s.repo.UpdateLocation(ctx, id, latitude, longitude)
+}
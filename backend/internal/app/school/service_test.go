@@ -0,0 +1,69 @@
+package school
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+type fakeSchoolRepo struct {
+	updated bool
+}
+
+func (f *fakeSchoolRepo) List(ctx context.Context) ([]models.School, error) { return nil, nil }
+
+func (f *fakeSchoolRepo) GetByID(ctx context.Context, id string) (*models.School, error) {
+	return nil, nil
+}
+
+func (f *fakeSchoolRepo) UpdateLocation(ctx context.Context, id string, latitude, longitude *float64) error {
+	f.updated = true
+	return nil
+}
+
+func TestThatUpdateLocationRejectsLatitudeWithoutLongitude(t *testing.T) {
+	// GIVEN a latitude with no longitude
+	svc := New(&fakeSchoolRepo{})
+	lat := 35.9
+
+	// WHEN UpdateLocation is called
+	err := svc.UpdateLocation(context.Background(), "school-1", &lat, nil)
+
+	// THEN an invalid argument error is returned
+	var invalid *apperrors.InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidArgumentError, got %v", err)
+	}
+}
+
+func TestThatUpdateLocationRejectsOutOfRangeLongitude(t *testing.T) {
+	// GIVEN a longitude beyond 180 degrees
+	svc := New(&fakeSchoolRepo{})
+	lat, lon := 35.9, 200.0
+
+	// WHEN UpdateLocation is called
+	err := svc.UpdateLocation(context.Background(), "school-1", &lat, &lon)
+
+	// THEN an invalid argument error is returned
+	var invalid *apperrors.InvalidArgumentError
+	if !errors.As(err, &invalid) {
+		t.Errorf("expected InvalidArgumentError, got %v", err)
+	}
+}
+
+func TestThatUpdateLocationAllowsClearingLocation(t *testing.T) {
+	// GIVEN no coordinates
+	repo := &fakeSchoolRepo{}
+	svc := New(repo)
+
+	// WHEN UpdateLocation is called
+	_ = svc.UpdateLocation(context.Background(), "school-1", nil, nil)
+
+	// THEN the repository is updated
+	if !repo.updated {
+		t.Error("expected location to be cleared")
+	}
+}
@@ -0,0 +1,103 @@
+package scorefeed
+
+import (
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// maxCandidates caps the schools suggested for an ambiguous name.
+const maxCandidates = 10
+
+// NormalizeName reduces a team name to the key names are matched on: lower
+// case words joined by hyphens, with '&' spelled out and other punctuation
+// dropped. Slugs are already in this form.
+func NormalizeName(name string) string {
+	name = strings.ReplaceAll(strings.ToLower(name), "&", " and ")
+	var b strings.Builder
+	for _, r := range name {
+		switch {
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			b.WriteRune(r)
+		case r == '\'' || r == '.' || r == '’':
+			// "St. John's" and "St Johns" normalize alike.
+		default:
+			b.WriteRune(' ')
+		}
+	}
+	return strings.Join(strings.Fields(b.String()), "-")
+}
+
+// matcher resolves feed team names to school IDs for one source.
+type matcher struct {
+	mapped  map[string]string
+	schools []models.SchoolSlug
+	keys    []string
+}
+
+func newMatcher(schools []models.SchoolSlug, mappings []*models.SchoolNameMapping) *matcher {
+	m := &matcher{mapped: make(map[string]string, len(mappings)), schools: schools, keys: make([]string, len(schools))}
+	for _, mp := range mappings {
+		m.mapped[mp.NameKey] = mp.SchoolID
+	}
+	for i, s := range schools {
+		m.keys[i] = NormalizeName(s.Name)
+	}
+	return m
+}
+
+// resolve returns the school a name refers to. When it cannot pick exactly
+// one, it returns no school and the schools the name might mean, which is
+// empty for names that resemble no school at all.
+func (m *matcher) resolve(name string) (string, []string) {
+	key := NormalizeName(name)
+	if key == "" {
+		return "", nil
+	}
+	if id, ok := m.mapped[key]; ok {
+		return id, nil
+	}
+
+	var exact []string
+	for i, s := range m.schools {
+		if s.Slug == key || m.keys[i] == key {
+			exact = append(exact, s.ID)
+		}
+	}
+	if len(exact) == 1 {
+		return exact[0], nil
+	}
+	if len(exact) > 1 {
+		return "", exact
+	}
+
+	words := strings.Split(key, "-")
+	var candidates []string
+	for i, s := range m.schools {
+		schoolWords := strings.Split(m.keys[i], "-")
+		if containsAll(schoolWords, words) || containsAll(words, schoolWords) {
+			candidates = append(candidates, s.ID)
+		}
+	}
+	sort.Strings(candidates)
+	if len(candidates) > maxCandidates {
+		candidates = candidates[:maxCandidates]
+	}
+	return "", candidates
+}
+
+// containsAll reports whether every word in want appears in have.
+func containsAll(have, want []string) bool {
+	set := make(map[string]bool, len(have))
+	for _, w := range have {
+		set[w] = true
+	}
+	for _, w := range want {
+		if !set[w] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,96 @@
+package scorefeed
+
+import (
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func testSchools() []models.SchoolSlug {
+	return []models.SchoolSlug{
+		{ID: "s-duke", Slug: "duke", Name: "Duke"},
+		{ID: "s-stjohns", Slug: "st-johns", Name: "St. John's"},
+		{ID: "s-texas", Slug: "texas", Name: "Texas"},
+		{ID: "s-texas-am", Slug: "texas-a-and-m", Name: "Texas A&M"},
+		{ID: "s-texas-tech", Slug: "texas-tech", Name: "Texas Tech"},
+	}
+}
+
+func TestThatNormalizeNameDropsPunctuation(t *testing.T) {
+	// GIVEN a name with periods and an apostrophe
+	name := "  St. John's "
+
+	// WHEN normalizing the name
+	key := NormalizeName(name)
+
+	// THEN it matches the school's slug form
+	if key != "st-johns" {
+		t.Errorf("expected st-johns, got %q", key)
+	}
+}
+
+func TestThatNormalizeNameSpellsOutAmpersand(t *testing.T) {
+	// GIVEN a name with an ampersand
+	name := "Texas A&M"
+
+	// WHEN normalizing the name
+	key := NormalizeName(name)
+
+	// THEN the ampersand becomes a word
+	if key != "texas-a-and-m" {
+		t.Errorf("expected texas-a-and-m, got %q", key)
+	}
+}
+
+func TestThatResolveMatchesSchoolName(t *testing.T) {
+	// GIVEN a matcher with no mappings
+	m := newMatcher(testSchools(), nil)
+
+	// WHEN resolving a feed name spelled like the school's name
+	id, _ := m.resolve("ST JOHNS")
+
+	// THEN the school is found
+	if id != "s-stjohns" {
+		t.Errorf("expected s-stjohns, got %q", id)
+	}
+}
+
+func TestThatResolvePrefersMapping(t *testing.T) {
+	// GIVEN a mapping for a feed's abbreviation
+	mappings := []*models.SchoolNameMapping{{NameKey: "ttu", SchoolID: "s-texas-tech"}}
+	m := newMatcher(testSchools(), mappings)
+
+	// WHEN resolving the abbreviation
+	id, _ := m.resolve("TTU")
+
+	// THEN the mapped school is returned
+	if id != "s-texas-tech" {
+		t.Errorf("expected s-texas-tech, got %q", id)
+	}
+}
+
+func TestThatResolveSuggestsCandidatesForAmbiguousName(t *testing.T) {
+	// GIVEN a name that several schools' names contain
+	m := newMatcher(testSchools(), nil)
+
+	// WHEN resolving a partial name
+	id, candidates := m.resolve("Texas Aggies")
+
+	// THEN no school is picked and the similar school is suggested
+	if id != "" || len(candidates) != 1 || candidates[0] != "s-texas" {
+		t.Errorf("expected no match with candidate s-texas, got %q %v", id, candidates)
+	}
+}
+
+func TestThatResolveReturnsNothingForUnknownName(t *testing.T) {
+	// GIVEN a name resembling no school
+	m := newMatcher(testSchools(), nil)
+
+	// WHEN resolving it
+	id, candidates := m.resolve("Gonzaga")
+
+	// THEN there is neither a match nor a candidate
+	if id != "" || len(candidates) != 0 {
+		t.Errorf("expected nothing, got %q %v", id, candidates)
+	}
+}
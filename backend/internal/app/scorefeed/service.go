@@ -0,0 +1,351 @@
+// Package scorefeed ingests game results from external feeds. Feed team names
+// are matched to schools, results are matched to open bracket games, and
+// final results are recorded as winners.
+package scorefeed
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"strings"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	appbracket "github.com/andrewcopp/Calcutta/backend/internal/app/bracket"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+)
+
+// BracketWriter applies feed results to a tournament bracket.
+type BracketWriter interface {
+	GetBracket(ctx context.Context, tournamentID string) (*models.BracketStructure, error)
+	SelectWinner(ctx context.Context, tournamentID, gameID string, selection appbracket.WinnerSelection) (*models.BracketStructure, error)
+	UpdateLiveGame(ctx context.Context, tournamentID, gameID string, update appbracket.LiveGameUpdate) (*models.LiveGameState, error)
+}
+
+// TournamentResolver finds the tournament a feed result's season refers to.
+type TournamentResolver interface {
+	ResolveCoreTournamentID(ctx context.Context, season int) (string, error)
+}
+
+// Ports defines the dependencies for the score feed service.
+type Ports struct {
+	Feeds       ports.ScoreFeedRepository
+	Tournaments TournamentResolver
+	// Bracket is only required by Ingest.
+	Bracket BracketWriter
+}
+
+// Service matches feed results to bracket games and manages the name
+// mappings and review queue that matching relies on.
+type Service struct {
+	ports Ports
+}
+
+// New creates a new score feed service.
+func New(p Ports) *Service {
+	return &Service{ports: p}
+}
+
+// IngestReport counts what happened to each result in a batch.
+type IngestReport struct {
+	Applied        int `json:"applied"`
+	AlreadyApplied int `json:"alreadyApplied"`
+	Live           int `json:"live"`
+	NeedsReview    int `json:"needsReview"`
+	Unmatched      int `json:"unmatched"`
+	Conflicts      int `json:"conflicts"`
+	Invalid        int `json:"invalid"`
+	// TournamentIDs lists the tournaments whose brackets changed.
+	TournamentIDs []string `json:"tournamentIds,omitempty"`
+}
+
+func (r *IngestReport) touched(tournamentID string) {
+	for _, id := range r.TournamentIDs {
+		if id == tournamentID {
+			return
+		}
+	}
+	r.TournamentIDs = append(r.TournamentIDs, tournamentID)
+}
+
+// Ingest applies a batch of results from source. Final results are recorded
+// as winners of the open bracket game between the two teams; results already
+// recorded are skipped, so ingesting the same batch again changes nothing.
+// A result for a game whose teams are not both set yet is retried after the
+// rest of the batch, since an earlier result may fill the game in. Team names
+// that match no school or several are queued for review.
+func (s *Service) Ingest(ctx context.Context, source string, results []models.ScoreFeedResult) (*IngestReport, error) {
+	schools, err := s.ports.Feeds.ListSchoolSlugs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list schools: %w", err)
+	}
+	mappings, err := s.ports.Feeds.ListSchoolNameMappings(ctx, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list school name mappings: %w", err)
+	}
+	names := newMatcher(schools, mappings)
+
+	report := &IngestReport{}
+	brackets := make(map[int]*seasonBracket)
+	var pending []matchedResult
+	for _, res := range results {
+		if err := validateResult(res); err != nil {
+			slog.Warn("score_feed_result_invalid", "source", source, "external_game_id", res.ExternalGameID, "error", err)
+			report.Invalid++
+			continue
+		}
+
+		school1, ok1, err := s.resolveName(ctx, names, source, res.Team1Name)
+		if err != nil {
+			return nil, err
+		}
+		school2, ok2, err := s.resolveName(ctx, names, source, res.Team2Name)
+		if err != nil {
+			return nil, err
+		}
+		if !ok1 || !ok2 {
+			report.NeedsReview++
+			continue
+		}
+
+		sb, ok := brackets[res.Season]
+		if !ok {
+			sb, err = s.loadSeasonBracket(ctx, res.Season)
+			if err != nil {
+				return nil, err
+			}
+			brackets[res.Season] = sb
+		}
+		if sb == nil {
+			report.Unmatched++
+			continue
+		}
+		pending = append(pending, matchedResult{result: res, school1: school1, school2: school2, bracket: sb})
+	}
+
+	for len(pending) > 0 {
+		var unmatched []matchedResult
+		for _, m := range pending {
+			matched, err := s.apply(ctx, source, m, report)
+			if err != nil {
+				return nil, err
+			}
+			if !matched {
+				unmatched = append(unmatched, m)
+			}
+		}
+		if len(unmatched) == len(pending) {
+			break
+		}
+		pending = unmatched
+	}
+	report.Unmatched += len(pending)
+	return report, nil
+}
+
+// matchedResult is a feed result whose teams have been matched to schools.
+type matchedResult struct {
+	result           models.ScoreFeedResult
+	school1, school2 string
+	bracket          *seasonBracket
+}
+
+// seasonBracket is a season's tournament and its current bracket.
+type seasonBracket struct {
+	tournamentID string
+	bracket      *models.BracketStructure
+}
+
+func (s *Service) loadSeasonBracket(ctx context.Context, season int) (*seasonBracket, error) {
+	tournamentID, err := s.ports.Tournaments.ResolveCoreTournamentID(ctx, season)
+	if err != nil {
+		slog.Warn("score_feed_season_unresolved", "season", season, "error", err)
+		return nil, nil
+	}
+	bracket, err := s.ports.Bracket.GetBracket(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get bracket for season %d: %w", season, err)
+	}
+	return &seasonBracket{tournamentID: tournamentID, bracket: bracket}, nil
+}
+
+// resolveName returns the school a feed name refers to, queueing the name for
+// review when it cannot be matched to exactly one school.
+func (s *Service) resolveName(ctx context.Context, names *matcher, source, name string) (string, bool, error) {
+	schoolID, candidates := names.resolve(name)
+	if schoolID != "" {
+		return schoolID, true, nil
+	}
+	if err := s.ports.Feeds.EnqueueScoreFeedReview(ctx, &models.ScoreFeedReview{
+		Source:             source,
+		ExternalName:       strings.TrimSpace(name),
+		NameKey:            NormalizeName(name),
+		CandidateSchoolIDs: candidates,
+	}); err != nil {
+		return "", false, fmt.Errorf("failed to queue %q for review: %w", name, err)
+	}
+	return "", false, nil
+}
+
+// apply records or posts one result against the game the two schools play,
+// reporting false when the bracket has no such game.
+func (s *Service) apply(ctx context.Context, source string, m matchedResult, report *IngestReport) (bool, error) {
+	res, sb := m.result, m.bracket
+	game, swapped := findGame(sb.bracket, m.school1, m.school2)
+	if game == nil {
+		return false, nil
+	}
+	// Orient the feed's scores to the bracket game's team order.
+	score1, score2 := res.Team1Score, res.Team2Score
+	if swapped {
+		score1, score2 = score2, score1
+	}
+
+	if !res.Final {
+		if res.SecondsRemaining == nil || game.Winner != nil {
+			return true, nil
+		}
+		if _, err := s.ports.Bracket.UpdateLiveGame(ctx, sb.tournamentID, game.GameID, appbracket.LiveGameUpdate{
+			Team1Score:       score1,
+			Team2Score:       score2,
+			SecondsRemaining: *res.SecondsRemaining,
+			Source:           source,
+		}); err != nil {
+			return false, fmt.Errorf("failed to post live state for game %s: %w", game.GameID, err)
+		}
+		report.Live++
+		report.touched(sb.tournamentID)
+		return true, nil
+	}
+
+	winner, winnerScore, loserScore := game.Team1, score1, score2
+	if score2 > score1 {
+		winner, winnerScore, loserScore = game.Team2, score2, score1
+	}
+	if game.Winner != nil {
+		if game.Winner.TeamID == winner.TeamID {
+			report.AlreadyApplied++
+		} else {
+			slog.Warn("score_feed_result_conflict", "source", source, "tournament_id", sb.tournamentID,
+				"game_id", game.GameID, "recorded_winner", game.Winner.TeamID, "feed_winner", winner.TeamID)
+			report.Conflicts++
+		}
+		return true, nil
+	}
+
+	bracket, err := s.ports.Bracket.SelectWinner(ctx, sb.tournamentID, game.GameID, appbracket.WinnerSelection{
+		WinnerTeamID: winner.TeamID,
+		WinnerScore:  &winnerScore,
+		LoserScore:   &loserScore,
+	})
+	if err != nil {
+		return false, fmt.Errorf("failed to record result for game %s: %w", game.GameID, err)
+	}
+	sb.bracket = bracket
+	slog.Info("score_feed_result_applied", "source", source, "tournament_id", sb.tournamentID,
+		"game_id", game.GameID, "winner_team_id", winner.TeamID)
+	report.Applied++
+	report.touched(sb.tournamentID)
+	return true, nil
+}
+
+// findGame returns the bracket game between the two schools and whether the
+// bracket lists them in the opposite order.
+func findGame(bracket *models.BracketStructure, school1, school2 string) (*models.BracketGame, bool) {
+	for _, g := range bracket.Games {
+		if g.Team1 == nil || g.Team2 == nil {
+			continue
+		}
+		switch {
+		case g.Team1.SchoolID == school1 && g.Team2.SchoolID == school2:
+			return g, false
+		case g.Team1.SchoolID == school2 && g.Team2.SchoolID == school1:
+			return g, true
+		}
+	}
+	return nil, false
+}
+
+func validateResult(res models.ScoreFeedResult) error {
+	switch {
+	case res.Season <= 0:
+		return fmt.Errorf("season is required")
+	case strings.TrimSpace(res.Team1Name) == "" || strings.TrimSpace(res.Team2Name) == "":
+		return fmt.Errorf("both team names are required")
+	case res.Team1Score < 0 || res.Team2Score < 0:
+		return fmt.Errorf("scores must not be negative")
+	case res.Final && res.Team1Score == res.Team2Score:
+		return fmt.Errorf("a final result cannot be tied")
+	case res.SecondsRemaining != nil && *res.SecondsRemaining < 0:
+		return fmt.Errorf("secondsRemaining must not be negative")
+	}
+	return nil
+}
+
+// ListReviews returns the review queue, filtered by status when one is given.
+func (s *Service) ListReviews(ctx context.Context, status string) ([]*models.ScoreFeedReview, error) {
+	switch status {
+	case "", models.ScoreFeedReviewPending, models.ScoreFeedReviewResolved, models.ScoreFeedReviewDismissed:
+	default:
+		return nil, &apperrors.InvalidArgumentError{Field: "status", Message: "status must be pending, resolved or dismissed"}
+	}
+	return s.ports.Feeds.ListScoreFeedReviews(ctx, status)
+}
+
+// ResolveReview maps a queued name to a school. Results naming it are applied
+// on the next poll.
+func (s *Service) ResolveReview(ctx context.Context, reviewID, schoolID, resolvedBy string) (*models.SchoolNameMapping, error) {
+	if strings.TrimSpace(schoolID) == "" {
+		return nil, &apperrors.InvalidArgumentError{Field: "schoolId", Message: "schoolId is required"}
+	}
+	return s.ports.Feeds.ResolveScoreFeedReview(ctx, reviewID, schoolID, optionalUser(resolvedBy))
+}
+
+// DismissReview removes a queued name without mapping it, such as a team
+// that is not in the tournament.
+func (s *Service) DismissReview(ctx context.Context, reviewID, resolvedBy string) error {
+	return s.ports.Feeds.DismissScoreFeedReview(ctx, reviewID, optionalUser(resolvedBy))
+}
+
+// ListMappings returns the school name mappings, filtered by source when one
+// is given.
+func (s *Service) ListMappings(ctx context.Context, source string) ([]*models.SchoolNameMapping, error) {
+	return s.ports.Feeds.ListSchoolNameMappings(ctx, source)
+}
+
+// CreateMapping maps a feed's name for a team to a school ahead of time.
+func (s *Service) CreateMapping(ctx context.Context, source, externalName, schoolID, createdBy string) (*models.SchoolNameMapping, error) {
+	key := NormalizeName(externalName)
+	if strings.TrimSpace(source) == "" {
+		return nil, &apperrors.InvalidArgumentError{Field: "source", Message: "source is required"}
+	}
+	if key == "" {
+		return nil, &apperrors.InvalidArgumentError{Field: "externalName", Message: "externalName must contain a letter or digit"}
+	}
+	if strings.TrimSpace(schoolID) == "" {
+		return nil, &apperrors.InvalidArgumentError{Field: "schoolId", Message: "schoolId is required"}
+	}
+	mapping := &models.SchoolNameMapping{
+		Source:       strings.TrimSpace(source),
+		ExternalName: strings.TrimSpace(externalName),
+		NameKey:      key,
+		SchoolID:     schoolID,
+		CreatedBy:    optionalUser(createdBy),
+	}
+	if err := s.ports.Feeds.UpsertSchoolNameMapping(ctx, mapping); err != nil {
+		return nil, fmt.Errorf("failed to store school name mapping: %w", err)
+	}
+	return mapping, nil
+}
+
+// DeleteMapping removes a school name mapping.
+func (s *Service) DeleteMapping(ctx context.Context, id string) error {
+	return s.ports.Feeds.DeleteSchoolNameMapping(ctx, id)
+}
+
+func optionalUser(id string) *string {
+	if id == "" {
+		return nil
+	}
+	return &id
+}
@@ -0,0 +1,228 @@
+package scorefeed
+
+import (
+	"context"
+	"testing"
+
+	appbracket "github.com/andrewcopp/Calcutta/backend/internal/app/bracket"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+)
+
+type fakeFeedRepo struct {
+	ports.ScoreFeedRepository
+	mappings []*models.SchoolNameMapping
+	reviews  []*models.ScoreFeedReview
+}
+
+func (f *fakeFeedRepo) ListSchoolSlugs(context.Context) ([]models.SchoolSlug, error) {
+	return []models.SchoolSlug{
+		{ID: "s-duke", Slug: "duke", Name: "Duke"},
+		{ID: "s-vermont", Slug: "vermont", Name: "Vermont"},
+		{ID: "s-baylor", Slug: "baylor", Name: "Baylor"},
+	}, nil
+}
+
+func (f *fakeFeedRepo) ListSchoolNameMappings(context.Context, string) ([]*models.SchoolNameMapping, error) {
+	return f.mappings, nil
+}
+
+func (f *fakeFeedRepo) EnqueueScoreFeedReview(_ context.Context, review *models.ScoreFeedReview) error {
+	f.reviews = append(f.reviews, review)
+	return nil
+}
+
+type fakeResolver struct{}
+
+func (fakeResolver) ResolveCoreTournamentID(context.Context, int) (string, error) {
+	return "t", nil
+}
+
+// fakeBracket is a two-game bracket: duke plays vermont, and the winner plays
+// baylor.
+type fakeBracket struct {
+	bracket  *models.BracketStructure
+	selected []appbracket.WinnerSelection
+	live     []appbracket.LiveGameUpdate
+}
+
+func newFakeBracket() *fakeBracket {
+	return &fakeBracket{bracket: &models.BracketStructure{
+		TournamentID: "t",
+		Games: map[string]*models.BracketGame{
+			"game1": {
+				GameID:       "game1",
+				Team1:        &models.BracketTeam{TeamID: "team-duke", SchoolID: "s-duke"},
+				Team2:        &models.BracketTeam{TeamID: "team-vermont", SchoolID: "s-vermont"},
+				NextGameID:   "game2",
+				NextGameSlot: 1,
+			},
+			"game2": {
+				GameID: "game2",
+				Team2:  &models.BracketTeam{TeamID: "team-baylor", SchoolID: "s-baylor"},
+			},
+		},
+	}}
+}
+
+func (f *fakeBracket) GetBracket(context.Context, string) (*models.BracketStructure, error) {
+	return f.bracket, nil
+}
+
+func (f *fakeBracket) SelectWinner(_ context.Context, _, gameID string, selection appbracket.WinnerSelection) (*models.BracketStructure, error) {
+	f.selected = append(f.selected, selection)
+	game := f.bracket.Games[gameID]
+	game.Winner = game.Team1
+	if game.Team2.TeamID == selection.WinnerTeamID {
+		game.Winner = game.Team2
+	}
+	if next, ok := f.bracket.Games[game.NextGameID]; ok {
+		if game.NextGameSlot == 1 {
+			next.Team1 = game.Winner
+		} else {
+			next.Team2 = game.Winner
+		}
+	}
+	return f.bracket, nil
+}
+
+func (f *fakeBracket) UpdateLiveGame(_ context.Context, _, gameID string, update appbracket.LiveGameUpdate) (*models.LiveGameState, error) {
+	f.live = append(f.live, update)
+	return &models.LiveGameState{GameID: gameID}, nil
+}
+
+func newTestService(repo *fakeFeedRepo, bracket *fakeBracket) *Service {
+	return New(Ports{Feeds: repo, Tournaments: fakeResolver{}, Bracket: bracket})
+}
+
+func TestThatIngestRecordsFinalResultWinner(t *testing.T) {
+	// GIVEN a final result listing the bracket's teams in reverse order
+	bracket := newFakeBracket()
+	svc := newTestService(&fakeFeedRepo{}, bracket)
+	results := []models.ScoreFeedResult{{Season: 2026, Team1Name: "Vermont", Team2Name: "Duke", Team1Score: 70, Team2Score: 68, Final: true}}
+
+	// WHEN ingesting the result
+	_, err := svc.Ingest(context.Background(), "test", results)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN vermont is recorded as the winner
+	if len(bracket.selected) != 1 || bracket.selected[0].WinnerTeamID != "team-vermont" {
+		t.Errorf("expected team-vermont to be selected, got %+v", bracket.selected)
+	}
+}
+
+func TestThatIngestIsIdempotent(t *testing.T) {
+	// GIVEN a final result that has already been ingested once
+	bracket := newFakeBracket()
+	svc := newTestService(&fakeFeedRepo{}, bracket)
+	results := []models.ScoreFeedResult{{Season: 2026, Team1Name: "Duke", Team2Name: "Vermont", Team1Score: 80, Team2Score: 60, Final: true}}
+	if _, err := svc.Ingest(context.Background(), "test", results); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// WHEN ingesting it again
+	report, err := svc.Ingest(context.Background(), "test", results)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the result is reported as already applied
+	if report.AlreadyApplied != 1 || len(bracket.selected) != 1 {
+		t.Errorf("expected one already-applied result and one selection, got %+v and %d selections", report, len(bracket.selected))
+	}
+}
+
+func TestThatIngestAppliesLaterRoundListedBeforeItsFeederGame(t *testing.T) {
+	// GIVEN a batch listing the second-round result before the first
+	bracket := newFakeBracket()
+	svc := newTestService(&fakeFeedRepo{}, bracket)
+	results := []models.ScoreFeedResult{
+		{Season: 2026, Team1Name: "Duke", Team2Name: "Baylor", Team1Score: 75, Team2Score: 71, Final: true},
+		{Season: 2026, Team1Name: "Duke", Team2Name: "Vermont", Team1Score: 80, Team2Score: 60, Final: true},
+	}
+
+	// WHEN ingesting the batch
+	report, err := svc.Ingest(context.Background(), "test", results)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN both results are applied
+	if report.Applied != 2 {
+		t.Errorf("expected 2 applied results, got %+v", report)
+	}
+}
+
+func TestThatIngestReportsConflictingWinner(t *testing.T) {
+	// GIVEN a game already won by duke
+	bracket := newFakeBracket()
+	bracket.bracket.Games["game1"].Winner = bracket.bracket.Games["game1"].Team1
+	svc := newTestService(&fakeFeedRepo{}, bracket)
+	results := []models.ScoreFeedResult{{Season: 2026, Team1Name: "Duke", Team2Name: "Vermont", Team1Score: 60, Team2Score: 61, Final: true}}
+
+	// WHEN ingesting a result won by vermont
+	report, err := svc.Ingest(context.Background(), "test", results)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the result is reported as a conflict
+	if report.Conflicts != 1 || len(bracket.selected) != 0 {
+		t.Errorf("expected one conflict and no selection, got %+v", report)
+	}
+}
+
+func TestThatIngestQueuesUnknownNameForReview(t *testing.T) {
+	// GIVEN a result naming a team the feed abbreviates
+	repo := &fakeFeedRepo{}
+	svc := newTestService(repo, newFakeBracket())
+	results := []models.ScoreFeedResult{{Season: 2026, Team1Name: "UVM", Team2Name: "Duke", Team1Score: 70, Team2Score: 68, Final: true}}
+
+	// WHEN ingesting the result
+	if _, err := svc.Ingest(context.Background(), "test", results); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the abbreviation is queued for review
+	if len(repo.reviews) != 1 || repo.reviews[0].NameKey != "uvm" {
+		t.Errorf("expected uvm to be queued, got %+v", repo.reviews)
+	}
+}
+
+func TestThatIngestUsesNameMapping(t *testing.T) {
+	// GIVEN a mapping for the feed's abbreviation
+	repo := &fakeFeedRepo{mappings: []*models.SchoolNameMapping{{NameKey: "uvm", SchoolID: "s-vermont"}}}
+	bracket := newFakeBracket()
+	svc := newTestService(repo, bracket)
+	results := []models.ScoreFeedResult{{Season: 2026, Team1Name: "UVM", Team2Name: "Duke", Team1Score: 70, Team2Score: 68, Final: true}}
+
+	// WHEN ingesting a result naming the abbreviation
+	if _, err := svc.Ingest(context.Background(), "test", results); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the mapped school's team is recorded as the winner
+	if len(bracket.selected) != 1 || bracket.selected[0].WinnerTeamID != "team-vermont" {
+		t.Errorf("expected team-vermont to be selected, got %+v", bracket.selected)
+	}
+}
+
+func TestThatIngestPostsGameInProgressAsLiveState(t *testing.T) {
+	// GIVEN a result for a game in progress listing the teams in reverse order
+	bracket := newFakeBracket()
+	svc := newTestService(&fakeFeedRepo{}, bracket)
+	secs := 600
+	results := []models.ScoreFeedResult{{Season: 2026, Team1Name: "Vermont", Team2Name: "Duke", Team1Score: 50, Team2Score: 44, SecondsRemaining: &secs}}
+
+	// WHEN ingesting the result
+	if _, err := svc.Ingest(context.Background(), "test", results); err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the live state is posted in the bracket's team order
+	if len(bracket.live) != 1 || bracket.live[0].Team1Score != 44 || bracket.live[0].Team2Score != 50 {
+		t.Errorf("expected duke 44 vermont 50, got %+v", bracket.live)
+	}
+}
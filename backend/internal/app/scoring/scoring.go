@@ -1,14 +1,170 @@
 package scoring
 
+import (
+	"sort"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// Rule pays PointsAwarded on the win at WinIndex, scaled by its kind (one of
+// the models.ScoringRuleKind constants). An empty Kind is a round rule.
 type Rule struct {
+	Kind          string
 	WinIndex      int
 	PointsAwarded int
 }
 
+// FromModels converts stored scoring rules into scoring rules.
+func FromModels(rules []*models.ScoringRule) []Rule {
+	out := make([]Rule, 0, len(rules))
+	for _, r := range rules {
+		if r == nil {
+			continue
+		}
+		out = append(out, Rule{Kind: r.Kind, WinIndex: r.WinIndex, PointsAwarded: r.PointsAwarded})
+	}
+	return out
+}
+
+// isFlat reports whether the rule pays the same for every team.
+func (r Rule) isFlat() bool {
+	return r.Kind == "" || r.Kind == models.ScoringRuleKindRound || r.Kind == models.ScoringRuleKindChampionBonus
+}
+
+// paysOn reports whether the rule pays on the win at winIndex. Rules below
+// win index 1 pay with the first win, as PointsForProgress counts them.
+func (r Rule) paysOn(winIndex int) bool {
+	return r.WinIndex == winIndex || (winIndex == 1 && r.WinIndex < 1)
+}
+
+// SeedDependent reports whether any rule pays differently by seed, so that
+// progress alone cannot price a team.
+func SeedDependent(rules []Rule) bool {
+	for _, r := range rules {
+		if !r.isFlat() {
+			return true
+		}
+	}
+	return false
+}
+
+// Run is one team's path through the bracket as far as scoring needs it.
+type Run struct {
+	Seed int
+	Wins int
+	Byes int
+	// BeatenSeeds holds the seed of each team beaten, in the order the wins
+	// came. Wins past the end of the slice earn no upset bonus.
+	BeatenSeeds []int
+}
+
+// Capped returns the run as it stood at the given progress (wins + byes).
+// Byes come first, as they do in the bracket.
+func (r Run) Capped(progress int) Run {
+	if r.Wins+r.Byes <= progress {
+		return r
+	}
+	out := r
+	if out.Byes > progress {
+		out.Byes = progress
+	}
+	out.Wins = progress - out.Byes
+	if len(out.BeatenSeeds) > out.Wins {
+		out.BeatenSeeds = out.BeatenSeeds[:out.Wins]
+	}
+	return out
+}
+
+// WinPoints returns what a team of the given seed earns for its win at
+// winIndex over a team of beatenSeed. A beatenSeed of 0 stands for a bye or
+// an unknown opponent and earns no upset bonus.
+func WinPoints(rules []Rule, winIndex, seed, beatenSeed int) int {
+	pts := 0
+	for _, r := range rules {
+		if !r.paysOn(winIndex) {
+			continue
+		}
+		switch r.Kind {
+		case models.ScoringRuleKindSeedMultiplier:
+			pts += r.PointsAwarded * seed
+		case models.ScoringRuleKindUpsetBonus:
+			if beatenSeed > 0 && seed > beatenSeed {
+				pts += r.PointsAwarded * (seed - beatenSeed)
+			}
+		default:
+			pts += r.PointsAwarded
+		}
+	}
+	return pts
+}
+
+// UpsetPointsPerSeed returns the upset bonus paid per seed line for the win at
+// winIndex.
+func UpsetPointsPerSeed(rules []Rule, winIndex int) int {
+	pts := 0
+	for _, r := range rules {
+		if r.Kind == models.ScoringRuleKindUpsetBonus && r.paysOn(winIndex) {
+			pts += r.PointsAwarded
+		}
+	}
+	return pts
+}
+
+// PointsForRun scores a team's run under every rule kind.
+func PointsForRun(rules []Rule, run Run) int {
+	pts := 0
+	for w := 1; w <= run.Wins+run.Byes; w++ {
+		beaten := 0
+		if i := w - run.Byes - 1; i >= 0 && i < len(run.BeatenSeeds) {
+			beaten = run.BeatenSeeds[i]
+		}
+		pts += WinPoints(rules, w, run.Seed, beaten)
+	}
+	return pts
+}
+
+// RunsFromResults builds each team's run from its recorded results.
+func RunsFromResults(teams []*models.TournamentTeam, results []*models.GameResult) map[string]Run {
+	seedByTeam := make(map[string]int, len(teams))
+	for _, t := range teams {
+		seedByTeam[t.ID] = t.Seed
+	}
+	beaten := BeatenSeeds(seedByTeam, results)
+
+	runs := make(map[string]Run, len(teams))
+	for _, t := range teams {
+		runs[t.ID] = Run{Seed: t.Seed, Wins: t.Wins, Byes: t.Byes, BeatenSeeds: beaten[t.ID]}
+	}
+	return runs
+}
+
+// BeatenSeeds maps each winning team to the seeds it beat, ordering its wins
+// by when they were decided.
+func BeatenSeeds(seedByTeam map[string]int, results []*models.GameResult) map[string][]int {
+	ordered := make([]*models.GameResult, 0, len(results))
+	for _, gr := range results {
+		if gr != nil {
+			ordered = append(ordered, gr)
+		}
+	}
+	sort.SliceStable(ordered, func(i, j int) bool {
+		if !ordered[i].DecidedAt.Equal(ordered[j].DecidedAt) {
+			return ordered[i].DecidedAt.Before(ordered[j].DecidedAt)
+		}
+		return ordered[i].GameID < ordered[j].GameID
+	})
+	beaten := make(map[string][]int)
+	for _, gr := range ordered {
+		beaten[gr.WinnerTeamID] = append(beaten[gr.WinnerTeamID], seedByTeam[gr.LoserTeamID])
+	}
+	return beaten
+}
+
 // TournamentTotal computes the deterministic point total for a bracket.
 // gamesPerRound[i] is the number of games in round i+1 (0-indexed).
 // For each round, total += gamesPerRound[i] * incrementalPoints[i+1].
-// Rules beyond the number of rounds are ignored.
+// Rules beyond the number of rounds are ignored, as are seed-dependent kinds,
+// whose payout depends on who wins.
 func TournamentTotal(rules []Rule, gamesPerRound []int) int {
 	total := 0
 	for i, games := range gamesPerRound {
@@ -41,6 +197,8 @@ func NCAAgamesPerRound() []int {
 	return []int{64, 32, 16, 8, 4, 2, 1}
 }
 
+// PointsForProgress sums the round and champion rules reached at the given
+// progress. It ignores seed-dependent kinds; use PointsForRun for those.
 func PointsForProgress(rules []Rule, wins int, byes int) int {
 	p := wins + byes
 	if p <= 0 {
@@ -48,7 +206,7 @@ func PointsForProgress(rules []Rule, wins int, byes int) int {
 	}
 	pts := 0
 	for _, r := range rules {
-		if r.WinIndex <= p {
+		if r.isFlat() && r.WinIndex <= p {
 			pts += r.PointsAwarded
 		}
 	}
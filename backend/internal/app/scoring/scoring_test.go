@@ -1,6 +1,11 @@
 package scoring
 
-import "testing"
+import (
+	"testing"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
 
 func TestThatPointsForProgressReturnsZeroWhenRulesEmpty(t *testing.T) {
 	// GIVEN
@@ -271,3 +276,141 @@ func TestThatGamesPerRoundForOneTeamReturnsNil(t *testing.T) {
 		t.Fatalf("expected nil, got %v", result)
 	}
 }
+
+// --- Rule kind tests ---
+
+func TestThatPointsForProgressIgnoresSeedMultiplierRules(t *testing.T) {
+	// GIVEN a round rule and a seed multiplier on the same win
+	rules := []Rule{
+		{Kind: models.ScoringRuleKindRound, WinIndex: 1, PointsAwarded: 10},
+		{Kind: models.ScoringRuleKindSeedMultiplier, WinIndex: 1, PointsAwarded: 2},
+	}
+
+	// WHEN scoring one win by progress alone
+	points := PointsForProgress(rules, 1, 0)
+
+	// THEN only the round rule counts
+	if points != 10 {
+		t.Fatalf("expected 10, got %d", points)
+	}
+}
+
+func TestThatPointsForRunMultipliesBySeed(t *testing.T) {
+	// GIVEN a 12 seed with one win under a 2-points-per-seed rule
+	rules := []Rule{{Kind: models.ScoringRuleKindSeedMultiplier, WinIndex: 1, PointsAwarded: 2}}
+	run := Run{Seed: 12, Wins: 1, BeatenSeeds: []int{5}}
+
+	// WHEN scoring the run
+	points := PointsForRun(rules, run)
+
+	// THEN it earns 2 * 12
+	if points != 24 {
+		t.Fatalf("expected 24, got %d", points)
+	}
+}
+
+func TestThatPointsForRunPaysUpsetBonusPerSeedLine(t *testing.T) {
+	// GIVEN a 12 seed that beat a 5 seed under a 3-points-per-line upset bonus
+	rules := []Rule{{Kind: models.ScoringRuleKindUpsetBonus, WinIndex: 1, PointsAwarded: 3}}
+	run := Run{Seed: 12, Wins: 1, BeatenSeeds: []int{5}}
+
+	// WHEN scoring the run
+	points := PointsForRun(rules, run)
+
+	// THEN it earns 3 * (12 - 5)
+	if points != 21 {
+		t.Fatalf("expected 21, got %d", points)
+	}
+}
+
+func TestThatPointsForRunPaysNoUpsetBonusToFavorite(t *testing.T) {
+	// GIVEN a 5 seed that beat a 12 seed under an upset bonus
+	rules := []Rule{{Kind: models.ScoringRuleKindUpsetBonus, WinIndex: 1, PointsAwarded: 3}}
+	run := Run{Seed: 5, Wins: 1, BeatenSeeds: []int{12}}
+
+	// WHEN scoring the run
+	points := PointsForRun(rules, run)
+
+	// THEN it earns nothing
+	if points != 0 {
+		t.Fatalf("expected 0, got %d", points)
+	}
+}
+
+func TestThatPointsForRunMatchesUpsetsAfterByes(t *testing.T) {
+	// GIVEN a team with one bye whose first game (win index 2) beat a better seed
+	rules := []Rule{
+		{Kind: models.ScoringRuleKindUpsetBonus, WinIndex: 1, PointsAwarded: 100},
+		{Kind: models.ScoringRuleKindUpsetBonus, WinIndex: 2, PointsAwarded: 1},
+	}
+	run := Run{Seed: 9, Byes: 1, Wins: 1, BeatenSeeds: []int{8}}
+
+	// WHEN scoring the run
+	points := PointsForRun(rules, run)
+
+	// THEN only the win-index-2 bonus applies
+	if points != 1 {
+		t.Fatalf("expected 1, got %d", points)
+	}
+}
+
+func TestThatChampionBonusCountsAsProgress(t *testing.T) {
+	// GIVEN a champion bonus on the seventh win
+	rules := []Rule{{Kind: models.ScoringRuleKindChampionBonus, WinIndex: 7, PointsAwarded: 50}}
+
+	// WHEN scoring a champion by progress
+	points := PointsForProgress(rules, 6, 1)
+
+	// THEN the bonus is paid
+	if points != 50 {
+		t.Fatalf("expected 50, got %d", points)
+	}
+}
+
+func TestThatChampionBonusIsNotPaidToTheRunnerUp(t *testing.T) {
+	// GIVEN a champion bonus on the title win
+	rules := []Rule{{Kind: models.ScoringRuleKindChampionBonus, WinIndex: 7, PointsAwarded: 50}}
+
+	// WHEN scoring the runner-up, who won every game but the title
+	points := PointsForProgress(rules, 6, 0)
+
+	// THEN no bonus is paid
+	if points != 0 {
+		t.Fatalf("expected 0, got %d", points)
+	}
+}
+
+func TestThatCappedRunDropsLaterUpsets(t *testing.T) {
+	// GIVEN a run with one bye and three wins
+	run := Run{Seed: 11, Byes: 1, Wins: 3, BeatenSeeds: []int{6, 3, 2}}
+
+	// WHEN capping it at progress 2
+	capped := run.Capped(2)
+
+	// THEN only the first beaten seed remains
+	if len(capped.BeatenSeeds) != 1 {
+		t.Fatalf("expected 1 beaten seed, got %v", capped.BeatenSeeds)
+	}
+}
+
+func TestThatRunsFromResultsOrdersWinsByDecidedAt(t *testing.T) {
+	// GIVEN a team whose later win is listed first
+	teams := []*models.TournamentTeam{
+		{ID: "a", Seed: 11, Wins: 2},
+		{ID: "b", Seed: 6},
+		{ID: "c", Seed: 3},
+	}
+	start := time.Date(2026, 3, 19, 0, 0, 0, 0, time.UTC)
+	results := []*models.GameResult{
+		{GameID: "g2", WinnerTeamID: "a", LoserTeamID: "c", DecidedAt: start.Add(48 * time.Hour)},
+		{GameID: "g1", WinnerTeamID: "a", LoserTeamID: "b", DecidedAt: start},
+	}
+
+	// WHEN building runs
+	runs := RunsFromResults(teams, results)
+
+	// THEN the 6 seed was beaten first
+	if got := runs["a"].BeatenSeeds; len(got) != 2 || got[0] != 6 {
+		t.Fatalf("expected [6 3], got %v", got)
+	}
+}
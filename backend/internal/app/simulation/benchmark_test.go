@@ -65,3 +65,16 @@ func BenchmarkRoundOrder(b *testing.B) {
 		_ = rounds[i%len(rounds)].Order()
 	}
 }
+
+func BenchmarkSimulateAggregatedToyBracket(b *testing.B) {
+	br := toyBracket()
+	provider := mapProbabilityProvider{probs: toyProbs()}
+
+	b.ReportAllocs()
+	for i := 0; i < b.N; i++ {
+		_, err := SimulateAggregated(br, provider, 5000, 42, Options{Workers: 1})
+		if err != nil {
+			b.Fatal(err)
+		}
+	}
+}
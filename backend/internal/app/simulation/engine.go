@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"math/rand"
 	"sort"
+	"sync"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
@@ -40,50 +41,138 @@ func SimulateWithProvider(
 	seed int64,
 	opts Options,
 ) ([]TeamSimulationResult, error) {
-	if bracket == nil {
-		return nil, errors.New("bracket must not be nil")
+	if nSims <= 0 {
+		return nil, errors.New("nSims must be positive")
+	}
+	if err := validateOptions(opts); err != nil {
+		return nil, err
+	}
+	if opts.Sampling.Weighted() {
+		return nil, errors.New("weighted sampling needs SimulateAggregated, which returns the weights")
+	}
+	layout, err := NewOutcomeLayout(bracket)
+	if err != nil {
+		return nil, err
 	}
-	if len(bracket.Games) == 0 {
-		return nil, errors.New("bracket must have games")
+
+	nTeams := len(layout.TeamIDs)
+	results := make([]TeamSimulationResult, nSims*nTeams)
+	err = runWorkers(nSims, opts.Workers, func() (func(simID int) error, func()) {
+		r := newSimRunner(layout, provider, seed, opts, nil)
+		outcome := make([]byte, layout.BytesPerSim())
+		return func(simID int) error {
+			if _, err := r.run(simID, outcome); err != nil {
+				return err
+			}
+			layout.decodeInto(simID, outcome, r.winners, results[simID*nTeams:(simID+1)*nTeams])
+			return nil
+		}, nil
+	})
+	if err != nil {
+		return nil, err
 	}
+	return results, nil
+}
+
+// AggregateResult is a simulation run reduced as it went, without a result
+// row per team per simulation.
+type AggregateResult struct {
+	Layout     *OutcomeLayout
+	Outcomes   *PackedOutcomes
+	RoundReach *RoundReach
+}
+
+// SimulateAggregated runs the same tournaments as SimulateWithProvider for a
+// seed, but packs each into a bit per game and folds it into per-worker
+// round-reach histograms as it goes, which are merged at the end. Memory
+// grows by BytesPerSim per simulation, so 100k simulations fit comfortably.
+func SimulateAggregated(
+	bracket *models.BracketStructure,
+	provider ProbabilityProvider,
+	nSims int,
+	seed int64,
+	opts Options,
+) (*AggregateResult, error) {
 	if nSims <= 0 {
 		return nil, errors.New("nSims must be positive")
 	}
+	if err := validateOptions(opts); err != nil {
+		return nil, err
+	}
+	layout, err := NewOutcomeLayout(bracket)
+	if err != nil {
+		return nil, err
+	}
+	var strata *championStrata
+	if opts.Sampling.Stratified() {
+		if strata, err = newChampionStrata(layout, provider, nSims); err != nil {
+			return nil, err
+		}
+	}
 
-	games, prevByNext := prepareGames(bracket)
-	teams, baseByes := collectTeams(games)
-	if len(teams) == 0 {
-		return nil, errors.New("bracket has no teams")
+	outcomes := NewPackedOutcomes(layout, nSims)
+	if opts.Sampling.Weighted() {
+		outcomes.Weights = make([]float64, nSims)
+	}
+	var mu sync.Mutex
+	reach := NewRoundReach(layout)
+	err = runWorkers(nSims, opts.Workers, func() (func(simID int) error, func()) {
+		r := newSimRunner(layout, provider, seed, opts, strata)
+		progress := make([]int, len(layout.TeamIDs))
+		local := NewRoundReach(layout)
+		run := func(simID int) error {
+			outcome := outcomes.Sim(simID)
+			weight, err := r.run(simID, outcome)
+			if err != nil {
+				return err
+			}
+			if outcomes.Weights != nil {
+				outcomes.Weights[simID] = weight
+			}
+			layout.Progress(outcome, progress, r.winners)
+			local.add(progress, weight)
+			return nil
+		}
+		flush := func() {
+			mu.Lock()
+			reach.Merge(local)
+			mu.Unlock()
+		}
+		return run, flush
+	})
+	if err != nil {
+		return nil, err
 	}
+	return &AggregateResult{Layout: layout, Outcomes: outcomes, RoundReach: reach}, nil
+}
 
-	workers := opts.Workers
+// runWorkers hands simulation IDs 0..nSims-1 to workers, each running the
+// function newWorker builds for it. Once the IDs run out, each worker calls
+// its flush function, if any, to hand over what it folded.
+func runWorkers(nSims, workers int, newWorker func() (run func(simID int) error, flush func())) error {
 	if workers <= 0 {
 		workers = 1
 	}
 
-	results := make([]TeamSimulationResult, nSims*len(teams))
-
 	workCh := make(chan int)
 	errCh := make(chan error, workers)
 	doneCh := make(chan struct{})
 
 	for w := 0; w < workers; w++ {
+		run, flush := newWorker()
 		go func() {
 			for simID := range workCh {
-				if err := runOneSimulation(
-					simID,
-					seed,
-					games,
-					prevByNext,
-					teams,
-					baseByes,
-					provider,
-					results,
-				); err != nil {
+				if err := run(simID); err != nil {
 					errCh <- err
+					// Keep draining so the producer never blocks.
+					for range workCh {
+					}
 					return
 				}
 			}
+			if flush != nil {
+				flush()
+			}
 			doneCh <- struct{}{}
 		}()
 	}
@@ -93,15 +182,17 @@ func SimulateWithProvider(
 	}
 	close(workCh)
 
+	var firstErr error
 	for i := 0; i < workers; i++ {
 		select {
 		case err := <-errCh:
-			return nil, err
+			if firstErr == nil {
+				firstErr = err
+			}
 		case <-doneCh:
 		}
 	}
-
-	return results, nil
+	return firstErr
 }
 
 func prepareGames(bracket *models.BracketStructure) ([]*models.BracketGame, map[string]map[int]string) {
@@ -143,25 +234,24 @@ func prepareGames(bracket *models.BracketStructure) ([]*models.BracketGame, map[
 
 func collectTeams(games []*models.BracketGame) ([]string, map[string]int) {
 	seen := make(map[string]struct{})
-	minRound := make(map[string]int)
+	entryRound := make(map[string]models.BracketRound)
+
+	enter := func(team *models.BracketTeam, round models.BracketRound) {
+		if team == nil || team.TeamID == "" {
+			return
+		}
+		seen[team.TeamID] = struct{}{}
+		if prev, ok := entryRound[team.TeamID]; !ok || round.Order() < prev.Order() {
+			entryRound[team.TeamID] = round
+		}
+	}
 
 	for _, g := range games {
 		if g == nil {
 			continue
 		}
-		ro := g.Round.Order()
-		if g.Team1 != nil && g.Team1.TeamID != "" {
-			seen[g.Team1.TeamID] = struct{}{}
-			if prev, ok := minRound[g.Team1.TeamID]; !ok || ro < prev {
-				minRound[g.Team1.TeamID] = ro
-			}
-		}
-		if g.Team2 != nil && g.Team2.TeamID != "" {
-			seen[g.Team2.TeamID] = struct{}{}
-			if prev, ok := minRound[g.Team2.TeamID]; !ok || ro < prev {
-				minRound[g.Team2.TeamID] = ro
-			}
-		}
+		enter(g.Team1, g.Round)
+		enter(g.Team2, g.Round)
 	}
 
 	teams := make([]string, 0, len(seen))
@@ -170,102 +260,92 @@ func collectTeams(games []*models.BracketGame) ([]string, map[string]int) {
 	}
 	sort.Strings(teams)
 
+	// A team is credited a bye for every round it skips before its first game.
 	baseByes := make(map[string]int, len(teams))
-	firstFourOrder := models.RoundFirstFour.Order()
 	for _, tid := range teams {
-		ro := minRound[tid]
-		if ro != firstFourOrder {
-			baseByes[tid] = 1
-		} else {
-			baseByes[tid] = 0
-		}
+		baseByes[tid] = entryRound[tid].MinProgressRequired()
 	}
 
 	return teams, baseByes
 }
 
-func runOneSimulation(
-	simID int,
-	seed int64,
-	games []*models.BracketGame,
-	prevByNext map[string]map[int]string,
-	teams []string,
-	baseByes map[string]int,
-	provider ProbabilityProvider,
-	out []TeamSimulationResult,
-) error {
-	if simID < 0 {
-		return fmt.Errorf("simID must be non-negative")
-	}
-
-	rng := rand.New(rand.NewSource(seed + int64(simID)*1_000_003))
-
-	wins := make(map[string]int, len(teams))
-	isEliminated := make(map[string]bool, len(teams))
-	winnersByGame := make(map[string]string, len(games))
-
-	for _, tid := range teams {
-		wins[tid] = 0
-		isEliminated[tid] = false
-	}
-
+// collectSeeds maps every team placed in the bracket to its seed.
+func collectSeeds(games []*models.BracketGame) map[string]int {
+	seeds := make(map[string]int)
 	for _, g := range games {
-		if g == nil || g.GameID == "" {
-			continue
-		}
-
-		team1 := ""
-		team2 := ""
-		if g.Team1 != nil {
-			team1 = g.Team1.TeamID
-		}
-		if g.Team2 != nil {
-			team2 = g.Team2.TeamID
-		}
-
-		if team1 == "" {
-			if prev := prevByNext[g.GameID][1]; prev != "" {
-				team1 = winnersByGame[prev]
-			}
-		}
-		if team2 == "" {
-			if prev := prevByNext[g.GameID][2]; prev != "" {
-				team2 = winnersByGame[prev]
+		for _, team := range []*models.BracketTeam{g.Team1, g.Team2} {
+			if team != nil && team.TeamID != "" {
+				seeds[team.TeamID] = team.Seed
 			}
 		}
+	}
+	return seeds
+}
 
-		if team1 == "" || team2 == "" {
-			continue
-		}
-
-		p1 := 0.5
-		if provider != nil {
-			p1 = provider.Prob(g.GameID, team1, team2)
-		}
+// simRunner plays simulations for one worker, reusing its scratch space.
+type simRunner struct {
+	seed     int64
+	layout   *OutcomeLayout
+	provider ProbabilityProvider
+	strength *StrengthUncertainty
+	sampling *Sampling
+	strata   *championStrata
+	winners  []int
+	shifts   []float64
+}
 
-		roll := rng.Float64()
-		winner := team2
-		loser := team1
-		if roll < p1 {
-			winner = team1
-			loser = team2
-		}
+func newSimRunner(layout *OutcomeLayout, provider ProbabilityProvider, seed int64, opts Options, strata *championStrata) *simRunner {
+	r := &simRunner{
+		seed:     seed,
+		layout:   layout,
+		provider: provider,
+		strength: opts.StrengthUncertainty,
+		sampling: opts.Sampling,
+		strata:   strata,
+		winners:  make([]int, len(layout.Games)),
+	}
+	if r.strength != nil {
+		r.shifts = make([]float64, len(layout.TeamIDs))
+	}
+	return r
+}
 
-		winnersByGame[g.GameID] = winner
-		wins[winner] = wins[winner] + 1
-		isEliminated[loser] = true
+// run plays simulation simID into outcome, clearing it first, and returns
+// its weight. Each simulation draws from its own stream, seeded from seed
+// and simID, so results do not depend on which worker ran it. With strength
+// uncertainty, the stream first draws every team's perturbation, in TeamIDs
+// order. An antithetic pair shares the stream of its first simulation.
+func (r *simRunner) run(simID int, outcome []byte) (float64, error) {
+	if simID < 0 {
+		return 0, fmt.Errorf("simID must be non-negative")
 	}
 
-	base := simID * len(teams)
-	for i, tid := range teams {
-		out[base+i] = TeamSimulationResult{
-			SimID:        simID,
-			TeamID:       tid,
-			Wins:         wins[tid],
-			Byes:         baseByes[tid],
-			IsEliminated: isEliminated[tid],
+	streamID := simID
+	d := drawer{shifts: r.shifts}
+	if r.sampling != nil {
+		switch r.sampling.Method {
+		case SamplingAntithetic:
+			streamID = simID &^ 1
+			d.antithetic = simID&1 == 1
+		case SamplingImportanceUpsets:
+			d.upsetTilt = r.sampling.UpsetTilt
 		}
 	}
 
-	return nil
+	rng := rand.New(rand.NewSource(r.seed + int64(streamID)*1_000_003))
+	clear(outcome)
+	if r.strata != nil {
+		champion, weight := r.strata.stratum(simID)
+		r.strata.playConditioned(r.layout, rng, r.provider, champion, outcome, r.winners)
+		return weight, nil
+	}
+	if r.strength != nil {
+		r.strength.sample(rng, r.shifts)
+		if d.antithetic {
+			for i := range r.shifts {
+				r.shifts[i] = -r.shifts[i]
+			}
+		}
+	}
+	return r.layout.play(rng, r.provider, d, outcome, r.winners), nil
 }
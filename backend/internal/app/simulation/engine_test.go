@@ -1,6 +1,7 @@
 package simulation
 
 import (
+	"reflect"
 	"testing"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
@@ -75,7 +76,7 @@ func TestThatDeterministicSimulationProducesIdenticalResults(t *testing.T) {
 		t.Fatalf("expected equal lengths, got %d and %d", len(res1), len(res2))
 	}
 	for i := range res1 {
-		if res1[i] != res2[i] {
+		if !reflect.DeepEqual(res1[i], res2[i]) {
 			t.Errorf("mismatch at index %d: %+v vs %+v", i, res1[i], res2[i])
 		}
 	}
@@ -139,3 +140,40 @@ func TestThatWinsAreBoundedByTotalGames(t *testing.T) {
 		}
 	}
 }
+
+func TestThatCollectTeamsCreditsByesForEveryRoundSkipped(t *testing.T) {
+	// GIVEN a team whose first game is in the round of 32
+	games := []*models.BracketGame{
+		{GameID: "g1", Round: models.RoundOf64, Team1: &models.BracketTeam{TeamID: "t2"}, Team2: &models.BracketTeam{TeamID: "t3"}},
+		{GameID: "g2", Round: models.RoundOf32, Team1: &models.BracketTeam{TeamID: "t1"}},
+	}
+
+	// WHEN collecting teams
+	_, baseByes := collectTeams(games)
+
+	// THEN it is credited two byes
+	if baseByes["t1"] != 2 {
+		t.Errorf("expected 2 byes, got %d", baseByes["t1"])
+	}
+}
+
+func TestThatSimulationRecordsSeedsEachTeamBeat(t *testing.T) {
+	// GIVEN a bracket where the 12 seed always beats the 5 seed
+	b := toyBracket()
+	b.Games["g1"].Team1 = &models.BracketTeam{TeamID: "t1", Seed: 5}
+	b.Games["g1"].Team2 = &models.BracketTeam{TeamID: "t3", Seed: 12}
+	probs := map[MatchupKey]float64{{GameID: "g1", Team1ID: "t1", Team2ID: "t3"}: 0}
+
+	// WHEN simulating once
+	results, err := Simulate(b, probs, 1, 42, Options{})
+	if err != nil {
+		t.Fatalf("simulation failed: %v", err)
+	}
+
+	// THEN the 12 seed's first beaten seed is 5
+	for _, r := range results {
+		if r.TeamID == "t3" && (len(r.BeatenSeeds) == 0 || r.BeatenSeeds[0] != 5) {
+			t.Errorf("expected t3 to have beaten seed 5 first, got %v", r.BeatenSeeds)
+		}
+	}
+}
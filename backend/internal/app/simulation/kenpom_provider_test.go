@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/app/winprob"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
 func TestThatKenPomProviderReturnsOverrideProbabilityWhenMatchupKeyExists(t *testing.T) {
@@ -90,3 +91,100 @@ func TestThatKenPomProviderUsesSpecWinProbWhenBothRatingsPresent(t *testing.T) {
 		t.Errorf("expected %v, got %v", expected, result)
 	}
 }
+
+func TestThatKenPomProviderReturnsFiftyForTeamWithoutRatingWhenTeamsPresent(t *testing.T) {
+	// GIVEN a kenpom provider whose team inputs include a team with no rating
+	spec := &winprob.Model{Kind: "kenpom", Sigma: 10.0}
+	provider := KenPomProvider{
+		Spec:        spec,
+		NetByTeamID: map[string]float64{"a": -20.0},
+		Teams:       map[string]winprob.Team{"a": {ID: "a", Net: -20.0}, "b": {ID: "b"}},
+	}
+
+	// WHEN calling Prob with the unrated team
+	result := provider.Prob("g1", "a", "b")
+
+	// THEN 0.5 is returned rather than pricing it as an average team
+	if result != 0.5 {
+		t.Errorf("expected 0.5, got %v", result)
+	}
+}
+
+func TestThatKenPomProviderUsesTeamInputsWhenPresent(t *testing.T) {
+	// GIVEN a seed-model provider with team inputs but no net ratings
+	spec := &winprob.Model{Kind: winprob.KindSeed, Sigma: 10.0}
+	provider := KenPomProvider{
+		Spec:  spec,
+		Teams: map[string]winprob.Team{"a": {ID: "a", Seed: 1}, "b": {ID: "b", Seed: 16}},
+	}
+
+	// WHEN calling Prob
+	result := provider.Prob("g1", "a", "b")
+
+	// THEN the seed model's probability is returned
+	expected := spec.Prob(provider.Teams["a"], provider.Teams["b"])
+	if result != expected {
+		t.Errorf("expected %v, got %v", expected, result)
+	}
+}
+
+func TestThatKenPomProviderPrefersTableOverSpec(t *testing.T) {
+	// GIVEN a provider whose table covers the matchup
+	provider := KenPomProvider{
+		Spec:        &winprob.Model{Kind: "kenpom", Sigma: 10.0},
+		NetByTeamID: map[string]float64{"a": 20.0, "b": 10.0},
+		Table: &models.MatchupProbabilityTable{Probabilities: map[models.TeamPair]float64{
+			{Team1ID: "a", Team2ID: "b"}: 0.4,
+		}},
+	}
+
+	// WHEN calling Prob
+	result := provider.Prob("g1", "a", "b")
+
+	// THEN the table probability is returned
+	if result != 0.4 {
+		t.Errorf("expected 0.4, got %v", result)
+	}
+}
+
+func TestThatKenPomProviderPrefersOverrideOverTable(t *testing.T) {
+	// GIVEN a provider with both an override and a table entry for the matchup
+	provider := KenPomProvider{
+		Table: &models.MatchupProbabilityTable{Probabilities: map[models.TeamPair]float64{
+			{Team1ID: "a", Team2ID: "b"}: 0.4,
+		}},
+		Overrides: map[MatchupKey]float64{{GameID: "g1", Team1ID: "a", Team2ID: "b"}: 1.0},
+	}
+
+	// WHEN calling Prob
+	result := provider.Prob("g1", "a", "b")
+
+	// THEN the override wins
+	if result != 1.0 {
+		t.Errorf("expected 1.0, got %v", result)
+	}
+}
+
+func TestThatKenPomProviderAppliesHomeCourtAtGameSite(t *testing.T) {
+	// GIVEN a tempo model with home court and equal teams, one on campus at g1's site
+	spec := &winprob.Model{Kind: winprob.KindTempo, HomeCourt: 3.5}
+	spec.Normalize()
+	site := &winprob.Location{Latitude: 35.9, Longitude: -79.0}
+	provider := KenPomProvider{
+		Spec: spec,
+		Teams: map[string]winprob.Team{
+			"a": {ID: "a", ORtg: 110, DRtg: 100, AdjT: 68, Home: site},
+			"b": {ID: "b", ORtg: 110, DRtg: 100, AdjT: 68},
+		},
+		NetByTeamID: map[string]float64{"a": 10.0, "b": 10.0},
+		Sites:       map[string]*winprob.Location{"g1": site},
+	}
+
+	// WHEN calling Prob for the game at the site
+	result := provider.Prob("g1", "a", "b")
+
+	// THEN the home team is favored
+	if result <= 0.5 {
+		t.Errorf("expected home team to be favored, got %v", result)
+	}
+}
@@ -0,0 +1,307 @@
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// OutcomeLayout is a bracket compiled for packed outcomes: its games in play
+// order, each with the fixed team or feeder game of both slots. Given the
+// layout, a simulated tournament is one bit per game, set when the slot-1
+// team won, and every team's run can be replayed from those bits.
+type OutcomeLayout struct {
+	TeamIDs  []string     `json:"teamIds"`
+	Seeds    []int        `json:"seeds"`
+	BaseByes []int        `json:"baseByes"`
+	Games    []LayoutGame `json:"games"`
+}
+
+// LayoutGame is one game of an OutcomeLayout. Team1 and Team2 index
+// TeamIDs, or are -1 when the slot is filled by the winner of the game at
+// Prev1 or Prev2, which index Games, or -1 when there is none.
+type LayoutGame struct {
+	GameID string `json:"gameId"`
+	Team1  int    `json:"team1"`
+	Team2  int    `json:"team2"`
+	Prev1  int    `json:"prev1"`
+	Prev2  int    `json:"prev2"`
+}
+
+// NewOutcomeLayout compiles the bracket. Teams are ordered by ID, as in
+// SimulateWithProvider's results.
+func NewOutcomeLayout(bracket *models.BracketStructure) (*OutcomeLayout, error) {
+	if bracket == nil {
+		return nil, errors.New("bracket must not be nil")
+	}
+	if len(bracket.Games) == 0 {
+		return nil, errors.New("bracket must have games")
+	}
+
+	games, prevByNext := prepareGames(bracket)
+	teams, baseByes := collectTeams(games)
+	if len(teams) == 0 {
+		return nil, errors.New("bracket has no teams")
+	}
+	seeds := collectSeeds(games)
+
+	l := &OutcomeLayout{
+		TeamIDs:  teams,
+		Seeds:    make([]int, len(teams)),
+		BaseByes: make([]int, len(teams)),
+	}
+	teamIndex := make(map[string]int, len(teams))
+	for i, tid := range teams {
+		teamIndex[tid] = i
+		l.Seeds[i] = seeds[tid]
+		l.BaseByes[i] = baseByes[tid]
+	}
+
+	gameIndex := make(map[string]int, len(games))
+	for _, g := range games {
+		if g.GameID == "" {
+			continue
+		}
+		gameIndex[g.GameID] = len(l.Games)
+		l.Games = append(l.Games, LayoutGame{GameID: g.GameID, Team1: -1, Team2: -1, Prev1: -1, Prev2: -1})
+	}
+	for _, g := range games {
+		if g.GameID == "" {
+			continue
+		}
+		lg := &l.Games[gameIndex[g.GameID]]
+		if g.Team1 != nil && g.Team1.TeamID != "" {
+			lg.Team1 = teamIndex[g.Team1.TeamID]
+		}
+		if g.Team2 != nil && g.Team2.TeamID != "" {
+			lg.Team2 = teamIndex[g.Team2.TeamID]
+		}
+		// Games are in play order, so a feeder game always precedes the
+		// game it feeds.
+		if prev, ok := gameIndex[prevByNext[g.GameID][1]]; ok {
+			lg.Prev1 = prev
+		}
+		if prev, ok := gameIndex[prevByNext[g.GameID][2]]; ok {
+			lg.Prev2 = prev
+		}
+	}
+	return l, nil
+}
+
+// BytesPerSim is the size of one packed outcome.
+func (l *OutcomeLayout) BytesPerSim() int {
+	return (len(l.Games) + 7) / 8
+}
+
+// play simulates one tournament, setting a bit in outcome, which must be
+// zeroed, for each game the slot-1 team wins, and returns its importance
+// weight, 1 unless d tilts the draws. winners is scratch space of
+// len(Games). d.shifts, when not nil, holds each team's strength
+// perturbation in TeamIDs order. Without shifts or tilts the random draws
+// match what SimulateWithProvider has always made, so a seed yields the same
+// tournaments either way.
+func (l *OutcomeLayout) play(rng *rand.Rand, provider ProbabilityProvider, d drawer, outcome []byte, winners []int) float64 {
+	weight := 1.0
+	for i, g := range l.Games {
+		team1, team2 := l.slotTeams(g, winners)
+		if team1 < 0 || team2 < 0 {
+			winners[i] = -1
+			continue
+		}
+
+		p1 := l.prob(provider, g, team1, team2)
+		if d.shifts != nil {
+			p1 = perturbProb(p1, d.shifts[team1]-d.shifts[team2])
+		}
+		won, ratio := d.draw(rng, p1)
+		weight *= ratio
+		if won {
+			winners[i] = team1
+			outcome[i>>3] |= 1 << (i & 7)
+		} else {
+			winners[i] = team2
+		}
+	}
+	return weight
+}
+
+func (l *OutcomeLayout) prob(provider ProbabilityProvider, g LayoutGame, team1, team2 int) float64 {
+	if provider == nil {
+		return 0.5
+	}
+	return provider.Prob(g.GameID, l.TeamIDs[team1], l.TeamIDs[team2])
+}
+
+// replay walks a packed outcome, calling visit for each game played.
+// winners is scratch space of len(Games).
+func (l *OutcomeLayout) replay(outcome []byte, winners []int, visit func(winner, loser int)) {
+	for i, g := range l.Games {
+		team1, team2 := l.slotTeams(g, winners)
+		if team1 < 0 || team2 < 0 {
+			winners[i] = -1
+			continue
+		}
+		winner, loser := team2, team1
+		if outcome[i>>3]&(1<<(i&7)) != 0 {
+			winner, loser = team1, team2
+		}
+		winners[i] = winner
+		visit(winner, loser)
+	}
+}
+
+func (l *OutcomeLayout) slotTeams(g LayoutGame, winners []int) (int, int) {
+	team1, team2 := g.Team1, g.Team2
+	if team1 < 0 && g.Prev1 >= 0 {
+		team1 = winners[g.Prev1]
+	}
+	if team2 < 0 && g.Prev2 >= 0 {
+		team2 = winners[g.Prev2]
+	}
+	return team1, team2
+}
+
+// Decode replays a packed outcome into one result per team, in TeamIDs
+// order.
+func (l *OutcomeLayout) Decode(simID int, outcome []byte) []TeamSimulationResult {
+	out := make([]TeamSimulationResult, len(l.TeamIDs))
+	l.decodeInto(simID, outcome, make([]int, len(l.Games)), out)
+	return out
+}
+
+func (l *OutcomeLayout) decodeInto(simID int, outcome []byte, winners []int, out []TeamSimulationResult) {
+	for i, tid := range l.TeamIDs {
+		out[i] = TeamSimulationResult{SimID: simID, TeamID: tid, Byes: l.BaseByes[i], Seed: l.Seeds[i]}
+	}
+	l.replay(outcome, winners, func(winner, loser int) {
+		out[winner].Wins++
+		out[winner].BeatenSeeds = append(out[winner].BeatenSeeds, l.Seeds[loser])
+		out[loser].IsEliminated = true
+	})
+}
+
+// Progress returns each team's wins plus byes in a packed outcome, in
+// TeamIDs order. progress and winners are scratch space of len(TeamIDs) and
+// len(Games).
+func (l *OutcomeLayout) Progress(outcome []byte, progress []int, winners []int) {
+	copy(progress, l.BaseByes)
+	l.replay(outcome, winners, func(winner, _ int) {
+		progress[winner]++
+	})
+}
+
+// PackedOutcomes holds simulated tournaments at one bit per game: a
+// 67-game bracket takes 9 bytes per simulation instead of 68 rows. Weights
+// holds each simulation's weight under weighted sampling, and is nil when
+// every simulation counts once.
+type PackedOutcomes struct {
+	BytesPerSim int
+	NSims       int
+	Data        []byte
+	Weights     []float64
+}
+
+func NewPackedOutcomes(layout *OutcomeLayout, nSims int) *PackedOutcomes {
+	bytesPerSim := layout.BytesPerSim()
+	return &PackedOutcomes{BytesPerSim: bytesPerSim, NSims: nSims, Data: make([]byte, bytesPerSim*nSims)}
+}
+
+// Sim returns the packed outcome of one simulation.
+func (p *PackedOutcomes) Sim(simID int) []byte {
+	return p.Data[simID*p.BytesPerSim : (simID+1)*p.BytesPerSim]
+}
+
+// Weight returns the weight of one simulation.
+func (p *PackedOutcomes) Weight(simID int) float64 {
+	if p.Weights == nil {
+		return 1
+	}
+	return p.Weights[simID]
+}
+
+// Append adds other's simulations after p's.
+func (p *PackedOutcomes) Append(other *PackedOutcomes) error {
+	if other.BytesPerSim != p.BytesPerSim {
+		return fmt.Errorf("appending outcomes of %d bytes per sim to %d", other.BytesPerSim, p.BytesPerSim)
+	}
+	if p.Weights != nil || other.Weights != nil {
+		weights := make([]float64, 0, p.NSims+other.NSims)
+		for i := 0; i < p.NSims; i++ {
+			weights = append(weights, p.Weight(i))
+		}
+		for i := 0; i < other.NSims; i++ {
+			weights = append(weights, other.Weight(i))
+		}
+		p.Weights = weights
+	}
+	p.Data = append(p.Data, other.Data...)
+	p.NSims += other.NSims
+	return nil
+}
+
+// RoundReach counts, for each team, the simulations in which its run ended
+// at each progress level (wins plus byes). Counts[i][k] is for TeamIDs[i]
+// finishing at progress k, and Weights[i][k] is those simulations' total
+// weight, which equals the count unless sampling was weighted.
+type RoundReach struct {
+	TeamIDs     []string
+	Counts      [][]int
+	Weights     [][]float64
+	NSims       int
+	TotalWeight float64
+}
+
+func NewRoundReach(layout *OutcomeLayout) *RoundReach {
+	return &RoundReach{
+		TeamIDs: layout.TeamIDs,
+		Counts:  make([][]int, len(layout.TeamIDs)),
+		Weights: make([][]float64, len(layout.TeamIDs)),
+	}
+}
+
+func (r *RoundReach) grow(i, n int) {
+	for len(r.Counts[i]) < n {
+		r.Counts[i] = append(r.Counts[i], 0)
+		r.Weights[i] = append(r.Weights[i], 0)
+	}
+}
+
+func (r *RoundReach) add(progress []int, weight float64) {
+	for i, p := range progress {
+		r.grow(i, p+1)
+		r.Counts[i][p]++
+		r.Weights[i][p] += weight
+	}
+	r.NSims++
+	r.TotalWeight += weight
+}
+
+// Merge adds other's counts into r. Both must be for the same layout.
+func (r *RoundReach) Merge(other *RoundReach) {
+	for i, counts := range other.Counts {
+		r.grow(i, len(counts))
+		for k, n := range counts {
+			r.Counts[i][k] += n
+			r.Weights[i][k] += other.Weights[i][k]
+		}
+	}
+	r.NSims += other.NSims
+	r.TotalWeight += other.TotalWeight
+}
+
+// ProbReach returns the weighted share of simulations in which the team at
+// index i reached at least the given progress.
+func (r *RoundReach) ProbReach(i, progress int) float64 {
+	if r.TotalWeight == 0 {
+		return 0
+	}
+	w := 0.0
+	for k := progress; k < len(r.Weights[i]); k++ {
+		if k >= 0 {
+			w += r.Weights[i][k]
+		}
+	}
+	return w / r.TotalWeight
+}
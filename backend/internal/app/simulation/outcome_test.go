@@ -0,0 +1,90 @@
+package simulation
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestThatAggregatedOutcomesDecodeToTheSameResultsAsRows(t *testing.T) {
+	// GIVEN a bracket with explicit probabilities
+	b := toyBracket()
+	provider := mapProbabilityProvider{probs: toyProbs()}
+	rows, err := SimulateWithProvider(b, provider, 50, 42, Options{Workers: 1})
+	if err != nil {
+		t.Fatalf("row simulation failed: %v", err)
+	}
+
+	// WHEN simulating with the same seed in aggregating mode and decoding
+	agg, err := SimulateAggregated(b, provider, 50, 42, Options{Workers: 4})
+	if err != nil {
+		t.Fatalf("aggregated simulation failed: %v", err)
+	}
+	var decoded []TeamSimulationResult
+	for simID := 0; simID < agg.Outcomes.NSims; simID++ {
+		decoded = append(decoded, agg.Layout.Decode(simID, agg.Outcomes.Sim(simID))...)
+	}
+
+	// THEN the decoded results match the rows
+	if !reflect.DeepEqual(rows, decoded) {
+		t.Errorf("decoded outcomes differ from simulated rows")
+	}
+}
+
+func TestThatPackedOutcomesTakeOneBitPerGame(t *testing.T) {
+	// GIVEN a three-game bracket
+	layout, err := NewOutcomeLayout(toyBracket())
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// WHEN packing 10 simulations
+	got := NewPackedOutcomes(layout, 10)
+
+	// THEN they take one byte each
+	if len(got.Data) != 10 {
+		t.Errorf("expected 10 bytes, got %d", len(got.Data))
+	}
+}
+
+func TestThatRoundReachCountsEverySimulationForEachTeam(t *testing.T) {
+	// GIVEN a bracket with explicit probabilities
+	b := toyBracket()
+
+	// WHEN simulating in aggregating mode across workers
+	agg, err := SimulateAggregated(b, mapProbabilityProvider{probs: toyProbs()}, 200, 7, Options{Workers: 3})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN every team's histogram sums to the number of simulations
+	for i, counts := range agg.RoundReach.Counts {
+		total := 0
+		for _, n := range counts {
+			total += n
+		}
+		if total != 200 {
+			t.Errorf("team %s: expected 200 simulations, got %d", agg.RoundReach.TeamIDs[i], total)
+		}
+	}
+}
+
+func TestThatExactlyOneTeamWinsEachAggregatedSimulation(t *testing.T) {
+	// GIVEN a bracket with explicit probabilities
+	b := toyBracket()
+
+	// WHEN simulating in aggregating mode
+	agg, err := SimulateAggregated(b, mapProbabilityProvider{probs: toyProbs()}, 200, 7, Options{Workers: 2})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the probabilities of winning both games after the first-round bye
+	// sum to one
+	sum := 0.0
+	for i := range agg.RoundReach.TeamIDs {
+		sum += agg.RoundReach.ProbReach(i, 3)
+	}
+	if sum < 0.999999 || sum > 1.000001 {
+		t.Errorf("expected champion probabilities to sum to 1, got %f", sum)
+	}
+}
@@ -316,3 +316,35 @@ func TestThatSimulationPruneOnlyAffectsSpecifiedTournament(t *testing.T) {
 		t.Errorf("expected 3 simulation batches for tournament B, got %d", count)
 	}
 }
+
+func TestThatSimulationPruneCascadesDeleteToPackedOutcomes(t *testing.T) {
+	ctx := context.Background()
+	t.Cleanup(func() { testutil.TruncateAll(ctx, pool) })
+
+	// GIVEN a tournament with 2 batches, oldest has a packed outcome chunk
+	tid := seedTournament(t, ctx)
+	snapID := insertSnapshot(t, ctx, tid)
+	base := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	oldBatchID := insertSimBatch(t, ctx, tid, snapID, base)
+	svc := New(pool)
+	chunk := &PackedOutcomes{BytesPerSim: 1, NSims: 2, Data: []byte{0x05, 0x02}}
+	if err := svc.insertSimulatedOutcomeChunk(ctx, oldBatchID, 0, chunk); err != nil {
+		t.Fatalf("inserting outcome chunk: %v", err)
+	}
+	insertSimBatch(t, ctx, tid, snapID, base.Add(1*time.Hour))
+
+	// WHEN pruning with keepN=1
+	svc.pruneOldBatches(ctx, tid, 1)
+
+	// THEN the old batch's outcome chunk is also deleted
+	var count int
+	if err := pool.QueryRow(ctx, `
+		SELECT COUNT(*) FROM compute.simulated_outcome_chunks
+		WHERE simulated_tournament_id = $1::uuid
+	`, oldBatchID).Scan(&count); err != nil {
+		t.Fatalf("counting outcome chunks: %v", err)
+	}
+	if count != 0 {
+		t.Errorf("expected 0 outcome chunks for pruned batch, got %d", count)
+	}
+}
@@ -0,0 +1,111 @@
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/mathutil"
+)
+
+// Sampling methods that reduce the variance of estimates from a run.
+const (
+	// SamplingAntithetic pairs each simulation with its mirror image: every
+	// random draw u becomes 1-u, so an upset in one is a favorite's win in
+	// the other. Consecutive simulations 2k and 2k+1 form a pair.
+	SamplingAntithetic = "antithetic"
+	// SamplingStratifiedChampion splits the run among every possible
+	// champion in proportion to its exact title odds, then plays each
+	// simulation conditioned on its champion and weights it so the strata
+	// add up to the true distribution. It needs independent games.
+	SamplingStratifiedChampion = "stratified_champion"
+	// SamplingImportanceUpsets plays every underdog UpsetTilt log-odds
+	// stronger than it is and weights each simulation by how much likelier
+	// it was under the true probabilities, so upset-heavy brackets, where
+	// tail outcomes live, are sampled more often without biasing estimates.
+	SamplingImportanceUpsets = "importance_upsets"
+)
+
+// Sampling selects a variance-reduction method. Nil plays every simulation
+// as an independent, equally weighted draw.
+type Sampling struct {
+	Method string `json:"method"`
+	// UpsetTilt is the log-odds boost importance sampling gives underdogs.
+	UpsetTilt float64 `json:"upsetTilt,omitempty"`
+}
+
+func (s *Sampling) Validate() error {
+	switch s.Method {
+	case SamplingAntithetic, SamplingStratifiedChampion:
+		return nil
+	case SamplingImportanceUpsets:
+		if s.UpsetTilt <= 0 || math.IsNaN(s.UpsetTilt) || math.IsInf(s.UpsetTilt, 0) {
+			return errors.New("importance sampling upset tilt must be positive")
+		}
+		return nil
+	default:
+		return fmt.Errorf("sampling method must be %q, %q or %q", SamplingAntithetic, SamplingStratifiedChampion, SamplingImportanceUpsets)
+	}
+}
+
+// Weighted reports whether simulations carry unequal weights.
+func (s *Sampling) Weighted() bool {
+	return s != nil && (s.Method == SamplingStratifiedChampion || s.Method == SamplingImportanceUpsets)
+}
+
+// BlockSize is how many consecutive simulations form one independent
+// sample: 2 for antithetic pairs, otherwise 1.
+func (s *Sampling) BlockSize() int {
+	if s != nil && s.Method == SamplingAntithetic {
+		return 2
+	}
+	return 1
+}
+
+// Stratified reports whether simulations are stratified by champion.
+func (s *Sampling) Stratified() bool {
+	return s != nil && s.Method == SamplingStratifiedChampion
+}
+
+func validateOptions(opts Options) error {
+	if opts.Sampling == nil {
+		return nil
+	}
+	if err := opts.Sampling.Validate(); err != nil {
+		return err
+	}
+	if opts.Sampling.Stratified() && opts.StrengthUncertainty != nil {
+		return errors.New("stratified sampling needs independent games and cannot be combined with strength uncertainty")
+	}
+	return nil
+}
+
+// drawer turns a game's probability into an outcome for play.
+type drawer struct {
+	shifts     []float64
+	antithetic bool
+	upsetTilt  float64
+}
+
+// draw reports whether slot 1 wins a game it wins with probability p1, and
+// the likelihood ratio of that outcome under p1 versus the probability it
+// was drawn with.
+func (d drawer) draw(rng *rand.Rand, p1 float64) (bool, float64) {
+	u := rng.Float64()
+	if d.antithetic {
+		u = 1 - u
+	}
+	if d.upsetTilt == 0 || p1 <= 0 || p1 >= 1 || p1 == 0.5 {
+		return u < p1, 1
+	}
+
+	q1 := mathutil.Sigmoid(math.Log(p1/(1-p1)) - d.upsetTilt)
+	if p1 < 0.5 {
+		q1 = mathutil.Sigmoid(math.Log(p1/(1-p1)) + d.upsetTilt)
+	}
+	if u < q1 {
+		return true, p1 / q1
+	}
+	return false, (1 - p1) / (1 - q1)
+}
@@ -0,0 +1,85 @@
+package simulation
+
+import (
+	"math"
+	"testing"
+)
+
+func TestThatAntitheticPairsMirrorCoinFlips(t *testing.T) {
+	// GIVEN a bracket of coin-flip games
+	b := toyBracket()
+
+	// WHEN simulating with antithetic pairs
+	agg, err := SimulateAggregated(b, nil, 100, 42, Options{Workers: 3, Sampling: &Sampling{Method: SamplingAntithetic}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN every game goes the other way in each simulation's mirror
+	for simID := 0; simID < 100; simID += 2 {
+		if agg.Outcomes.Sim(simID)[0]^agg.Outcomes.Sim(simID + 1)[0] != 0b111 {
+			t.Fatalf("simulation %d is not mirrored by %d", simID, simID+1)
+		}
+	}
+}
+
+func TestThatStratifiedSamplingMatchesTitleOddsExactly(t *testing.T) {
+	// GIVEN a bracket in which t1 wins the title 30% of the time
+	b := toyBracket()
+
+	// WHEN simulating stratified by champion
+	agg, err := SimulateAggregated(b, mapProbabilityProvider{probs: toyProbs()}, 100, 42, Options{Workers: 2, Sampling: &Sampling{Method: SamplingStratifiedChampion}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the weighted title odds carry no sampling error
+	if got := agg.RoundReach.ProbReach(0, 3); math.Abs(got-0.3) > 1e-9 {
+		t.Errorf("expected 0.3, got %f", got)
+	}
+}
+
+func TestThatImportanceSamplingReweightsToTheTrueOdds(t *testing.T) {
+	// GIVEN a bracket in which t4 wins the title 15% of the time
+	b := toyBracket()
+
+	// WHEN simulating with upsets made likelier
+	agg, err := SimulateAggregated(b, mapProbabilityProvider{probs: toyProbs()}, 20000, 42, Options{Workers: 2, Sampling: &Sampling{Method: SamplingImportanceUpsets, UpsetTilt: 1}})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the weighted title odds are close to the true odds
+	if got := agg.RoundReach.ProbReach(3, 3); math.Abs(got-0.15) > 0.02 {
+		t.Errorf("expected about 0.15, got %f", got)
+	}
+}
+
+func TestThatStratifiedSamplingRejectsStrengthUncertainty(t *testing.T) {
+	// GIVEN stratified sampling with correlated games
+	opts := Options{
+		Sampling:            &Sampling{Method: SamplingStratifiedChampion},
+		StrengthUncertainty: &StrengthUncertainty{Distribution: StrengthDistributionNormal, Scale: 1},
+	}
+
+	// WHEN validating the options
+	err := validateOptions(opts)
+
+	// THEN they are rejected
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestThatRowSimulationRejectsWeightedSampling(t *testing.T) {
+	// GIVEN importance sampling, whose weights rows cannot hold
+	opts := Options{Workers: 1, Sampling: &Sampling{Method: SamplingImportanceUpsets, UpsetTilt: 1}}
+
+	// WHEN simulating rows
+	_, err := Simulate(toyBracket(), toyProbs(), 10, 42, opts)
+
+	// THEN the run is rejected
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
@@ -19,6 +19,7 @@ import (
 type TournamentResolver interface {
 	ResolveCoreTournamentID(ctx context.Context, season int) (string, error)
 	LoadFinalFourConfig(ctx context.Context, coreTournamentID string) (*models.FinalFourConfig, error)
+	LoadBracketTemplate(ctx context.Context, coreTournamentID string) (*models.BracketTemplate, error)
 }
 
 type Service struct {
@@ -40,6 +41,16 @@ func WithTournamentResolver(r TournamentResolver) Option {
 	return func(s *Service) { s.tournamentResolver = r }
 }
 
+// Storage modes for a simulation batch.
+const (
+	// StorageRows writes one compute.simulated_teams row per team per
+	// simulation.
+	StorageRows = "rows"
+	// StoragePacked writes each simulation as one bit per game, plus the
+	// per-team round-reach histograms folded while simulating.
+	StoragePacked = "packed"
+)
+
 type RunParams struct {
 	Season               int
 	NSims                int
@@ -49,6 +60,14 @@ type RunParams struct {
 	ProbabilitySourceKey string
 	StartingStateKey     string
 	GameOutcomeSpec      *winprob.Model
+	// Storage is StorageRows or StoragePacked; empty means StorageRows.
+	Storage string
+	// StrengthUncertainty, when set, correlates each team's games within a
+	// simulation; see Options.
+	StrengthUncertainty *StrengthUncertainty
+	// Sampling, when set, selects a variance-reduction method; see Options.
+	// Weighted methods need StoragePacked, which keeps the weights.
+	Sampling *Sampling
 }
 
 type RunResult struct {
@@ -56,6 +75,7 @@ type RunResult struct {
 	TournamentStateSnapshotID   string
 	TournamentSimulationBatchID string
 	NSims                       int
+	Storage                     string
 	RowsWritten                 int64
 	LoadDuration                time.Duration
 	SimulateWriteDuration       time.Duration
@@ -85,7 +105,12 @@ func (s *Service) Run(ctx context.Context, p RunParams) (*RunResult, error) {
 
 	// Phase 3: Run simulation batches and write results.
 	simStart := time.Now()
-	rowsWritten, err := s.runSimulationBatches(ctx, setup.bracket, setup.provider, setup.probs, batchID, setup.coreTournamentID, p)
+	var rowsWritten int64
+	if p.Storage == StoragePacked {
+		rowsWritten, err = s.runPackedSimulationBatches(ctx, setup.bracket, setup.provider, setup.probs, batchID, setup.coreTournamentID, p)
+	} else {
+		rowsWritten, err = s.runSimulationBatches(ctx, setup.bracket, setup.provider, setup.probs, batchID, setup.coreTournamentID, p)
+	}
 	if err != nil {
 		return nil, fmt.Errorf("running simulation batches: %w", err)
 	}
@@ -99,6 +124,7 @@ func (s *Service) Run(ctx context.Context, p RunParams) (*RunResult, error) {
 		TournamentStateSnapshotID:   snapshotID,
 		TournamentSimulationBatchID: batchID,
 		NSims:                       p.NSims,
+		Storage:                     p.Storage,
 		RowsWritten:                 rowsWritten,
 		LoadDuration:                loadDur,
 		SimulateWriteDuration:       simDur,
@@ -107,7 +133,8 @@ func (s *Service) Run(ctx context.Context, p RunParams) (*RunResult, error) {
 }
 
 // pruneOldBatches deletes simulation batches older than the latest keepN
-// for a tournament. CASCADE on the FK auto-deletes simulated_teams.
+// for a tournament. CASCADE on the FK auto-deletes simulated_teams and the
+// packed outcome chunks and round-reach rows.
 // After pruning batches, orphaned snapshots are also cleaned up.
 // Best-effort: logs and continues on error.
 func (s *Service) pruneOldBatches(ctx context.Context, coreTournamentID string, keepN int) {
@@ -157,6 +184,10 @@ func (s *Service) pruneOrphanedSnapshots(ctx context.Context, coreTournamentID s
 	}
 }
 
+func (p RunParams) simulationOptions() Options {
+	return Options{Workers: p.Workers, StrengthUncertainty: p.StrengthUncertainty, Sampling: p.Sampling}
+}
+
 // validateAndDefaultParams validates required fields and fills in defaults for
 // optional fields. It modifies p in place.
 func validateAndDefaultParams(p *RunParams) error {
@@ -187,6 +218,23 @@ func validateAndDefaultParams(p *RunParams) error {
 	if p.StartingStateKey != "current" && p.StartingStateKey != "post_first_four" {
 		return errors.New("StartingStateKey must be 'current' or 'post_first_four'")
 	}
+	if p.Storage == "" {
+		p.Storage = StorageRows
+	}
+	if p.Storage != StorageRows && p.Storage != StoragePacked {
+		return errors.New("Storage must be 'rows' or 'packed'")
+	}
+	if p.StrengthUncertainty != nil {
+		if err := p.StrengthUncertainty.Validate(); err != nil {
+			return err
+		}
+	}
+	if err := validateOptions(p.simulationOptions()); err != nil {
+		return err
+	}
+	if p.Sampling.Weighted() && p.Storage != StoragePacked {
+		return errors.New("weighted sampling needs Storage 'packed'")
+	}
 	return nil
 }
 
@@ -218,12 +266,17 @@ func (s *Service) loadBracketAndProbabilities(ctx context.Context, p RunParams)
 		return nil, fmt.Errorf("loading teams: %w", err)
 	}
 
-	br, err := appbracket.BuildBracketStructure(coreTournamentID, teams, ff)
+	tmpl, err := s.tournamentResolver.LoadBracketTemplate(ctx, coreTournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("loading bracket template: %w", err)
+	}
+
+	br, err := appbracket.BuildBracket(coreTournamentID, teams, tmpl, ff)
 	if err != nil {
 		return nil, fmt.Errorf("failed to build bracket: %w", err)
 	}
 
-	provider, probs, err := s.resolveProbabilities(ctx, coreTournamentID, br, p)
+	provider, probs, err := s.resolveProbabilities(ctx, coreTournamentID, br, appbracket.ResolveTemplate(tmpl, ff), p)
 	if err != nil {
 		return nil, fmt.Errorf("resolving probabilities: %w", err)
 	}
@@ -237,52 +290,80 @@ func (s *Service) loadBracketAndProbabilities(ctx context.Context, p RunParams)
 	}, nil
 }
 
-// resolveProbabilities builds a KenPom-based provider using the explicitly
-// provided spec, or falls back to the latest prediction batch's spec.
+// resolveProbabilities builds a model-based provider using the explicitly
+// provided spec, or falls back to the latest prediction batch's spec. A matchup
+// probability table uploaded under the run's ProbabilitySourceKey takes
+// precedence over the model for the pairs it covers.
 func (s *Service) resolveProbabilities(
 	ctx context.Context,
 	coreTournamentID string,
 	br *models.BracketStructure,
+	tmpl *models.BracketTemplate,
 	p RunParams,
 ) (ProbabilityProvider, map[MatchupKey]float64, error) {
 	if p.GameOutcomeSpec != nil {
-		return s.resolveKenPomProbabilities(ctx, coreTournamentID, br, p)
+		return s.resolveKenPomProbabilities(ctx, coreTournamentID, br, tmpl, p)
 	}
 	spec, err := s.loadGameOutcomeSpecFromPredictionBatch(ctx, coreTournamentID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("resolving probability model from predictions: %w", err)
 	}
 	p.GameOutcomeSpec = spec
-	return s.resolveKenPomProbabilities(ctx, coreTournamentID, br, p)
+	return s.resolveKenPomProbabilities(ctx, coreTournamentID, br, tmpl, p)
 }
 
 func (s *Service) resolveKenPomProbabilities(
 	ctx context.Context,
 	coreTournamentID string,
 	br *models.BracketStructure,
+	tmpl *models.BracketTemplate,
 	p RunParams,
 ) (ProbabilityProvider, map[MatchupKey]float64, error) {
 	p.GameOutcomeSpec.Normalize()
 	if err := p.GameOutcomeSpec.Validate(); err != nil {
 		return nil, nil, fmt.Errorf("validating game outcome spec: %w", err)
 	}
-	netByTeamID, err := s.loadKenPomNetByTeamID(ctx, coreTournamentID)
+	teams, netByTeamID, err := s.loadTeamRatings(ctx, coreTournamentID)
 	if err != nil {
-		return nil, nil, fmt.Errorf("loading kenpom ratings: %w", err)
+		return nil, nil, fmt.Errorf("loading team ratings: %w", err)
 	}
-	if len(netByTeamID) == 0 {
+	table, err := s.loadMatchupProbabilityTable(ctx, coreTournamentID, p.ProbabilitySourceKey)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading matchup probability table: %w", err)
+	}
+	if len(netByTeamID) == 0 && table == nil && p.GameOutcomeSpec.UsesNet() {
 		return nil, nil, errors.New("no kenpom ratings available for tournament")
 	}
+	results, err := s.loadGameResults(ctx, coreTournamentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading game results: %w", err)
+	}
+	history := appbracket.HistoryThroughRound(results, tmpl, historyRound(p.StartingStateKey))
+	sites, err := s.loadGameSites(ctx, coreTournamentID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("loading game sites: %w", err)
+	}
+	spec := p.GameOutcomeSpec.WithHistory(teams, history)
 	overrides := make(map[MatchupKey]float64)
 	if p.StartingStateKey == "post_first_four" {
 		if err := s.lockInFirstFourResults(ctx, br, overrides); err != nil {
 			return nil, nil, fmt.Errorf("locking in first four results: %w", err)
 		}
 	}
-	provider := KenPomProvider{Spec: p.GameOutcomeSpec, NetByTeamID: netByTeamID, Overrides: overrides}
+	provider := KenPomProvider{Spec: spec, NetByTeamID: netByTeamID, Teams: teams, Sites: sites, Table: table, Overrides: overrides}
 	return provider, nil, nil
 }
 
+// historyRound returns the last round whose results the starting state keeps:
+// a post_first_four run replays the tournament from the Round of 64, so the
+// history-aware models must not see anything decided after the First Four.
+func historyRound(startingStateKey string) int {
+	if startingStateKey == "post_first_four" {
+		return models.RoundFirstFour.Order()
+	}
+	return models.MaxRounds
+}
+
 // createSnapshotAndBatch persists the tournament state snapshot and creates the
 // simulation batch record.
 func (s *Service) createSnapshotAndBatch(
@@ -303,7 +384,15 @@ func (s *Service) createSnapshotAndBatch(
 		return "", "", fmt.Errorf("creating tournament state snapshot: %w", err)
 	}
 
-	batchID, err := s.createTournamentSimulationBatch(ctx, coreTournamentID, snapshotID, p.NSims, p.Seed, p.ProbabilitySourceKey)
+	var layout *OutcomeLayout
+	if p.Storage == StoragePacked {
+		layout, err = NewOutcomeLayout(br)
+		if err != nil {
+			return "", "", fmt.Errorf("compiling outcome layout: %w", err)
+		}
+	}
+
+	batchID, err := s.createTournamentSimulationBatch(ctx, coreTournamentID, snapshotID, p.NSims, p.Seed, p.ProbabilitySourceKey, layout, p.StrengthUncertainty, p.Sampling)
 	if err != nil {
 		return "", "", fmt.Errorf("creating simulation batch: %w", err)
 	}
@@ -334,9 +423,9 @@ func (s *Service) runSimulationBatches(
 		var results []TeamSimulationResult
 		var err error
 		if provider != nil {
-			results, err = SimulateWithProvider(br, provider, n, batchSeed, Options{Workers: p.Workers})
+			results, err = SimulateWithProvider(br, provider, n, batchSeed, p.simulationOptions())
 		} else {
-			results, err = Simulate(br, probs, n, batchSeed, Options{Workers: p.Workers})
+			results, err = Simulate(br, probs, n, batchSeed, p.simulationOptions())
 		}
 		if err != nil {
 			return 0, fmt.Errorf("simulating batch at offset %d: %w", offset, err)
@@ -351,3 +440,53 @@ func (s *Service) runSimulationBatches(
 
 	return rowsWritten, nil
 }
+
+// runPackedSimulationBatches runs simulations in chunks of BatchSize like
+// runSimulationBatches, with the same seeds, but writes each chunk as one
+// packed row and folds every team's round reach as it goes, writing those
+// histograms once at the end. It returns the total number of rows written.
+func (s *Service) runPackedSimulationBatches(
+	ctx context.Context,
+	br *models.BracketStructure,
+	provider ProbabilityProvider,
+	probs map[MatchupKey]float64,
+	batchID string,
+	coreTournamentID string,
+	p RunParams,
+) (int64, error) {
+	if provider == nil {
+		provider = mapProbabilityProvider{probs: probs}
+	}
+
+	rowsWritten := int64(0)
+	var reach *RoundReach
+	for offset := 0; offset < p.NSims; offset += p.BatchSize {
+		n := p.BatchSize
+		if offset+n > p.NSims {
+			n = p.NSims - offset
+		}
+
+		batchSeed := int64(p.Seed) + int64(offset)*1_000_003
+		agg, err := SimulateAggregated(br, provider, n, batchSeed, p.simulationOptions())
+		if err != nil {
+			return 0, fmt.Errorf("simulating batch at offset %d: %w", offset, err)
+		}
+
+		if err := s.insertSimulatedOutcomeChunk(ctx, batchID, offset, agg.Outcomes); err != nil {
+			return 0, fmt.Errorf("inserting simulated outcomes at offset %d: %w", offset, err)
+		}
+		rowsWritten++
+
+		if reach == nil {
+			reach = agg.RoundReach
+		} else {
+			reach.Merge(agg.RoundReach)
+		}
+	}
+
+	inserted, err := s.copyInsertRoundReach(ctx, batchID, coreTournamentID, reach)
+	if err != nil {
+		return 0, fmt.Errorf("inserting round reach: %w", err)
+	}
+	return rowsWritten + inserted, nil
+}
@@ -11,12 +11,21 @@ import (
 	"github.com/jackc/pgx/v5"
 )
 
-// KenPomProvider implements ProbabilityProvider using KenPom net ratings
-// and a logistic model to estimate win probabilities.
+// KenPomProvider implements ProbabilityProvider using a registered
+// win-probability model over per-team ratings.
 type KenPomProvider struct {
 	Spec        *winprob.Model
 	NetByTeamID map[string]float64
-	Overrides   map[MatchupKey]float64
+	// Teams carries the full per-team model inputs (seed, ratings, season
+	// record, campus). When nil, only NetByTeamID is consulted.
+	Teams map[string]winprob.Team
+	// Sites locates games by bracket game ID for venue-aware models; games
+	// without a site are neutral.
+	Sites map[string]*winprob.Location
+	// Table supplies uploaded pairwise probabilities; pairs it covers take
+	// precedence over Spec.
+	Table     *models.MatchupProbabilityTable
+	Overrides map[MatchupKey]float64
 }
 
 // NewKenPomProvider creates a KenPomProvider from a spec, net ratings by team
@@ -35,9 +44,25 @@ func (p KenPomProvider) Prob(gameID string, team1ID string, team2ID string) floa
 			return v
 		}
 	}
+	if v, ok := p.Table.Lookup(team1ID, team2ID); ok {
+		return v
+	}
 	if p.Spec == nil {
 		return 0.5
 	}
+	// Teams holds unrated teams too, with a zero Net that would price them
+	// as average; a model reading Net cannot price them at all.
+	if p.Spec.UsesNet() && (!p.rated(team1ID) || !p.rated(team2ID)) {
+		return 0.5
+	}
+	if p.Teams != nil {
+		t1, ok1 := p.Teams[team1ID]
+		t2, ok2 := p.Teams[team2ID]
+		if !ok1 || !ok2 {
+			return 0.5
+		}
+		return p.Spec.ProbAtSite(t1, t2, p.Sites[gameID])
+	}
 	n1, ok1 := p.NetByTeamID[team1ID]
 	n2, ok2 := p.NetByTeamID[team2ID]
 	if !ok1 || !ok2 {
@@ -46,34 +71,161 @@ func (p KenPomProvider) Prob(gameID string, team1ID string, team2ID string) floa
 	return p.Spec.WinProb(n1, n2)
 }
 
-func (s *Service) loadKenPomNetByTeamID(ctx context.Context, coreTournamentID string) (map[string]float64, error) {
+func (p KenPomProvider) rated(teamID string) bool {
+	_, ok := p.NetByTeamID[teamID]
+	return ok
+}
+
+// loadTeamRatings returns the model inputs for every team in the tournament,
+// along with the net ratings of the teams that have one.
+func (s *Service) loadTeamRatings(ctx context.Context, coreTournamentID string) (map[string]winprob.Team, map[string]float64, error) {
 	rows, err := s.pool.Query(ctx, `
-		SELECT t.id, ks.net_rtg
+		SELECT t.id, t.seed, ks.net_rtg, ks.season_wins, ks.season_losses,
+			ks.o_rtg, ks.d_rtg, ks.adj_t, s.latitude, s.longitude
 		FROM core.teams t
 		LEFT JOIN core.team_kenpom_stats ks
 			ON ks.team_id = t.id
 			AND ks.deleted_at IS NULL
+		LEFT JOIN core.schools s
+			ON s.id = t.school_id
+			AND s.deleted_at IS NULL
 		WHERE t.tournament_id = $1::uuid
 			AND t.deleted_at IS NULL
 	`, coreTournamentID)
 	if err != nil {
-		return nil, fmt.Errorf("querying kenpom ratings: %w", err)
+		return nil, nil, fmt.Errorf("querying team ratings: %w", err)
 	}
 	defer rows.Close()
 
-	out := make(map[string]float64)
+	teams := make(map[string]winprob.Team)
+	netByTeamID := make(map[string]float64)
 	for rows.Next() {
 		var teamID string
+		var seed *int
 		var net *float64
-		if err := rows.Scan(&teamID, &net); err != nil {
-			return nil, fmt.Errorf("scanning kenpom rating: %w", err)
+		var wins, losses *int
+		var oRtg, dRtg, adjT, lat, lon *float64
+		if err := rows.Scan(&teamID, &seed, &net, &wins, &losses, &oRtg, &dRtg, &adjT, &lat, &lon); err != nil {
+			return nil, nil, fmt.Errorf("scanning team rating: %w", err)
+		}
+		team := winprob.Team{ID: teamID}
+		if seed != nil {
+			team.Seed = *seed
 		}
 		if net != nil {
-			out[teamID] = *net
+			team.Net = *net
+			netByTeamID[teamID] = *net
+		}
+		if wins != nil && losses != nil && *wins+*losses > 0 {
+			team.WinPct = float64(*wins) / float64(*wins+*losses)
+		}
+		if oRtg != nil {
+			team.ORtg = *oRtg
+		}
+		if dRtg != nil {
+			team.DRtg = *dRtg
+		}
+		if adjT != nil {
+			team.AdjT = *adjT
+		}
+		if lat != nil && lon != nil {
+			team.Home = &winprob.Location{Latitude: *lat, Longitude: *lon}
+		}
+		teams[teamID] = team
+	}
+	if rows.Err() != nil {
+		return nil, nil, fmt.Errorf("iterating team ratings: %w", rows.Err())
+	}
+	return teams, netByTeamID, nil
+}
+
+// loadMatchupProbabilityTable returns the probabilities uploaded under
+// sourceKey, or nil when there are none.
+func (s *Service) loadMatchupProbabilityTable(ctx context.Context, coreTournamentID, sourceKey string) (*models.MatchupProbabilityTable, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT mp.team1_id::text, mp.team2_id::text, mp.p_team1_wins
+		FROM core.matchup_probability_tables mpt
+		JOIN core.matchup_probabilities mp
+			ON mp.table_id = mpt.id
+		WHERE mpt.tournament_id = $1::uuid
+			AND mpt.source_key = $2
+			AND mpt.deleted_at IS NULL
+	`, coreTournamentID, sourceKey)
+	if err != nil {
+		return nil, fmt.Errorf("querying matchup probabilities: %w", err)
+	}
+	defer rows.Close()
+
+	probs := make(map[models.TeamPair]float64)
+	for rows.Next() {
+		var pair models.TeamPair
+		var p float64
+		if err := rows.Scan(&pair.Team1ID, &pair.Team2ID, &p); err != nil {
+			return nil, fmt.Errorf("scanning matchup probability: %w", err)
+		}
+		probs[pair] = p
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("iterating matchup probabilities: %w", rows.Err())
+	}
+	if len(probs) == 0 {
+		return nil, nil
+	}
+	return &models.MatchupProbabilityTable{TournamentID: coreTournamentID, SourceKey: sourceKey, Probabilities: probs}, nil
+}
+
+// loadGameSites returns game site locations keyed by bracket game ID.
+func (s *Service) loadGameSites(ctx context.Context, coreTournamentID string) (map[string]*winprob.Location, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT game_id, latitude, longitude
+		FROM core.game_sites
+		WHERE tournament_id = $1::uuid
+	`, coreTournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("querying game sites: %w", err)
+	}
+	defer rows.Close()
+
+	out := make(map[string]*winprob.Location)
+	for rows.Next() {
+		var gameID string
+		var loc winprob.Location
+		if err := rows.Scan(&gameID, &loc.Latitude, &loc.Longitude); err != nil {
+			return nil, fmt.Errorf("scanning game site: %w", err)
+		}
+		out[gameID] = &loc
+	}
+	if rows.Err() != nil {
+		return nil, fmt.Errorf("iterating game sites: %w", rows.Err())
+	}
+	return out, nil
+}
+
+// loadGameResults returns the tournament's recorded game results in the order
+// they were decided.
+func (s *Service) loadGameResults(ctx context.Context, coreTournamentID string) ([]*models.GameResult, error) {
+	rows, err := s.pool.Query(ctx, `
+		SELECT game_id, winner_team_id::text, loser_team_id::text
+		FROM core.game_results
+		WHERE tournament_id = $1::uuid
+			AND deleted_at IS NULL
+		ORDER BY decided_at ASC, created_at ASC
+	`, coreTournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("querying game results: %w", err)
+	}
+	defer rows.Close()
+
+	var out []*models.GameResult
+	for rows.Next() {
+		r := &models.GameResult{TournamentID: coreTournamentID}
+		if err := rows.Scan(&r.GameID, &r.WinnerTeamID, &r.LoserTeamID); err != nil {
+			return nil, fmt.Errorf("scanning game result: %w", err)
 		}
+		out = append(out, r)
 	}
 	if rows.Err() != nil {
-		return nil, fmt.Errorf("iterating kenpom ratings: %w", rows.Err())
+		return nil, fmt.Errorf("iterating game results: %w", rows.Err())
 	}
 	return out, nil
 }
@@ -127,8 +279,8 @@ func (s *Service) loadTeams(ctx context.Context, coreTournamentID string) ([]*mo
 	if rows.Err() != nil {
 		return nil, fmt.Errorf("iterating teams: %w", rows.Err())
 	}
-	if len(out) != 68 {
-		return nil, fmt.Errorf("expected 68 teams, got %d", len(out))
+	if len(out) == 0 {
+		return nil, fmt.Errorf("tournament %s has no teams", coreTournamentID)
 	}
 	return out, nil
 }
@@ -2,6 +2,7 @@ package simulation
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 
@@ -116,7 +117,34 @@ func (s *Service) createTournamentSimulationBatch(
 	nSims int,
 	seed int,
 	probabilitySourceKey string,
+	layout *OutcomeLayout,
+	strength *StrengthUncertainty,
+	sampling *Sampling,
 ) (string, error) {
+	storage := StorageRows
+	var layoutJSON []byte
+	if layout != nil {
+		storage = StoragePacked
+		var err error
+		if layoutJSON, err = json.Marshal(layout); err != nil {
+			return "", fmt.Errorf("marshaling outcome layout: %w", err)
+		}
+	}
+	var strengthJSON []byte
+	if strength != nil {
+		var err error
+		if strengthJSON, err = json.Marshal(strength); err != nil {
+			return "", fmt.Errorf("marshaling strength uncertainty: %w", err)
+		}
+	}
+	var samplingJSON []byte
+	if sampling != nil {
+		var err error
+		if samplingJSON, err = json.Marshal(sampling); err != nil {
+			return "", fmt.Errorf("marshaling sampling: %w", err)
+		}
+	}
+
 	var batchID string
 	if err := s.pool.QueryRow(ctx, `
 		INSERT INTO compute.simulated_tournaments (
@@ -124,11 +152,15 @@ func (s *Service) createTournamentSimulationBatch(
 			tournament_snapshot_id,
 			n_sims,
 			seed,
-			probability_source_key
+			probability_source_key,
+			storage,
+			outcome_layout,
+			strength_uncertainty,
+			sampling
 		)
-		VALUES ($1, $2, $3, $4, $5)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9)
 		RETURNING id
-	`, coreTournamentID, snapshotID, nSims, seed, probabilitySourceKey).Scan(&batchID); err != nil {
+	`, coreTournamentID, snapshotID, nSims, seed, probabilitySourceKey, storage, layoutJSON, strengthJSON, samplingJSON).Scan(&batchID); err != nil {
 		return "", fmt.Errorf("creating simulation batch: %w", err)
 	}
 	return batchID, nil
@@ -157,9 +189,18 @@ func (s *simResultsSource) Values() ([]any, error) {
 		r.Wins,
 		r.Byes,
 		r.IsEliminated,
+		beatenSeedsToInt32(r.BeatenSeeds),
 	}, nil
 }
 
+func beatenSeedsToInt32(seeds []int) []int32 {
+	out := make([]int32, len(seeds))
+	for i, s := range seeds {
+		out[i] = int32(s)
+	}
+	return out
+}
+
 func (s *simResultsSource) Err() error { return nil }
 
 func (s *Service) copyInsertSimulatedTournaments(
@@ -186,7 +227,7 @@ func (s *Service) copyInsertSimulatedTournaments(
 	inserted, err := conn.Conn().CopyFrom(
 		ctx,
 		pgx.Identifier{"compute", "simulated_teams"},
-		[]string{"simulated_tournament_id", "tournament_id", "sim_id", "team_id", "wins", "byes", "is_eliminated"},
+		[]string{"simulated_tournament_id", "tournament_id", "sim_id", "team_id", "wins", "byes", "is_eliminated", "beaten_seeds"},
 		src,
 	)
 	if err != nil {
@@ -194,3 +235,46 @@ func (s *Service) copyInsertSimulatedTournaments(
 	}
 	return inserted, nil
 }
+
+func (s *Service) insertSimulatedOutcomeChunk(ctx context.Context, batchID string, simOffset int, outcomes *PackedOutcomes) error {
+	_, err := s.pool.Exec(ctx, `
+		INSERT INTO compute.simulated_outcome_chunks (
+			simulated_tournament_id,
+			sim_offset,
+			n_sims,
+			bytes_per_sim,
+			outcomes,
+			weights
+		)
+		VALUES ($1, $2, $3, $4, $5, $6)
+	`, batchID, simOffset, outcomes.NSims, outcomes.BytesPerSim, outcomes.Data, outcomes.Weights)
+	if err != nil {
+		return fmt.Errorf("inserting simulated outcome chunk: %w", err)
+	}
+	return nil
+}
+
+func (s *Service) copyInsertRoundReach(ctx context.Context, batchID string, tournamentID string, reach *RoundReach) (int64, error) {
+	if reach == nil {
+		return 0, nil
+	}
+	rows := make([][]any, 0, len(reach.TeamIDs))
+	for i, teamID := range reach.TeamIDs {
+		counts := make([]int32, len(reach.Counts[i]))
+		for k, n := range reach.Counts[i] {
+			counts[k] = int32(n)
+		}
+		rows = append(rows, []any{batchID, tournamentID, teamID, counts, reach.Weights[i]})
+	}
+
+	inserted, err := s.pool.CopyFrom(
+		ctx,
+		pgx.Identifier{"compute", "simulated_team_round_reach"},
+		[]string{"simulated_tournament_id", "tournament_id", "team_id", "progress_counts", "progress_weights"},
+		pgx.CopyFromRows(rows),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("copy inserting round reach: %w", err)
+	}
+	return inserted, nil
+}
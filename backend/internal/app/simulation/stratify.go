@@ -0,0 +1,219 @@
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+	"sort"
+)
+
+// finalGame returns the index of the game no other game feeds into.
+func (l *OutcomeLayout) finalGame() (int, error) {
+	fed := make([]bool, len(l.Games))
+	for _, g := range l.Games {
+		if g.Prev1 >= 0 {
+			fed[g.Prev1] = true
+		}
+		if g.Prev2 >= 0 {
+			fed[g.Prev2] = true
+		}
+	}
+	final := -1
+	for i, f := range fed {
+		if f {
+			continue
+		}
+		if final >= 0 {
+			return -1, errors.New("bracket must have a single final game")
+		}
+		final = i
+	}
+	if final < 0 {
+		return -1, errors.New("bracket has no final game")
+	}
+	return final, nil
+}
+
+// Champion returns the index in TeamIDs of the team that won a packed
+// outcome, or -1 when the final was not played. winners is scratch space of
+// len(Games).
+func (l *OutcomeLayout) Champion(outcome []byte, winners []int) int {
+	final, err := l.finalGame()
+	if err != nil {
+		return -1
+	}
+	l.replay(outcome, winners, func(int, int) {})
+	return winners[final]
+}
+
+// championStrata plans a run stratified by champion. slots holds, for each
+// game and slot, the probability of each team filling it; reach holds the
+// probability of each team winning each game. Stratum k covers simulations
+// up to ends[k], all won by champions[k] and weighted weights[k].
+type championStrata struct {
+	final     int
+	slots     [][2][]float64
+	reach     [][]float64
+	champions []int
+	ends      []int
+	weights   []float64
+}
+
+// newChampionStrata computes every team's exact title odds, assuming
+// independent games, and allocates nSims among the possible champions in
+// proportion, giving each at least one simulation.
+func newChampionStrata(l *OutcomeLayout, provider ProbabilityProvider, nSims int) (*championStrata, error) {
+	final, err := l.finalGame()
+	if err != nil {
+		return nil, err
+	}
+
+	nTeams := len(l.TeamIDs)
+	st := &championStrata{
+		final: final,
+		slots: make([][2][]float64, len(l.Games)),
+		reach: make([][]float64, len(l.Games)),
+	}
+	slot := func(team, prev int) []float64 {
+		switch {
+		case team >= 0:
+			d := make([]float64, nTeams)
+			d[team] = 1
+			return d
+		case prev >= 0:
+			return st.reach[prev]
+		default:
+			return make([]float64, nTeams)
+		}
+	}
+	for i, g := range l.Games {
+		s1, s2 := slot(g.Team1, g.Prev1), slot(g.Team2, g.Prev2)
+		st.slots[i] = [2][]float64{s1, s2}
+		reach := make([]float64, nTeams)
+		for a, pa := range s1 {
+			if pa == 0 {
+				continue
+			}
+			for b, pb := range s2 {
+				if pb == 0 {
+					continue
+				}
+				p := l.prob(provider, g, a, b)
+				reach[a] += pa * pb * p
+				reach[b] += pa * pb * (1 - p)
+			}
+		}
+		st.reach[i] = reach
+	}
+
+	titleOdds := st.reach[final]
+	total := 0.0
+	for team, p := range titleOdds {
+		if p > 0 {
+			st.champions = append(st.champions, team)
+			total += p
+		}
+	}
+	if len(st.champions) == 0 {
+		return nil, errors.New("bracket has no possible champion")
+	}
+	if nSims < len(st.champions) {
+		return nil, fmt.Errorf("stratified sampling needs at least %d simulations, one per possible champion", len(st.champions))
+	}
+
+	// Every stratum gets one simulation, and the rest go by largest
+	// remainder of its share.
+	counts := make([]int, len(st.champions))
+	remainders := make([]float64, len(st.champions))
+	spare := nSims - len(st.champions)
+	assigned := 0
+	for k, team := range st.champions {
+		share := titleOdds[team] / total * float64(spare)
+		counts[k] = 1 + int(math.Floor(share))
+		remainders[k] = share - math.Floor(share)
+		assigned += counts[k]
+	}
+	order := make([]int, len(st.champions))
+	for k := range order {
+		order[k] = k
+	}
+	sort.SliceStable(order, func(i, j int) bool { return remainders[order[i]] > remainders[order[j]] })
+	for _, k := range order[:nSims-assigned] {
+		counts[k]++
+	}
+
+	end := 0
+	for k, team := range st.champions {
+		end += counts[k]
+		st.ends = append(st.ends, end)
+		st.weights = append(st.weights, titleOdds[team]/total*float64(nSims)/float64(counts[k]))
+	}
+	return st, nil
+}
+
+// stratum returns the champion and weight of simulation simID.
+func (st *championStrata) stratum(simID int) (int, float64) {
+	k := sort.SearchInts(st.ends, simID+1)
+	return st.champions[k], st.weights[k]
+}
+
+// playConditioned simulates one tournament won by champion, drawing each
+// game's loser from the teams that could have reached it, in proportion to
+// how likely they were to get there and to lose to the winner. outcome must
+// be zeroed; winners is scratch space of len(Games).
+func (st *championStrata) playConditioned(l *OutcomeLayout, rng *rand.Rand, provider ProbabilityProvider, champion int, outcome []byte, winners []int) {
+	for i := range winners {
+		winners[i] = -1
+	}
+	st.playGame(l, rng, provider, st.final, champion, outcome, winners)
+}
+
+func (st *championStrata) playGame(l *OutcomeLayout, rng *rand.Rand, provider ProbabilityProvider, game, winner int, outcome []byte, winners []int) {
+	g := l.Games[game]
+	winnerSlot := 0
+	if st.slots[game][0][winner] == 0 {
+		winnerSlot = 1
+	}
+	loserSlot := 1 - winnerSlot
+
+	beats := func(loser int) float64 {
+		if winnerSlot == 0 {
+			return l.prob(provider, g, winner, loser)
+		}
+		return 1 - l.prob(provider, g, loser, winner)
+	}
+	candidates := st.slots[game][loserSlot]
+	total := 0.0
+	for team, p := range candidates {
+		if p > 0 {
+			total += p * beats(team)
+		}
+	}
+	u := rng.Float64() * total
+	loser := -1
+	for team, p := range candidates {
+		if p <= 0 {
+			continue
+		}
+		loser = team
+		u -= p * beats(team)
+		if u < 0 {
+			break
+		}
+	}
+
+	winners[game] = winner
+	if winnerSlot == 0 {
+		outcome[game>>3] |= 1 << (game & 7)
+	}
+
+	prev := [2]int{g.Prev1, g.Prev2}
+	fixed := [2]int{g.Team1, g.Team2}
+	if fixed[winnerSlot] < 0 && prev[winnerSlot] >= 0 {
+		st.playGame(l, rng, provider, prev[winnerSlot], winner, outcome, winners)
+	}
+	if loser >= 0 && fixed[loserSlot] < 0 && prev[loserSlot] >= 0 {
+		st.playGame(l, rng, provider, prev[loserSlot], loser, outcome, winners)
+	}
+}
@@ -0,0 +1,76 @@
+package simulation
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/rand"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/mathutil"
+)
+
+// Distributions a team's latent strength perturbation can be drawn from.
+const (
+	StrengthDistributionNormal   = "normal"
+	StrengthDistributionStudentT = "student_t"
+)
+
+// maxStrengthDegreesOfFreedom bounds the Student's t draw, which sums one
+// squared normal per degree of freedom; past it the draw is all but normal.
+const maxStrengthDegreesOfFreedom = 100
+
+// StrengthUncertainty makes a team's games within one simulation move
+// together. Each simulation draws one perturbation per team, in log-odds,
+// and every game shifts the matchup probability's log-odds by the difference
+// between the two teams' draws, so a team that drew underrated stays
+// underrated in every round. Games with a certain outcome are not shifted.
+type StrengthUncertainty struct {
+	Distribution string `json:"distribution"`
+	// Scale is the standard deviation of a normal draw, or the scale of a
+	// Student's t draw, in log-odds.
+	Scale float64 `json:"scale"`
+	// DegreesOfFreedom shapes a Student's t draw; fewer means heavier tails.
+	// Normal draws ignore it.
+	DegreesOfFreedom int `json:"degreesOfFreedom,omitempty"`
+}
+
+func (u *StrengthUncertainty) Validate() error {
+	if u.Scale <= 0 || math.IsNaN(u.Scale) || math.IsInf(u.Scale, 0) {
+		return errors.New("strength uncertainty scale must be positive")
+	}
+	switch u.Distribution {
+	case StrengthDistributionNormal:
+		return nil
+	case StrengthDistributionStudentT:
+		if u.DegreesOfFreedom < 1 || u.DegreesOfFreedom > maxStrengthDegreesOfFreedom {
+			return fmt.Errorf("strength uncertainty degrees of freedom must be between 1 and %d", maxStrengthDegreesOfFreedom)
+		}
+		return nil
+	default:
+		return fmt.Errorf("strength uncertainty distribution must be %q or %q", StrengthDistributionNormal, StrengthDistributionStudentT)
+	}
+}
+
+// sample draws one perturbation per team into shifts.
+func (u *StrengthUncertainty) sample(rng *rand.Rand, shifts []float64) {
+	for i := range shifts {
+		z := rng.NormFloat64()
+		if u.Distribution == StrengthDistributionStudentT {
+			chi2 := 0.0
+			for k := 0; k < u.DegreesOfFreedom; k++ {
+				n := rng.NormFloat64()
+				chi2 += n * n
+			}
+			z /= math.Sqrt(chi2 / float64(u.DegreesOfFreedom))
+		}
+		shifts[i] = z * u.Scale
+	}
+}
+
+// perturbProb shifts the log-odds of p by shift.
+func perturbProb(p, shift float64) float64 {
+	if p <= 0 || p >= 1 {
+		return p
+	}
+	return mathutil.Sigmoid(math.Log(p/(1-p)) + shift)
+}
@@ -0,0 +1,126 @@
+package simulation
+
+import (
+	"math"
+	"reflect"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func TestThatSimulationWithoutStrengthUncertaintyIsUnchanged(t *testing.T) {
+	// GIVEN a bracket simulated without options
+	b := toyBracket()
+	want, err := Simulate(b, toyProbs(), 100, 42, Options{Workers: 1})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// WHEN simulating with a nil strength uncertainty
+	got, err := Simulate(b, toyProbs(), 100, 42, Options{Workers: 2, StrengthUncertainty: nil})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN the results are identical
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("expected identical results")
+	}
+}
+
+func TestThatStrengthUncertaintyIsDeterministicForASeed(t *testing.T) {
+	// GIVEN a bracket with correlated outcomes
+	b := toyBracket()
+	opts := Options{Workers: 3, StrengthUncertainty: &StrengthUncertainty{Distribution: StrengthDistributionStudentT, Scale: 0.5, DegreesOfFreedom: 4}}
+
+	// WHEN simulating twice with the same seed
+	res1, err1 := Simulate(b, toyProbs(), 100, 42, opts)
+	res2, err2 := Simulate(b, toyProbs(), 100, 42, opts)
+	if err1 != nil || err2 != nil {
+		t.Fatalf("unexpected errors: %v, %v", err1, err2)
+	}
+
+	// THEN both runs produce identical results
+	if !reflect.DeepEqual(res1, res2) {
+		t.Errorf("expected identical results")
+	}
+}
+
+func TestThatStrengthUncertaintyFavorsTeamsThatAlreadyWon(t *testing.T) {
+	// GIVEN coin-flip games where t2 waits in the final for the winner of t1
+	// and t3
+	b := toyBracket()
+	delete(b.Games, "g2")
+	b.Games["g3"].Team2 = &models.BracketTeam{TeamID: "t2"}
+	opts := Options{Workers: 1, StrengthUncertainty: &StrengthUncertainty{Distribution: StrengthDistributionNormal, Scale: 2}}
+
+	// WHEN simulating with strength uncertainty
+	results, err := Simulate(b, nil, 4000, 7, opts)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	// THEN t2 wins the final less than half the time, since its opponent
+	// has already shown it drew strong
+	titles := 0
+	for _, r := range results {
+		if r.TeamID == "t2" && r.Wins == 1 {
+			titles++
+		}
+	}
+	if got := float64(titles) / 4000; got > 0.45 {
+		t.Errorf("expected t2 to win under 45%% of finals, got %.3f", got)
+	}
+}
+
+func TestThatPerturbedProbabilityKeepsCertainOutcomes(t *testing.T) {
+	// GIVEN a locked-in result
+	p := 1.0
+
+	// WHEN perturbing it
+	got := perturbProb(p, -3)
+
+	// THEN it stays certain
+	if got != 1.0 {
+		t.Errorf("expected 1.0, got %f", got)
+	}
+}
+
+func TestThatPerturbedProbabilityShiftsLogOdds(t *testing.T) {
+	// GIVEN an even matchup
+	p := 0.5
+
+	// WHEN shifting its log-odds by log(3)
+	got := perturbProb(p, math.Log(3))
+
+	// THEN the favorite wins three times in four
+	if math.Abs(got-0.75) > 1e-9 {
+		t.Errorf("expected 0.75, got %f", got)
+	}
+}
+
+func TestThatStudentTStrengthUncertaintyRequiresDegreesOfFreedom(t *testing.T) {
+	// GIVEN a Student's t distribution without degrees of freedom
+	u := &StrengthUncertainty{Distribution: StrengthDistributionStudentT, Scale: 0.5}
+
+	// WHEN validating it
+	err := u.Validate()
+
+	// THEN it is rejected
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
+
+func TestThatStrengthUncertaintyRequiresPositiveScale(t *testing.T) {
+	// GIVEN a normal distribution with no scale
+	u := &StrengthUncertainty{Distribution: StrengthDistributionNormal}
+
+	// WHEN validating it
+	err := u.Validate()
+
+	// THEN it is rejected
+	if err == nil {
+		t.Errorf("expected an error")
+	}
+}
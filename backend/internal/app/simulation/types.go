@@ -14,11 +14,21 @@ type TeamSimulationResult struct {
 	Wins       int
 	Byes       int
 	IsEliminated bool
+	// Seed is the team's seed and BeatenSeeds the seeds of the teams it beat,
+	// in order; seed-based scoring rules need both.
+	Seed        int
+	BeatenSeeds []int
 }
 
 // Options configures simulation execution.
 type Options struct {
 	Workers int
+	// StrengthUncertainty, when set, correlates each team's games within a
+	// simulation. Nil plays every game as an independent draw.
+	StrengthUncertainty *StrengthUncertainty
+	// Sampling, when set, selects a variance-reduction method. Nil plays
+	// independent, equally weighted simulations.
+	Sampling *Sampling
 }
 
 // ProbabilityProvider returns the probability that team1 beats team2 in a given game.
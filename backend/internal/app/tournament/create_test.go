@@ -84,6 +84,10 @@ func (m *mockRepo) BulkUpsertKenPomStats(context.Context, []models.TeamKenPomUpd
 	panic("BulkUpsertKenPomStats not implemented")
 }
 
+func (m *mockRepo) GetBracketTemplate(context.Context, string) (*models.BracketTemplate, error) {
+	panic("GetBracketTemplate not implemented")
+}
+
 func TestThatCreateReturnsNewTournamentWithCorrectName(t *testing.T) {
 	// GIVEN a service with a mock repo
 	repo := &mockRepo{}
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"time"
 
+	appbracket "github.com/andrewcopp/Calcutta/backend/internal/app/bracket"
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 	"github.com/google/uuid"
 )
@@ -26,6 +27,7 @@ type TournamentRepo interface {
 	GetSeasons(ctx context.Context) ([]models.Season, error)
 	ReplaceTeams(ctx context.Context, tournamentID string, teams []*models.TournamentTeam) error
 	BulkUpsertKenPomStats(ctx context.Context, updates []models.TeamKenPomUpdate) error
+	GetBracketTemplate(ctx context.Context, tournamentID string) (*models.BracketTemplate, error)
 }
 
 type Service struct {
@@ -100,7 +102,16 @@ type ReplaceTeamsInput struct {
 func (s *Service) ReplaceTeams(ctx context.Context, tournamentID string, inputs []ReplaceTeamsInput) ([]*models.TournamentTeam, error) {
 	teams := buildTeamsFromInputs(tournamentID, inputs)
 
-	if errs := ValidateBracketSetup(teams); len(errs) > 0 {
+	tmpl, err := s.repo.GetBracketTemplate(ctx, tournamentID)
+	if err != nil {
+		return nil, fmt.Errorf("getting bracket template: %w", err)
+	}
+	if tmpl != nil {
+		appbracket.AssignTemplateByes(teams, tmpl)
+		if err := appbracket.ValidateTeamsForTemplate(teams, tmpl); err != nil {
+			return nil, &BracketValidationError{Errors: []string{err.Error()}}
+		}
+	} else if errs := ValidateBracketSetup(teams); len(errs) > 0 {
 		return nil, &BracketValidationError{Errors: errs}
 	}
 
@@ -116,12 +127,15 @@ func (s *Service) ReplaceTeams(ctx context.Context, tournamentID string, inputs
 }
 
 // KenPomUpdateInput represents a single team's KenPom rating update.
+// SeasonWins and SeasonLosses are optional; nil leaves the stored record untouched.
 type KenPomUpdateInput struct {
-	TeamID string
-	NetRtg float64
-	ORtg   float64
-	DRtg   float64
-	AdjT   float64
+	TeamID       string
+	NetRtg       float64
+	ORtg         float64
+	DRtg         float64
+	AdjT         float64
+	SeasonWins   *int
+	SeasonLosses *int
 }
 
 // UpdateKenPomStats validates that all team IDs belong to the tournament, then upserts KenPom ratings.
@@ -145,11 +159,13 @@ func (s *Service) UpdateKenPomStats(ctx context.Context, tournamentID string, in
 	updates := make([]models.TeamKenPomUpdate, 0, len(inputs))
 	for _, input := range inputs {
 		updates = append(updates, models.TeamKenPomUpdate{
-			TeamID: input.TeamID,
-			NetRtg: input.NetRtg,
-			ORtg:   input.ORtg,
-			DRtg:   input.DRtg,
-			AdjT:   input.AdjT,
+			TeamID:       input.TeamID,
+			NetRtg:       input.NetRtg,
+			ORtg:         input.ORtg,
+			DRtg:         input.DRtg,
+			AdjT:         input.AdjT,
+			SeasonWins:   input.SeasonWins,
+			SeasonLosses: input.SeasonLosses,
 		})
 	}
 
@@ -0,0 +1,61 @@
+package winprob
+
+import (
+	"errors"
+	"fmt"
+)
+
+// KindBlend is a weighted average of its component models' probabilities.
+const KindBlend = "blend"
+
+func init() {
+	Register(KindBlend, Kind{
+		Normalize: func(m *Model) {
+			for i := range m.Components {
+				m.Components[i].Model.Normalize()
+			}
+		},
+		Validate: func(m *Model) error {
+			if len(m.Components) == 0 {
+				return errors.New("blend requires at least one component")
+			}
+			for i := range m.Components {
+				c := &m.Components[i]
+				if c.Weight <= 0 {
+					return fmt.Errorf("component %d weight must be positive", i)
+				}
+				if err := c.Model.Validate(); err != nil {
+					return fmt.Errorf("component %d: %w", i, err)
+				}
+			}
+			return nil
+		},
+		Prob: func(m *Model, a, b Team) float64 {
+			return blendProb(m, a, b, nil)
+		},
+		ProbAtSite: blendProb,
+		UsesNet:    blendUsesNet,
+	})
+}
+
+func blendProb(m *Model, a, b Team, site *Location) float64 {
+	var sum, weight float64
+	for i := range m.Components {
+		c := &m.Components[i]
+		sum += c.Weight * c.Model.ProbAtSite(a, b, site)
+		weight += c.Weight
+	}
+	if weight == 0 {
+		return 0.5
+	}
+	return sum / weight
+}
+
+func blendUsesNet(m *Model) bool {
+	for i := range m.Components {
+		if m.Components[i].Model.UsesNet() {
+			return true
+		}
+	}
+	return false
+}
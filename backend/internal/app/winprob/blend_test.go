@@ -0,0 +1,85 @@
+package winprob
+
+import (
+	"math"
+	"testing"
+)
+
+func TestThatBlendReturnsWeightedAverageOfComponents(t *testing.T) {
+	// GIVEN a blend weighting kenpom 3:1 over log5
+	m := &Model{Kind: KindBlend, Components: []Component{
+		{Weight: 3, Model: Model{Kind: KindKenPom}},
+		{Weight: 1, Model: Model{Kind: KindLog5}},
+	}}
+	m.Normalize()
+	a, b := Team{Net: 20.0, WinPct: 0.8}, Team{Net: 5.0, WinPct: 0.6}
+
+	// WHEN Prob is called
+	prob := m.Prob(a, b)
+
+	// THEN it is the weighted average of the component probabilities
+	want := (3*m.Components[0].Model.Prob(a, b) + m.Components[1].Model.Prob(a, b)) / 4
+	if math.Abs(prob-want) > 1e-9 {
+		t.Errorf("expected %f, got %f", want, prob)
+	}
+}
+
+func TestThatBlendValidateRejectsEmptyComponents(t *testing.T) {
+	// GIVEN a blend with no components
+	m := &Model{Kind: KindBlend, Sigma: 10.0}
+
+	// WHEN Validate is called
+	err := m.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for empty blend")
+	}
+}
+
+func TestThatBlendValidateRejectsInvalidComponent(t *testing.T) {
+	// GIVEN a blend with an unsupported component kind
+	m := &Model{Kind: KindBlend, Sigma: 10.0, Components: []Component{
+		{Weight: 1, Model: Model{Kind: "unknown", Sigma: 10.0}},
+	}}
+
+	// WHEN Validate is called
+	err := m.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for invalid component")
+	}
+}
+
+func TestThatBlendUsesNetWhenAnyComponentDoes(t *testing.T) {
+	// GIVEN a blend of seed and kenpom
+	m := &Model{Kind: KindBlend, Components: []Component{
+		{Weight: 1, Model: Model{Kind: KindSeed}},
+		{Weight: 1, Model: Model{Kind: KindKenPom}},
+	}}
+
+	// WHEN UsesNet is called
+	usesNet := m.UsesNet()
+
+	// THEN it reports true
+	if !usesNet {
+		t.Error("expected blend to use net ratings")
+	}
+}
+
+func TestThatBlendWithHistoryUpdatesEloComponent(t *testing.T) {
+	// GIVEN a blend containing an elo component
+	m := &Model{Kind: KindBlend, Components: []Component{{Weight: 1, Model: Model{Kind: KindElo}}}}
+	m.Normalize()
+	teams := map[string]Team{"a": {ID: "a", Net: 20.0}, "b": {ID: "b", Net: 5.0}}
+	before := m.Prob(teams["b"], teams["a"])
+
+	// WHEN b's upset of a is applied
+	updated := m.WithHistory(teams, []Game{{WinnerID: "b", LoserID: "a"}})
+
+	// THEN b's rematch probability rises
+	if updated.Prob(teams["b"], teams["a"]) <= before {
+		t.Errorf("expected probability above %f, got %f", before, updated.Prob(teams["b"], teams["a"]))
+	}
+}
@@ -0,0 +1,61 @@
+package winprob
+
+import (
+	"errors"
+	"math"
+)
+
+// KindElo is an Elo model. Ratings start from KenPom net so that, before any
+// games are played, it agrees with the kenpom kind at the same Sigma; each
+// completed game then moves both teams by K times the surprise.
+const KindElo = "elo"
+
+const (
+	eloScale    = 400.0
+	defaultEloK = 20.0
+)
+
+func init() {
+	Register(KindElo, Kind{
+		Normalize: func(m *Model) {
+			if m.K <= 0 {
+				m.K = defaultEloK
+			}
+		},
+		Validate: func(m *Model) error {
+			if m.K <= 0 {
+				return errors.New("k must be positive")
+			}
+			return nil
+		},
+		Prob: func(m *Model, a, b Team) float64 {
+			return eloExpected(eloRating(m, a), eloRating(m, b))
+		},
+		UsesNet: alwaysUsesNet,
+	})
+}
+
+// eloRating maps net rating onto the Elo scale and adds any history updates.
+func eloRating(m *Model, t Team) float64 {
+	return t.Net*eloScale/(m.Sigma*math.Ln10) + m.eloDelta[t.ID]
+}
+
+func eloExpected(ra, rb float64) float64 {
+	return 1.0 / (1.0 + math.Pow(10, (rb-ra)/eloScale))
+}
+
+func eloDeltas(m *Model, teams map[string]Team, history []Game) map[string]float64 {
+	work := *m
+	work.eloDelta = make(map[string]float64)
+	for _, g := range history {
+		winner, ok1 := teams[g.WinnerID]
+		loser, ok2 := teams[g.LoserID]
+		if !ok1 || !ok2 {
+			continue
+		}
+		shift := work.K * (1.0 - eloExpected(eloRating(&work, winner), eloRating(&work, loser)))
+		work.eloDelta[g.WinnerID] += shift
+		work.eloDelta[g.LoserID] -= shift
+	}
+	return work.eloDelta
+}
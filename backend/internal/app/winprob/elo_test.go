@@ -0,0 +1,71 @@
+package winprob
+
+import (
+	"math"
+	"testing"
+)
+
+func TestThatEloMatchesKenpomBeforeAnyGames(t *testing.T) {
+	// GIVEN an elo model and a kenpom model at the same sigma
+	elo := &Model{Kind: KindElo, Sigma: 10.0}
+	elo.Normalize()
+	kenpom := &Model{Kind: KindKenPom, Sigma: 10.0}
+
+	// WHEN both price the same matchup
+	a, b := Team{ID: "a", Net: 20.0}, Team{ID: "b", Net: 5.0}
+	diff := elo.Prob(a, b) - kenpom.Prob(a, b)
+
+	// THEN the probabilities agree
+	if math.Abs(diff) > 1e-9 {
+		t.Errorf("expected equal probabilities, got difference %f", diff)
+	}
+}
+
+func TestThatEloHistoryRaisesWinnerProbability(t *testing.T) {
+	// GIVEN an elo model and an upset of a by c
+	m := &Model{Kind: KindElo, Sigma: 10.0, K: 30}
+	m.Normalize()
+	teams := map[string]Team{
+		"a": {ID: "a", Net: 20.0},
+		"b": {ID: "b", Net: 15.0},
+		"c": {ID: "c", Net: 5.0},
+	}
+	before := m.Prob(teams["c"], teams["b"])
+
+	// WHEN the history is applied
+	updated := m.WithHistory(teams, []Game{{WinnerID: "c", LoserID: "a"}})
+
+	// THEN c is a stronger favorite against b than before
+	if updated.Prob(teams["c"], teams["b"]) <= before {
+		t.Errorf("expected probability above %f, got %f", before, updated.Prob(teams["c"], teams["b"]))
+	}
+}
+
+func TestThatEloWithHistoryLeavesOriginalModelUnchanged(t *testing.T) {
+	// GIVEN an elo model
+	m := &Model{Kind: KindElo, Sigma: 10.0}
+	m.Normalize()
+	teams := map[string]Team{"a": {ID: "a", Net: 20.0}, "b": {ID: "b", Net: 5.0}}
+	before := m.Prob(teams["a"], teams["b"])
+
+	// WHEN history is applied
+	m.WithHistory(teams, []Game{{WinnerID: "b", LoserID: "a"}})
+
+	// THEN the original model's probability is unchanged
+	if m.Prob(teams["a"], teams["b"]) != before {
+		t.Errorf("expected %f, got %f", before, m.Prob(teams["a"], teams["b"]))
+	}
+}
+
+func TestThatEloValidateRejectsNonPositiveK(t *testing.T) {
+	// GIVEN an elo model with a negative K
+	m := &Model{Kind: KindElo, Sigma: 10.0, K: -1}
+
+	// WHEN Validate is called
+	err := m.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for non-positive k")
+	}
+}
@@ -0,0 +1,15 @@
+package winprob
+
+import "github.com/andrewcopp/Calcutta/backend/internal/mathutil"
+
+// KindKenPom is a logistic model over KenPom net rating difference.
+const KindKenPom = "kenpom"
+
+func init() {
+	Register(KindKenPom, Kind{
+		Prob: func(m *Model, a, b Team) float64 {
+			return mathutil.Sigmoid((a.Net - b.Net) / m.Sigma)
+		},
+		UsesNet: alwaysUsesNet,
+	})
+}
@@ -0,0 +1,70 @@
+package winprob
+
+import (
+	"math"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/mathutil"
+)
+
+const (
+	// RegulationSeconds is the length of a regulation college game.
+	RegulationSeconds = 2400
+	// OvertimeSeconds is the length of one overtime period.
+	OvertimeSeconds = 300
+	// DefaultGameSD is the standard deviation of a full game's final margin,
+	// used when the model does not project one.
+	DefaultGameSD = 11.0
+	// possessionValue is the expected points of one possession.
+	possessionValue = 1.0
+)
+
+// GameState is a snapshot of a game in progress, from a's point of view.
+type GameState struct {
+	ScoreA           int
+	ScoreB           int
+	SecondsRemaining int
+	// Possession is 1 when a has the ball, -1 when b has it, 0 when neither.
+	Possession int
+}
+
+// LiveProb returns the probability that a beats b from the given game state.
+// The pregame margin comes from the model's projected spread, or is implied
+// from its win probability when the model does not project scores.
+func (m *Model) LiveProb(a, b Team, site *Location, state GameState) float64 {
+	spread, sd, ok := m.Spread(a, b, site)
+	if !ok {
+		sd = DefaultGameSD
+		spread = ImpliedSpread(m.ProbAtSite(a, b, site), sd)
+	}
+	return LiveProb(spread, sd, state)
+}
+
+// ImpliedSpread returns the pregame margin for which a normal margin with
+// standard deviation sd wins with probability p.
+func ImpliedSpread(p, sd float64) float64 {
+	const eps = 1e-6
+	p = math.Min(math.Max(p, eps), 1-eps)
+	return mathutil.NormalQuantile(p) * sd
+}
+
+// LiveProb prices the remaining game as a normal margin. The pregame spread
+// and its variance are both scaled by the share of regulation left, and the
+// current lead and possession are added to the expected margin. A game tied
+// with no time left is priced as an overtime period.
+func LiveProb(spread, sd float64, state GameState) float64 {
+	lead := float64(state.ScoreA - state.ScoreB)
+	seconds := state.SecondsRemaining
+	if seconds <= 0 {
+		if lead > 0 {
+			return 1
+		}
+		if lead < 0 {
+			return 0
+		}
+		seconds = OvertimeSeconds
+	}
+
+	share := math.Min(float64(seconds)/RegulationSeconds, 1)
+	margin := lead + spread*share + possessionValue*float64(state.Possession)
+	return mathutil.NormalCDF(margin / (sd * math.Sqrt(share)))
+}
@@ -0,0 +1,93 @@
+package winprob
+
+import (
+	"math"
+	"testing"
+)
+
+func TestThatLiveProbAtTipoffMatchesPregameSpread(t *testing.T) {
+	// GIVEN a 5-point favorite with a full game left and no score
+	state := GameState{SecondsRemaining: RegulationSeconds}
+
+	// WHEN LiveProb is called
+	p := LiveProb(5, 11, state)
+
+	// THEN it matches the pregame probability of the spread
+	expected := 0.5 + 0.5*math.Erf(5.0/11.0/math.Sqrt2)
+	if math.Abs(p-expected) > 1e-9 {
+		t.Errorf("expected %f, got %f", expected, p)
+	}
+}
+
+func TestThatLiveProbIsCertainForLeaderAtFinalBuzzer(t *testing.T) {
+	// GIVEN the underdog leading by one with no time left
+	state := GameState{ScoreA: 60, ScoreB: 61, SecondsRemaining: 0}
+
+	// WHEN LiveProb is called for the favorite
+	p := LiveProb(10, 11, state)
+
+	// THEN the favorite has lost
+	if p != 0 {
+		t.Errorf("expected 0, got %f", p)
+	}
+}
+
+func TestThatLiveProbPricesTieAtBuzzerAsOvertime(t *testing.T) {
+	// GIVEN a tie with no time left between evenly matched teams
+	state := GameState{ScoreA: 70, ScoreB: 70, SecondsRemaining: 0}
+
+	// WHEN LiveProb is called
+	p := LiveProb(0, 11, state)
+
+	// THEN the game is a coin flip
+	if math.Abs(p-0.5) > 1e-9 {
+		t.Errorf("expected 0.5, got %f", p)
+	}
+}
+
+func TestThatLiveProbWeighsLeadMoreAsTimeRunsOut(t *testing.T) {
+	// GIVEN the same 6-point lead early and late in the second half
+	early := GameState{ScoreA: 40, ScoreB: 34, SecondsRemaining: 1200}
+	late := GameState{ScoreA: 70, ScoreB: 64, SecondsRemaining: 120}
+
+	// WHEN LiveProb is called for both
+	pEarly := LiveProb(0, 11, early)
+	pLate := LiveProb(0, 11, late)
+
+	// THEN the late lead is worth more
+	if pLate <= pEarly {
+		t.Errorf("expected late %f > early %f", pLate, pEarly)
+	}
+}
+
+func TestThatLiveProbCreditsPossession(t *testing.T) {
+	// GIVEN a tie late in the game with and without possession
+	without := GameState{ScoreA: 65, ScoreB: 65, SecondsRemaining: 30}
+	with := without
+	with.Possession = 1
+
+	// WHEN LiveProb is called for both
+	pWithout := LiveProb(0, 11, without)
+	pWith := LiveProb(0, 11, with)
+
+	// THEN holding the ball raises the probability
+	if pWith <= pWithout {
+		t.Errorf("expected %f > %f", pWith, pWithout)
+	}
+}
+
+func TestThatModelLiveProbImpliesSpreadFromWinProbability(t *testing.T) {
+	// GIVEN a kenpom model, which does not project scores, at tipoff
+	m := &Model{Kind: KindKenPom, Sigma: 10}
+	a := Team{ID: "a", Net: 20}
+	b := Team{ID: "b", Net: 5}
+	state := GameState{SecondsRemaining: RegulationSeconds}
+
+	// WHEN LiveProb is called
+	p := m.LiveProb(a, b, nil, state)
+
+	// THEN it matches the pregame probability
+	if expected := m.Prob(a, b); math.Abs(p-expected) > 1e-9 {
+		t.Errorf("expected %f, got %f", expected, p)
+	}
+}
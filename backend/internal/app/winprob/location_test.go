@@ -0,0 +1,20 @@
+package winprob
+
+import (
+	"math"
+	"testing"
+)
+
+func TestThatMilesMeasuresGreatCircleDistance(t *testing.T) {
+	// GIVEN Chapel Hill and Durham
+	chapelHill := Location{Latitude: 35.9049, Longitude: -79.0469}
+	durham := Location{Latitude: 36.0014, Longitude: -78.9382}
+
+	// WHEN Miles is called
+	miles := chapelHill.Miles(durham)
+
+	// THEN the distance is about nine miles
+	if math.Abs(miles-9.0) > 0.5 {
+		t.Errorf("expected about 9 miles, got %f", miles)
+	}
+}
@@ -0,0 +1,30 @@
+package winprob
+
+// KindLog5 is Bill James' log5 estimate from each team's season win
+// percentage. Teams with an unknown record are treated as .500.
+const KindLog5 = "log5"
+
+// log5Clamp keeps undefeated or winless records from producing certainties.
+const log5Clamp = 0.01
+
+func init() {
+	Register(KindLog5, Kind{
+		Prob: func(_ *Model, a, b Team) float64 {
+			pa := log5WinPct(a.WinPct)
+			pb := log5WinPct(b.WinPct)
+			return (pa - pa*pb) / (pa + pb - 2*pa*pb)
+		},
+	})
+}
+
+func log5WinPct(p float64) float64 {
+	switch {
+	case p <= 0:
+		return 0.5
+	case p < log5Clamp:
+		return log5Clamp
+	case p > 1-log5Clamp:
+		return 1 - log5Clamp
+	}
+	return p
+}
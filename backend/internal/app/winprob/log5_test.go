@@ -0,0 +1,46 @@
+package winprob
+
+import (
+	"math"
+	"testing"
+)
+
+func TestThatLog5MatchesBillJamesFormula(t *testing.T) {
+	// GIVEN a log5 model and teams winning 80% and 60% of their games
+	m := &Model{Kind: KindLog5, Sigma: 10.0}
+
+	// WHEN Prob is called
+	prob := m.Prob(Team{WinPct: 0.8}, Team{WinPct: 0.6})
+
+	// THEN the result is (pa - pa*pb) / (pa + pb - 2*pa*pb)
+	want := (0.8 - 0.48) / (0.8 + 0.6 - 0.96)
+	if math.Abs(prob-want) > 1e-9 {
+		t.Errorf("expected %f, got %f", want, prob)
+	}
+}
+
+func TestThatLog5TreatsUnknownRecordAsFiftyPercent(t *testing.T) {
+	// GIVEN a log5 model and an opponent with an unknown record
+	m := &Model{Kind: KindLog5, Sigma: 10.0}
+
+	// WHEN a 70% team plays it
+	prob := m.Prob(Team{WinPct: 0.7}, Team{})
+
+	// THEN the probability equals the team's own win percentage
+	if math.Abs(prob-0.7) > 1e-9 {
+		t.Errorf("expected 0.7, got %f", prob)
+	}
+}
+
+func TestThatLog5DoesNotReturnCertaintyForUndefeatedTeam(t *testing.T) {
+	// GIVEN a log5 model and an undefeated team
+	m := &Model{Kind: KindLog5, Sigma: 10.0}
+
+	// WHEN it plays a .500 team
+	prob := m.Prob(Team{WinPct: 1.0}, Team{WinPct: 0.5})
+
+	// THEN the probability is below 1
+	if prob >= 1.0 {
+		t.Errorf("expected probability below 1, got %f", prob)
+	}
+}
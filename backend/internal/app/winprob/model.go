@@ -2,16 +2,102 @@ package winprob
 
 import (
 	"errors"
+	"fmt"
+	"sort"
 	"strings"
-
-	"github.com/andrewcopp/Calcutta/backend/internal/mathutil"
 )
 
 // Model is a parameterized win-probability model used by both the prediction
-// (deterministic) and simulation (Monte Carlo) pipelines.
+// (deterministic) and simulation (Monte Carlo) pipelines. Kind selects a
+// registered model; the remaining fields are parameters read by that kind.
 type Model struct {
 	Kind  string  `json:"kind"`
 	Sigma float64 `json:"sigma"`
+
+	// K is the Elo update step applied per completed game.
+	K float64 `json:"k,omitempty"`
+	// Components are the weighted models combined by the blend kind.
+	Components []Component `json:"components,omitempty"`
+
+	// LeagueTempo and LeagueEfficiency are the national averages of AdjT and
+	// offensive efficiency the tempo kind projects scores against.
+	LeagueTempo      float64 `json:"leagueTempo,omitempty"`
+	LeagueEfficiency float64 `json:"leagueEfficiency,omitempty"`
+	// HomeCourt is the point advantage for a team playing on its own campus;
+	// zero disables the proximity adjustment. It fades linearly to nothing
+	// at ProximityMiles from the game site.
+	HomeCourt      float64 `json:"homeCourt,omitempty"`
+	ProximityMiles float64 `json:"proximityMiles,omitempty"`
+
+	// eloDelta holds per-team Elo adjustments from WithHistory.
+	eloDelta map[string]float64
+}
+
+// Component is one weighted member of a blended model.
+type Component struct {
+	Weight float64 `json:"weight"`
+	Model  Model   `json:"model"`
+}
+
+// Team carries the per-team inputs a model may read.
+type Team struct {
+	ID   string
+	Seed int
+	// Net is the KenPom net rating.
+	Net float64
+	// WinPct is the regular-season win percentage; 0 means unknown.
+	WinPct float64
+	// ORtg, DRtg and AdjT are KenPom adjusted efficiencies and tempo; 0 means unknown.
+	ORtg float64
+	DRtg float64
+	AdjT float64
+	// Home is the team's campus location, if known.
+	Home *Location
+}
+
+// Game is a completed game used to update history-aware models.
+type Game struct {
+	WinnerID string
+	LoserID  string
+}
+
+// Kind is a registered win-probability model.
+type Kind struct {
+	// Normalize fills in defaults for kind-specific parameters.
+	Normalize func(m *Model)
+	// Validate checks kind-specific parameters after normalization.
+	Validate func(m *Model) error
+	// Prob returns P(a beats b).
+	Prob func(m *Model, a, b Team) float64
+	// ProbAtSite returns P(a beats b) at a game site; nil means the kind
+	// ignores the site and Prob is used.
+	ProbAtSite func(m *Model, a, b Team, site *Location) float64
+	// Spread returns a's projected margin over b in points and its standard
+	// deviation; nil means the kind does not project scores.
+	Spread func(m *Model, a, b Team, site *Location) (spread, sd float64)
+	// UsesNet reports whether the model reads Team.Net; nil means it does not.
+	UsesNet func(m *Model) bool
+}
+
+var registry = map[string]Kind{}
+
+// Register adds a model kind. It panics on a duplicate name, since kinds are
+// registered from package init.
+func Register(name string, k Kind) {
+	if _, exists := registry[name]; exists {
+		panic(fmt.Sprintf("winprob: kind %q already registered", name))
+	}
+	registry[name] = k
+}
+
+// Kinds returns the registered kind names in sorted order.
+func Kinds() []string {
+	out := make([]string, 0, len(registry))
+	for name := range registry {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
 }
 
 func (m *Model) Normalize() {
@@ -26,21 +112,87 @@ func (m *Model) Normalize() {
 	if m.Sigma <= 0 {
 		m.Sigma = 10.0
 	}
+	if kind, ok := registry[m.Kind]; ok && kind.Normalize != nil {
+		kind.Normalize(m)
+	}
 }
 
 func (m *Model) Validate() error {
 	if m == nil {
 		return errors.New("model must not be nil")
 	}
-	if m.Kind != "kenpom" {
+	kind, ok := registry[m.Kind]
+	if !ok {
 		return errors.New("unsupported win probability model kind")
 	}
 	if m.Sigma <= 0 {
 		return errors.New("sigma must be positive")
 	}
+	if kind.Validate != nil {
+		return kind.Validate(m)
+	}
 	return nil
 }
 
+// UsesNet reports whether the model reads KenPom net ratings.
+func (m *Model) UsesNet() bool {
+	kind, ok := registry[m.Kind]
+	return ok && kind.UsesNet != nil && kind.UsesNet(m)
+}
+
+func alwaysUsesNet(*Model) bool { return true }
+
+// Prob returns the probability that a beats b. Unknown kinds return 0.5.
+func (m *Model) Prob(a, b Team) float64 {
+	kind, ok := registry[m.Kind]
+	if !ok {
+		return 0.5
+	}
+	return kind.Prob(m, a, b)
+}
+
+// ProbAtSite returns the probability that a beats b at the given game site. A
+// nil site is a neutral floor.
+func (m *Model) ProbAtSite(a, b Team, site *Location) float64 {
+	kind, ok := registry[m.Kind]
+	if !ok {
+		return 0.5
+	}
+	if kind.ProbAtSite != nil {
+		return kind.ProbAtSite(m, a, b, site)
+	}
+	return kind.Prob(m, a, b)
+}
+
+// Spread returns a's projected point margin over b and its standard
+// deviation. ok is false when the model does not project scores.
+func (m *Model) Spread(a, b Team, site *Location) (spread, sd float64, ok bool) {
+	kind, found := registry[m.Kind]
+	if !found || kind.Spread == nil {
+		return 0, 0, false
+	}
+	spread, sd = kind.Spread(m, a, b, site)
+	return spread, sd, true
+}
+
+// WinProb returns the probability that a team with net rating net1 beats a
+// team with net rating net2.
 func (m *Model) WinProb(net1 float64, net2 float64) float64 {
-	return mathutil.Sigmoid((net1 - net2) / m.Sigma)
+	return m.Prob(Team{Net: net1}, Team{Net: net2})
+}
+
+// WithHistory returns a copy of the model whose history-aware components have
+// been updated with the given completed games, in order.
+func (m *Model) WithHistory(teams map[string]Team, history []Game) *Model {
+	out := *m
+	switch m.Kind {
+	case KindElo:
+		out.eloDelta = eloDeltas(&out, teams, history)
+	case KindBlend:
+		out.Components = make([]Component, len(m.Components))
+		for i, c := range m.Components {
+			out.Components[i] = Component{Weight: c.Weight, Model: *c.Model.WithHistory(teams, history)}
+		}
+	}
+	return &out
 }
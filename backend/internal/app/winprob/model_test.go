@@ -2,6 +2,7 @@ package winprob
 
 import (
 	"math"
+	"strings"
 	"testing"
 )
 
@@ -108,3 +109,42 @@ func TestThatWinProbFavorsBetterTeam(t *testing.T) {
 		t.Errorf("expected probability > 0.5, got %f", prob)
 	}
 }
+
+func TestThatKindsListsEveryBuiltInModel(t *testing.T) {
+	// GIVEN the package's built-in registrations
+
+	// WHEN Kinds is called
+	kinds := Kinds()
+
+	// THEN every built-in kind is listed in sorted order
+	want := []string{"blend", "elo", "kenpom", "log5", "seed", "tempo"}
+	if strings.Join(kinds, ",") != strings.Join(want, ",") {
+		t.Errorf("expected %v, got %v", want, kinds)
+	}
+}
+
+func TestThatRegisterPanicsOnDuplicateKind(t *testing.T) {
+	// GIVEN a kind that is already registered
+	defer func() {
+		// THEN Register panics
+		if recover() == nil {
+			t.Error("expected panic for duplicate kind")
+		}
+	}()
+
+	// WHEN it is registered again
+	Register(KindKenPom, Kind{})
+}
+
+func TestThatProbReturns0Point5ForUnknownKind(t *testing.T) {
+	// GIVEN a model with an unregistered kind
+	m := &Model{Kind: "unknown", Sigma: 10.0}
+
+	// WHEN Prob is called
+	prob := m.Prob(Team{Net: 20.0}, Team{Net: 5.0})
+
+	// THEN the probability is 0.5
+	if prob != 0.5 {
+		t.Errorf("expected 0.5, got %f", prob)
+	}
+}
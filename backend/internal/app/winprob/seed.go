@@ -0,0 +1,48 @@
+package winprob
+
+import "github.com/andrewcopp/Calcutta/backend/internal/mathutil"
+
+// KindSeed is a prior built from tournament seed history. First-round seed
+// pairings use historical win rates; other pairings compare the typical net
+// rating of each seed line.
+const KindSeed = "seed"
+
+// seedR64WinRate is the historical rate at which the better seed wins each
+// Round of 64 pairing in the 64-team era, keyed by the better seed.
+var seedR64WinRate = map[int]float64{
+	1: 0.987, 2: 0.929, 3: 0.853, 4: 0.795,
+	5: 0.647, 6: 0.615, 7: 0.609, 8: 0.487,
+}
+
+// seedTypicalNet is the typical KenPom net rating of an at-large or
+// automatic bid on each seed line.
+var seedTypicalNet = map[int]float64{
+	1: 27.0, 2: 23.5, 3: 21.0, 4: 19.0, 5: 17.0, 6: 16.0, 7: 14.5, 8: 13.5,
+	9: 13.0, 10: 12.5, 11: 12.0, 12: 10.0, 13: 6.5, 14: 4.0, 15: 1.0, 16: -4.0,
+}
+
+func init() {
+	Register(KindSeed, Kind{
+		Prob: func(m *Model, a, b Team) float64 {
+			if a.Seed == b.Seed {
+				return 0.5
+			}
+			if a.Seed+b.Seed == 17 {
+				if p, ok := seedR64WinRate[min(a.Seed, b.Seed)]; ok {
+					if a.Seed < b.Seed {
+						return p
+					}
+					return 1 - p
+				}
+			}
+			return mathutil.Sigmoid((seedNet(a.Seed) - seedNet(b.Seed)) / m.Sigma)
+		},
+	})
+}
+
+func seedNet(seed int) float64 {
+	if n, ok := seedTypicalNet[seed]; ok {
+		return n
+	}
+	return seedTypicalNet[16]
+}
@@ -0,0 +1,58 @@
+package winprob
+
+import (
+	"math"
+	"testing"
+)
+
+func TestThatSeedUsesHistoricalRateForFirstRoundPairing(t *testing.T) {
+	// GIVEN a seed model
+	m := &Model{Kind: KindSeed, Sigma: 10.0}
+
+	// WHEN a 5 seed plays a 12 seed
+	prob := m.Prob(Team{Seed: 5}, Team{Seed: 12})
+
+	// THEN the historical 5-over-12 rate is returned
+	if math.Abs(prob-seedR64WinRate[5]) > 1e-9 {
+		t.Errorf("expected %f, got %f", seedR64WinRate[5], prob)
+	}
+}
+
+func TestThatSeedIsSymmetricForFirstRoundPairing(t *testing.T) {
+	// GIVEN a seed model
+	m := &Model{Kind: KindSeed, Sigma: 10.0}
+
+	// WHEN both orderings of a 1-16 game are priced
+	sum := m.Prob(Team{Seed: 1}, Team{Seed: 16}) + m.Prob(Team{Seed: 16}, Team{Seed: 1})
+
+	// THEN the probabilities sum to 1
+	if math.Abs(sum-1.0) > 1e-9 {
+		t.Errorf("expected 1.0, got %f", sum)
+	}
+}
+
+func TestThatSeedFavorsBetterSeedInLaterRounds(t *testing.T) {
+	// GIVEN a seed model
+	m := &Model{Kind: KindSeed, Sigma: 10.0}
+
+	// WHEN a 2 seed plays a 7 seed
+	prob := m.Prob(Team{Seed: 2}, Team{Seed: 7})
+
+	// THEN the 2 seed is favored
+	if prob <= 0.5 {
+		t.Errorf("expected probability > 0.5, got %f", prob)
+	}
+}
+
+func TestThatSeedDoesNotUseNetRatings(t *testing.T) {
+	// GIVEN a seed model
+	m := &Model{Kind: KindSeed, Sigma: 10.0}
+
+	// WHEN UsesNet is called
+	usesNet := m.UsesNet()
+
+	// THEN it reports false
+	if usesNet {
+		t.Error("expected seed model not to use net ratings")
+	}
+}
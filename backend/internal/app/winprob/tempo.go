@@ -0,0 +1,101 @@
+package winprob
+
+import (
+	"errors"
+	"math"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/mathutil"
+)
+
+// KindTempo projects each team's score from adjusted offensive and defensive
+// efficiency over the expected number of possessions, then prices the
+// projected margin with a normal distribution. Sigma is the standard
+// deviation of the margin at league-average tempo; it grows with the square
+// root of possessions. Teams missing efficiencies fall back to net rating.
+const KindTempo = "tempo"
+
+const (
+	defaultLeagueTempo       = 67.5
+	defaultLeagueEfficiency  = 105.0
+	defaultProximityMiles    = 500.0
+	possessionsPerEfficiency = 100.0
+)
+
+func init() {
+	Register(KindTempo, Kind{
+		Normalize: func(m *Model) {
+			if m.LeagueTempo <= 0 {
+				m.LeagueTempo = defaultLeagueTempo
+			}
+			if m.LeagueEfficiency <= 0 {
+				m.LeagueEfficiency = defaultLeagueEfficiency
+			}
+			if m.HomeCourt > 0 && m.ProximityMiles <= 0 {
+				m.ProximityMiles = defaultProximityMiles
+			}
+		},
+		Validate: func(m *Model) error {
+			if m.LeagueTempo <= 0 || m.LeagueEfficiency <= 0 {
+				return errors.New("league tempo and efficiency must be positive")
+			}
+			if m.HomeCourt < 0 {
+				return errors.New("home court must not be negative")
+			}
+			return nil
+		},
+		Prob: func(m *Model, a, b Team) float64 {
+			return tempoProb(m, a, b, nil)
+		},
+		ProbAtSite: tempoProb,
+		Spread:     tempoSpread,
+		UsesNet:    alwaysUsesNet,
+	})
+}
+
+func tempoProb(m *Model, a, b Team, site *Location) float64 {
+	spread, sd := tempoSpread(m, a, b, site)
+	return mathutil.NormalCDF(spread / sd)
+}
+
+func tempoSpread(m *Model, a, b Team, site *Location) (float64, float64) {
+	possessions := tempoPossessions(m, a, b)
+	var spread float64
+	if hasEfficiencies(a) && hasEfficiencies(b) {
+		pointsA := a.ORtg * b.DRtg / m.LeagueEfficiency * possessions / possessionsPerEfficiency
+		pointsB := b.ORtg * a.DRtg / m.LeagueEfficiency * possessions / possessionsPerEfficiency
+		spread = pointsA - pointsB
+	} else {
+		spread = (a.Net - b.Net) * possessions / possessionsPerEfficiency
+	}
+	spread += homeCourtEdge(m, a, site) - homeCourtEdge(m, b, site)
+	sd := m.Sigma * math.Sqrt(possessions/m.LeagueTempo)
+	return spread, sd
+}
+
+func tempoPossessions(m *Model, a, b Team) float64 {
+	ta, tb := a.AdjT, b.AdjT
+	if ta <= 0 {
+		ta = m.LeagueTempo
+	}
+	if tb <= 0 {
+		tb = m.LeagueTempo
+	}
+	return ta * tb / m.LeagueTempo
+}
+
+func hasEfficiencies(t Team) bool {
+	return t.ORtg > 0 && t.DRtg > 0
+}
+
+// homeCourtEdge is the share of HomeCourt a team earns from its proximity to
+// the site: all of it on campus, none beyond ProximityMiles.
+func homeCourtEdge(m *Model, t Team, site *Location) float64 {
+	if m.HomeCourt <= 0 || site == nil || t.Home == nil {
+		return 0
+	}
+	share := 1 - t.Home.Miles(*site)/m.ProximityMiles
+	if share <= 0 {
+		return 0
+	}
+	return m.HomeCourt * share
+}
@@ -0,0 +1,149 @@
+package winprob
+
+import (
+	"math"
+	"testing"
+)
+
+func TestThatTempoSpreadIsZeroForIdenticalTeams(t *testing.T) {
+	// GIVEN two teams with identical efficiencies and tempo
+	m := &Model{Kind: KindTempo}
+	m.Normalize()
+	a := Team{ID: "a", ORtg: 115, DRtg: 95, AdjT: 70}
+	b := Team{ID: "b", ORtg: 115, DRtg: 95, AdjT: 70}
+
+	// WHEN Spread is called
+	spread, _, _ := m.Spread(a, b, nil)
+
+	// THEN the projected margin is zero
+	if math.Abs(spread) > 1e-9 {
+		t.Errorf("expected 0, got %f", spread)
+	}
+}
+
+func TestThatTempoSpreadProjectsPointsFromEfficiencies(t *testing.T) {
+	// GIVEN two league-tempo teams at league-average tempo and efficiency constants
+	m := &Model{Kind: KindTempo, LeagueTempo: 70, LeagueEfficiency: 100}
+	m.Normalize()
+	a := Team{ID: "a", ORtg: 110, DRtg: 90, AdjT: 70}
+	b := Team{ID: "b", ORtg: 100, DRtg: 100, AdjT: 70}
+
+	// WHEN Spread is called
+	spread, _, _ := m.Spread(a, b, nil)
+
+	// THEN it is a's points (110*100/100*0.7) minus b's (100*90/100*0.7)
+	if math.Abs(spread-14.0) > 1e-9 {
+		t.Errorf("expected 14.0, got %f", spread)
+	}
+}
+
+func TestThatTempoSpreadWidensWithPossessions(t *testing.T) {
+	// GIVEN the same pair of efficiencies played slow and fast
+	m := &Model{Kind: KindTempo}
+	m.Normalize()
+	slowA, slowB := Team{ORtg: 115, DRtg: 95, AdjT: 60}, Team{ORtg: 105, DRtg: 100, AdjT: 60}
+	fastA, fastB := Team{ORtg: 115, DRtg: 95, AdjT: 75}, Team{ORtg: 105, DRtg: 100, AdjT: 75}
+
+	// WHEN spreads are projected for both
+	slow, _, _ := m.Spread(slowA, slowB, nil)
+	fast, _, _ := m.Spread(fastA, fastB, nil)
+
+	// THEN the faster game has the larger margin
+	if fast <= slow {
+		t.Errorf("expected fast spread %f to exceed slow spread %f", fast, slow)
+	}
+}
+
+func TestThatTempoProbIsComplementary(t *testing.T) {
+	// GIVEN a tempo model and two unequal teams
+	m := &Model{Kind: KindTempo}
+	m.Normalize()
+	a := Team{ORtg: 118, DRtg: 94, AdjT: 68}
+	b := Team{ORtg: 108, DRtg: 99, AdjT: 72}
+
+	// WHEN Prob is called in both orientations
+	sum := m.Prob(a, b) + m.Prob(b, a)
+
+	// THEN the probabilities sum to 1
+	if math.Abs(sum-1.0) > 1e-9 {
+		t.Errorf("expected 1.0, got %f", sum)
+	}
+}
+
+func TestThatTempoFallsBackToNetWithoutEfficiencies(t *testing.T) {
+	// GIVEN teams with net ratings but no efficiencies
+	m := &Model{Kind: KindTempo}
+	m.Normalize()
+	a, b := Team{Net: 10}, Team{Net: 0}
+
+	// WHEN Spread is called
+	spread, _, _ := m.Spread(a, b, nil)
+
+	// THEN the net difference is scaled to league-tempo possessions
+	want := 10 * defaultLeagueTempo / 100
+	if math.Abs(spread-want) > 1e-9 {
+		t.Errorf("expected %f, got %f", want, spread)
+	}
+}
+
+func TestThatTempoHomeCourtFavorsNearbyTeam(t *testing.T) {
+	// GIVEN a home-court model and equal teams, one playing on its campus
+	m := &Model{Kind: KindTempo, HomeCourt: 3.5}
+	m.Normalize()
+	site := &Location{Latitude: 36.0, Longitude: -79.0}
+	home := Team{ORtg: 110, DRtg: 100, AdjT: 68, Home: &Location{Latitude: 36.0, Longitude: -79.0}}
+	away := Team{ORtg: 110, DRtg: 100, AdjT: 68, Home: &Location{Latitude: 34.0, Longitude: -118.0}}
+
+	// WHEN Spread is called at the site
+	spread, _, _ := m.Spread(home, away, site)
+
+	// THEN the home team gets the full home-court edge
+	if math.Abs(spread-3.5) > 1e-9 {
+		t.Errorf("expected 3.5, got %f", spread)
+	}
+}
+
+func TestThatTempoIgnoresSiteWhenHomeCourtIsOff(t *testing.T) {
+	// GIVEN a model without home court and a team playing on its campus
+	m := &Model{Kind: KindTempo}
+	m.Normalize()
+	site := &Location{Latitude: 36.0, Longitude: -79.0}
+	home := Team{ORtg: 110, DRtg: 100, AdjT: 68, Home: site}
+	away := Team{ORtg: 110, DRtg: 100, AdjT: 68}
+
+	// WHEN ProbAtSite is called
+	prob := m.ProbAtSite(home, away, site)
+
+	// THEN the game is a coin flip
+	if math.Abs(prob-0.5) > 1e-9 {
+		t.Errorf("expected 0.5, got %f", prob)
+	}
+}
+
+func TestThatSpreadIsUnavailableForKindsWithoutScores(t *testing.T) {
+	// GIVEN a kenpom model
+	m := &Model{Kind: KindKenPom}
+	m.Normalize()
+
+	// WHEN Spread is called
+	_, _, ok := m.Spread(Team{Net: 5}, Team{Net: 0}, nil)
+
+	// THEN no spread is projected
+	if ok {
+		t.Error("expected kenpom to have no spread")
+	}
+}
+
+func TestThatTempoValidateRejectsNegativeHomeCourt(t *testing.T) {
+	// GIVEN a tempo model with a negative home-court edge
+	m := &Model{Kind: KindTempo, HomeCourt: -1}
+	m.Normalize()
+
+	// WHEN Validate is called
+	err := m.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for negative home court")
+	}
+}
@@ -0,0 +1,72 @@
+package workers
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	dbadapters "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	appauction "github.com/andrewcopp/Calcutta/backend/internal/app/auction"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const defaultAuctionWorkerPollInterval = 5 * time.Second
+
+// AuctionWorker sells auction lots whose countdown has run out. The API
+// closes lots on in-memory timers; this sweep catches the lots whose timer
+// was lost to a restart or armed on another replica.
+type AuctionWorker struct {
+	pool    *pgxpool.Pool
+	service *appauction.Service
+}
+
+// NewAuctionWorker creates a new AuctionWorker.
+func NewAuctionWorker(pool *pgxpool.Pool) *AuctionWorker {
+	w := &AuctionWorker{pool: pool}
+	if pool != nil {
+		poolRepo := dbadapters.NewPoolRepository(pool)
+		tournamentRepo := dbadapters.NewTournamentRepository(pool)
+		w.service = appauction.New(appauction.Ports{
+			Auctions:       dbadapters.NewAuctionRepository(pool),
+			Pools:          poolRepo,
+			Portfolios:     poolRepo,
+			Teams:          tournamentRepo,
+			Tournaments:    tournamentRepo,
+			BiddingWindows: dbadapters.NewBiddingWindowRepository(pool),
+			Changes:        dbadapters.NewChangeFeed(pool),
+		})
+	}
+	return w
+}
+
+// Run starts the auction worker loop.
+func (w *AuctionWorker) Run(ctx context.Context) {
+	w.RunWithOptions(ctx, defaultAuctionWorkerPollInterval)
+}
+
+// RunWithOptions starts the worker loop with a custom poll interval. Expired
+// lots are swept once at startup and then on every tick.
+func (w *AuctionWorker) RunWithOptions(ctx context.Context, pollInterval time.Duration) {
+	if w == nil || w.pool == nil {
+		slog.Warn("auction_worker_disabled", "reason", "database pool not available")
+		<-ctx.Done()
+		return
+	}
+	if pollInterval <= 0 {
+		pollInterval = defaultAuctionWorkerPollInterval
+	}
+
+	t := time.NewTicker(pollInterval)
+	defer t.Stop()
+
+	for {
+		if err := w.service.CloseExpiredLots(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("auction_worker poll_failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
@@ -22,6 +22,9 @@ type LabPipelineWorkerConfig struct {
 	PythonBin          string
 	RunJobsMaxAttempts int
 	WorkerID           string
+	// EvaluationTargetSE, when positive, stops evaluations once estimates
+	// are this precise.
+	EvaluationTargetSE float64
 }
 
 // LabPipelineWorker processes lab pipeline jobs (predictions, optimization, evaluation).
@@ -58,6 +58,7 @@ func (w *LabPipelineWorker) processEvaluationJob(ctx context.Context, workerID s
 	evalService := appcalcuttaevaluations.New(w.pool,
 		appcalcuttaevaluations.WithTournamentResolver(dbadapters.NewTournamentQueryRepository(w.pool)),
 		appcalcuttaevaluations.WithEnqueuer(w.enqueuer),
+		appcalcuttaevaluations.WithTargetStandardError(w.cfg.EvaluationTargetSE),
 	)
 	result, err := evalService.EvaluateLabEntry(ctx, calcuttaID, labEntryBids, params.ExcludedEntryName)
 	if err != nil {
@@ -143,6 +144,6 @@ func (w *LabPipelineWorker) processEvaluationJob(ctx context.Context, workerID s
 		slog.Warn("lab_pipeline_worker update_calcutta_run_completed", "error", err)
 	}
 
-	slog.Info("lab_pipeline_worker evaluation_success", "worker_id", workerID, "run_id", job.RunID, "evaluation_id", evaluationID, "n_sims", result.NSims, "mean_payout", result.MeanNormalizedPayout, "p_top1", result.PTop1, "dur_ms", dur.Milliseconds())
+	slog.Info("lab_pipeline_worker evaluation_success", "worker_id", workerID, "run_id", job.RunID, "evaluation_id", evaluationID, "n_sims", result.NSims, "mean_payout", result.MeanNormalizedPayout, "mean_payout_se", result.MeanPayoutSE, "p_top1", result.PTop1, "p_top1_se", result.PTop1SE, "dur_ms", dur.Milliseconds())
 	return true
 }
@@ -0,0 +1,115 @@
+package workers
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	dbadapters "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	appreveal "github.com/andrewcopp/Calcutta/backend/internal/app/reveal"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const defaultRevealWorkerPollInterval = 30 * time.Second
+
+// RevealWorker freezes each pool's market summary when its bids are
+// revealed, so the summary is what the market looked like at the reveal
+// rather than whenever it is first asked for.
+type RevealWorker struct {
+	pool        *pgxpool.Pool
+	reveals     *dbadapters.MarketRevealRepository
+	pools       *dbadapters.PoolRepository
+	tournaments *dbadapters.TournamentRepository
+	windows     *dbadapters.BiddingWindowRepository
+	service     *appreveal.Service
+}
+
+// NewRevealWorker creates a new RevealWorker.
+func NewRevealWorker(pool *pgxpool.Pool) *RevealWorker {
+	w := &RevealWorker{pool: pool}
+	if pool != nil {
+		w.reveals = dbadapters.NewMarketRevealRepository(pool)
+		w.pools = dbadapters.NewPoolRepository(pool)
+		w.tournaments = dbadapters.NewTournamentRepository(pool)
+		w.windows = dbadapters.NewBiddingWindowRepository(pool)
+		w.service = appreveal.New(appreveal.Ports{
+			Reveals:     w.reveals,
+			Pools:       w.pools,
+			Portfolios:  w.pools,
+			Predictions: dbadapters.NewPredictionRepository(pool),
+		})
+	}
+	return w
+}
+
+// Run starts the reveal worker loop.
+func (w *RevealWorker) Run(ctx context.Context) {
+	w.RunWithOptions(ctx, defaultRevealWorkerPollInterval)
+}
+
+// RunWithOptions starts the worker loop with a custom poll interval. Pools
+// are checked once at startup and then on every tick.
+func (w *RevealWorker) RunWithOptions(ctx context.Context, pollInterval time.Duration) {
+	if w == nil || w.pool == nil {
+		slog.Warn("reveal_worker_disabled", "reason", "database pool not available")
+		<-ctx.Done()
+		return
+	}
+	if pollInterval <= 0 {
+		pollInterval = defaultRevealWorkerPollInterval
+	}
+
+	t := time.NewTicker(pollInterval)
+	defer t.Stop()
+
+	for {
+		if err := w.poll(ctx, time.Now()); err != nil && ctx.Err() == nil {
+			slog.Warn("reveal_worker poll_failed", "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (w *RevealWorker) poll(ctx context.Context, now time.Time) error {
+	poolIDs, err := w.reveals.ListPoolIDsAwaitingReveal(ctx, now)
+	if err != nil {
+		return err
+	}
+	for _, poolID := range poolIDs {
+		if err := w.freeze(ctx, poolID, now); err != nil {
+			// One pool's failure should not hold up the others.
+			slog.Warn("reveal_worker freeze_failed", "pool_id", poolID, "error", err)
+		}
+	}
+	return nil
+}
+
+func (w *RevealWorker) freeze(ctx context.Context, poolID string, now time.Time) error {
+	pool, err := w.pools.GetByID(ctx, poolID)
+	if err != nil {
+		return fmt.Errorf("getting pool: %w", err)
+	}
+	tournament, err := w.tournaments.GetByID(ctx, pool.TournamentID)
+	if err != nil {
+		return fmt.Errorf("getting tournament %s: %w", pool.TournamentID, err)
+	}
+	windows, err := w.windows.ListBiddingWindows(ctx, pool.ID)
+	if err != nil {
+		return fmt.Errorf("listing bidding windows: %w", err)
+	}
+
+	reveal, err := w.service.FreezeDue(ctx, pool, models.NewPoolBiddingSchedule(pool, tournament, windows), now)
+	if err != nil {
+		return err
+	}
+	if reveal != nil {
+		slog.Info("reveal_worker market_frozen", "pool_id", pool.ID, "revealed_at", reveal.RevealedAt)
+	}
+	return nil
+}
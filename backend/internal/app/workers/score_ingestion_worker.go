@@ -0,0 +1,116 @@
+package workers
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"time"
+
+	dbadapters "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
+	appbracket "github.com/andrewcopp/Calcutta/backend/internal/app/bracket"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/jobqueue"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/scorefeed"
+	"github.com/andrewcopp/Calcutta/backend/internal/ports"
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const defaultScoreIngestionWorkerPollInterval = 60 * time.Second
+
+// ScoreIngestionWorker polls a results feed and records final results in
+// the bracket, then queues a prediction refresh for each changed tournament.
+type ScoreIngestionWorker struct {
+	pool     *pgxpool.Pool
+	feed     ports.ScoreFeed
+	ingester *scorefeed.Service
+	enqueuer *jobqueue.Enqueuer
+	interval time.Duration
+}
+
+// NewScoreIngestionWorker creates a new ScoreIngestionWorker. A nil feed
+// leaves the worker disabled.
+func NewScoreIngestionWorker(pool *pgxpool.Pool, feed ports.ScoreFeed, interval time.Duration) *ScoreIngestionWorker {
+	w := &ScoreIngestionWorker{pool: pool, feed: feed, interval: interval}
+	if pool != nil {
+		gameResultRepo := dbadapters.NewGameResultRepository(pool)
+		w.ingester = scorefeed.New(scorefeed.Ports{
+			Feeds:       dbadapters.NewScoreFeedRepository(pool),
+			Tournaments: dbadapters.NewTournamentQueryRepository(pool),
+			Bracket:     appbracket.New(dbadapters.NewTournamentRepository(pool), gameResultRepo, gameResultRepo),
+		})
+		w.enqueuer = jobqueue.NewEnqueuer(pool)
+	}
+	return w
+}
+
+// Run starts the score ingestion worker loop.
+func (w *ScoreIngestionWorker) Run(ctx context.Context) {
+	w.RunWithOptions(ctx, w.interval)
+}
+
+// RunWithOptions starts the worker loop with a custom poll interval. The feed
+// is polled once at startup and then on every tick.
+func (w *ScoreIngestionWorker) RunWithOptions(ctx context.Context, pollInterval time.Duration) {
+	if w == nil || w.pool == nil {
+		slog.Warn("score_ingestion_worker_disabled", "reason", "database pool not available")
+		<-ctx.Done()
+		return
+	}
+	if w.feed == nil {
+		slog.Warn("score_ingestion_worker_disabled", "reason", "no score feed configured")
+		<-ctx.Done()
+		return
+	}
+	if pollInterval <= 0 {
+		pollInterval = defaultScoreIngestionWorkerPollInterval
+	}
+
+	t := time.NewTicker(pollInterval)
+	defer t.Stop()
+
+	for {
+		if err := w.poll(ctx); err != nil && ctx.Err() == nil {
+			slog.Warn("score_ingestion_worker poll_failed", "source", w.feed.Source(), "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-t.C:
+		}
+	}
+}
+
+func (w *ScoreIngestionWorker) poll(ctx context.Context) error {
+	results, err := w.feed.Fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("fetching feed: %w", err)
+	}
+	if len(results) == 0 {
+		return nil
+	}
+
+	report, err := w.ingester.Ingest(ctx, w.feed.Source(), results)
+	if err != nil {
+		return fmt.Errorf("ingesting results: %w", err)
+	}
+	if report.Applied > 0 || report.NeedsReview > 0 || report.Conflicts > 0 {
+		slog.Info("score_ingestion_worker ingested",
+			"source", w.feed.Source(),
+			"applied", report.Applied,
+			"already_applied", report.AlreadyApplied,
+			"live", report.Live,
+			"needs_review", report.NeedsReview,
+			"unmatched", report.Unmatched,
+			"conflicts", report.Conflicts,
+			"invalid", report.Invalid)
+	}
+
+	for _, tournamentID := range report.TournamentIDs {
+		params, _ := json.Marshal(refreshPredictionsParams{TournamentID: tournamentID})
+		dedupKey := fmt.Sprintf("refresh_predictions:%s", tournamentID)
+		if _, err := w.enqueuer.Enqueue(ctx, jobqueue.KindRefreshPredictions, params, jobqueue.PriorityCoreApp, dedupKey); err != nil {
+			slog.Warn("score_ingestion_worker enqueue_refresh_failed", "tournament_id", tournamentID, "error", err)
+		}
+	}
+	return nil
+}
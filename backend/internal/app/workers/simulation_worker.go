@@ -10,6 +10,7 @@ import (
 	dbadapters "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/jobqueue"
 	"github.com/andrewcopp/Calcutta/backend/internal/app/simulation"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/winprob"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -40,6 +41,18 @@ type runSimulationParams struct {
 	Seed                 int    `json:"seed"`
 	StartingStateKey     string `json:"startingStateKey"`
 	ProbabilitySourceKey string `json:"probabilitySourceKey"`
+	// GameOutcomeSpec selects the win-probability model; nil reuses the spec
+	// of the latest prediction batch.
+	GameOutcomeSpec *winprob.Model `json:"gameOutcomeSpec,omitempty"`
+	// Storage is simulation.StorageRows or simulation.StoragePacked; empty
+	// means rows.
+	Storage string `json:"storage,omitempty"`
+	// StrengthUncertainty correlates each team's games within a simulation;
+	// nil plays every game independently.
+	StrengthUncertainty *simulation.StrengthUncertainty `json:"strengthUncertainty,omitempty"`
+	// Sampling selects a variance-reduction method; nil runs independent
+	// simulations.
+	Sampling *simulation.Sampling `json:"sampling,omitempty"`
 }
 
 // Run starts the simulation worker loop.
@@ -134,6 +147,10 @@ func (w *SimulationWorker) processRunSimulation(ctx context.Context, job *jobque
 		BatchSize:            1000,
 		ProbabilitySourceKey: params.ProbabilitySourceKey,
 		StartingStateKey:     params.StartingStateKey,
+		GameOutcomeSpec:      params.GameOutcomeSpec,
+		Storage:              params.Storage,
+		StrengthUncertainty:  params.StrengthUncertainty,
+		Sampling:             params.Sampling,
 	})
 	if err != nil {
 		slog.Warn("simulation_worker run_failed", "season", params.Season, "error", err)
@@ -151,6 +168,7 @@ func (w *SimulationWorker) processRunSimulation(ctx context.Context, job *jobque
 		"season", params.Season,
 		"batch_id", result.TournamentSimulationBatchID,
 		"n_sims", result.NSims,
+		"storage", result.Storage,
 		"rows_written", result.RowsWritten,
 		"duration_ms", time.Since(start).Milliseconds())
 }
@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/bundles"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -189,7 +190,12 @@ func exportPools(ctx context.Context, pool *pgxpool.Pool, outDir string, generat
 			comp.name || ' (' || seas.year || ')' AS tournament_name,
 			COALESCE(u.email, ''),
 			COALESCE(u.first_name, ''),
-			COALESCE(u.last_name, '')
+			COALESCE(u.last_name, ''),
+			p.ownership_mode,
+			p.ownership_cap_percent,
+			p.unclaimed_mode,
+			p.tie_breaker,
+			p.max_portfolios_per_user
 		FROM core.pools p
 		JOIN core.tournaments t ON t.id = p.tournament_id
 		JOIN core.competitions comp ON comp.id = t.competition_id
@@ -207,10 +213,24 @@ func exportPools(ctx context.Context, pool *pgxpool.Pool, outDir string, generat
 
 	for r.Next() {
 		var poolID, poolName, ownerID, tournamentKey, tournamentName string
-		var email, first, last string
-		if err := r.Scan(&poolID, &poolName, &ownerID, &tournamentKey, &tournamentName, &email, &first, &last); err != nil {
+		var email, first, last, ownershipMode, unclaimedMode, tieBreaker string
+		var ownershipCapPercent *int
+		var maxPortfoliosPerUser int
+		if err := r.Scan(&poolID, &poolName, &ownerID, &tournamentKey, &tournamentName, &email, &first, &last, &ownershipMode, &ownershipCapPercent, &unclaimedMode, &tieBreaker, &maxPortfoliosPerUser); err != nil {
 			return err
 		}
+		if ownershipMode == models.OwnershipModeProportional {
+			ownershipMode = ""
+		}
+		if unclaimedMode == models.UnclaimedModeForfeit {
+			unclaimedMode = ""
+		}
+		if tieBreaker == models.TieBreakerSplit {
+			tieBreaker = ""
+		}
+		if maxPortfoliosPerUser == models.DefaultMaxPortfoliosPerUser {
+			maxPortfoliosPerUser = 0
+		}
 
 		if usedPoolKeysByTournament[tournamentKey] == nil {
 			usedPoolKeysByTournament[tournamentKey] = make(map[string]int)
@@ -246,9 +266,14 @@ func exportPools(ctx context.Context, pool *pgxpool.Pool, outDir string, generat
 			GeneratedAt: generatedAt,
 			Tournament:  bundles.TournamentRef{ImportKey: tournamentKey, Name: tournamentName},
 			Pool: bundles.PoolRecord{
-				Key:   poolKey,
-				Name:  poolName,
-				Owner: owner,
+				Key:                  poolKey,
+				Name:                 poolName,
+				Owner:                owner,
+				OwnershipMode:        ownershipMode,
+				OwnershipCapPercent:  ownershipCapPercent,
+				UnclaimedMode:        unclaimedMode,
+				TieBreaker:           tieBreaker,
+				MaxPortfoliosPerUser: maxPortfoliosPerUser,
 			},
 			Rounds:      rounds,
 			Payouts:     payouts,
@@ -265,7 +290,7 @@ func exportPools(ctx context.Context, pool *pgxpool.Pool, outDir string, generat
 }
 
 func loadScoringRules(ctx context.Context, pool *pgxpool.Pool, poolID string) ([]bundles.RoundRecord, error) {
-	r, err := pool.Query(ctx, `SELECT win_index AS round, points_awarded AS points FROM core.pool_scoring_rules WHERE pool_id = $1 AND deleted_at IS NULL ORDER BY win_index ASC`, poolID)
+	r, err := pool.Query(ctx, `SELECT kind, win_index AS round, points_awarded AS points FROM core.pool_scoring_rules WHERE pool_id = $1 AND deleted_at IS NULL ORDER BY win_index ASC, kind ASC`, poolID)
 	if err != nil {
 		return nil, err
 	}
@@ -273,11 +298,15 @@ func loadScoringRules(ctx context.Context, pool *pgxpool.Pool, poolID string) ([
 
 	out := make([]bundles.RoundRecord, 0)
 	for r.Next() {
+		var kind string
 		var round, points int
-		if err := r.Scan(&round, &points); err != nil {
+		if err := r.Scan(&kind, &round, &points); err != nil {
 			return nil, err
 		}
-		out = append(out, bundles.RoundRecord{Round: round, Points: points})
+		if kind == models.ScoringRuleKindRound {
+			kind = ""
+		}
+		out = append(out, bundles.RoundRecord{Round: round, Points: points, Kind: kind})
 	}
 	return out, r.Err()
 }
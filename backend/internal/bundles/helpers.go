@@ -22,6 +22,14 @@ func DerefFloat64(p *float64) float64 {
 	return *p
 }
 
+// DerefInt safely dereferences a *int, returning 0 if nil.
+func DerefInt(p *int) int {
+	if p == nil {
+		return 0
+	}
+	return *p
+}
+
 // ReadJSON reads a JSON file and unmarshals it into the provided value.
 func ReadJSON(path string, v any) error {
 	b, err := os.ReadFile(path)
@@ -9,6 +9,7 @@ import (
 	"strings"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/bundles"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
 	"github.com/jackc/pgx/v5"
 )
 
@@ -65,23 +66,44 @@ func importPools(ctx context.Context, tx pgx.Tx, inDir string) (int, int, int, i
 		}
 		stubUserCount++
 
+		ownershipMode := b.Pool.OwnershipMode
+		if ownershipMode == "" {
+			ownershipMode = models.OwnershipModeProportional
+		}
+		unclaimedMode := b.Pool.UnclaimedMode
+		if unclaimedMode == "" {
+			unclaimedMode = models.UnclaimedModeForfeit
+		}
+		tieBreaker := b.Pool.TieBreaker
+		if tieBreaker == "" {
+			tieBreaker = models.TieBreakerSplit
+		}
+		maxPortfoliosPerUser := b.Pool.MaxPortfoliosPerUser
+		if maxPortfoliosPerUser == 0 {
+			maxPortfoliosPerUser = models.DefaultMaxPortfoliosPerUser
+		}
+
 		var poolID string
 		err = tx.QueryRow(ctx, `
-			INSERT INTO core.pools (tournament_id, owner_id, created_by, name)
-			VALUES ($1, $2, $2, $3)
+			INSERT INTO core.pools (tournament_id, owner_id, created_by, name, ownership_mode, ownership_cap_percent, unclaimed_mode, tie_breaker, max_portfolios_per_user)
+			VALUES ($1, $2, $2, $3, $4, $5, $6, $7, $8)
 			RETURNING id
-		`, tournamentID, ownerID, b.Pool.Name).Scan(&poolID)
+		`, tournamentID, ownerID, b.Pool.Name, ownershipMode, b.Pool.OwnershipCapPercent, unclaimedMode, tieBreaker, maxPortfoliosPerUser).Scan(&poolID)
 		if err != nil {
 			return 0, 0, 0, 0, 0, 0, err
 		}
 
 		for _, r := range b.Rounds {
+			kind := r.Kind
+			if kind == "" {
+				kind = models.ScoringRuleKindRound
+			}
 			_, err := tx.Exec(ctx, `
-				INSERT INTO core.pool_scoring_rules (pool_id, win_index, points_awarded)
-				VALUES ($1, $2, $3)
-				ON CONFLICT (pool_id, win_index)
+				INSERT INTO core.pool_scoring_rules (pool_id, kind, win_index, points_awarded)
+				VALUES ($1, $2, $3, $4)
+				ON CONFLICT (pool_id, kind, win_index)
 				DO UPDATE SET points_awarded = EXCLUDED.points_awarded, updated_at = NOW(), deleted_at = NULL
-			`, poolID, r.Round, r.Points)
+			`, poolID, kind, r.Round, r.Points)
 			if err != nil {
 				return 0, 0, 0, 0, 0, 0, err
 			}
@@ -65,10 +65,19 @@ type TournamentRef struct {
 	Name      string `json:"name"`
 }
 
+// PoolRecord omits the ownership mode for proportional pools, the
+// unclaimed-team mode for forfeit pools, the tie-breaker for split pools and
+// the portfolio limit for one-portfolio pools, so bundles written before
+// pools had these settings still read back the same.
 type PoolRecord struct {
-	Key   string   `json:"key"`
-	Name  string   `json:"name"`
-	Owner *UserRef `json:"owner,omitempty"`
+	Key                  string   `json:"key"`
+	Name                 string   `json:"name"`
+	Owner                *UserRef `json:"owner,omitempty"`
+	OwnershipMode        string   `json:"ownership_mode,omitempty"`
+	OwnershipCapPercent  *int     `json:"ownership_cap_percent,omitempty"`
+	UnclaimedMode        string   `json:"unclaimed_mode,omitempty"`
+	TieBreaker           string   `json:"tie_breaker,omitempty"`
+	MaxPortfoliosPerUser int      `json:"max_portfolios_per_user,omitempty"`
 }
 
 type UserRef struct {
@@ -80,6 +89,8 @@ type UserRef struct {
 type RoundRecord struct {
 	Round  int `json:"round"`
 	Points int `json:"points"`
+	// Kind is the scoring rule kind; empty means a per-round rule.
+	Kind string `json:"kind,omitempty"`
 }
 
 type PayoutRecord struct {
@@ -99,3 +110,21 @@ type InvestmentRecord struct {
 	SchoolSlug   string `json:"school_slug"`
 	Credits      int    `json:"credits"`
 }
+
+// MatchupProbabilityBundle is an externally produced pairwise win-probability
+// matrix for one tournament, uploaded under SourceKey. Teams are identified by
+// school slug; a pair may appear in one orientation or both.
+type MatchupProbabilityBundle struct {
+	Version     int                        `json:"version"`
+	GeneratedAt time.Time                  `json:"generated_at"`
+	Tournament  TournamentRef              `json:"tournament"`
+	SourceKey   string                     `json:"source_key"`
+	Description *string                    `json:"description,omitempty"`
+	Matchups    []MatchupProbabilityRecord `json:"matchups"`
+}
+
+type MatchupProbabilityRecord struct {
+	Team1SchoolSlug string  `json:"team1_school_slug"`
+	Team2SchoolSlug string  `json:"team2_school_slug"`
+	PTeam1Wins      float64 `json:"p_team1_wins"`
+}
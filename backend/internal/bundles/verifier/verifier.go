@@ -11,6 +11,7 @@ import (
 	"strings"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/bundles"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -257,12 +258,17 @@ func verifyPools(ctx context.Context, pool *pgxpool.Pool, inDir string) []Mismat
 		var poolID string
 		var poolName string
 		var ownerEmail string
+		var ownershipMode string
+		var ownershipCapPercent *int
+		var unclaimedMode string
+		var tieBreaker string
+		var maxPortfoliosPerUser int
 		err = pool.QueryRow(ctx, `
-			SELECT p.id, p.name, COALESCE(u.email, '')
+			SELECT p.id, p.name, COALESCE(u.email, ''), p.ownership_mode, p.ownership_cap_percent, p.unclaimed_mode, p.tie_breaker, p.max_portfolios_per_user
 			FROM core.pools p
 			JOIN core.users u ON u.id = p.owner_id
 			WHERE p.name = $1 AND p.tournament_id = $2 AND p.deleted_at IS NULL AND u.deleted_at IS NULL
-		`, b.Pool.Name, tournamentID).Scan(&poolID, &poolName, &ownerEmail)
+		`, b.Pool.Name, tournamentID).Scan(&poolID, &poolName, &ownerEmail, &ownershipMode, &ownershipCapPercent, &unclaimedMode, &tieBreaker, &maxPortfoliosPerUser)
 		if err != nil {
 			out = append(out, Mismatch{Where: "pools:" + b.Pool.Key, What: "missing in db"})
 			continue
@@ -271,6 +277,37 @@ func verifyPools(ctx context.Context, pool *pgxpool.Pool, inDir string) []Mismat
 		if poolName != b.Pool.Name {
 			out = append(out, Mismatch{Where: "pools:" + b.Pool.Key, What: fmt.Sprintf("name mismatch db=%q bundle=%q", poolName, b.Pool.Name)})
 		}
+		bundleMode := b.Pool.OwnershipMode
+		if bundleMode == "" {
+			bundleMode = models.OwnershipModeProportional
+		}
+		if ownershipMode != bundleMode {
+			out = append(out, Mismatch{Where: "pools:" + b.Pool.Key, What: fmt.Sprintf("ownership mode mismatch db=%q bundle=%q", ownershipMode, bundleMode)})
+		}
+		if bundles.DerefInt(ownershipCapPercent) != bundles.DerefInt(b.Pool.OwnershipCapPercent) {
+			out = append(out, Mismatch{Where: "pools:" + b.Pool.Key, What: fmt.Sprintf("ownership cap mismatch db=%d bundle=%d", bundles.DerefInt(ownershipCapPercent), bundles.DerefInt(b.Pool.OwnershipCapPercent))})
+		}
+		bundleUnclaimed := b.Pool.UnclaimedMode
+		if bundleUnclaimed == "" {
+			bundleUnclaimed = models.UnclaimedModeForfeit
+		}
+		if unclaimedMode != bundleUnclaimed {
+			out = append(out, Mismatch{Where: "pools:" + b.Pool.Key, What: fmt.Sprintf("unclaimed mode mismatch db=%q bundle=%q", unclaimedMode, bundleUnclaimed)})
+		}
+		bundleTieBreaker := b.Pool.TieBreaker
+		if bundleTieBreaker == "" {
+			bundleTieBreaker = models.TieBreakerSplit
+		}
+		if tieBreaker != bundleTieBreaker {
+			out = append(out, Mismatch{Where: "pools:" + b.Pool.Key, What: fmt.Sprintf("tie breaker mismatch db=%q bundle=%q", tieBreaker, bundleTieBreaker)})
+		}
+		bundleMaxPortfolios := b.Pool.MaxPortfoliosPerUser
+		if bundleMaxPortfolios == 0 {
+			bundleMaxPortfolios = models.DefaultMaxPortfoliosPerUser
+		}
+		if maxPortfoliosPerUser != bundleMaxPortfolios {
+			out = append(out, Mismatch{Where: "pools:" + b.Pool.Key, What: fmt.Sprintf("max portfolios per user mismatch db=%d bundle=%d", maxPortfoliosPerUser, bundleMaxPortfolios)})
+		}
 		if b.Pool.Owner != nil && b.Pool.Owner.Email != nil {
 			if ownerEmail != *b.Pool.Owner.Email {
 				out = append(out, Mismatch{Where: "pools:" + b.Pool.Key, What: fmt.Sprintf("owner email mismatch db=%q bundle=%q", ownerEmail, *b.Pool.Owner.Email)})
@@ -286,19 +323,24 @@ func verifyPools(ctx context.Context, pool *pgxpool.Pool, inDir string) []Mismat
 }
 
 func verifyScoringRules(ctx context.Context, pool *pgxpool.Pool, poolID, poolKey string, rounds []bundles.RoundRecord) []Mismatch {
-	rows, err := pool.Query(ctx, `SELECT win_index AS round, points_awarded AS points FROM core.pool_scoring_rules WHERE pool_id = $1 AND deleted_at IS NULL`, poolID)
+	rows, err := pool.Query(ctx, `SELECT kind, win_index AS round, points_awarded AS points FROM core.pool_scoring_rules WHERE pool_id = $1 AND deleted_at IS NULL`, poolID)
 	if err != nil {
 		return []Mismatch{{Where: "pool_scoring_rules:" + poolKey, What: err.Error()}}
 	}
 	defer rows.Close()
 
-	inDB := map[int]int{}
+	type ruleKey struct {
+		kind  string
+		round int
+	}
+	inDB := map[ruleKey]int{}
 	for rows.Next() {
+		var kind string
 		var r, p int
-		if err := rows.Scan(&r, &p); err != nil {
+		if err := rows.Scan(&kind, &r, &p); err != nil {
 			return []Mismatch{{Where: "pool_scoring_rules:" + poolKey, What: err.Error()}}
 		}
-		inDB[r] = p
+		inDB[ruleKey{kind, r}] = p
 	}
 	if err := rows.Err(); err != nil {
 		return []Mismatch{{Where: "pool_scoring_rules:" + poolKey, What: err.Error()}}
@@ -306,13 +348,17 @@ func verifyScoringRules(ctx context.Context, pool *pgxpool.Pool, poolID, poolKey
 
 	var out []Mismatch
 	for _, rr := range rounds {
-		p, ok := inDB[rr.Round]
+		kind := rr.Kind
+		if kind == "" {
+			kind = models.ScoringRuleKindRound
+		}
+		p, ok := inDB[ruleKey{kind, rr.Round}]
 		if !ok {
-			out = append(out, Mismatch{Where: "pool_scoring_rules:" + poolKey, What: fmt.Sprintf("missing round %d", rr.Round)})
+			out = append(out, Mismatch{Where: "pool_scoring_rules:" + poolKey, What: fmt.Sprintf("missing %s round %d", kind, rr.Round)})
 			continue
 		}
 		if p != rr.Points {
-			out = append(out, Mismatch{Where: "pool_scoring_rules:" + poolKey, What: fmt.Sprintf("round %d points mismatch db=%d bundle=%d", rr.Round, p, rr.Points)})
+			out = append(out, Mismatch{Where: "pool_scoring_rules:" + poolKey, What: fmt.Sprintf("%s round %d points mismatch db=%d bundle=%d", kind, rr.Round, p, rr.Points)})
 		}
 	}
 	return out
@@ -0,0 +1,20 @@
+package mathutil
+
+import "math"
+
+// NormalCDF returns P(X <= x) for a standard normal X.
+func NormalCDF(x float64) float64 {
+	return 0.5 * math.Erfc(-x/math.Sqrt2)
+}
+
+// NormalQuantile returns x such that NormalCDF(x) = p. It returns -Inf for
+// p <= 0 and +Inf for p >= 1.
+func NormalQuantile(p float64) float64 {
+	if p <= 0 {
+		return math.Inf(-1)
+	}
+	if p >= 1 {
+		return math.Inf(1)
+	}
+	return -math.Sqrt2 * math.Erfcinv(2*p)
+}
@@ -0,0 +1,71 @@
+package mathutil
+
+import (
+	"math"
+	"testing"
+)
+
+func TestThatNormalCDFReturnsPointFiveForZero(t *testing.T) {
+	// GIVEN an input of zero
+	x := 0.0
+
+	// WHEN NormalCDF is called
+	result := NormalCDF(x)
+
+	// THEN the result is 0.5
+	if math.Abs(result-0.5) > 1e-12 {
+		t.Errorf("expected 0.5, got %v", result)
+	}
+}
+
+func TestThatNormalCDFMatchesOneStandardDeviation(t *testing.T) {
+	// GIVEN an input of one standard deviation
+	x := 1.0
+
+	// WHEN NormalCDF is called
+	result := NormalCDF(x)
+
+	// THEN the result is approximately 0.8413
+	if math.Abs(result-0.841344746) > 1e-6 {
+		t.Errorf("expected ~0.8413, got %v", result)
+	}
+}
+
+func TestThatNormalCDFIsSymmetric(t *testing.T) {
+	// GIVEN a value and its negation
+	x := 1.7
+
+	// WHEN NormalCDF is called on both
+	sum := NormalCDF(x) + NormalCDF(-x)
+
+	// THEN they sum to 1
+	if math.Abs(sum-1.0) > 1e-12 {
+		t.Errorf("expected 1.0, got %v", sum)
+	}
+}
+
+func TestThatNormalQuantileInvertsNormalCDF(t *testing.T) {
+	// GIVEN a probability
+	p := 0.8
+
+	// WHEN NormalQuantile is applied and the result passed back through NormalCDF
+	result := NormalCDF(NormalQuantile(p))
+
+	// THEN the original probability is recovered
+	if math.Abs(result-p) > 1e-12 {
+		t.Errorf("expected %v, got %v", p, result)
+	}
+}
+
+func TestThatNormalQuantileReturnsZeroForOneHalf(t *testing.T) {
+	// GIVEN a probability of one half
+	p := 0.5
+
+	// WHEN NormalQuantile is called
+	result := NormalQuantile(p)
+
+	// THEN the result is zero
+	if math.Abs(result) > 1e-12 {
+		t.Errorf("expected 0, got %v", result)
+	}
+}
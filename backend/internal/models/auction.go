@@ -0,0 +1,102 @@
+package models
+
+import "time"
+
+// Auction session statuses.
+const (
+	AuctionStatusPending   = "pending"
+	AuctionStatusOpen      = "open"
+	AuctionStatusCompleted = "completed"
+)
+
+// Auction lot statuses.
+const (
+	AuctionLotOpen = "open"
+	AuctionLotSold = "sold"
+)
+
+// Default auction timing for new sessions.
+const (
+	DefaultAuctionLotSeconds          = 30
+	DefaultAuctionBidExtensionSeconds = 10
+	DefaultAuctionMinIncrementCredits = 1
+)
+
+// AuctionSession is a live ascending auction for a pool. Portfolios take turns
+// nominating a team, which is then sold to the highest bidder when its
+// countdown runs out. The winner owns 100% of the team.
+type AuctionSession struct {
+	ID     string `json:"id"`
+	PoolID string `json:"poolId"`
+	Status string `json:"status"`
+	// NominationOrder lists portfolio IDs in nominating order; turns wrap
+	// around until every team has been sold.
+	NominationOrder     []string   `json:"nominationOrder"`
+	NominationIndex     int        `json:"nominationIndex"`
+	LotSeconds          int        `json:"lotSeconds"`
+	BidExtensionSeconds int        `json:"bidExtensionSeconds"`
+	MinIncrementCredits int        `json:"minIncrementCredits"`
+	CreatedBy           *string    `json:"createdBy,omitempty"`
+	StartedAt           *time.Time `json:"startedAt,omitempty"`
+	CompletedAt         *time.Time `json:"completedAt,omitempty"`
+	CreatedAt           time.Time  `json:"createdAt"`
+	UpdatedAt           time.Time  `json:"updatedAt"`
+}
+
+// ApplyDefaults fills in zero-value timing fields.
+func (s *AuctionSession) ApplyDefaults() {
+	if s.LotSeconds == 0 {
+		s.LotSeconds = DefaultAuctionLotSeconds
+	}
+	if s.BidExtensionSeconds == 0 {
+		s.BidExtensionSeconds = DefaultAuctionBidExtensionSeconds
+	}
+	if s.MinIncrementCredits == 0 {
+		s.MinIncrementCredits = DefaultAuctionMinIncrementCredits
+	}
+}
+
+// CurrentNominator returns the portfolio whose turn it is to nominate, or ""
+// when the order is empty.
+func (s *AuctionSession) CurrentNominator() string {
+	if len(s.NominationOrder) == 0 {
+		return ""
+	}
+	return s.NominationOrder[s.NominationIndex%len(s.NominationOrder)]
+}
+
+// AuctionLot is one team put up for auction. The nominator opens the bidding.
+type AuctionLot struct {
+	ID                     string     `json:"id"`
+	SessionID              string     `json:"sessionId"`
+	TeamID                 string     `json:"teamId"`
+	NominatedByPortfolioID string     `json:"nominatedByPortfolioId"`
+	Status                 string     `json:"status"`
+	HighBidCredits         int        `json:"highBidCredits"`
+	HighBidPortfolioID     string     `json:"highBidPortfolioId"`
+	ClosesAt               time.Time  `json:"closesAt"`
+	SoldAt                 *time.Time `json:"soldAt,omitempty"`
+	CreatedAt              time.Time  `json:"createdAt"`
+	UpdatedAt              time.Time  `json:"updatedAt"`
+}
+
+// AuctionBid is an accepted bid on a lot.
+type AuctionBid struct {
+	ID          string    `json:"id"`
+	LotID       string    `json:"lotId"`
+	PortfolioID string    `json:"portfolioId"`
+	Credits     int       `json:"credits"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+// AuctionBidContext is what a bid is validated against, read while the lot
+// is locked.
+type AuctionBidContext struct {
+	Lot                 *AuctionLot
+	MinIncrementCredits int
+	// BidderSpentCredits and BidderTeamsWon cover the bidder's investments:
+	// lots already sold to it, plus any sealed bids before a leftovers round.
+	BidderSpentCredits int
+	BidderTeamsWon     int
+	Now                time.Time
+}
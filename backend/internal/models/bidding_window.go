@@ -0,0 +1,166 @@
+package models
+
+import "time"
+
+// BiddingWindow is a span during which a pool takes bids, open from OpensAt
+// up to but not including ClosesAt.
+type BiddingWindow struct {
+	ID        string     `json:"id"`
+	PoolID    string     `json:"poolId"`
+	OpensAt   time.Time  `json:"opensAt"`
+	ClosesAt  time.Time  `json:"closesAt"`
+	CreatedAt time.Time  `json:"createdAt"`
+	UpdatedAt time.Time  `json:"updatedAt"`
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
+}
+
+// Contains reports whether the window is open at now.
+func (w *BiddingWindow) Contains(now time.Time) bool {
+	return w != nil && !now.Before(w.OpensAt) && now.Before(w.ClosesAt)
+}
+
+const BiddingEditDeniedReasonBiddingClosed = "bidding_closed"
+
+// BiddingSchedule decides when a pool takes bids and when they are
+// revealed. A pool with bidding windows takes them only inside one; a pool
+// without any takes them until its tournament starts.
+type BiddingSchedule struct {
+	Tournament *Tournament
+	Windows    []*BiddingWindow
+	// RevealAt keeps bids sealed after bidding closes until this time.
+	RevealAt *time.Time
+}
+
+func NewBiddingSchedule(tournament *Tournament, windows []*BiddingWindow) BiddingSchedule {
+	return BiddingSchedule{Tournament: tournament, Windows: windows}
+}
+
+// NewPoolBiddingSchedule is NewBiddingSchedule with the pool's reveal time.
+func NewPoolBiddingSchedule(pool *Pool, tournament *Tournament, windows []*BiddingWindow) BiddingSchedule {
+	s := NewBiddingSchedule(tournament, windows)
+	if pool != nil {
+		s.RevealAt = pool.RevealAt
+	}
+	return s
+}
+
+func (s BiddingSchedule) hasWindows() bool {
+	for _, w := range s.Windows {
+		if w != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// IsOpen reports whether bids are accepted at now.
+func (s BiddingSchedule) IsOpen(now time.Time) bool {
+	if !s.hasWindows() {
+		return s.Tournament != nil && !s.Tournament.HasStarted(now)
+	}
+	return s.CurrentWindow(now) != nil
+}
+
+// IsFinished reports whether bidding has closed for good at now: the last
+// window has closed, or without windows, the tournament has started. Bids
+// stay sealed until then, even between windows, and until IsRevealed.
+func (s BiddingSchedule) IsFinished(now time.Time) bool {
+	if !s.hasWindows() {
+		return s.Tournament.HasStarted(now)
+	}
+	for _, w := range s.Windows {
+		if w != nil && now.Before(w.ClosesAt) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsRevealed reports whether bids are no longer sealed at now: bidding has
+// finished and the reveal time, if any, has passed.
+func (s BiddingSchedule) IsRevealed(now time.Time) bool {
+	if !s.IsFinished(now) {
+		return false
+	}
+	return s.RevealAt == nil || !now.Before(*s.RevealAt)
+}
+
+// RevealsAt returns when bids are revealed: the later of the close of
+// bidding and the reveal time. It is nil when bidding has no deadline.
+func (s BiddingSchedule) RevealsAt() *time.Time {
+	var closes *time.Time
+	if !s.hasWindows() {
+		if s.Tournament != nil && s.Tournament.StartingAt != nil {
+			t := *s.Tournament.StartingAt
+			closes = &t
+		}
+	} else {
+		for _, w := range s.Windows {
+			if w != nil && (closes == nil || w.ClosesAt.After(*closes)) {
+				t := w.ClosesAt
+				closes = &t
+			}
+		}
+	}
+	if closes == nil {
+		return nil
+	}
+	if s.RevealAt != nil && s.RevealAt.After(*closes) {
+		t := *s.RevealAt
+		return &t
+	}
+	return closes
+}
+
+// CurrentWindow returns the window open at now, or nil.
+func (s BiddingSchedule) CurrentWindow(now time.Time) *BiddingWindow {
+	for _, w := range s.Windows {
+		if w.Contains(now) {
+			return w
+		}
+	}
+	return nil
+}
+
+// NextWindow returns the earliest window that opens after now, or nil.
+func (s BiddingSchedule) NextWindow(now time.Time) *BiddingWindow {
+	var next *BiddingWindow
+	for _, w := range s.Windows {
+		if w == nil || !w.OpensAt.After(now) {
+			continue
+		}
+		if next == nil || w.OpensAt.Before(next.OpensAt) {
+			next = w
+		}
+	}
+	return next
+}
+
+// ClosesAt returns when the bidding open at now closes: the current window's
+// close, or without windows, the tournament start. It is nil when bidding is
+// not open or has no deadline.
+func (s BiddingSchedule) ClosesAt(now time.Time) *time.Time {
+	if !s.IsOpen(now) {
+		return nil
+	}
+	if !s.hasWindows() {
+		return s.Tournament.StartingAt
+	}
+	closesAt := s.CurrentWindow(now).ClosesAt
+	return &closesAt
+}
+
+// CanEditBids is Tournament.CanEditBids for a pool's schedule. Admins may
+// edit bids at any time.
+func (s BiddingSchedule) CanEditBids(now time.Time, isAdmin bool) (bool, string) {
+	if !s.hasWindows() {
+		return s.Tournament.CanEditBids(now, isAdmin)
+	}
+	if s.Tournament == nil {
+		return false, TournamentEditDeniedReasonTournamentMissing
+	}
+	if isAdmin || s.CurrentWindow(now) != nil {
+		return true, ""
+	}
+	return false, BiddingEditDeniedReasonBiddingClosed
+}
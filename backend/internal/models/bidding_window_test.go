@@ -0,0 +1,110 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func twoWindowSchedule() (BiddingSchedule, time.Time) {
+	start := time.Date(2026, 3, 17, 0, 0, 0, 0, time.UTC)
+	tournament := &Tournament{StartingAt: &start}
+	windows := []*BiddingWindow{
+		{OpensAt: start.Add(-72 * time.Hour), ClosesAt: start.Add(-12 * time.Hour)},
+		{OpensAt: start.Add(48 * time.Hour), ClosesAt: start.Add(60 * time.Hour)},
+	}
+	return NewBiddingSchedule(tournament, windows), start
+}
+
+func TestThatScheduleWithoutWindowsIsOpenBeforeTournamentStarts(t *testing.T) {
+	GIVENStartingAt := time.Date(2026, 3, 17, 0, 0, 0, 0, time.UTC)
+	GIVENSchedule := NewBiddingSchedule(&Tournament{StartingAt: &GIVENStartingAt}, nil)
+	WHENNow := GIVENStartingAt.Add(-1 * time.Second)
+	THENOpen := GIVENSchedule.IsOpen(WHENNow)
+	if THENOpen != true {
+		t.Fatalf("expected true")
+	}
+}
+
+func TestThatScheduleIsClosedBetweenWindows(t *testing.T) {
+	GIVENSchedule, GIVENStartingAt := twoWindowSchedule()
+	WHENNow := GIVENStartingAt.Add(-1 * time.Hour)
+	THENOpen := GIVENSchedule.IsOpen(WHENNow)
+	if THENOpen != false {
+		t.Fatalf("expected false")
+	}
+}
+
+func TestThatScheduleIsOpenInWindowAfterTournamentStarts(t *testing.T) {
+	GIVENSchedule, GIVENStartingAt := twoWindowSchedule()
+	WHENNow := GIVENStartingAt.Add(50 * time.Hour)
+	THENOpen := GIVENSchedule.IsOpen(WHENNow)
+	if THENOpen != true {
+		t.Fatalf("expected true")
+	}
+}
+
+func TestThatScheduleIsNotFinishedBetweenWindows(t *testing.T) {
+	GIVENSchedule, GIVENStartingAt := twoWindowSchedule()
+	WHENNow := GIVENStartingAt.Add(1 * time.Hour)
+	THENFinished := GIVENSchedule.IsFinished(WHENNow)
+	if THENFinished != false {
+		t.Fatalf("expected false")
+	}
+}
+
+func TestThatScheduleIsFinishedWhenLastWindowCloses(t *testing.T) {
+	GIVENSchedule, GIVENStartingAt := twoWindowSchedule()
+	WHENNow := GIVENStartingAt.Add(60 * time.Hour)
+	THENFinished := GIVENSchedule.IsFinished(WHENNow)
+	if THENFinished != true {
+		t.Fatalf("expected true")
+	}
+}
+
+func TestThatScheduleDeniesBidEditsBetweenWindowsAsBiddingClosed(t *testing.T) {
+	GIVENSchedule, GIVENStartingAt := twoWindowSchedule()
+	WHENNow := GIVENStartingAt.Add(-1 * time.Hour)
+	_, THENReason := GIVENSchedule.CanEditBids(WHENNow, false)
+	if THENReason != BiddingEditDeniedReasonBiddingClosed {
+		t.Fatalf("expected %q, got %q", BiddingEditDeniedReasonBiddingClosed, THENReason)
+	}
+}
+
+func TestThatNextWindowIsTheEarliestStillToOpen(t *testing.T) {
+	GIVENSchedule, GIVENStartingAt := twoWindowSchedule()
+	WHENNow := GIVENStartingAt
+	THENNext := GIVENSchedule.NextWindow(WHENNow)
+	if THENNext == nil || !THENNext.OpensAt.Equal(GIVENStartingAt.Add(48*time.Hour)) {
+		t.Fatalf("expected the second window, got %+v", THENNext)
+	}
+}
+
+func TestThatScheduleStaysSealedAfterBiddingUntilRevealAt(t *testing.T) {
+	GIVENSchedule, GIVENStartingAt := twoWindowSchedule()
+	GIVENRevealAt := GIVENStartingAt.Add(72 * time.Hour)
+	GIVENSchedule.RevealAt = &GIVENRevealAt
+	WHENNow := GIVENStartingAt.Add(61 * time.Hour)
+	THENRevealed := GIVENSchedule.IsRevealed(WHENNow)
+	if THENRevealed != false {
+		t.Fatalf("expected false")
+	}
+}
+
+func TestThatScheduleWithoutRevealAtIsRevealedWhenBiddingFinishes(t *testing.T) {
+	GIVENSchedule, GIVENStartingAt := twoWindowSchedule()
+	WHENNow := GIVENStartingAt.Add(60 * time.Hour)
+	THENRevealed := GIVENSchedule.IsRevealed(WHENNow)
+	if THENRevealed != true {
+		t.Fatalf("expected true")
+	}
+}
+
+func TestThatRevealAtBeforeBiddingClosesRevealsAtClose(t *testing.T) {
+	GIVENSchedule, GIVENStartingAt := twoWindowSchedule()
+	GIVENRevealAt := GIVENStartingAt
+	GIVENSchedule.RevealAt = &GIVENRevealAt
+	THENRevealsAt := GIVENSchedule.RevealsAt()
+	if !THENRevealsAt.Equal(GIVENStartingAt.Add(60 * time.Hour)) {
+		t.Fatalf("expected reveal at last close, got %v", THENRevealsAt)
+	}
+}
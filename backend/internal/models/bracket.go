@@ -132,7 +132,7 @@ func CalculateWinsAndByes(teamID string, bracket *BracketStructure) (wins int, b
 	for _, game := range bracket.Games {
 		if (game.Team1 != nil && game.Team1.TeamID == teamID) ||
 			(game.Team2 != nil && game.Team2.TeamID == teamID) {
-			if firstGame == nil || game.Round == RoundFirstFour {
+			if firstGame == nil || game.Round.Order() < firstGame.Round.Order() {
 				firstGame = game
 			}
 		}
@@ -142,10 +142,8 @@ func CalculateWinsAndByes(teamID string, bracket *BracketStructure) (wins int, b
 		return 0, 0, true // team not found; treat as eliminated
 	}
 
-	// If first game is not First Four, team has a bye
-	if firstGame.Round != RoundFirstFour {
-		byes = 1
-	}
+	// A team is credited a bye for every round it skips before its first game
+	byes = firstGame.Round.MinProgressRequired()
 
 	// Traverse the bracket to count wins
 	currentGame := firstGame
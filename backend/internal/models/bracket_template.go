@@ -0,0 +1,146 @@
+package models
+
+import (
+	"errors"
+	"fmt"
+)
+
+// PlayInRule controls how a seeded slot shared by two teams is resolved.
+type PlayInRule string
+
+const (
+	// PlayInDuplicateSeeds creates a First Four game between the two teams
+	// that share a region and seed; its winner fills the slot.
+	PlayInDuplicateSeeds PlayInRule = "duplicate_seeds"
+	// PlayInNone requires exactly one team per seeded slot.
+	PlayInNone PlayInRule = "none"
+)
+
+// BracketTemplate declares the shape of a single-elimination bracket: which
+// games exist, which round each belongs to, and what fills each slot.
+//
+// Byes are implied by where a team enters: a team placed directly into a game
+// is credited with that round's MinProgressRequired() as byes, so NCAA teams
+// entering the Round of 64 carry one bye and First Four teams carry none.
+type BracketTemplate struct {
+	Key     string         `json:"key"`
+	Name    string         `json:"name,omitempty"`
+	Regions []string       `json:"regions"`
+	PlayIns PlayInRule     `json:"playIns"`
+	Games   []TemplateGame `json:"games"`
+}
+
+// TemplateGame is one game in a bracket template.
+type TemplateGame struct {
+	GameID    string          `json:"gameId"`
+	Round     BracketRound    `json:"round"`
+	Region    string          `json:"region"`
+	SortOrder int             `json:"sortOrder"`
+	Slots     [2]TemplateSlot `json:"slots"`
+}
+
+// TemplateSlot is filled either by the team holding Seed in the game's region
+// (or Region when set), or by the winner of FeederGameID.
+type TemplateSlot struct {
+	Seed         int    `json:"seed,omitempty"`
+	Region       string `json:"region,omitempty"`
+	FeederGameID string `json:"feederGameId,omitempty"`
+}
+
+// IsFeeder reports whether the slot is filled by the winner of another game.
+func (s TemplateSlot) IsFeeder() bool {
+	return s.FeederGameID != ""
+}
+
+// AllowsPlayIns reports whether two teams may share a seeded slot.
+func (t *BracketTemplate) AllowsPlayIns() bool {
+	return t.PlayIns == PlayInDuplicateSeeds
+}
+
+// SeededSlotCount returns the number of slots filled directly by seed.
+func (t *BracketTemplate) SeededSlotCount() int {
+	n := 0
+	for _, g := range t.Games {
+		for _, s := range g.Slots {
+			if !s.IsFeeder() {
+				n++
+			}
+		}
+	}
+	return n
+}
+
+// Validate checks that the template forms a single tree: game IDs are unique,
+// every feeder exists and feeds exactly one slot, rounds strictly increase
+// toward the root, and exactly one game (the championship) feeds nothing.
+func (t *BracketTemplate) Validate() error {
+	if t == nil {
+		return errors.New("bracket template is required")
+	}
+	if len(t.Games) == 0 {
+		return errors.New("bracket template must have games")
+	}
+	if t.PlayIns != "" && t.PlayIns != PlayInDuplicateSeeds && t.PlayIns != PlayInNone {
+		return fmt.Errorf("unknown play-in rule %q", t.PlayIns)
+	}
+
+	regions := make(map[string]bool, len(t.Regions))
+	for _, r := range t.Regions {
+		regions[r] = true
+	}
+
+	byID := make(map[string]TemplateGame, len(t.Games))
+	for _, g := range t.Games {
+		if g.GameID == "" {
+			return errors.New("template game id is required")
+		}
+		if _, dup := byID[g.GameID]; dup {
+			return fmt.Errorf("duplicate template game id %q", g.GameID)
+		}
+		if _, ok := bracketRoundMetaByRound[g.Round]; !ok {
+			return fmt.Errorf("game %s has unknown round %q", g.GameID, g.Round)
+		}
+		byID[g.GameID] = g
+	}
+
+	fedBy := make(map[string]string, len(t.Games))
+	for _, g := range t.Games {
+		for i, s := range g.Slots {
+			if !s.IsFeeder() {
+				if s.Seed < 1 {
+					return fmt.Errorf("game %s slot %d needs a seed or a feeder game", g.GameID, i+1)
+				}
+				region := s.Region
+				if region == "" {
+					region = g.Region
+				}
+				if !regions[region] {
+					return fmt.Errorf("game %s slot %d references unknown region %q", g.GameID, i+1, region)
+				}
+				continue
+			}
+			feeder, ok := byID[s.FeederGameID]
+			if !ok {
+				return fmt.Errorf("game %s slot %d references unknown feeder %q", g.GameID, i+1, s.FeederGameID)
+			}
+			if prev, used := fedBy[s.FeederGameID]; used {
+				return fmt.Errorf("game %s feeds both %s and %s", s.FeederGameID, prev, g.GameID)
+			}
+			if feeder.Round.Order() >= g.Round.Order() {
+				return fmt.Errorf("feeder %s must be in an earlier round than %s", s.FeederGameID, g.GameID)
+			}
+			fedBy[s.FeederGameID] = g.GameID
+		}
+	}
+
+	roots := 0
+	for _, g := range t.Games {
+		if _, feeds := fedBy[g.GameID]; !feeds {
+			roots++
+		}
+	}
+	if roots != 1 {
+		return fmt.Errorf("bracket template must have exactly one final game, found %d", roots)
+	}
+	return nil
+}
@@ -0,0 +1,25 @@
+package models
+
+// Change kinds carried by ChangeEvent.
+const (
+	// ChangeKindStandings: game results or team progress changed.
+	ChangeKindStandings = "standings"
+	// ChangeKindPredictions: a prediction batch was written; Detail is its ID.
+	ChangeKindPredictions = "predictions"
+	// ChangeKindAuction: a pool's auction changed; Detail is the auction
+	// event type.
+	ChangeKindAuction = "auction"
+	// ChangeKindResync is delivered locally after the listener reconnects,
+	// since notifications sent while it was disconnected are lost.
+	ChangeKindResync = "resync"
+)
+
+// ChangeEvent says that something pool viewers watch live has changed. Events
+// travel through Postgres NOTIFY so every API instance hears them; they carry
+// identifiers only, and subscribers reload what they show.
+type ChangeEvent struct {
+	Kind         string `json:"kind"`
+	TournamentID string `json:"tournamentId,omitempty"`
+	PoolID       string `json:"poolId,omitempty"`
+	Detail       string `json:"detail,omitempty"`
+}
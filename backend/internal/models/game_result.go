@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// GameResult records the outcome of a single bracket game. Game results are the
+// source of truth for tournament progress; TournamentTeam.Wins and IsEliminated
+// are derived from them.
+type GameResult struct {
+	ID           string     `json:"id"`
+	TournamentID string     `json:"tournamentId"`
+	GameID       string     `json:"gameId"`
+	WinnerTeamID string     `json:"winnerTeamId"`
+	LoserTeamID  string     `json:"loserTeamId"`
+	WinnerScore  *int       `json:"winnerScore,omitempty"`
+	LoserScore   *int       `json:"loserScore,omitempty"`
+	DecidedAt    time.Time  `json:"decidedAt"`
+	EnteredBy    *string    `json:"enteredBy,omitempty"`
+	CreatedAt    time.Time  `json:"createdAt"`
+	UpdatedAt    time.Time  `json:"updatedAt"`
+	DeletedAt    *time.Time `json:"deletedAt,omitempty"`
+}
+
+// GameResultRollback removes a set of game results atomically. Backfill is
+// recorded first so that winners inferred from legacy team counters survive
+// the rollback.
+type GameResultRollback struct {
+	TournamentID string
+	GameIDs      []string
+	Backfill     []*GameResult
+	// FromCheckpoint is the earliest prediction checkpoint made stale by the
+	// removal; batches at or after it are invalidated.
+	FromCheckpoint int
+}
+
+// RollbackInvalidations counts derived data invalidated by a rollback.
+type RollbackInvalidations struct {
+	PredictionBatches int
+	SimulationBatches int
+}
@@ -0,0 +1,14 @@
+package models
+
+import "time"
+
+// GameSite is where a bracket game is played. Games without a site are
+// treated as neutral-floor games by venue-aware win-probability models.
+type GameSite struct {
+	TournamentID string    `json:"tournamentId"`
+	GameID       string    `json:"gameId"`
+	Name         string    `json:"name"`
+	Latitude     float64   `json:"latitude"`
+	Longitude    float64   `json:"longitude"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
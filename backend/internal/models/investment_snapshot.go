@@ -1,6 +1,9 @@
 package models
 
-import "time"
+import (
+	"sort"
+	"time"
+)
 
 // InvestmentSnapshot records the state of investments at the time of a change.
 type InvestmentSnapshot struct {
@@ -17,3 +20,45 @@ type InvestmentSnapshotEntry struct {
 	TeamID  string `json:"teamId"`
 	Credits int    `json:"credits"`
 }
+
+// InvestmentSnapshotChange is a team whose credits differ between two
+// snapshots. A team added has FromCredits 0; a team dropped has ToCredits 0.
+type InvestmentSnapshotChange struct {
+	TeamID      string `json:"teamId"`
+	FromCredits int    `json:"fromCredits"`
+	ToCredits   int    `json:"toCredits"`
+}
+
+// DiffInvestmentSnapshots lists the teams whose credits changed from prev to
+// next, ordered by team ID. A nil prev is a portfolio with no bids.
+func DiffInvestmentSnapshots(prev, next *InvestmentSnapshot) []InvestmentSnapshotChange {
+	from := snapshotCredits(prev)
+	to := snapshotCredits(next)
+
+	var changes []InvestmentSnapshotChange
+	for teamID, credits := range to {
+		if from[teamID] != credits {
+			changes = append(changes, InvestmentSnapshotChange{TeamID: teamID, FromCredits: from[teamID], ToCredits: credits})
+		}
+	}
+	for teamID, credits := range from {
+		if _, ok := to[teamID]; !ok {
+			changes = append(changes, InvestmentSnapshotChange{TeamID: teamID, FromCredits: credits})
+		}
+	}
+	sort.Slice(changes, func(i, j int) bool { return changes[i].TeamID < changes[j].TeamID })
+	return changes
+}
+
+func snapshotCredits(snapshot *InvestmentSnapshot) map[string]int {
+	credits := make(map[string]int)
+	if snapshot == nil {
+		return credits
+	}
+	for _, entry := range snapshot.Investments {
+		if entry.Credits > 0 {
+			credits[entry.TeamID] += entry.Credits
+		}
+	}
+	return credits
+}
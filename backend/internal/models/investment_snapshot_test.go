@@ -0,0 +1,45 @@
+package models
+
+import "testing"
+
+func snapshotOf(entries ...InvestmentSnapshotEntry) *InvestmentSnapshot {
+	return &InvestmentSnapshot{Investments: entries}
+}
+
+func TestThatDiffReportsRaisedBid(t *testing.T) {
+	GIVENPrev := snapshotOf(InvestmentSnapshotEntry{TeamID: "duke", Credits: 20})
+	GIVENNext := snapshotOf(InvestmentSnapshotEntry{TeamID: "duke", Credits: 35})
+	WHENChanges := DiffInvestmentSnapshots(GIVENPrev, GIVENNext)
+	THENWant := InvestmentSnapshotChange{TeamID: "duke", FromCredits: 20, ToCredits: 35}
+	if len(WHENChanges) != 1 || WHENChanges[0] != THENWant {
+		t.Fatalf("expected %+v, got %+v", THENWant, WHENChanges)
+	}
+}
+
+func TestThatDiffReportsDroppedTeam(t *testing.T) {
+	GIVENPrev := snapshotOf(InvestmentSnapshotEntry{TeamID: "duke", Credits: 20}, InvestmentSnapshotEntry{TeamID: "gonzaga", Credits: 10})
+	GIVENNext := snapshotOf(InvestmentSnapshotEntry{TeamID: "duke", Credits: 20})
+	WHENChanges := DiffInvestmentSnapshots(GIVENPrev, GIVENNext)
+	THENWant := InvestmentSnapshotChange{TeamID: "gonzaga", FromCredits: 10}
+	if len(WHENChanges) != 1 || WHENChanges[0] != THENWant {
+		t.Fatalf("expected %+v, got %+v", THENWant, WHENChanges)
+	}
+}
+
+func TestThatDiffFromNothingListsEveryBid(t *testing.T) {
+	GIVENNext := snapshotOf(InvestmentSnapshotEntry{TeamID: "gonzaga", Credits: 10}, InvestmentSnapshotEntry{TeamID: "duke", Credits: 20})
+	WHENChanges := DiffInvestmentSnapshots(nil, GIVENNext)
+	THENFirst := WHENChanges[0].TeamID
+	if len(WHENChanges) != 2 || THENFirst != "duke" {
+		t.Fatalf("expected two changes starting with duke, got %+v", WHENChanges)
+	}
+}
+
+func TestThatDiffOfIdenticalSnapshotsIsEmpty(t *testing.T) {
+	GIVENPrev := snapshotOf(InvestmentSnapshotEntry{TeamID: "duke", Credits: 20})
+	GIVENNext := snapshotOf(InvestmentSnapshotEntry{TeamID: "duke", Credits: 20})
+	WHENChanges := DiffInvestmentSnapshots(GIVENPrev, GIVENNext)
+	if len(WHENChanges) != 0 {
+		t.Fatalf("expected no changes, got %+v", WHENChanges)
+	}
+}
@@ -0,0 +1,36 @@
+package models
+
+import "time"
+
+// Ledger entry kinds.
+const (
+	// LedgerEntryKindPaymentReceived records an entry fee collected from a
+	// portfolio's owner.
+	LedgerEntryKindPaymentReceived = "payment_received"
+	// LedgerEntryKindPayoutSent records winnings paid to a portfolio's owner.
+	LedgerEntryKindPayoutSent = "payout_sent"
+)
+
+// LedgerEntry is money that changed hands between a pool and one of its
+// portfolios. Entries are voided by soft-deleting them, never edited.
+type LedgerEntry struct {
+	ID          string     `json:"id"`
+	PoolID      string     `json:"poolId"`
+	PortfolioID string     `json:"portfolioId"`
+	Kind        string     `json:"kind"`
+	AmountCents int        `json:"amountCents"`
+	Note        string     `json:"note"`
+	RecordedBy  string     `json:"recordedBy"`
+	CreatedAt   time.Time  `json:"createdAt"`
+	UpdatedAt   time.Time  `json:"updatedAt"`
+	DeletedAt   *time.Time `json:"deletedAt,omitempty"`
+}
+
+// PoolSettlement marks a pool's books as closed. A settled pool accepts no
+// ledger changes until it is reopened.
+type PoolSettlement struct {
+	ID        string    `json:"id"`
+	PoolID    string    `json:"poolId"`
+	SettledBy string    `json:"settledBy"`
+	SettledAt time.Time `json:"settledAt"`
+}
@@ -0,0 +1,18 @@
+package models
+
+import "time"
+
+// LiveGameState is the latest reported state of a bracket game in progress.
+// It is cleared when the game's result is recorded.
+type LiveGameState struct {
+	TournamentID     string    `json:"tournamentId"`
+	GameID           string    `json:"gameId"`
+	Team1ID          string    `json:"team1Id"`
+	Team2ID          string    `json:"team2Id"`
+	Team1Score       int       `json:"team1Score"`
+	Team2Score       int       `json:"team2Score"`
+	SecondsRemaining int       `json:"secondsRemaining"`
+	PossessionTeamID *string   `json:"possessionTeamId,omitempty"`
+	Source           string    `json:"source"`
+	UpdatedAt        time.Time `json:"updatedAt"`
+}
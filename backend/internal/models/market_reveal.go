@@ -0,0 +1,34 @@
+package models
+
+import "time"
+
+// MarketReveal is the market summary frozen when a pool's sealed bids are
+// revealed. It is never updated: later bid changes by admins do not touch it.
+type MarketReveal struct {
+	ID         string
+	PoolID     string
+	RevealedAt time.Time
+	// RevealedBy is the commissioner who revealed the bids early, or nil when
+	// they were revealed on schedule.
+	RevealedBy *string
+	// PredictionBatchID names the predictions ExpectedPoints came from, or is
+	// nil when the tournament had none.
+	PredictionBatchID *string
+	TotalCredits      int
+	PortfolioCount    int
+	Teams             []MarketRevealTeam
+	CreatedAt         time.Time
+}
+
+// MarketRevealTeam is one team's line in a market summary. The expected
+// fields are nil when the tournament had no predictions.
+type MarketRevealTeam struct {
+	TeamID         string   `json:"teamId"`
+	TotalCredits   int      `json:"totalCredits"`
+	Bidders        int      `json:"bidders"`
+	MarketShare    float64  `json:"marketShare"`
+	MarketRank     int      `json:"marketRank"`
+	ExpectedPoints *float64 `json:"expectedPoints,omitempty"`
+	ExpectedShare  *float64 `json:"expectedShare,omitempty"`
+	ExpectedRank   *int     `json:"expectedRank,omitempty"`
+}
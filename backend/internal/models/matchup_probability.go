@@ -0,0 +1,37 @@
+package models
+
+import "time"
+
+// MatchupProbabilityTable is an externally supplied set of pairwise win
+// probabilities for a tournament. Prediction and simulation runs whose
+// ProbabilitySourceKey matches SourceKey price matchups from the table and fall
+// back to their win-probability model for pairs it does not cover.
+type MatchupProbabilityTable struct {
+	ID           string    `json:"id"`
+	TournamentID string    `json:"tournamentId"`
+	SourceKey    string    `json:"sourceKey"`
+	Description  *string   `json:"description,omitempty"`
+	UploadedBy   *string   `json:"uploadedBy,omitempty"`
+	PairCount    int       `json:"pairCount"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+
+	// Probabilities holds both orientations of every pair. It is only
+	// populated when the table is loaded for a run or being stored.
+	Probabilities map[TeamPair]float64 `json:"-"`
+}
+
+// TeamPair is an ordered pair of team IDs.
+type TeamPair struct {
+	Team1ID string
+	Team2ID string
+}
+
+// Lookup returns the probability that team1 beats team2, if the table has it.
+func (t *MatchupProbabilityTable) Lookup(team1ID, team2ID string) (float64, bool) {
+	if t == nil {
+		return 0, false
+	}
+	p, ok := t.Probabilities[TeamPair{Team1ID: team1ID, Team2ID: team2ID}]
+	return p, ok
+}
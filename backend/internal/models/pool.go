@@ -8,6 +8,52 @@ const (
 	DefaultMaxTeams              = 10
 	DefaultMaxInvestmentCredits  = 50
 	DefaultBudgetCredits         = 100
+	DefaultMaxPortfoliosPerUser  = 1
+)
+
+// MaxPortfoliosPerUserLimit is the most portfolios a pool may let one user
+// hold.
+const MaxPortfoliosPerUserLimit = 10
+
+// Ownership modes decide how a team's points are split among the portfolios
+// that bid on it.
+const (
+	// OwnershipModeProportional gives each owner its bid over the team's total.
+	OwnershipModeProportional = "proportional"
+	// OwnershipModeWinnerTakeAll gives the team to the top bid; tied top bids
+	// split it evenly.
+	OwnershipModeWinnerTakeAll = "winner_take_all"
+	// OwnershipModeCappedShare is proportional, but no owner holds more than
+	// OwnershipCapPercent of a team.
+	OwnershipModeCappedShare = "capped_share"
+)
+
+// Unclaimed modes decide what happens to the points of teams nobody bid on.
+const (
+	// UnclaimedModeForfeit awards their points to no one.
+	UnclaimedModeForfeit = "forfeit"
+	// UnclaimedModeProRata splits their points among every portfolio in
+	// proportion to the credits it invested.
+	UnclaimedModeProRata = "pro_rata"
+	// UnclaimedModeLeftovers auctions them in a live leftovers round once
+	// sealed bidding has closed; teams still unsold are forfeited.
+	UnclaimedModeLeftovers = "leftovers"
+)
+
+// Tie-breakers decide how portfolios tied on points at a paying position are
+// resolved.
+const (
+	// TieBreakerSplit shares the tied positions' payouts evenly.
+	TieBreakerSplit = "split"
+	// TieBreakerChampionPoints ranks the portfolio that earned the most points
+	// from the champion first.
+	TieBreakerChampionPoints = "champion_points"
+	// TieBreakerFewestCredits ranks the portfolio that spent the fewest
+	// credits first.
+	TieBreakerFewestCredits = "fewest_credits"
+	// TieBreakerEarliestSubmission ranks the portfolio whose bids were
+	// finalized first.
+	TieBreakerEarliestSubmission = "earliest_submission"
 )
 
 // ApplyDefaults fills in zero-value constraint fields with sensible defaults.
@@ -24,6 +70,18 @@ func (p *Pool) ApplyDefaults() {
 	if p.BudgetCredits == 0 {
 		p.BudgetCredits = DefaultBudgetCredits
 	}
+	if p.OwnershipMode == "" {
+		p.OwnershipMode = OwnershipModeProportional
+	}
+	if p.UnclaimedMode == "" {
+		p.UnclaimedMode = UnclaimedModeForfeit
+	}
+	if p.TieBreaker == "" {
+		p.TieBreaker = TieBreakerSplit
+	}
+	if p.MaxPortfoliosPerUser == 0 {
+		p.MaxPortfoliosPerUser = DefaultMaxPortfoliosPerUser
+	}
 }
 
 // Pool represents an investment pool for a tournament
@@ -38,6 +96,16 @@ type Pool struct {
 	MaxInvestmentCredits int        `json:"maxInvestmentCredits"`
 	BudgetCredits        int        `json:"budgetCredits"`
 	EntryFeeCents        int        `json:"entryFeeCents"`
+	OwnershipMode        string     `json:"ownershipMode"`
+	OwnershipCapPercent  *int       `json:"ownershipCapPercent,omitempty"`
+	UnclaimedMode        string     `json:"unclaimedMode"`
+	TieBreaker           string     `json:"tieBreaker"`
+	// MaxPortfoliosPerUser is how many portfolios one user may hold in the
+	// pool.
+	MaxPortfoliosPerUser int        `json:"maxPortfoliosPerUser"`
+	// RevealAt holds bids sealed past the close of bidding until this time.
+	// Nil reveals them as soon as bidding closes.
+	RevealAt             *time.Time `json:"revealAt,omitempty"`
 	Visibility           string     `json:"visibility"`
 	CreatedAt            time.Time  `json:"createdAt"`
 	UpdatedAt            time.Time  `json:"updatedAt"`
@@ -11,4 +11,18 @@ type PortfolioStanding struct {
 	InTheMoney         bool
 	ExpectedValue      *float64
 	ProjectedFavorites *float64
+	TieResolution      *TieResolution
+}
+
+// TieResolution records how a tie on points was resolved. Every portfolio in
+// the tied group carries one, so the audit trail reads the same from any of
+// them.
+type TieResolution struct {
+	Rule          string
+	TiedOnReturns float64
+	PortfolioIDs  []string
+	FirstPosition int
+	LastPosition  int
+	PayoutCents   int
+	Reason        string
 }
@@ -28,11 +28,40 @@ type PredictedTeamValue struct {
 	PRound6              float64
 	PRound7              float64
 	FavoritesTotalPoints float64
+	// ExpectedUpsetMargins[r-1] is the expected number of seed lines by which
+	// the team beats a better seed in round r: the sum over possible opponents
+	// of P(team wins that matchup) * max(seed - opponent seed, 0).
+	ExpectedUpsetMargins []float64
+}
+
+// PredictedMatchup represents a potential game between two teams with probabilities.
+type PredictedMatchup struct {
+	GameID                 string
+	RoundOrder             int
+	Team1ID                string
+	Team2ID                string
+	PMatchup               float64 // Probability this matchup occurs
+	PTeam1WinsGivenMatchup float64 // P(team1 wins | matchup occurs)
+	PTeam2WinsGivenMatchup float64 // P(team2 wins | matchup occurs) = 1 - PTeam1WinsGivenMatchup
+	// ProjectedSpread is team1's projected margin in points and SpreadSD its
+	// standard deviation; both are nil unless the win-probability model
+	// projects scores.
+	ProjectedSpread *float64
+	SpreadSD        *float64
 }
 
 // MaxRounds is the total number of rounds in the NCAA tournament (including First Four).
 const MaxRounds = 7
 
+// ExpectedUpsetMarginByIndex returns the expected upset margin for a given
+// round index (1-7), or 0 when none was recorded.
+func (v PredictedTeamValue) ExpectedUpsetMarginByIndex(round int) float64 {
+	if round < 1 || round > len(v.ExpectedUpsetMargins) {
+		return 0
+	}
+	return v.ExpectedUpsetMargins[round-1]
+}
+
 // PRoundByIndex returns the advancement probability for a given round index (1-7).
 func (v PredictedTeamValue) PRoundByIndex(round int) float64 {
 	switch round {
@@ -63,4 +92,16 @@ type PredictionTeamInput struct {
 	KenPomNet float64
 	Wins      int
 	Byes      int
+	// BeatenSeeds are the seeds of the teams it has beaten, in order.
+	BeatenSeeds []int
+	// WinPct is the regular-season winning percentage, or 0 when unknown.
+	WinPct float64
+	// ORtg, DRtg and AdjT are KenPom adjusted efficiencies and tempo, or 0
+	// when unknown.
+	ORtg float64
+	DRtg float64
+	AdjT float64
+	// HomeLatitude and HomeLongitude locate the team's campus; nil when unknown.
+	HomeLatitude  *float64
+	HomeLongitude *float64
 }
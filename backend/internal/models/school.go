@@ -6,6 +6,9 @@ import "time"
 type School struct {
 	ID      string     `json:"id"`
 	Name    string     `json:"name"`
+	// Latitude and Longitude locate the campus; both are nil when unknown.
+	Latitude  *float64   `json:"latitude,omitempty"`
+	Longitude *float64   `json:"longitude,omitempty"`
 	CreatedAt time.Time  `json:"createdAt"`
 	UpdatedAt time.Time  `json:"updatedAt"`
 	DeletedAt *time.Time `json:"deletedAt,omitempty"`
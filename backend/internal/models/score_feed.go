@@ -0,0 +1,61 @@
+package models
+
+import "time"
+
+// Score feed review statuses.
+const (
+	ScoreFeedReviewPending   = "pending"
+	ScoreFeedReviewResolved  = "resolved"
+	ScoreFeedReviewDismissed = "dismissed"
+)
+
+// SchoolNameMapping maps a results feed's name for a team to a school. NameKey
+// is the normalized form of ExternalName that feed names are matched on.
+type SchoolNameMapping struct {
+	ID           string    `json:"id"`
+	Source       string    `json:"source"`
+	ExternalName string    `json:"externalName"`
+	NameKey      string    `json:"nameKey"`
+	SchoolID     string    `json:"schoolId"`
+	CreatedBy    *string   `json:"createdBy,omitempty"`
+	CreatedAt    time.Time `json:"createdAt"`
+	UpdatedAt    time.Time `json:"updatedAt"`
+}
+
+// ScoreFeedReview is a feed team name that did not match exactly one school.
+// Results naming it wait until an admin maps it to a school.
+type ScoreFeedReview struct {
+	ID                 string     `json:"id"`
+	Source             string     `json:"source"`
+	ExternalName       string     `json:"externalName"`
+	NameKey            string     `json:"nameKey"`
+	CandidateSchoolIDs []string   `json:"candidateSchoolIds"`
+	Status             string     `json:"status"`
+	ResolvedSchoolID   *string    `json:"resolvedSchoolId,omitempty"`
+	ResolvedBy         *string    `json:"resolvedBy,omitempty"`
+	ResolvedAt         *time.Time `json:"resolvedAt,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt"`
+	UpdatedAt          time.Time  `json:"updatedAt"`
+}
+
+// SchoolSlug identifies a school for feed name matching.
+type SchoolSlug struct {
+	ID   string
+	Slug string
+	Name string
+}
+
+// ScoreFeedResult is one game as reported by a results feed. Team names are
+// the feed's own.
+type ScoreFeedResult struct {
+	ExternalGameID string `json:"externalGameId,omitempty"`
+	Season         int    `json:"season"`
+	Team1Name      string `json:"team1Name"`
+	Team2Name      string `json:"team2Name"`
+	Team1Score     int    `json:"team1Score"`
+	Team2Score     int    `json:"team2Score"`
+	Final          bool   `json:"final"`
+	// SecondsRemaining is set for games in progress; they are posted as live
+	// game states.
+	SecondsRemaining *int `json:"secondsRemaining,omitempty"`
+}
@@ -2,11 +2,26 @@ package models
 
 import "time"
 
+// Scoring rule kinds. Every kind pays on the win at WinIndex; they differ in
+// how much that win is worth.
+const (
+	// ScoringRuleKindRound awards PointsAwarded for the win.
+	ScoringRuleKindRound = "round"
+	// ScoringRuleKindSeedMultiplier awards PointsAwarded times the winner's seed.
+	ScoringRuleKindSeedMultiplier = "seed_multiplier"
+	// ScoringRuleKindUpsetBonus awards PointsAwarded for each seed line the
+	// winner is below the team it beat. Wins over worse seeds earn nothing.
+	ScoringRuleKindUpsetBonus = "upset_bonus"
+	// ScoringRuleKindChampionBonus awards PointsAwarded for the title-game win.
+	ScoringRuleKindChampionBonus = "champion_bonus"
+)
+
 // ScoringRule represents the points awarded for a specific win index in a pool.
 // Note: In the NCAA tournament, win_index 0 is a bye, 1 is the First Four, 2 is the First Round, etc.
 type ScoringRule struct {
 	ID            string     `json:"id"`
 	PoolID        string     `json:"poolId"`
+	Kind          string     `json:"kind"`
 	WinIndex      int        `json:"winIndex"`
 	PointsAwarded int        `json:"pointsAwarded"`
 	CreatedAt     time.Time  `json:"createdAt"`
@@ -0,0 +1,39 @@
+package models
+
+import "time"
+
+// Side pot ranking metrics.
+const (
+	// SidePotMetricTotalPoints ranks portfolios by the pool's standings.
+	SidePotMetricTotalPoints = "total_points"
+	// SidePotMetricPointsThroughRound ranks portfolios by points counting
+	// only wins up to ThroughWinIndex.
+	SidePotMetricPointsThroughRound = "points_through_round"
+	// SidePotMetricBestTeamROI ranks portfolios by the most points per credit
+	// earned on any one team they bid on.
+	SidePotMetricBestTeamROI = "best_team_roi"
+	// SidePotMetricFewestPoints ranks the portfolio with the fewest points
+	// first.
+	SidePotMetricFewestPoints = "fewest_points"
+)
+
+// SidePot is a named payout pool run alongside a pool's main payouts, with
+// its own ranking metric and position table.
+type SidePot struct {
+	ID     string `json:"id"`
+	PoolID string `json:"poolId"`
+	Name   string `json:"name"`
+	Metric string `json:"metric"`
+	// ThroughWinIndex only applies to points_through_round pots.
+	ThroughWinIndex *int             `json:"throughWinIndex,omitempty"`
+	Payouts         []*SidePotPayout `json:"payouts"`
+	CreatedAt       time.Time        `json:"createdAt"`
+	UpdatedAt       time.Time        `json:"updatedAt"`
+	DeletedAt       *time.Time       `json:"deletedAt,omitempty"`
+}
+
+// SidePotPayout is the amount a side pot pays to one finishing position.
+type SidePotPayout struct {
+	Position    int `json:"position"`
+	AmountCents int `json:"amountCents"`
+}
@@ -30,11 +30,13 @@ type KenPomStats struct {
 }
 
 type TeamKenPomUpdate struct {
-	TeamID string
-	NetRtg float64
-	ORtg   float64
-	DRtg   float64
-	AdjT   float64
+	TeamID       string
+	NetRtg       float64
+	ORtg         float64
+	DRtg         float64
+	AdjT         float64
+	SeasonWins   *int
+	SeasonLosses *int
 }
 
 // TournamentConfig holds configuration for tournament rules and validation
@@ -48,6 +48,19 @@ type Config struct {
 	PythonBin          string
 	RunJobsMaxAttempts int
 	WorkerID           string
+	// EvaluationTargetSE stops lab evaluations once every entry's standard
+	// errors reach it (0 = evaluate every simulation)
+	EvaluationTargetSE float64
+
+	// Score feed (score-ingestion worker; disabled when kind is empty)
+	ScoreFeedKind        string // "dir" or "http"
+	ScoreFeedSource      string
+	ScoreFeedLocation    string
+	ScoreFeedPollSeconds int
+
+	// Live dashboards: pools enumerate every remaining outcome once this many
+	// games or fewer remain
+	ExactOutcomesMaxRemainingGames int
 
 	// Proxy
 	TrustProxyHeaders bool
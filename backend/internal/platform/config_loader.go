@@ -57,6 +57,21 @@ func envInt64(key string, defaultValue int64, minValue int64) int64 {
 	return parsed
 }
 
+func envFloat(key string, defaultValue float64, minValue float64) float64 {
+	v := strings.TrimSpace(os.Getenv(key))
+	if v == "" {
+		return defaultValue
+	}
+	parsed, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return defaultValue
+	}
+	if parsed < minValue {
+		return defaultValue
+	}
+	return parsed
+}
+
 func envString(key string, defaultValue string) string {
 	v := strings.TrimSpace(os.Getenv(key))
 	if v == "" {
@@ -303,8 +318,9 @@ func loadDatabaseURL() string {
 	return ""
 }
 
-func loadWorkerConfig() (defaultNSims int, excludedEntryName, pythonBin string, runJobsMaxAttempts int, workerID string) {
+func loadWorkerConfig() (defaultNSims int, excludedEntryName, pythonBin string, runJobsMaxAttempts int, workerID string, evaluationTargetSE float64) {
 	defaultNSims = envInt("DEFAULT_N_SIMS", 10000, 1)
+	evaluationTargetSE = envFloat("EVALUATION_TARGET_SE", 0, 0)
 	excludedEntryName = strings.TrimSpace(os.Getenv("EXCLUDED_ENTRY_NAME"))
 	pythonBin = envString("PYTHON_BIN", "python3")
 	runJobsMaxAttempts = envInt("RUN_JOBS_MAX_ATTEMPTS", 5, 1)
@@ -312,6 +328,14 @@ func loadWorkerConfig() (defaultNSims int, excludedEntryName, pythonBin string,
 	return
 }
 
+func loadScoreFeedConfig() (kind, source, location string, pollSeconds int) {
+	kind = strings.ToLower(strings.TrimSpace(os.Getenv("SCORE_FEED_KIND")))
+	source = strings.TrimSpace(os.Getenv("SCORE_FEED_SOURCE"))
+	location = strings.TrimSpace(os.Getenv("SCORE_FEED_LOCATION"))
+	pollSeconds = envInt("SCORE_FEED_POLL_SECONDS", 60, 1)
+	return
+}
+
 func loadCookieConfig() (*bool, string) {
 	secure := envBoolPtr("COOKIE_SECURE")
 	sameSite := strings.ToLower(strings.TrimSpace(os.Getenv("COOKIE_SAMESITE")))
@@ -379,7 +403,9 @@ func LoadConfigFromEnv() (Config, error) {
 	maxConns, minConns, maxConnLifetimeSeconds, healthCheckPeriodSeconds,
 		statementTimeoutMS, lockTimeoutMS := loadPoolConfig()
 
-	defaultNSims, excludedEntryName, pythonBin, runJobsMaxAttempts, workerID := loadWorkerConfig()
+	defaultNSims, excludedEntryName, pythonBin, runJobsMaxAttempts, workerID, evaluationTargetSE := loadWorkerConfig()
+
+	scoreFeedKind, scoreFeedSource, scoreFeedLocation, scoreFeedPollSeconds := loadScoreFeedConfig()
 
 	cookieSecure, cookieSameSite := loadCookieConfig()
 
@@ -430,9 +456,15 @@ func LoadConfigFromEnv() (Config, error) {
 		CognitoAllowUnprovisioned:       envBool("COGNITO_ALLOW_UNPROVISIONED", false),
 		DefaultNSims:                    defaultNSims,
 		ExcludedEntryName:               excludedEntryName,
+		EvaluationTargetSE:              evaluationTargetSE,
 		PythonBin:                       pythonBin,
 		RunJobsMaxAttempts:              runJobsMaxAttempts,
 		WorkerID:                        workerID,
+		ScoreFeedKind:                   scoreFeedKind,
+		ScoreFeedSource:                 scoreFeedSource,
+		ScoreFeedLocation:               scoreFeedLocation,
+		ScoreFeedPollSeconds:            scoreFeedPollSeconds,
+		ExactOutcomesMaxRemainingGames:  envInt("EXACT_OUTCOMES_MAX_REMAINING_GAMES", 15, 1),
 		SentryDSN:                       strings.TrimSpace(os.Getenv("SENTRY_DSN")),
 		SentryEnvironment:               envString("SENTRY_ENVIRONMENT", env),
 		TrustProxyHeaders:               envBool("TRUST_PROXY_HEADERS", false),
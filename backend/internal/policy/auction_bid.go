@@ -0,0 +1,44 @@
+package policy
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// CanActInAuction checks if a user can nominate or bid in a pool's live
+// auction on behalf of a portfolio. Pool admins may act for any portfolio,
+// e.g. to run an in-person auction from one screen.
+func CanActInAuction(
+	ctx context.Context,
+	authz AuthorizationChecker,
+	userID string,
+	portfolio *models.Portfolio,
+	pool *models.Pool,
+) (Decision, error) {
+	if userID == "" {
+		return Decision{Allowed: false, Status: http.StatusUnauthorized, Code: "unauthorized", Message: "Authentication required"}, nil
+	}
+	if pool == nil {
+		return Decision{Allowed: false, Status: http.StatusBadRequest, Code: "pool_missing", Message: "Pool not found"}, nil
+	}
+	if portfolio == nil || portfolio.PoolID != pool.ID {
+		return Decision{Allowed: false, Status: http.StatusBadRequest, Code: "portfolio_missing", Message: "Portfolio not found"}, nil
+	}
+
+	isAdmin, err := isPoolAdminOrOwner(ctx, authz, userID, pool)
+	if err != nil {
+		return Decision{}, err
+	}
+
+	authorized := isAdmin
+	if portfolio.UserID != nil && *portfolio.UserID == userID {
+		authorized = true
+	}
+	if !authorized {
+		return Decision{Allowed: false, IsAdmin: isAdmin, Status: http.StatusForbidden, Code: "forbidden", Message: "Insufficient permissions"}, nil
+	}
+
+	return Decision{Allowed: true, IsAdmin: isAdmin}, nil
+}
@@ -0,0 +1,81 @@
+package policy
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func TestThatPortfolioOwnerCanActInAuction(t *testing.T) {
+	// GIVEN a portfolio owned by the user
+	userID := "user1"
+	pool := &models.Pool{ID: "p1", OwnerID: "owner"}
+	portfolio := &models.Portfolio{ID: "e1", PoolID: "p1", UserID: &userID}
+
+	// WHEN checking auction permission
+	decision, err := CanActInAuction(context.Background(), nil, userID, portfolio, pool)
+
+	// THEN access is allowed
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !decision.Allowed {
+		t.Fatalf("expected allowed, got %+v", decision)
+	}
+}
+
+func TestThatParticipantCannotActForAnotherPortfolioInAuction(t *testing.T) {
+	// GIVEN a portfolio owned by someone else
+	other := "user2"
+	pool := &models.Pool{ID: "p1", OwnerID: "owner"}
+	portfolio := &models.Portfolio{ID: "e1", PoolID: "p1", UserID: &other}
+
+	// WHEN checking auction permission for user1
+	decision, err := CanActInAuction(context.Background(), nil, "user1", portfolio, pool)
+
+	// THEN access is forbidden
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision.Status != http.StatusForbidden {
+		t.Fatalf("expected status %d, got %d", http.StatusForbidden, decision.Status)
+	}
+}
+
+func TestThatPoolOwnerCanActForAnyPortfolioInAuction(t *testing.T) {
+	// GIVEN a portfolio owned by a participant
+	other := "user2"
+	pool := &models.Pool{ID: "p1", OwnerID: "owner"}
+	portfolio := &models.Portfolio{ID: "e1", PoolID: "p1", UserID: &other}
+
+	// WHEN checking auction permission for the pool owner
+	decision, err := CanActInAuction(context.Background(), nil, "owner", portfolio, pool)
+
+	// THEN access is allowed
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !decision.Allowed {
+		t.Fatalf("expected allowed, got %+v", decision)
+	}
+}
+
+func TestThatPortfolioFromAnotherPoolCannotActInAuction(t *testing.T) {
+	// GIVEN a portfolio in a different pool
+	userID := "user1"
+	pool := &models.Pool{ID: "p1", OwnerID: "owner"}
+	portfolio := &models.Portfolio{ID: "e1", PoolID: "p2", UserID: &userID}
+
+	// WHEN checking auction permission
+	decision, err := CanActInAuction(context.Background(), nil, userID, portfolio, pool)
+
+	// THEN the request is rejected
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision.Status != http.StatusBadRequest {
+		t.Fatalf("expected status %d, got %d", http.StatusBadRequest, decision.Status)
+	}
+}
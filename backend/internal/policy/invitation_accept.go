@@ -14,6 +14,7 @@ func CanAcceptInvitation(
 	userID string,
 	pool *models.Pool,
 	tournament *models.Tournament,
+	windows []*models.BiddingWindow,
 	now time.Time,
 ) (Decision, error) {
 	if userID == "" {
@@ -31,7 +32,7 @@ func CanAcceptInvitation(
 		return Decision{}, err
 	}
 
-	if ok, reason := tournament.CanEditBids(now, isAdmin); !ok {
+	if ok, reason := models.NewBiddingSchedule(tournament, windows).CanEditBids(now, isAdmin); !ok {
 		code := "tournament_locked"
 		if reason != "" {
 			code = reason
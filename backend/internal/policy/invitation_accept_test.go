@@ -15,7 +15,7 @@ func TestThatUnauthenticatedUserCannotAcceptInvitation(t *testing.T) {
 	tournament := &models.Tournament{ID: "t1"}
 
 	// WHEN checking accept invitation permission
-	decision, err := CanAcceptInvitation(context.Background(), nil, "", pool, tournament, time.Now())
+	decision, err := CanAcceptInvitation(context.Background(), nil, "", pool, tournament, nil, time.Now())
 
 	// THEN access is denied with unauthorized status
 	if err != nil {
@@ -31,7 +31,7 @@ func TestThatNilPoolRejectsInvitationAcceptance(t *testing.T) {
 	tournament := &models.Tournament{ID: "t1"}
 
 	// WHEN checking accept invitation permission
-	decision, err := CanAcceptInvitation(context.Background(), nil, "user1", nil, tournament, time.Now())
+	decision, err := CanAcceptInvitation(context.Background(), nil, "user1", nil, tournament, nil, time.Now())
 
 	// THEN access is denied with bad request status
 	if err != nil {
@@ -47,7 +47,7 @@ func TestThatNilTournamentRejectsInvitationAcceptance(t *testing.T) {
 	pool := &models.Pool{ID: "p1", OwnerID: "owner"}
 
 	// WHEN checking accept invitation permission
-	decision, err := CanAcceptInvitation(context.Background(), nil, "user1", pool, nil, time.Now())
+	decision, err := CanAcceptInvitation(context.Background(), nil, "user1", pool, nil, nil, time.Now())
 
 	// THEN access is denied with bad request status
 	if err != nil {
@@ -66,7 +66,7 @@ func TestThatNonAdminCannotAcceptInvitationAfterTournamentStarts(t *testing.T) {
 	authz := &mockAuthzChecker{result: false}
 
 	// WHEN checking accept invitation permission
-	decision, err := CanAcceptInvitation(context.Background(), authz, "regular-user", pool, tournament, time.Now())
+	decision, err := CanAcceptInvitation(context.Background(), authz, "regular-user", pool, tournament, nil, time.Now())
 
 	// THEN access is denied with locked status
 	if err != nil {
@@ -85,7 +85,7 @@ func TestThatNonAdminCanAcceptInvitationBeforeTournamentStarts(t *testing.T) {
 	authz := &mockAuthzChecker{result: false}
 
 	// WHEN checking accept invitation permission
-	decision, err := CanAcceptInvitation(context.Background(), authz, "regular-user", pool, tournament, time.Now())
+	decision, err := CanAcceptInvitation(context.Background(), authz, "regular-user", pool, tournament, nil, time.Now())
 
 	// THEN access is allowed
 	if err != nil {
@@ -103,7 +103,7 @@ func TestThatAdminCanAcceptInvitationAfterTournamentStarts(t *testing.T) {
 	tournament := &models.Tournament{ID: "t1", StartingAt: &startingAt}
 
 	// WHEN checking accept invitation permission as the pool owner
-	decision, err := CanAcceptInvitation(context.Background(), nil, "owner", pool, tournament, time.Now())
+	decision, err := CanAcceptInvitation(context.Background(), nil, "owner", pool, tournament, nil, time.Now())
 
 	// THEN access is allowed
 	if err != nil {
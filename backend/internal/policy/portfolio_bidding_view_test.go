@@ -15,7 +15,7 @@ func TestThatPortfolioOwnerCanViewOwnDataDuringBidding(t *testing.T) {
 	tournament := &models.Tournament{StartingAt: &startingAt}
 
 	// WHEN checking bidding phase view access
-	result := IsBiddingPhaseViewAllowed(userID, portfolio, tournament, time.Now(), false)
+	result := IsBiddingPhaseViewAllowed(userID, portfolio, models.NewBiddingSchedule(tournament, nil), time.Now(), false)
 
 	// THEN access is allowed
 	if !result {
@@ -31,7 +31,7 @@ func TestThatAdminCanViewAnyPortfolioDuringBidding(t *testing.T) {
 	tournament := &models.Tournament{StartingAt: &startingAt}
 
 	// WHEN checking bidding phase view access as admin
-	result := IsBiddingPhaseViewAllowed("admin", portfolio, tournament, time.Now(), true)
+	result := IsBiddingPhaseViewAllowed("admin", portfolio, models.NewBiddingSchedule(tournament, nil), time.Now(), true)
 
 	// THEN access is allowed
 	if !result {
@@ -47,7 +47,7 @@ func TestThatParticipantCannotViewOtherPortfolioDuringBidding(t *testing.T) {
 	tournament := &models.Tournament{StartingAt: &startingAt}
 
 	// WHEN checking bidding phase view access
-	result := IsBiddingPhaseViewAllowed("u1", portfolio, tournament, time.Now(), false)
+	result := IsBiddingPhaseViewAllowed("u1", portfolio, models.NewBiddingSchedule(tournament, nil), time.Now(), false)
 
 	// THEN access is denied
 	if result {
@@ -63,10 +63,28 @@ func TestThatAnyoneCanViewPortfolioDataAfterTournamentStarts(t *testing.T) {
 	tournament := &models.Tournament{StartingAt: &startingAt}
 
 	// WHEN checking bidding phase view access as a non-owner non-admin
-	result := IsBiddingPhaseViewAllowed("u1", portfolio, tournament, time.Now(), false)
+	result := IsBiddingPhaseViewAllowed("u1", portfolio, models.NewBiddingSchedule(tournament, nil), time.Now(), false)
 
 	// THEN access is allowed
 	if !result {
 		t.Fatal("expected anyone to view portfolio data after tournament starts")
 	}
 }
+
+func TestThatParticipantCannotViewOtherPortfolioBeforeRevealAt(t *testing.T) {
+	// GIVEN a started tournament whose pool reveals bids tomorrow
+	otherUserID := "u2"
+	portfolio := &models.Portfolio{UserID: &otherUserID}
+	startingAt := time.Now().Add(-24 * time.Hour)
+	revealAt := time.Now().Add(24 * time.Hour)
+	pool := &models.Pool{RevealAt: &revealAt}
+	schedule := models.NewPoolBiddingSchedule(pool, &models.Tournament{StartingAt: &startingAt}, nil)
+
+	// WHEN checking bidding phase view access as a non-owner non-admin
+	result := IsBiddingPhaseViewAllowed("u1", portfolio, schedule, time.Now(), false)
+
+	// THEN access is denied
+	if result {
+		t.Fatal("expected non-owner to be denied before bids are revealed")
+	}
+}
@@ -8,6 +8,9 @@ import (
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
+// CanEditPortfolioInvestments decides whether userID may change a
+// portfolio's bids. Bids lock outside the pool's bidding windows, or without
+// windows, once the tournament starts; admins may edit them at any time.
 func CanEditPortfolioInvestments(
 	ctx context.Context,
 	authz AuthorizationChecker,
@@ -15,6 +18,7 @@ func CanEditPortfolioInvestments(
 	portfolio *models.Portfolio,
 	pool *models.Pool,
 	tournament *models.Tournament,
+	windows []*models.BiddingWindow,
 	now time.Time,
 ) (Decision, error) {
 	if userID == "" {
@@ -43,7 +47,7 @@ func CanEditPortfolioInvestments(
 		return Decision{Allowed: false, IsAdmin: isAdmin, Status: http.StatusForbidden, Code: "forbidden", Message: "Insufficient permissions"}, nil
 	}
 
-	if ok, reason := tournament.CanEditBids(now, isAdmin); !ok {
+	if ok, reason := models.NewBiddingSchedule(tournament, windows).CanEditBids(now, isAdmin); !ok {
 		code := "tournament_locked"
 		if reason != "" {
 			code = reason
@@ -85,10 +89,11 @@ func CanViewPortfolioData(
 }
 
 // IsBiddingPhaseViewAllowed checks whether a user may view another portfolio's
-// investment-sensitive data (investments, ownership summaries, ownership details) while bidding is
-// still open. Pure function -- no interfaces, no context.
-func IsBiddingPhaseViewAllowed(userID string, portfolio *models.Portfolio, tournament *models.Tournament, now time.Time, isAdmin bool) bool {
-	if tournament.HasStarted(now) {
+// investment-sensitive data (investments, ownership summaries, ownership details) while bids are
+// still sealed: while bidding is open, between the pool's bidding windows and until the pool's
+// reveal. Pure function -- no interfaces, no context.
+func IsBiddingPhaseViewAllowed(userID string, portfolio *models.Portfolio, schedule models.BiddingSchedule, now time.Time, isAdmin bool) bool {
+	if schedule.IsRevealed(now) {
 		return true
 	}
 	if isAdmin {
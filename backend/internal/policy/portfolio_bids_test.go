@@ -19,7 +19,7 @@ func TestThatUnauthenticatedUserCannotEditPortfolioInvestments(t *testing.T) {
 	tournament := &models.Tournament{ID: "t1"}
 
 	// WHEN checking edit investments permission
-	decision, err := CanEditPortfolioInvestments(context.Background(), nil, "", portfolio, pool, tournament, time.Now())
+	decision, err := CanEditPortfolioInvestments(context.Background(), nil, "", portfolio, pool, tournament, nil, time.Now())
 
 	// THEN access is denied with unauthorized status
 	if err != nil {
@@ -36,7 +36,7 @@ func TestThatEditPortfolioInvestmentsDeniesWhenPortfolioIsNil(t *testing.T) {
 	tournament := &models.Tournament{ID: "t1"}
 
 	// WHEN checking edit investments permission
-	decision, err := CanEditPortfolioInvestments(context.Background(), nil, "user1", nil, pool, tournament, time.Now())
+	decision, err := CanEditPortfolioInvestments(context.Background(), nil, "user1", nil, pool, tournament, nil, time.Now())
 
 	// THEN access is denied with bad request status
 	if err != nil {
@@ -54,7 +54,7 @@ func TestThatEditPortfolioInvestmentsDeniesWhenPoolIsNil(t *testing.T) {
 	tournament := &models.Tournament{ID: "t1"}
 
 	// WHEN checking edit investments permission
-	decision, err := CanEditPortfolioInvestments(context.Background(), nil, "u1", portfolio, nil, tournament, time.Now())
+	decision, err := CanEditPortfolioInvestments(context.Background(), nil, "u1", portfolio, nil, tournament, nil, time.Now())
 
 	// THEN access is denied with bad request status
 	if err != nil {
@@ -72,7 +72,7 @@ func TestThatEditPortfolioInvestmentsDeniesWhenTournamentIsNil(t *testing.T) {
 	pool := &models.Pool{ID: "pool1", OwnerID: "owner"}
 
 	// WHEN checking edit investments permission
-	decision, err := CanEditPortfolioInvestments(context.Background(), nil, "u1", portfolio, pool, nil, time.Now())
+	decision, err := CanEditPortfolioInvestments(context.Background(), nil, "u1", portfolio, pool, nil, nil, time.Now())
 
 	// THEN access is denied with bad request status
 	if err != nil {
@@ -93,7 +93,7 @@ func TestThatNonOwnerNonAdminCannotEditPortfolioInvestments(t *testing.T) {
 	authz := &mockAuthzChecker{result: false}
 
 	// WHEN checking edit investments permission
-	decision, err := CanEditPortfolioInvestments(context.Background(), authz, "stranger", portfolio, pool, tournament, time.Now())
+	decision, err := CanEditPortfolioInvestments(context.Background(), authz, "stranger", portfolio, pool, tournament, nil, time.Now())
 
 	// THEN access is denied with forbidden status
 	if err != nil {
@@ -114,7 +114,7 @@ func TestThatPortfolioOwnerCannotEditInvestmentsAfterTournamentStarts(t *testing
 	authz := &mockAuthzChecker{result: false}
 
 	// WHEN checking edit investments permission
-	decision, err := CanEditPortfolioInvestments(context.Background(), authz, "u1", portfolio, pool, tournament, time.Now())
+	decision, err := CanEditPortfolioInvestments(context.Background(), authz, "u1", portfolio, pool, tournament, nil, time.Now())
 
 	// THEN access is denied with locked status
 	if err != nil {
@@ -135,7 +135,7 @@ func TestThatPortfolioOwnerCanEditInvestmentsBeforeTournamentStarts(t *testing.T
 	authz := &mockAuthzChecker{result: false}
 
 	// WHEN checking edit investments permission
-	decision, err := CanEditPortfolioInvestments(context.Background(), authz, "u1", portfolio, pool, tournament, time.Now())
+	decision, err := CanEditPortfolioInvestments(context.Background(), authz, "u1", portfolio, pool, tournament, nil, time.Now())
 
 	// THEN access is allowed
 	if err != nil {
@@ -155,7 +155,7 @@ func TestThatAdminCanEditInvestmentsAfterTournamentStarts(t *testing.T) {
 	tournament := &models.Tournament{ID: "t1", StartingAt: &startingAt}
 
 	// WHEN the pool owner checks edit investments permission
-	decision, err := CanEditPortfolioInvestments(context.Background(), nil, "owner", portfolio, pool, tournament, time.Now())
+	decision, err := CanEditPortfolioInvestments(context.Background(), nil, "owner", portfolio, pool, tournament, nil, time.Now())
 
 	// THEN access is allowed because admins bypass the tournament lock
 	if err != nil {
@@ -293,3 +293,47 @@ func TestThatAdminCanViewPortfolioData(t *testing.T) {
 		t.Fatal("expected admin to be able to view any portfolio data")
 	}
 }
+
+func TestThatEditPortfolioInvestmentsDeniesOutsidePoolBiddingWindow(t *testing.T) {
+	// GIVEN a pool whose only bidding window closed before the tournament starts
+	userID := "u1"
+	portfolio := &models.Portfolio{ID: "p1", UserID: &userID}
+	pool := &models.Pool{ID: "pool1", OwnerID: "owner"}
+	startingAt := time.Now().Add(24 * time.Hour)
+	tournament := &models.Tournament{ID: "t1", StartingAt: &startingAt}
+	windows := []*models.BiddingWindow{{OpensAt: time.Now().Add(-48 * time.Hour), ClosesAt: time.Now().Add(-1 * time.Hour)}}
+	authz := &mockAuthzChecker{result: false}
+
+	// WHEN checking edit investments permission
+	decision, err := CanEditPortfolioInvestments(context.Background(), authz, "u1", portfolio, pool, tournament, windows, time.Now())
+
+	// THEN access is denied with locked status
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision.Status != http.StatusLocked {
+		t.Fatalf("expected status %d, got %d", http.StatusLocked, decision.Status)
+	}
+}
+
+func TestThatEditPortfolioInvestmentsAllowsPoolBiddingWindowAfterTournamentStarts(t *testing.T) {
+	// GIVEN a pool with a bidding window open after the tournament started
+	userID := "u1"
+	portfolio := &models.Portfolio{ID: "p1", UserID: &userID}
+	pool := &models.Pool{ID: "pool1", OwnerID: "owner"}
+	startingAt := time.Now().Add(-24 * time.Hour)
+	tournament := &models.Tournament{ID: "t1", StartingAt: &startingAt}
+	windows := []*models.BiddingWindow{{OpensAt: time.Now().Add(-1 * time.Hour), ClosesAt: time.Now().Add(1 * time.Hour)}}
+	authz := &mockAuthzChecker{result: false}
+
+	// WHEN checking edit investments permission
+	decision, err := CanEditPortfolioInvestments(context.Background(), authz, "u1", portfolio, pool, tournament, windows, time.Now())
+
+	// THEN access is allowed
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !decision.Allowed {
+		t.Fatal("expected bids to be editable inside the window")
+	}
+}
@@ -2,19 +2,26 @@ package policy
 
 import (
 	"context"
+	"fmt"
 	"net/http"
 	"time"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
+// CanCreatePortfolio decides whether userID may create a portfolio in the
+// pool, for themselves or, as commissioner, for targetUserID.
+// heldPortfolios is how many live portfolios the portfolio's owner already
+// holds in the pool.
 func CanCreatePortfolio(
 	ctx context.Context,
 	authz AuthorizationChecker,
 	userID string,
 	pool *models.Pool,
 	tournament *models.Tournament,
+	windows []*models.BiddingWindow,
 	targetUserID *string,
+	heldPortfolios int,
 	now time.Time,
 ) (Decision, error) {
 	if userID == "" {
@@ -38,7 +45,7 @@ func CanCreatePortfolio(
 		return Decision{Allowed: false, IsAdmin: isAdmin, Status: http.StatusForbidden, Code: "forbidden", Message: "Only the commissioner can create portfolios for other users"}, nil
 	}
 
-	if ok, reason := tournament.CanEditBids(now, isAdmin); !ok {
+	if ok, reason := models.NewBiddingSchedule(tournament, windows).CanEditBids(now, isAdmin); !ok {
 		code := "tournament_locked"
 		if reason != "" {
 			code = reason
@@ -46,5 +53,13 @@ func CanCreatePortfolio(
 		return Decision{Allowed: false, IsAdmin: isAdmin, Status: http.StatusLocked, Code: code, Message: "Portfolios are locked"}, nil
 	}
 
+	limit := pool.MaxPortfoliosPerUser
+	if limit < 1 {
+		limit = models.DefaultMaxPortfoliosPerUser
+	}
+	if heldPortfolios >= limit {
+		return Decision{Allowed: false, IsAdmin: isAdmin, Status: http.StatusConflict, Code: "portfolio_limit_reached", Message: fmt.Sprintf("This pool allows at most %d portfolios per user", limit)}, nil
+	}
+
 	return Decision{Allowed: true, IsAdmin: isAdmin}, nil
 }
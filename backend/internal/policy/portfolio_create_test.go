@@ -15,7 +15,7 @@ func TestThatUnauthenticatedUserCannotCreatePortfolio(t *testing.T) {
 	tournament := &models.Tournament{ID: "t1"}
 
 	// WHEN checking create portfolio permission
-	decision, err := CanCreatePortfolio(context.Background(), nil, "", pool, tournament, nil, time.Now())
+	decision, err := CanCreatePortfolio(context.Background(), nil, "", pool, tournament, nil, nil, 0, time.Now())
 
 	// THEN access is denied with unauthorized status
 	if err != nil {
@@ -31,7 +31,7 @@ func TestThatCreatePortfolioDeniesWhenPoolIsNil(t *testing.T) {
 	tournament := &models.Tournament{ID: "t1"}
 
 	// WHEN checking create portfolio permission
-	decision, err := CanCreatePortfolio(context.Background(), nil, "user1", nil, tournament, nil, time.Now())
+	decision, err := CanCreatePortfolio(context.Background(), nil, "user1", nil, tournament, nil, nil, 0, time.Now())
 
 	// THEN access is denied with bad request status
 	if err != nil {
@@ -47,7 +47,7 @@ func TestThatCreatePortfolioDeniesWhenTournamentIsNil(t *testing.T) {
 	pool := &models.Pool{ID: "p1", OwnerID: "owner"}
 
 	// WHEN checking create portfolio permission
-	decision, err := CanCreatePortfolio(context.Background(), nil, "user1", pool, nil, nil, time.Now())
+	decision, err := CanCreatePortfolio(context.Background(), nil, "user1", pool, nil, nil, nil, 0, time.Now())
 
 	// THEN access is denied with bad request status
 	if err != nil {
@@ -67,7 +67,7 @@ func TestThatNonCommissionerCannotCreatePortfolioForAnotherUser(t *testing.T) {
 	targetUserID := "other-user"
 
 	// WHEN checking create portfolio permission for another user
-	decision, err := CanCreatePortfolio(context.Background(), authz, "regular-user", pool, tournament, &targetUserID, time.Now())
+	decision, err := CanCreatePortfolio(context.Background(), authz, "regular-user", pool, tournament, nil, &targetUserID, 0, time.Now())
 
 	// THEN access is denied with forbidden status
 	if err != nil {
@@ -86,7 +86,7 @@ func TestThatNonAdminCannotCreatePortfolioAfterTournamentStarts(t *testing.T) {
 	authz := &mockAuthzChecker{result: false}
 
 	// WHEN checking create portfolio permission
-	decision, err := CanCreatePortfolio(context.Background(), authz, "regular-user", pool, tournament, nil, time.Now())
+	decision, err := CanCreatePortfolio(context.Background(), authz, "regular-user", pool, tournament, nil, nil, 0, time.Now())
 
 	// THEN access is denied with locked status
 	if err != nil {
@@ -105,7 +105,7 @@ func TestThatUserCanCreatePortfolioForSelf(t *testing.T) {
 	authz := &mockAuthzChecker{result: false}
 
 	// WHEN checking create portfolio permission with no target user
-	decision, err := CanCreatePortfolio(context.Background(), authz, "regular-user", pool, tournament, nil, time.Now())
+	decision, err := CanCreatePortfolio(context.Background(), authz, "regular-user", pool, tournament, nil, nil, 0, time.Now())
 
 	// THEN access is allowed
 	if err != nil {
@@ -124,7 +124,7 @@ func TestThatCommissionerCanCreatePortfolioForAnotherUser(t *testing.T) {
 	targetUserID := "other-user"
 
 	// WHEN checking create portfolio permission for another user as the commissioner
-	decision, err := CanCreatePortfolio(context.Background(), nil, "owner", pool, tournament, &targetUserID, time.Now())
+	decision, err := CanCreatePortfolio(context.Background(), nil, "owner", pool, tournament, nil, &targetUserID, 0, time.Now())
 
 	// THEN access is allowed
 	if err != nil {
@@ -134,3 +134,41 @@ func TestThatCommissionerCanCreatePortfolioForAnotherUser(t *testing.T) {
 		t.Fatal("expected commissioner to be able to create portfolio for another user")
 	}
 }
+
+func TestThatUserCanCreateSecondPortfolioWhenPoolAllowsTwo(t *testing.T) {
+	// GIVEN a pool allowing two portfolios per user and a user holding one
+	pool := &models.Pool{ID: "p1", OwnerID: "owner", MaxPortfoliosPerUser: 2}
+	startingAt := time.Now().Add(24 * time.Hour)
+	tournament := &models.Tournament{ID: "t1", StartingAt: &startingAt}
+	authz := &mockAuthzChecker{result: false}
+
+	// WHEN checking create portfolio permission
+	decision, err := CanCreatePortfolio(context.Background(), authz, "regular-user", pool, tournament, nil, nil, 1, time.Now())
+
+	// THEN access is allowed
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if !decision.Allowed {
+		t.Fatal("expected user to be able to create a second portfolio")
+	}
+}
+
+func TestThatCreatePortfolioDeniesWhenUserHoldsPoolLimit(t *testing.T) {
+	// GIVEN a pool allowing two portfolios per user and a user holding two
+	pool := &models.Pool{ID: "p1", OwnerID: "owner", MaxPortfoliosPerUser: 2}
+	startingAt := time.Now().Add(24 * time.Hour)
+	tournament := &models.Tournament{ID: "t1", StartingAt: &startingAt}
+	authz := &mockAuthzChecker{result: false}
+
+	// WHEN checking create portfolio permission
+	decision, err := CanCreatePortfolio(context.Background(), authz, "regular-user", pool, tournament, nil, nil, 2, time.Now())
+
+	// THEN access is denied with conflict status
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if decision.Status != http.StatusConflict {
+		t.Fatalf("expected status %d, got %d", http.StatusConflict, decision.Status)
+	}
+}
@@ -15,6 +15,7 @@ func CanDeletePortfolio(
 	portfolio *models.Portfolio,
 	pool *models.Pool,
 	tournament *models.Tournament,
+	windows []*models.BiddingWindow,
 	now time.Time,
 ) (Decision, error) {
 	if userID == "" {
@@ -43,7 +44,7 @@ func CanDeletePortfolio(
 		return Decision{Allowed: false, IsAdmin: isAdmin, Status: http.StatusForbidden, Code: "forbidden", Message: "Insufficient permissions"}, nil
 	}
 
-	if ok, reason := tournament.CanEditBids(now, isAdmin); !ok {
+	if ok, reason := models.NewBiddingSchedule(tournament, windows).CanEditBids(now, isAdmin); !ok {
 		code := "tournament_locked"
 		if reason != "" {
 			code = reason
@@ -99,6 +99,7 @@ type CareerLeaderboardData struct {
 	Podiums             int
 	InTheMoneys         int
 	Top10s              int
+	// CareerEarningsCents sums the payouts recorded as sent in pool ledgers.
 	CareerEarningsCents int
 	ActiveInLatestPool  bool
 }
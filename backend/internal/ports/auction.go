@@ -0,0 +1,36 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// AuctionRepository stores live auction sessions, their lots and bids.
+// Countdown checks use the database clock so every API instance agrees on
+// when a lot closes.
+type AuctionRepository interface {
+	// CreateAuctionSession returns an AlreadyExistsError when the pool already
+	// has a session.
+	CreateAuctionSession(ctx context.Context, session *models.AuctionSession) error
+	GetAuctionSessionByPool(ctx context.Context, poolID string) (*models.AuctionSession, error)
+	HasAuctionSession(ctx context.Context, poolID string) (bool, error)
+	StartAuctionSession(ctx context.Context, sessionID string) error
+	CompleteAuctionSession(ctx context.Context, sessionID string) error
+	ListAuctionLots(ctx context.Context, sessionID string) ([]*models.AuctionLot, error)
+	// NominateAuctionLot opens the lot and records the nominator's opening bid,
+	// moving the session's nomination index to nextIndex. It fails unless the
+	// session is open, has no open lot and is still at expectedIndex.
+	NominateAuctionLot(ctx context.Context, lot *models.AuctionLot, expectedIndex, nextIndex int) error
+	// PlaceAuctionBid locks the lot, asks validate to accept the bid and then
+	// records it, pushing the countdown out by the session's extension.
+	PlaceAuctionBid(ctx context.Context, lotID, portfolioID string, credits int, validate func(models.AuctionBidContext) error) (*models.AuctionLot, error)
+	// CloseExpiredAuctionLot sells the session's open lot to its high bidder
+	// once the countdown has run out, writing the winner's investment. The
+	// lot is only sold if it is still open, so concurrent closes write one
+	// investment between them. It returns nil when no lot was due.
+	CloseExpiredAuctionLot(ctx context.Context, sessionID string) (*models.AuctionLot, error)
+	// ListPoolIDsWithExpiredAuctionLots returns the pools whose open auction
+	// has a lot past its countdown.
+	ListPoolIDsWithExpiredAuctionLots(ctx context.Context) ([]string, error)
+}
@@ -0,0 +1,19 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// ChangePublisher announces a change to every API instance.
+type ChangePublisher interface {
+	PublishChange(ctx context.Context, ev models.ChangeEvent) error
+}
+
+// ChangeListener receives announced changes. ListenChanges calls onListening
+// once it is subscribed, then handle for each change, until ctx is done or
+// the connection fails.
+type ChangeListener interface {
+	ListenChanges(ctx context.Context, onListening func(), handle func(models.ChangeEvent)) error
+}
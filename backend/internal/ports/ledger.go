@@ -0,0 +1,27 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// LedgerRepository stores the money recorded against a pool's portfolios and
+// whether the pool's books have been settled.
+type LedgerRepository interface {
+	ListLedgerEntries(ctx context.Context, poolID string) ([]*models.LedgerEntry, error)
+	// ListLedgerEntriesByUser returns the entries of every portfolio the user
+	// owns, across all pools.
+	ListLedgerEntriesByUser(ctx context.Context, userID string) ([]*models.LedgerEntry, error)
+	CreateLedgerEntry(ctx context.Context, entry *models.LedgerEntry) error
+	// VoidLedgerEntry returns a NotFoundError when the pool has no such live
+	// entry.
+	VoidLedgerEntry(ctx context.Context, poolID, entryID string) error
+	// GetPoolSettlement returns nil when the pool is unsettled.
+	GetPoolSettlement(ctx context.Context, poolID string) (*models.PoolSettlement, error)
+	// SettlePool returns an AlreadyExistsError when the pool is already
+	// settled.
+	SettlePool(ctx context.Context, settlement *models.PoolSettlement) error
+	// ReopenPool returns a NotFoundError when the pool is not settled.
+	ReopenPool(ctx context.Context, poolID string) error
+}
@@ -2,6 +2,7 @@ package ports
 
 import (
 	"context"
+	"time"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
@@ -29,6 +30,7 @@ type PortfolioReader interface {
 	GetInvestments(ctx context.Context, portfolioID string) ([]*models.Investment, error)
 	GetInvestmentsByPortfolioIDs(ctx context.Context, portfolioIDs []string) (map[string][]*models.Investment, error)
 	GetDistinctUserIDsByPool(ctx context.Context, poolID string) ([]string, error)
+	CountPortfoliosByUser(ctx context.Context, poolID, userID string) (int, error)
 }
 
 type PortfolioWriter interface {
@@ -76,6 +78,46 @@ type PayoutRepository interface {
 	PayoutWriter
 }
 
+type SidePotReader interface {
+	ListSidePots(ctx context.Context, poolID string) ([]*models.SidePot, error)
+}
+
+type SidePotWriter interface {
+	// ReplaceSidePots swaps a pool's side pots and their payouts for pots.
+	ReplaceSidePots(ctx context.Context, poolID string, pots []*models.SidePot) error
+}
+
+type SidePotRepository interface {
+	SidePotReader
+	SidePotWriter
+}
+
+type BiddingWindowReader interface {
+	ListBiddingWindows(ctx context.Context, poolID string) ([]*models.BiddingWindow, error)
+}
+
+type BiddingWindowWriter interface {
+	// ReplaceBiddingWindows swaps a pool's bidding windows for windows.
+	ReplaceBiddingWindows(ctx context.Context, poolID string, windows []*models.BiddingWindow) error
+}
+
+type BiddingWindowRepository interface {
+	BiddingWindowReader
+	BiddingWindowWriter
+}
+
+type MarketRevealRepository interface {
+	// GetMarketReveal returns nil while the pool's bids are unrevealed.
+	GetMarketReveal(ctx context.Context, poolID string) (*models.MarketReveal, error)
+	// CreateMarketReveal returns an AlreadyExistsError when the pool has
+	// already been revealed.
+	CreateMarketReveal(ctx context.Context, reveal *models.MarketReveal) error
+	// ListPoolIDsAwaitingReveal returns the pools with no market reveal yet
+	// whose bidding may have closed by now: their tournament has started or a
+	// bidding window has closed.
+	ListPoolIDsAwaitingReveal(ctx context.Context, now time.Time) ([]string, error)
+}
+
 type PoolInvitationReader interface {
 	ListInvitations(ctx context.Context, poolID string) ([]*models.PoolInvitation, error)
 	GetInvitationByPoolAndUser(ctx context.Context, poolID, userID string) (*models.PoolInvitation, error)
@@ -94,10 +136,23 @@ type PoolInvitationRepository interface {
 	PoolInvitationWriter
 }
 
+type InvestmentSnapshotReader interface {
+	// ListInvestmentSnapshots returns the portfolio's snapshots, oldest first.
+	ListInvestmentSnapshots(ctx context.Context, portfolioID string) ([]*models.InvestmentSnapshot, error)
+	// GetInvestmentSnapshotAsOf returns the last snapshot taken at or before
+	// at, or nil when there is none.
+	GetInvestmentSnapshotAsOf(ctx context.Context, portfolioID string, at time.Time) (*models.InvestmentSnapshot, error)
+}
+
 type InvestmentSnapshotWriter interface {
 	CreateInvestmentSnapshot(ctx context.Context, snapshot *models.InvestmentSnapshot) error
 }
 
+type InvestmentSnapshotRepository interface {
+	InvestmentSnapshotReader
+	InvestmentSnapshotWriter
+}
+
 type TournamentTeamReader interface {
 	GetTournamentTeam(ctx context.Context, id string) (*models.TournamentTeam, error)
 }
@@ -11,6 +11,12 @@ type TournamentDataLoader interface {
 	LoadTeams(ctx context.Context, tournamentID string) ([]models.PredictionTeamInput, error)
 	LoadScoringRules(ctx context.Context, tournamentID string) ([]scoring.Rule, error)
 	LoadFinalFourConfig(ctx context.Context, tournamentID string) (*models.FinalFourConfig, error)
+	LoadBracketTemplate(ctx context.Context, tournamentID string) (*models.BracketTemplate, error)
+	LoadGameResults(ctx context.Context, tournamentID string) ([]*models.GameResult, error)
+	// LoadMatchupProbabilityTable returns nil when no table is stored under sourceKey.
+	LoadMatchupProbabilityTable(ctx context.Context, tournamentID, sourceKey string) (*models.MatchupProbabilityTable, error)
+	LoadGameSites(ctx context.Context, tournamentID string) ([]*models.GameSite, error)
+	LoadLiveGameStates(ctx context.Context, tournamentID string) ([]*models.LiveGameState, error)
 }
 
 type PredictionBatchReader interface {
@@ -18,14 +24,22 @@ type PredictionBatchReader interface {
 	GetLatestBatch(ctx context.Context, tournamentID string) (*models.PredictionBatch, bool, error)
 	GetBatchSummary(ctx context.Context, batchID string) (*models.PredictionBatch, error)
 	GetTeamValues(ctx context.Context, batchID string) ([]models.PredictedTeamValue, error)
+	GetMatchups(ctx context.Context, batchID string) ([]models.PredictedMatchup, error)
 	ListEligibleTournamentsForBackfill(ctx context.Context) ([]string, error)
 }
 
 type PredictionBatchWriter interface {
-	StorePredictions(ctx context.Context, tournamentID string, probSourceKey string, specJSON []byte, values []models.PredictedTeamValue, throughRound int) (string, error)
+	StorePredictions(ctx context.Context, tournamentID string, probSourceKey string, specJSON []byte, values []models.PredictedTeamValue, matchups []models.PredictedMatchup, throughRound int) (string, error)
 	PruneOldBatchesForCheckpoint(ctx context.Context, tournamentID string, throughRound int, keepN int) (int64, error)
 }
 
+type MatchupProbabilityTableRepository interface {
+	LoadTeamIDsBySchoolSlug(ctx context.Context, tournamentID string) (map[string]string, error)
+	ReplaceMatchupProbabilityTable(ctx context.Context, table *models.MatchupProbabilityTable) (*models.MatchupProbabilityTable, error)
+	ListMatchupProbabilityTables(ctx context.Context, tournamentID string) ([]*models.MatchupProbabilityTable, error)
+	DeleteMatchupProbabilityTable(ctx context.Context, tournamentID, sourceKey string) error
+}
+
 type PredictionRepository interface {
 	TournamentDataLoader
 	PredictionBatchReader
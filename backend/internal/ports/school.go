@@ -6,8 +6,9 @@ import (
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
-// SchoolRepository provides read access to schools.
+// SchoolRepository provides access to schools.
 type SchoolRepository interface {
 	List(ctx context.Context) ([]models.School, error)
 	GetByID(ctx context.Context, id string) (*models.School, error)
+	UpdateLocation(ctx context.Context, id string, latitude, longitude *float64) error
 }
@@ -0,0 +1,34 @@
+package ports
+
+import (
+	"context"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// ScoreFeedRepository stores what the score-ingestion worker needs to match
+// feed team names to schools.
+type ScoreFeedRepository interface {
+	ListSchoolSlugs(ctx context.Context) ([]models.SchoolSlug, error)
+	// ListSchoolNameMappings returns every mapping when source is empty.
+	ListSchoolNameMappings(ctx context.Context, source string) ([]*models.SchoolNameMapping, error)
+	UpsertSchoolNameMapping(ctx context.Context, mapping *models.SchoolNameMapping) error
+	DeleteSchoolNameMapping(ctx context.Context, id string) error
+	// EnqueueScoreFeedReview adds a pending review unless one is already
+	// pending for the same source and name key.
+	EnqueueScoreFeedReview(ctx context.Context, review *models.ScoreFeedReview) error
+	// ListScoreFeedReviews returns every review when status is empty.
+	ListScoreFeedReviews(ctx context.Context, status string) ([]*models.ScoreFeedReview, error)
+	// ResolveScoreFeedReview marks a pending review resolved and maps its name
+	// to the school in the same transaction.
+	ResolveScoreFeedReview(ctx context.Context, id, schoolID string, resolvedBy *string) (*models.SchoolNameMapping, error)
+	DismissScoreFeedReview(ctx context.Context, id string, resolvedBy *string) error
+}
+
+// ScoreFeed is a source of game results. Adapters poll files, HTTP endpoints
+// or vendor APIs and translate what they find into ScoreFeedResults.
+type ScoreFeed interface {
+	// Source names the feed; school name mappings are kept per source.
+	Source() string
+	Fetch(ctx context.Context) ([]models.ScoreFeedResult, error)
+}
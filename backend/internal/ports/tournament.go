@@ -10,4 +10,28 @@ type TournamentResolver interface {
 	ResolveCoreTournamentID(ctx context.Context, season int) (string, error)
 	ResolveSeasonFromTournamentID(ctx context.Context, tournamentID string) (int, error)
 	LoadFinalFourConfig(ctx context.Context, coreTournamentID string) (*models.FinalFourConfig, error)
+	LoadBracketTemplate(ctx context.Context, coreTournamentID string) (*models.BracketTemplate, error)
+}
+
+type GameResultReader interface {
+	ListGameResults(ctx context.Context, tournamentID string) ([]*models.GameResult, error)
+}
+
+// GameResultWriter persists game results. Implementations must re-derive team
+// wins and elimination from the stored results in the same transaction.
+type GameResultWriter interface {
+	RecordGameResults(ctx context.Context, tournamentID string, results []*models.GameResult) error
+	RollbackGameResults(ctx context.Context, rollback models.GameResultRollback) (*models.RollbackInvalidations, error)
+}
+
+type GameResultRepository interface {
+	GameResultReader
+	GameResultWriter
+}
+
+// LiveGameRepository stores the latest reported state of games in progress.
+type LiveGameRepository interface {
+	UpsertLiveGameState(ctx context.Context, state *models.LiveGameState) error
+	ListLiveGameStates(ctx context.Context, tournamentID string) ([]*models.LiveGameState, error)
+	DeleteLiveGameState(ctx context.Context, tournamentID, gameID string) error
 }
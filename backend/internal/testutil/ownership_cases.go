@@ -0,0 +1,67 @@
+package testutil
+
+import "github.com/andrewcopp/Calcutta/backend/internal/models"
+
+// OwnershipCase is one team's bids under a pool ownership mode and the share
+// each owner should end up with. Owners are labels; tests map them to
+// portfolios. An owner missing from Want owns nothing.
+type OwnershipCase struct {
+	Name       string
+	Mode       string
+	CapPercent int
+	Credits    map[string]int
+	Want       map[string]float64
+}
+
+// OwnershipCases are the ownership rules as both the Go rules in
+// app/ownership and the derived.investment_shares view must apply them.
+func OwnershipCases() []OwnershipCase {
+	return []OwnershipCase{
+		{
+			Name:    "proportional",
+			Mode:    models.OwnershipModeProportional,
+			Credits: map[string]int{"a": 30, "b": 10},
+			Want:    map[string]float64{"a": 0.75, "b": 0.25},
+		},
+		{
+			Name:    "winner take all",
+			Mode:    models.OwnershipModeWinnerTakeAll,
+			Credits: map[string]int{"a": 30, "b": 10},
+			Want:    map[string]float64{"a": 1},
+		},
+		{
+			Name:    "winner take all with tied top bids",
+			Mode:    models.OwnershipModeWinnerTakeAll,
+			Credits: map[string]int{"a": 20, "b": 20, "c": 5},
+			Want:    map[string]float64{"a": 0.5, "b": 0.5},
+		},
+		{
+			Name:       "capped share over one owner",
+			Mode:       models.OwnershipModeCappedShare,
+			CapPercent: 50,
+			Credits:    map[string]int{"a": 80, "b": 20},
+			Want:       map[string]float64{"a": 0.5, "b": 0.5},
+		},
+		{
+			Name:       "capped share spreading the excess",
+			Mode:       models.OwnershipModeCappedShare,
+			CapPercent: 40,
+			Credits:    map[string]int{"a": 50, "b": 30, "c": 20},
+			Want:       map[string]float64{"a": 0.4, "b": 0.36, "c": 0.24},
+		},
+		{
+			Name:       "capped share cascading past a tie",
+			Mode:       models.OwnershipModeCappedShare,
+			CapPercent: 40,
+			Credits:    map[string]int{"a": 45, "b": 45, "c": 10},
+			Want:       map[string]float64{"a": 0.4, "b": 0.4, "c": 0.2},
+		},
+		{
+			Name:       "capped share with a sole owner",
+			Mode:       models.OwnershipModeCappedShare,
+			CapPercent: 30,
+			Credits:    map[string]int{"a": 10},
+			Want:       map[string]float64{"a": 0.3},
+		},
+	}
+}
@@ -107,22 +107,41 @@ func TruncateAll(ctx context.Context, pool *pgxpool.Pool) error {
 			lab.entries,
 			lab.investment_models,
 			-- compute
+			compute.simulated_team_round_reach,
+			compute.simulated_outcome_chunks,
 			compute.simulated_teams,
 			compute.simulated_tournaments,
 			compute.tournament_snapshot_teams,
 			compute.tournament_snapshots,
+			compute.predicted_matchups,
 			compute.predicted_team_values,
 			compute.prediction_batches,
 			-- derived
 			derived.run_jobs,
 			-- core
+			core.auction_bids,
+			core.auction_lots,
+			core.auction_sessions,
 			core.investment_snapshots,
+			core.pool_ledger_entries,
+			core.pool_settlements,
 			core.investments,
 			core.portfolios,
+			core.side_pot_payouts,
+			core.side_pots,
+			core.pool_bidding_windows,
+			core.pool_market_reveals,
 			core.payouts,
 			core.pool_scoring_rules,
 			core.pool_invitations,
 			core.pools,
+			core.matchup_probabilities,
+			core.matchup_probability_tables,
+			core.game_sites,
+			core.live_game_states,
+			core.score_feed_reviews,
+			core.school_name_mappings,
+			core.game_results,
 			core.team_kenpom_stats,
 			core.teams,
 			core.tournament_imports,
@@ -0,0 +1,153 @@
+package dtos
+
+import (
+	"time"
+
+	appauction "github.com/andrewcopp/Calcutta/backend/internal/app/auction"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// CreateAuctionRequest sets up a live auction for a pool. Zero values take
+// the defaults; an empty nomination order uses the pool's portfolios.
+type CreateAuctionRequest struct {
+	NominationOrder     []string `json:"nominationOrder"`
+	LotSeconds          int      `json:"lotSeconds"`
+	BidExtensionSeconds int      `json:"bidExtensionSeconds"`
+	MinIncrementCredits int      `json:"minIncrementCredits"`
+}
+
+func (r *CreateAuctionRequest) Validate() error {
+	if r.LotSeconds < 0 {
+		return ErrFieldInvalid("lotSeconds", "cannot be negative")
+	}
+	if r.BidExtensionSeconds < 0 {
+		return ErrFieldInvalid("bidExtensionSeconds", "cannot be negative")
+	}
+	if r.MinIncrementCredits < 0 {
+		return ErrFieldInvalid("minIncrementCredits", "cannot be negative")
+	}
+	return nil
+}
+
+// NominateAuctionLotRequest puts a team up for auction with an opening bid.
+type NominateAuctionLotRequest struct {
+	PortfolioID    string `json:"portfolioId"`
+	TeamID         string `json:"teamId"`
+	OpeningCredits int    `json:"openingCredits"`
+}
+
+func (r *NominateAuctionLotRequest) Validate() error {
+	if r.PortfolioID == "" {
+		return ErrFieldRequired("portfolioId")
+	}
+	if r.TeamID == "" {
+		return ErrFieldRequired("teamId")
+	}
+	if r.OpeningCredits < 1 {
+		return ErrFieldInvalid("openingCredits", "must be at least 1")
+	}
+	return nil
+}
+
+type PlaceAuctionBidRequest struct {
+	PortfolioID string `json:"portfolioId"`
+	LotID       string `json:"lotId"`
+	Credits     int    `json:"credits"`
+}
+
+func (r *PlaceAuctionBidRequest) Validate() error {
+	if r.PortfolioID == "" {
+		return ErrFieldRequired("portfolioId")
+	}
+	if r.LotID == "" {
+		return ErrFieldRequired("lotId")
+	}
+	if r.Credits < 1 {
+		return ErrFieldInvalid("credits", "must be at least 1")
+	}
+	return nil
+}
+
+type AuctionSessionResponse struct {
+	ID                  string     `json:"id"`
+	PoolID              string     `json:"poolId"`
+	Status              string     `json:"status"`
+	NominationOrder     []string   `json:"nominationOrder"`
+	LotSeconds          int        `json:"lotSeconds"`
+	BidExtensionSeconds int        `json:"bidExtensionSeconds"`
+	MinIncrementCredits int        `json:"minIncrementCredits"`
+	StartedAt           *time.Time `json:"startedAt,omitempty"`
+	CompletedAt         *time.Time `json:"completedAt,omitempty"`
+	CreatedAt           time.Time  `json:"createdAt"`
+}
+
+func NewAuctionSessionResponse(s *models.AuctionSession) *AuctionSessionResponse {
+	return &AuctionSessionResponse{
+		ID:                  s.ID,
+		PoolID:              s.PoolID,
+		Status:              s.Status,
+		NominationOrder:     s.NominationOrder,
+		LotSeconds:          s.LotSeconds,
+		BidExtensionSeconds: s.BidExtensionSeconds,
+		MinIncrementCredits: s.MinIncrementCredits,
+		StartedAt:           s.StartedAt,
+		CompletedAt:         s.CompletedAt,
+		CreatedAt:           s.CreatedAt,
+	}
+}
+
+type AuctionLotResponse struct {
+	ID                     string     `json:"id"`
+	TeamID                 string     `json:"teamId"`
+	NominatedByPortfolioID string     `json:"nominatedByPortfolioId"`
+	Status                 string     `json:"status"`
+	HighBidCredits         int        `json:"highBidCredits"`
+	HighBidPortfolioID     string     `json:"highBidPortfolioId"`
+	ClosesAt               time.Time  `json:"closesAt"`
+	SoldAt                 *time.Time `json:"soldAt,omitempty"`
+}
+
+func NewAuctionLotResponse(l *models.AuctionLot) *AuctionLotResponse {
+	return &AuctionLotResponse{
+		ID:                     l.ID,
+		TeamID:                 l.TeamID,
+		NominatedByPortfolioID: l.NominatedByPortfolioID,
+		Status:                 l.Status,
+		HighBidCredits:         l.HighBidCredits,
+		HighBidPortfolioID:     l.HighBidPortfolioID,
+		ClosesAt:               l.ClosesAt,
+		SoldAt:                 l.SoldAt,
+	}
+}
+
+// AuctionStateResponse is the whole auction as a client renders it. Clients
+// count down from openLot.closesAt, correcting for their clock with
+// serverTime.
+type AuctionStateResponse struct {
+	Session          *AuctionSessionResponse `json:"session"`
+	OpenLot          *AuctionLotResponse     `json:"openLot,omitempty"`
+	Lots             []*AuctionLotResponse   `json:"lots"`
+	CurrentNominator string                  `json:"currentNominatorPortfolioId,omitempty"`
+	RemainingTeamIDs []string                `json:"remainingTeamIds"`
+	ServerTime       time.Time               `json:"serverTime"`
+}
+
+func NewAuctionStateResponse(state *appauction.State) *AuctionStateResponse {
+	resp := &AuctionStateResponse{
+		Session:          NewAuctionSessionResponse(state.Session),
+		Lots:             make([]*AuctionLotResponse, 0, len(state.Lots)),
+		CurrentNominator: state.CurrentNominator,
+		RemainingTeamIDs: state.RemainingTeamIDs,
+		ServerTime:       state.ServerTime,
+	}
+	if state.OpenLot != nil {
+		resp.OpenLot = NewAuctionLotResponse(state.OpenLot)
+	}
+	for _, l := range state.Lots {
+		resp.Lots = append(resp.Lots, NewAuctionLotResponse(l))
+	}
+	if resp.RemainingTeamIDs == nil {
+		resp.RemainingTeamIDs = []string{}
+	}
+	return resp
+}
@@ -0,0 +1,124 @@
+package dtos
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// maxBiddingWindows bounds a bidding windows request.
+const maxBiddingWindows = 10
+
+type BiddingWindowInput struct {
+	OpensAt  time.Time `json:"opensAt"`
+	ClosesAt time.Time `json:"closesAt"`
+}
+
+// ReplaceBiddingWindowsRequest replaces every bidding window on a pool. An
+// empty list returns the pool to taking bids until its tournament starts.
+type ReplaceBiddingWindowsRequest struct {
+	Windows []BiddingWindowInput `json:"windows"`
+}
+
+func (r *ReplaceBiddingWindowsRequest) Validate() error {
+	if len(r.Windows) > maxBiddingWindows {
+		return ErrFieldInvalid("windows", fmt.Sprintf("a pool may have at most %d bidding windows", maxBiddingWindows))
+	}
+	for _, w := range r.Windows {
+		if w.OpensAt.IsZero() {
+			return ErrFieldRequired("opensAt")
+		}
+		if w.ClosesAt.IsZero() {
+			return ErrFieldRequired("closesAt")
+		}
+		if !w.ClosesAt.After(w.OpensAt) {
+			return ErrFieldInvalid("closesAt", "closesAt must be after opensAt")
+		}
+	}
+
+	sorted := make([]BiddingWindowInput, len(r.Windows))
+	copy(sorted, r.Windows)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i].OpensAt.Before(sorted[j].OpensAt) })
+	for i := 1; i < len(sorted); i++ {
+		if sorted[i].OpensAt.Before(sorted[i-1].ClosesAt) {
+			return ErrFieldInvalid("windows", "bidding windows cannot overlap")
+		}
+	}
+	return nil
+}
+
+func (r *ReplaceBiddingWindowsRequest) ToModels(poolID string) []*models.BiddingWindow {
+	windows := make([]*models.BiddingWindow, 0, len(r.Windows))
+	for _, input := range r.Windows {
+		windows = append(windows, &models.BiddingWindow{
+			PoolID:   poolID,
+			OpensAt:  input.OpensAt.UTC(),
+			ClosesAt: input.ClosesAt.UTC(),
+		})
+	}
+	return windows
+}
+
+type BiddingWindowResponse struct {
+	ID       string    `json:"id"`
+	OpensAt  time.Time `json:"opensAt"`
+	ClosesAt time.Time `json:"closesAt"`
+}
+
+func NewBiddingWindowListResponse(windows []*models.BiddingWindow) []*BiddingWindowResponse {
+	resp := make([]*BiddingWindowResponse, 0, len(windows))
+	for _, w := range windows {
+		if w == nil {
+			continue
+		}
+		resp = append(resp, &BiddingWindowResponse{ID: w.ID, OpensAt: w.OpensAt, ClosesAt: w.ClosesAt})
+	}
+	return resp
+}
+
+// BiddingStatusResponse is where a pool's bidding stands, with the seconds
+// left for a countdown. closesAt is set while bidding is open and
+// nextOpensAt while a later window is still to come. finished means bids
+// are final; revealed means they are no longer sealed, which revealsAt
+// schedules.
+type BiddingStatusResponse struct {
+	Open              bool                     `json:"open"`
+	Finished          bool                     `json:"finished"`
+	Revealed          bool                     `json:"revealed"`
+	RevealsAt         *time.Time               `json:"revealsAt,omitempty"`
+	ClosesAt          *time.Time               `json:"closesAt,omitempty"`
+	SecondsUntilClose *int64                   `json:"secondsUntilClose,omitempty"`
+	NextOpensAt       *time.Time               `json:"nextOpensAt,omitempty"`
+	SecondsUntilOpen  *int64                   `json:"secondsUntilOpen,omitempty"`
+	Windows           []*BiddingWindowResponse `json:"windows"`
+}
+
+func NewBiddingStatusResponse(schedule models.BiddingSchedule, now time.Time) *BiddingStatusResponse {
+	resp := &BiddingStatusResponse{
+		Open:      schedule.IsOpen(now),
+		Finished:  schedule.IsFinished(now),
+		Revealed:  schedule.IsRevealed(now),
+		RevealsAt: schedule.RevealsAt(),
+		Windows:   NewBiddingWindowListResponse(schedule.Windows),
+	}
+	if closesAt := schedule.ClosesAt(now); closesAt != nil {
+		resp.ClosesAt = closesAt
+		resp.SecondsUntilClose = secondsUntil(now, *closesAt)
+	}
+	if next := schedule.NextWindow(now); next != nil {
+		opensAt := next.OpensAt
+		resp.NextOpensAt = &opensAt
+		resp.SecondsUntilOpen = secondsUntil(now, opensAt)
+	}
+	return resp
+}
+
+func secondsUntil(now, t time.Time) *int64 {
+	seconds := int64(t.Sub(now).Seconds())
+	if seconds < 0 {
+		seconds = 0
+	}
+	return &seconds
+}
@@ -0,0 +1,54 @@
+package dtos
+
+import (
+	"testing"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func TestThatReplaceBiddingWindowsRejectsOverlappingWindows(t *testing.T) {
+	// GIVEN a window that opens before the previous one closes
+	opens := time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)
+	req := &ReplaceBiddingWindowsRequest{Windows: []BiddingWindowInput{
+		{OpensAt: opens.Add(24 * time.Hour), ClosesAt: opens.Add(48 * time.Hour)},
+		{OpensAt: opens, ClosesAt: opens.Add(25 * time.Hour)},
+	}}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for overlapping windows")
+	}
+}
+
+func TestThatReplaceBiddingWindowsRejectsWindowClosingBeforeItOpens(t *testing.T) {
+	// GIVEN a window whose close precedes its open
+	opens := time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)
+	req := &ReplaceBiddingWindowsRequest{Windows: []BiddingWindowInput{{OpensAt: opens, ClosesAt: opens.Add(-time.Hour)}}}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for a window closing before it opens")
+	}
+}
+
+func TestThatBiddingStatusCountsDownToCurrentWindowClose(t *testing.T) {
+	// GIVEN a window closing in 90 seconds
+	now := time.Date(2026, 3, 14, 12, 0, 0, 0, time.UTC)
+	windows := []*models.BiddingWindow{{OpensAt: now.Add(-time.Hour), ClosesAt: now.Add(90 * time.Second)}}
+	schedule := models.NewBiddingSchedule(&models.Tournament{}, windows)
+
+	// WHEN building the bidding status
+	resp := NewBiddingStatusResponse(schedule, now)
+
+	// THEN the countdown shows 90 seconds
+	if resp.SecondsUntilClose == nil || *resp.SecondsUntilClose != 90 {
+		t.Errorf("expected 90 seconds until close, got %v", resp.SecondsUntilClose)
+	}
+}
@@ -1,6 +1,10 @@
 package dtos
 
 import (
+	"fmt"
+	"strings"
+	"time"
+
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
@@ -46,15 +50,73 @@ type FinalFourConfigResponse struct {
 // SelectWinnerRequest represents a request to select a winner for a game
 type SelectWinnerRequest struct {
 	WinnerTeamID string `json:"winnerTeamId"`
+	WinnerScore  *int   `json:"winnerScore,omitempty"`
+	LoserScore   *int   `json:"loserScore,omitempty"`
 }
 
 func (r *SelectWinnerRequest) Validate() error {
 	if r.WinnerTeamID == "" {
 		return ErrFieldRequired("winnerTeamId")
 	}
+	if r.WinnerScore != nil && *r.WinnerScore < 0 {
+		return ErrFieldInvalid("winnerScore", "must be non-negative")
+	}
+	if r.LoserScore != nil && *r.LoserScore < 0 {
+		return ErrFieldInvalid("loserScore", "must be non-negative")
+	}
+	if r.WinnerScore != nil && r.LoserScore != nil && *r.WinnerScore <= *r.LoserScore {
+		return ErrFieldInvalid("winnerScore", "must be greater than loserScore")
+	}
 	return nil
 }
 
+// GameResultResponse represents a stored game result
+type GameResultResponse struct {
+	GameID       string    `json:"gameId"`
+	WinnerTeamID string    `json:"winnerTeamId"`
+	LoserTeamID  string    `json:"loserTeamId"`
+	WinnerScore  *int      `json:"winnerScore,omitempty"`
+	LoserScore   *int      `json:"loserScore,omitempty"`
+	DecidedAt    time.Time `json:"decidedAt"`
+	EnteredBy    *string   `json:"enteredBy,omitempty"`
+}
+
+// UnselectWinnerResponse is the rebuilt bracket plus a report of everything
+// the rollback changed
+type UnselectWinnerResponse struct {
+	*BracketResponse
+	RolledBack                   []*GameResultResponse `json:"rolledBack"`
+	InvalidatedPredictionBatches int                   `json:"invalidatedPredictionBatches"`
+	InvalidatedSimulationBatches int                   `json:"invalidatedSimulationBatches"`
+}
+
+// NewUnselectWinnerResponse converts a rollback outcome to a response DTO
+func NewUnselectWinnerResponse(bracket *models.BracketStructure, rolledBack []*models.GameResult, invalidations models.RollbackInvalidations) *UnselectWinnerResponse {
+	return &UnselectWinnerResponse{
+		BracketResponse:              NewBracketResponse(bracket),
+		RolledBack:                   NewGameResultListResponse(rolledBack),
+		InvalidatedPredictionBatches: invalidations.PredictionBatches,
+		InvalidatedSimulationBatches: invalidations.SimulationBatches,
+	}
+}
+
+// NewGameResultListResponse converts stored game results to response DTOs
+func NewGameResultListResponse(results []*models.GameResult) []*GameResultResponse {
+	out := make([]*GameResultResponse, 0, len(results))
+	for _, result := range results {
+		out = append(out, &GameResultResponse{
+			GameID:       result.GameID,
+			WinnerTeamID: result.WinnerTeamID,
+			LoserTeamID:  result.LoserTeamID,
+			WinnerScore:  result.WinnerScore,
+			LoserScore:   result.LoserScore,
+			DecidedAt:    result.DecidedAt,
+			EnteredBy:    result.EnteredBy,
+		})
+	}
+	return out
+}
+
 // NewBracketResponse converts a bracket structure to a response DTO
 func NewBracketResponse(bracket *models.BracketStructure) *BracketResponse {
 	games := make([]*BracketGameResponse, 0, len(bracket.Games))
@@ -118,3 +180,85 @@ func NewFinalFourConfigResponse(config *models.FinalFourConfig) *FinalFourConfig
 		BottomRightRegion: config.BottomRightRegion,
 	}
 }
+
+// UpdateBracketTemplateRequest replaces a tournament's bracket template. A
+// null template restores the default NCAA layout.
+type UpdateBracketTemplateRequest struct {
+	Template *models.BracketTemplate `json:"template"`
+}
+
+func (r *UpdateBracketTemplateRequest) Validate() error {
+	if r.Template == nil {
+		return nil
+	}
+	if r.Template.Key == "" {
+		return ErrFieldRequired("template.key")
+	}
+	if len(r.Template.Regions) == 0 {
+		return ErrFieldRequired("template.regions")
+	}
+	return nil
+}
+
+// GameSiteResponse represents where a bracket game is played
+type GameSiteResponse struct {
+	GameID    string  `json:"gameId"`
+	Name      string  `json:"name"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+// NewGameSiteListResponse converts game sites to response DTOs
+func NewGameSiteListResponse(sites []*models.GameSite) []*GameSiteResponse {
+	out := make([]*GameSiteResponse, 0, len(sites))
+	for _, gs := range sites {
+		out = append(out, &GameSiteResponse{
+			GameID:    gs.GameID,
+			Name:      gs.Name,
+			Latitude:  gs.Latitude,
+			Longitude: gs.Longitude,
+		})
+	}
+	return out
+}
+
+// ReplaceGameSitesRequest replaces every game site in a tournament. An empty
+// list makes every game neutral.
+type ReplaceGameSitesRequest struct {
+	Sites []GameSiteEntry `json:"sites"`
+}
+
+// GameSiteEntry is one game's site in a ReplaceGameSitesRequest.
+type GameSiteEntry struct {
+	GameID    string  `json:"gameId"`
+	Name      string  `json:"name"`
+	Latitude  float64 `json:"latitude"`
+	Longitude float64 `json:"longitude"`
+}
+
+func (r *ReplaceGameSitesRequest) Validate() error {
+	for i, s := range r.Sites {
+		if strings.TrimSpace(s.GameID) == "" {
+			return ErrFieldInvalid("sites", fmt.Sprintf("sites[%d]: gameId is required", i))
+		}
+		if strings.TrimSpace(s.Name) == "" {
+			return ErrFieldInvalid("sites", fmt.Sprintf("sites[%d]: name is required", i))
+		}
+	}
+	return nil
+}
+
+// ToModels converts the request entries to game sites.
+func (r *ReplaceGameSitesRequest) ToModels(tournamentID string) []*models.GameSite {
+	out := make([]*models.GameSite, 0, len(r.Sites))
+	for _, s := range r.Sites {
+		out = append(out, &models.GameSite{
+			TournamentID: tournamentID,
+			GameID:       strings.TrimSpace(s.GameID),
+			Name:         strings.TrimSpace(s.Name),
+			Latitude:     s.Latitude,
+			Longitude:    s.Longitude,
+		})
+	}
+	return out
+}
@@ -0,0 +1,65 @@
+package dtos
+
+import (
+	"time"
+
+	apppool "github.com/andrewcopp/Calcutta/backend/internal/app/pool"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// InvestmentSnapshotResponse is a portfolio's bids as they stood after one
+// change. reason is "admin_override" when a pool admin made the change.
+type InvestmentSnapshotResponse struct {
+	ID           string                           `json:"id"`
+	PortfolioID  string                           `json:"portfolioId"`
+	ChangedBy    string                           `json:"changedBy"`
+	Reason       string                           `json:"reason,omitempty"`
+	CreatedAt    time.Time                        `json:"createdAt"`
+	TotalCredits int                              `json:"totalCredits"`
+	Investments  []models.InvestmentSnapshotEntry `json:"investments"`
+}
+
+func NewInvestmentSnapshotResponse(s *models.InvestmentSnapshot) *InvestmentSnapshotResponse {
+	resp := &InvestmentSnapshotResponse{
+		ID:          s.ID,
+		PortfolioID: s.PortfolioID,
+		ChangedBy:   s.ChangedBy,
+		Reason:      s.Reason,
+		CreatedAt:   s.CreatedAt,
+		Investments: s.Investments,
+	}
+	if resp.Investments == nil {
+		resp.Investments = []models.InvestmentSnapshotEntry{}
+	}
+	for _, entry := range s.Investments {
+		resp.TotalCredits += entry.Credits
+	}
+	return resp
+}
+
+type InvestmentHistoryEntryResponse struct {
+	*InvestmentSnapshotResponse
+	Changes []models.InvestmentSnapshotChange `json:"changes"`
+}
+
+func NewInvestmentHistoryResponse(history []*apppool.InvestmentHistoryEntry) []*InvestmentHistoryEntryResponse {
+	resp := make([]*InvestmentHistoryEntryResponse, 0, len(history))
+	for _, entry := range history {
+		changes := entry.Changes
+		if changes == nil {
+			changes = []models.InvestmentSnapshotChange{}
+		}
+		resp = append(resp, &InvestmentHistoryEntryResponse{
+			InvestmentSnapshotResponse: NewInvestmentSnapshotResponse(entry.Snapshot),
+			Changes:                    changes,
+		})
+	}
+	return resp
+}
+
+// InvestmentsAsOfResponse is a portfolio's bids as they stood at asOf,
+// taken from the snapshot in force then.
+type InvestmentsAsOfResponse struct {
+	AsOf     time.Time                   `json:"asOf"`
+	Snapshot *InvestmentSnapshotResponse `json:"snapshot"`
+}
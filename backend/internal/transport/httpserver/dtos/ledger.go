@@ -0,0 +1,168 @@
+package dtos
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/ledger"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// maxLedgerNoteLength bounds the free-text note on a ledger entry.
+const maxLedgerNoteLength = 500
+
+type CreateLedgerEntryRequest struct {
+	PortfolioID string `json:"portfolioId"`
+	Kind        string `json:"kind"`
+	AmountCents int    `json:"amountCents"`
+	Note        string `json:"note"`
+}
+
+func (r *CreateLedgerEntryRequest) Validate() error {
+	if strings.TrimSpace(r.PortfolioID) == "" {
+		return ErrFieldRequired("portfolioId")
+	}
+	switch r.Kind {
+	case models.LedgerEntryKindPaymentReceived, models.LedgerEntryKindPayoutSent:
+	case "":
+		return ErrFieldRequired("kind")
+	default:
+		return ErrFieldInvalid("kind", "must be payment_received or payout_sent")
+	}
+	if r.AmountCents <= 0 {
+		return ErrFieldInvalid("amountCents", "amountCents must be positive")
+	}
+	if len(r.Note) > maxLedgerNoteLength {
+		return ErrFieldInvalid("note", fmt.Sprintf("note must be at most %d characters", maxLedgerNoteLength))
+	}
+	return nil
+}
+
+func (r *CreateLedgerEntryRequest) ToModel(poolID, recordedBy string) *models.LedgerEntry {
+	return &models.LedgerEntry{
+		PoolID:      poolID,
+		PortfolioID: strings.TrimSpace(r.PortfolioID),
+		Kind:        r.Kind,
+		AmountCents: r.AmountCents,
+		Note:        r.Note,
+		RecordedBy:  recordedBy,
+	}
+}
+
+type LedgerEntryResponse struct {
+	ID          string    `json:"id"`
+	PortfolioID string    `json:"portfolioId"`
+	Kind        string    `json:"kind"`
+	AmountCents int       `json:"amountCents"`
+	Note        string    `json:"note"`
+	RecordedBy  string    `json:"recordedBy"`
+	CreatedAt   time.Time `json:"createdAt"`
+}
+
+func NewLedgerEntryResponse(e *models.LedgerEntry) *LedgerEntryResponse {
+	return &LedgerEntryResponse{
+		ID:          e.ID,
+		PortfolioID: e.PortfolioID,
+		Kind:        e.Kind,
+		AmountCents: e.AmountCents,
+		Note:        e.Note,
+		RecordedBy:  e.RecordedBy,
+		CreatedAt:   e.CreatedAt,
+	}
+}
+
+func NewLedgerEntryListResponse(entries []*models.LedgerEntry) []*LedgerEntryResponse {
+	resp := make([]*LedgerEntryResponse, 0, len(entries))
+	for _, e := range entries {
+		resp = append(resp, NewLedgerEntryResponse(e))
+	}
+	return resp
+}
+
+type SettlementLineResponse struct {
+	PortfolioID     string  `json:"portfolioId"`
+	PortfolioName   string  `json:"portfolioName"`
+	UserID          *string `json:"userId,omitempty"`
+	EntryFeeCents   int     `json:"entryFeeCents"`
+	PaidCents       int     `json:"paidCents"`
+	PayoutOwedCents int     `json:"payoutOwedCents"`
+	PayoutSentCents int     `json:"payoutSentCents"`
+	BalanceCents    int     `json:"balanceCents"`
+}
+
+type SettlementReportResponse struct {
+	Lines                   []*SettlementLineResponse `json:"lines"`
+	CollectedCents          int                       `json:"collectedCents"`
+	PaidOutCents            int                       `json:"paidOutCents"`
+	OutstandingFeesCents    int                       `json:"outstandingFeesCents"`
+	OutstandingPayoutsCents int                       `json:"outstandingPayoutsCents"`
+	Settled                 bool                      `json:"settled"`
+	SettledAt               *time.Time                `json:"settledAt,omitempty"`
+	SettledBy               *string                   `json:"settledBy,omitempty"`
+}
+
+func NewSettlementReportResponse(report *ledger.SettlementReport) *SettlementReportResponse {
+	resp := &SettlementReportResponse{
+		Lines:                   make([]*SettlementLineResponse, 0, len(report.Lines)),
+		CollectedCents:          report.CollectedCents,
+		PaidOutCents:            report.PaidOutCents,
+		OutstandingFeesCents:    report.OutstandingFeesCents,
+		OutstandingPayoutsCents: report.OutstandingPayoutsCents,
+	}
+	for _, line := range report.Lines {
+		resp.Lines = append(resp.Lines, &SettlementLineResponse{
+			PortfolioID:     line.Portfolio.ID,
+			PortfolioName:   line.Portfolio.Name,
+			UserID:          line.Portfolio.UserID,
+			EntryFeeCents:   line.EntryFeeCents,
+			PaidCents:       line.PaidCents,
+			PayoutOwedCents: line.PayoutOwedCents,
+			PayoutSentCents: line.PayoutSentCents,
+			BalanceCents:    line.BalanceCents,
+		})
+	}
+	if s := report.Settlement; s != nil {
+		resp.Settled = true
+		settledAt, settledBy := s.SettledAt, s.SettledBy
+		resp.SettledAt = &settledAt
+		resp.SettledBy = &settledBy
+	}
+	return resp
+}
+
+type PoolPositionResponse struct {
+	PoolID        string `json:"poolId"`
+	PoolName      string `json:"poolName"`
+	PaidCents     int    `json:"paidCents"`
+	ReceivedCents int    `json:"receivedCents"`
+	NetCents      int    `json:"netCents"`
+}
+
+type NetPositionResponse struct {
+	PaidCents     int                     `json:"paidCents"`
+	ReceivedCents int                     `json:"receivedCents"`
+	NetCents      int                     `json:"netCents"`
+	Pools         []*PoolPositionResponse `json:"pools"`
+}
+
+// NewNetPositionResponse names each pool from poolNames, leaving the name
+// empty for pools that could not be loaded.
+func NewNetPositionResponse(pos *ledger.NetPosition, poolNames map[string]string) *NetPositionResponse {
+	resp := &NetPositionResponse{
+		PaidCents:     pos.PaidCents,
+		ReceivedCents: pos.ReceivedCents,
+		NetCents:      pos.NetCents,
+		Pools:         make([]*PoolPositionResponse, 0, len(pos.Pools)),
+	}
+	for _, p := range pos.Pools {
+		resp.Pools = append(resp.Pools, &PoolPositionResponse{
+			PoolID:        p.PoolID,
+			PoolName:      poolNames[p.PoolID],
+			PaidCents:     p.PaidCents,
+			ReceivedCents: p.ReceivedCents,
+			NetCents:      p.NetCents,
+		})
+	}
+	return resp
+}
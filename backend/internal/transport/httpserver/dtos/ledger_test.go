@@ -0,0 +1,29 @@
+package dtos
+
+import "testing"
+
+func TestThatCreateLedgerEntryRejectsUnknownKind(t *testing.T) {
+	// GIVEN an entry with an unknown kind
+	req := &CreateLedgerEntryRequest{PortfolioID: "p1", Kind: "refund", AmountCents: 1000}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for unknown kind")
+	}
+}
+
+func TestThatCreateLedgerEntryRejectsNonPositiveAmount(t *testing.T) {
+	// GIVEN a payment of zero cents
+	req := &CreateLedgerEntryRequest{PortfolioID: "p1", Kind: "payment_received"}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for zero amountCents")
+	}
+}
@@ -0,0 +1,71 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/prediction"
+)
+
+// UpdateLiveGameRequest reports the state of a bracket game in progress.
+// Scores are in the game's team order; team IDs, when given, must match it.
+type UpdateLiveGameRequest struct {
+	Team1ID          string `json:"team1Id,omitempty"`
+	Team2ID          string `json:"team2Id,omitempty"`
+	Team1Score       int    `json:"team1Score"`
+	Team2Score       int    `json:"team2Score"`
+	SecondsRemaining *int   `json:"secondsRemaining"`
+	PossessionTeamID string `json:"possessionTeamId,omitempty"`
+	Source           string `json:"source,omitempty"`
+}
+
+func (r *UpdateLiveGameRequest) Validate() error {
+	if r.Team1Score < 0 {
+		return ErrFieldInvalid("team1Score", "must be non-negative")
+	}
+	if r.Team2Score < 0 {
+		return ErrFieldInvalid("team2Score", "must be non-negative")
+	}
+	if r.SecondsRemaining == nil {
+		return ErrFieldRequired("secondsRemaining")
+	}
+	if *r.SecondsRemaining < 0 {
+		return ErrFieldInvalid("secondsRemaining", "must be non-negative")
+	}
+	return nil
+}
+
+// LiveGameResponse is a game in progress with its in-game win probability.
+type LiveGameResponse struct {
+	GameID            string    `json:"gameId"`
+	Team1ID           string    `json:"team1Id"`
+	Team2ID           string    `json:"team2Id"`
+	Team1Score        int       `json:"team1Score"`
+	Team2Score        int       `json:"team2Score"`
+	SecondsRemaining  int       `json:"secondsRemaining"`
+	PossessionTeamID  *string   `json:"possessionTeamId,omitempty"`
+	Source            string    `json:"source"`
+	PregamePTeam1Wins float64   `json:"pregamePTeam1Wins"`
+	PTeam1Wins        float64   `json:"pTeam1Wins"`
+	UpdatedAt         time.Time `json:"updatedAt"`
+}
+
+// NewLiveGameListResponse converts priced live games to response DTOs.
+func NewLiveGameListResponse(games []prediction.LiveGameProbability) []*LiveGameResponse {
+	out := make([]*LiveGameResponse, 0, len(games))
+	for _, g := range games {
+		out = append(out, &LiveGameResponse{
+			GameID:            g.State.GameID,
+			Team1ID:           g.State.Team1ID,
+			Team2ID:           g.State.Team2ID,
+			Team1Score:        g.State.Team1Score,
+			Team2Score:        g.State.Team2Score,
+			SecondsRemaining:  g.State.SecondsRemaining,
+			PossessionTeamID:  g.State.PossessionTeamID,
+			Source:            g.State.Source,
+			PregamePTeam1Wins: g.PregamePTeam1Wins,
+			PTeam1Wins:        g.PTeam1Wins,
+			UpdatedAt:         g.State.UpdatedAt,
+		})
+	}
+	return out
+}
@@ -0,0 +1,62 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// MarketRevealTeamResponse is one team's line in a market summary.
+// rankDelta is expectedRank minus marketRank: positive when the pool paid
+// more for the team than its predicted points justify.
+type MarketRevealTeamResponse struct {
+	TeamID         string   `json:"teamId"`
+	TotalCredits   int      `json:"totalCredits"`
+	Bidders        int      `json:"bidders"`
+	MarketShare    float64  `json:"marketShare"`
+	MarketRank     int      `json:"marketRank"`
+	ExpectedPoints *float64 `json:"expectedPoints,omitempty"`
+	ExpectedShare  *float64 `json:"expectedShare,omitempty"`
+	ExpectedRank   *int     `json:"expectedRank,omitempty"`
+	RankDelta      *int     `json:"rankDelta,omitempty"`
+}
+
+type MarketRevealResponse struct {
+	PoolID            string                      `json:"poolId"`
+	RevealedAt        time.Time                   `json:"revealedAt"`
+	RevealedBy        *string                     `json:"revealedBy,omitempty"`
+	PredictionBatchID *string                     `json:"predictionBatchId,omitempty"`
+	TotalCredits      int                         `json:"totalCredits"`
+	PortfolioCount    int                         `json:"portfolioCount"`
+	Teams             []*MarketRevealTeamResponse `json:"teams"`
+}
+
+func NewMarketRevealResponse(reveal *models.MarketReveal) *MarketRevealResponse {
+	resp := &MarketRevealResponse{
+		PoolID:            reveal.PoolID,
+		RevealedAt:        reveal.RevealedAt,
+		RevealedBy:        reveal.RevealedBy,
+		PredictionBatchID: reveal.PredictionBatchID,
+		TotalCredits:      reveal.TotalCredits,
+		PortfolioCount:    reveal.PortfolioCount,
+		Teams:             make([]*MarketRevealTeamResponse, 0, len(reveal.Teams)),
+	}
+	for _, t := range reveal.Teams {
+		team := &MarketRevealTeamResponse{
+			TeamID:         t.TeamID,
+			TotalCredits:   t.TotalCredits,
+			Bidders:        t.Bidders,
+			MarketShare:    t.MarketShare,
+			MarketRank:     t.MarketRank,
+			ExpectedPoints: t.ExpectedPoints,
+			ExpectedShare:  t.ExpectedShare,
+			ExpectedRank:   t.ExpectedRank,
+		}
+		if t.ExpectedRank != nil {
+			delta := *t.ExpectedRank - t.MarketRank
+			team.RankDelta = &delta
+		}
+		resp.Teams = append(resp.Teams, team)
+	}
+	return resp
+}
@@ -0,0 +1,21 @@
+package dtos
+
+import (
+	"testing"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+func TestThatMarketRevealResponseReportsRankDelta(t *testing.T) {
+	// GIVEN a team the market ranked first but predictions rank third
+	expectedRank := 3
+	reveal := &models.MarketReveal{Teams: []models.MarketRevealTeam{{TeamID: "duke", MarketRank: 1, ExpectedRank: &expectedRank}}}
+
+	// WHEN building the response
+	resp := NewMarketRevealResponse(reveal)
+
+	// THEN the rank delta shows the market paid up by two places
+	if resp.Teams[0].RankDelta == nil || *resp.Teams[0].RankDelta != 2 {
+		t.Fatalf("expected rank delta 2, got %v", resp.Teams[0].RankDelta)
+	}
+}
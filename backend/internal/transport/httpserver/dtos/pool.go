@@ -8,9 +8,11 @@ import (
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
+// ScoringRuleInput is one scoring rule. Kind defaults to "round".
 type ScoringRuleInput struct {
-	WinIndex      int `json:"winIndex"`
-	PointsAwarded int `json:"pointsAwarded"`
+	Kind          string `json:"kind"`
+	WinIndex      int    `json:"winIndex"`
+	PointsAwarded int    `json:"pointsAwarded"`
 }
 
 type CreatePoolRequest struct {
@@ -20,6 +22,13 @@ type CreatePoolRequest struct {
 	MaxTeams             int                `json:"maxTeams"`
 	MaxInvestmentCredits int                `json:"maxInvestmentCredits"`
 	BudgetCredits        int                `json:"budgetCredits"`
+	OwnershipMode        string             `json:"ownershipMode"`
+	OwnershipCapPercent  *int               `json:"ownershipCapPercent"`
+	UnclaimedMode        string             `json:"unclaimedMode"`
+	TieBreaker           string             `json:"tieBreaker"`
+	EntryFeeCents        int                `json:"entryFeeCents"`
+	MaxPortfoliosPerUser int                `json:"maxPortfoliosPerUser"`
+	RevealAt             *time.Time         `json:"revealAt"`
 	ScoringRules         []ScoringRuleInput `json:"scoringRules"`
 }
 
@@ -33,18 +42,120 @@ func (r *CreatePoolRequest) Validate() error {
 	if len(r.ScoringRules) == 0 {
 		return ErrFieldRequired("scoringRules")
 	}
-	seen := make(map[int]bool, len(r.ScoringRules))
+	type ruleKey struct {
+		kind     string
+		winIndex int
+	}
+	seen := make(map[ruleKey]bool, len(r.ScoringRules))
 	for _, rule := range r.ScoringRules {
+		kind := rule.Kind
+		if kind == "" {
+			kind = models.ScoringRuleKindRound
+		}
+		switch kind {
+		case models.ScoringRuleKindRound, models.ScoringRuleKindSeedMultiplier, models.ScoringRuleKindUpsetBonus, models.ScoringRuleKindChampionBonus:
+		default:
+			return ErrFieldInvalid("scoringRules", fmt.Sprintf("unknown kind %q", rule.Kind))
+		}
 		if rule.WinIndex < 1 {
 			return ErrFieldInvalid("scoringRules", fmt.Sprintf("winIndex must be >= 1, got %d", rule.WinIndex))
 		}
 		if rule.PointsAwarded < 0 {
 			return ErrFieldInvalid("scoringRules", fmt.Sprintf("pointsAwarded must be >= 0, got %d", rule.PointsAwarded))
 		}
-		if seen[rule.WinIndex] {
-			return ErrFieldInvalid("scoringRules", fmt.Sprintf("duplicate winIndex %d", rule.WinIndex))
+		key := ruleKey{kind: kind, winIndex: rule.WinIndex}
+		if seen[key] {
+			return ErrFieldInvalid("scoringRules", fmt.Sprintf("duplicate %s rule for winIndex %d", kind, rule.WinIndex))
+		}
+		seen[key] = true
+	}
+	if r.OwnershipMode != "" || r.OwnershipCapPercent != nil {
+		mode := r.OwnershipMode
+		if mode == "" {
+			mode = models.OwnershipModeProportional
+		}
+		if err := ValidateOwnership(mode, r.OwnershipCapPercent); err != nil {
+			return err
+		}
+	}
+	if r.UnclaimedMode != "" {
+		if err := ValidateUnclaimedMode(r.UnclaimedMode); err != nil {
+			return err
+		}
+	}
+	if r.TieBreaker != "" {
+		if err := ValidateTieBreaker(r.TieBreaker); err != nil {
+			return err
+		}
+	}
+	if r.EntryFeeCents < 0 {
+		return ErrFieldInvalid("entryFeeCents", "cannot be negative")
+	}
+	if r.MaxPortfoliosPerUser != 0 {
+		if err := ValidateMaxPortfoliosPerUser(r.MaxPortfoliosPerUser); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ValidateOwnership checks a pool's ownership mode and cap together: capped
+// pools need a cap between 1 and 100 percent, and other modes take none.
+func ValidateOwnership(mode string, capPercent *int) error {
+	switch mode {
+	case models.OwnershipModeProportional, models.OwnershipModeWinnerTakeAll:
+		if capPercent != nil {
+			return ErrFieldInvalid("ownershipCapPercent", "only applies to capped_share pools")
+		}
+	case models.OwnershipModeCappedShare:
+		if capPercent == nil {
+			return ErrFieldRequired("ownershipCapPercent")
+		}
+		if *capPercent < 1 || *capPercent > 100 {
+			return ErrFieldInvalid("ownershipCapPercent", "must be between 1 and 100")
+		}
+	default:
+		return ErrFieldInvalid("ownershipMode", "must be proportional, winner_take_all or capped_share")
+	}
+	return nil
+}
+
+// ValidateUnclaimedMode checks how a pool handles teams nobody bid on.
+func ValidateUnclaimedMode(mode string) error {
+	switch mode {
+	case models.UnclaimedModeForfeit, models.UnclaimedModeProRata, models.UnclaimedModeLeftovers:
+		return nil
+	default:
+		return ErrFieldInvalid("unclaimedMode", "must be forfeit, pro_rata or leftovers")
+	}
+}
+
+// ValidateTieBreaker checks how a pool resolves portfolios tied on points.
+func ValidateTieBreaker(rule string) error {
+	switch rule {
+	case models.TieBreakerSplit, models.TieBreakerChampionPoints, models.TieBreakerFewestCredits, models.TieBreakerEarliestSubmission:
+		return nil
+	default:
+		return ErrFieldInvalid("tieBreaker", "must be split, champion_points, fewest_credits or earliest_submission")
+	}
+}
+
+// ValidateMaxPortfoliosPerUser checks how many portfolios one user may hold
+// in a pool.
+func ValidateMaxPortfoliosPerUser(limit int) error {
+	if limit < 1 || limit > models.MaxPortfoliosPerUserLimit {
+		return ErrFieldInvalid("maxPortfoliosPerUser", fmt.Sprintf("must be between 1 and %d", models.MaxPortfoliosPerUserLimit))
+	}
+	return nil
+}
+
+// ValidateChampionBonus checks that every champion_bonus rule pays on the
+// title win, the tournament's last win index.
+func (r *CreatePoolRequest) ValidateChampionBonus(titleWinIndex int) error {
+	for _, rule := range r.ScoringRules {
+		if rule.Kind == models.ScoringRuleKindChampionBonus && rule.WinIndex != titleWinIndex {
+			return ErrFieldInvalid("scoringRules", fmt.Sprintf("champion_bonus must pay on the title win, winIndex %d, got %d", titleWinIndex, rule.WinIndex))
 		}
-		seen[rule.WinIndex] = true
 	}
 	return nil
 }
@@ -57,6 +168,13 @@ func (r *CreatePoolRequest) ToModel() *models.Pool {
 		MaxTeams:             r.MaxTeams,
 		MaxInvestmentCredits: r.MaxInvestmentCredits,
 		BudgetCredits:        r.BudgetCredits,
+		OwnershipMode:        r.OwnershipMode,
+		OwnershipCapPercent:  r.OwnershipCapPercent,
+		UnclaimedMode:        r.UnclaimedMode,
+		TieBreaker:           r.TieBreaker,
+		EntryFeeCents:        r.EntryFeeCents,
+		MaxPortfoliosPerUser: r.MaxPortfoliosPerUser,
+		RevealAt:             r.RevealAt,
 	}
 }
 
@@ -64,6 +182,7 @@ func (r *CreatePoolRequest) ToScoringRules() []*models.ScoringRule {
 	rules := make([]*models.ScoringRule, len(r.ScoringRules))
 	for i, input := range r.ScoringRules {
 		rules[i] = &models.ScoringRule{
+			Kind:          input.Kind,
 			WinIndex:      input.WinIndex,
 			PointsAwarded: input.PointsAwarded,
 		}
@@ -88,6 +207,13 @@ type PoolResponse struct {
 	MaxInvestmentCredits int            `json:"maxInvestmentCredits"`
 	BudgetCredits        int            `json:"budgetCredits"`
 	Visibility           string         `json:"visibility"`
+	OwnershipMode        string         `json:"ownershipMode"`
+	OwnershipCapPercent  *int           `json:"ownershipCapPercent,omitempty"`
+	UnclaimedMode        string         `json:"unclaimedMode"`
+	TieBreaker           string         `json:"tieBreaker"`
+	EntryFeeCents        int            `json:"entryFeeCents"`
+	MaxPortfoliosPerUser int            `json:"maxPortfoliosPerUser"`
+	RevealAt             *time.Time     `json:"revealAt,omitempty"`
 	CreatedAt            time.Time      `json:"createdAt"`
 	UpdatedAt            time.Time      `json:"updatedAt"`
 	Abilities            *PoolAbilities `json:"abilities,omitempty"`
@@ -104,20 +230,34 @@ func NewPoolResponse(p *models.Pool) *PoolResponse {
 		MaxInvestmentCredits: p.MaxInvestmentCredits,
 		BudgetCredits:        p.BudgetCredits,
 		Visibility:           p.Visibility,
+		OwnershipMode:        p.OwnershipMode,
+		OwnershipCapPercent:  p.OwnershipCapPercent,
+		UnclaimedMode:        p.UnclaimedMode,
+		TieBreaker:           p.TieBreaker,
+		EntryFeeCents:        p.EntryFeeCents,
+		MaxPortfoliosPerUser: p.MaxPortfoliosPerUser,
+		RevealAt:             p.RevealAt,
 		CreatedAt:            p.CreatedAt,
 		UpdatedAt:            p.UpdatedAt,
 	}
 }
 
 type UpdatePoolRequest struct {
-	Name                 *string `json:"name,omitempty"`
-	MinTeams             *int    `json:"minTeams,omitempty"`
-	MaxTeams             *int    `json:"maxTeams,omitempty"`
-	MaxInvestmentCredits *int    `json:"maxInvestmentCredits,omitempty"`
+	Name                 *string    `json:"name,omitempty"`
+	MinTeams             *int       `json:"minTeams,omitempty"`
+	MaxTeams             *int       `json:"maxTeams,omitempty"`
+	MaxInvestmentCredits *int       `json:"maxInvestmentCredits,omitempty"`
+	OwnershipMode        *string    `json:"ownershipMode,omitempty"`
+	OwnershipCapPercent  *int       `json:"ownershipCapPercent,omitempty"`
+	UnclaimedMode        *string    `json:"unclaimedMode,omitempty"`
+	TieBreaker           *string    `json:"tieBreaker,omitempty"`
+	EntryFeeCents        *int       `json:"entryFeeCents,omitempty"`
+	MaxPortfoliosPerUser *int       `json:"maxPortfoliosPerUser,omitempty"`
+	RevealAt             *time.Time `json:"revealAt,omitempty"`
 }
 
 func (r *UpdatePoolRequest) Validate() error {
-	if r.Name == nil && r.MinTeams == nil && r.MaxTeams == nil && r.MaxInvestmentCredits == nil {
+	if r.Name == nil && r.MinTeams == nil && r.MaxTeams == nil && r.MaxInvestmentCredits == nil && r.OwnershipMode == nil && r.OwnershipCapPercent == nil && r.UnclaimedMode == nil && r.TieBreaker == nil && r.EntryFeeCents == nil && r.MaxPortfoliosPerUser == nil && r.RevealAt == nil {
 		return ErrFieldInvalid("body", "at least one field must be provided")
 	}
 	if r.Name != nil && strings.TrimSpace(*r.Name) == "" {
@@ -132,6 +272,24 @@ func (r *UpdatePoolRequest) Validate() error {
 	if r.MaxInvestmentCredits != nil && *r.MaxInvestmentCredits <= 0 {
 		return ErrFieldInvalid("maxInvestmentCredits", "must be greater than 0")
 	}
+	if r.UnclaimedMode != nil {
+		if err := ValidateUnclaimedMode(*r.UnclaimedMode); err != nil {
+			return err
+		}
+	}
+	if r.TieBreaker != nil {
+		if err := ValidateTieBreaker(*r.TieBreaker); err != nil {
+			return err
+		}
+	}
+	if r.EntryFeeCents != nil && *r.EntryFeeCents < 0 {
+		return ErrFieldInvalid("entryFeeCents", "cannot be negative")
+	}
+	if r.MaxPortfoliosPerUser != nil {
+		if err := ValidateMaxPortfoliosPerUser(*r.MaxPortfoliosPerUser); err != nil {
+			return err
+		}
+	}
 	return nil
 }
 
@@ -3,6 +3,7 @@ package dtos
 import (
 	"time"
 
+	apppool "github.com/andrewcopp/Calcutta/backend/internal/app/pool"
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 )
 
@@ -10,8 +11,12 @@ type PoolDashboardResponse struct {
 	Pool                 *PoolResponse               `json:"pool"`
 	TournamentStartingAt *time.Time                  `json:"tournamentStartingAt,omitempty"`
 	InvestingOpen        bool                        `json:"investingOpen"`
+	Bidding              *BiddingStatusResponse      `json:"bidding"`
 	TotalPortfolios      int                         `json:"totalPortfolios"`
 	CurrentUserPortfolio *PortfolioResponse           `json:"currentUserPortfolio,omitempty"`
+	// CurrentUserPortfolios lists every portfolio the user holds in the pool;
+	// currentUserPortfolio is the first of them.
+	CurrentUserPortfolios []*PortfolioResponse        `json:"currentUserPortfolios,omitempty"`
 	Abilities            *PoolAbilities               `json:"abilities,omitempty"`
 	ScoringRules         []*ScoringRuleResponse       `json:"scoringRules"`
 	Portfolios           []*PortfolioResponse         `json:"portfolios"`
@@ -22,17 +27,36 @@ type PoolDashboardResponse struct {
 	TournamentTeams      []*TournamentTeamResponse    `json:"tournamentTeams"`
 	RoundStandings       []*RoundStandingGroup        `json:"roundStandings"`
 	FinalFourOutcomes    []*FinalFourOutcomeResponse  `json:"finalFourOutcomes,omitempty"`
+	ExactOutcomes        *ExactOutcomesResponse       `json:"exactOutcomes,omitempty"`
+	UnclaimedTeams       []*UnclaimedTeamResponse     `json:"unclaimedTeams"`
+	SidePots             []*SidePotStandingGroup      `json:"sidePots"`
+}
+
+// SidePotStandingGroup is one side pot's leaderboard. Each entry's
+// totalReturns holds the pot's metric.
+type SidePotStandingGroup struct {
+	*SidePotResponse
+	Entries []*RoundStandingEntry `json:"entries"`
+}
+
+// UnclaimedTeamResponse is a team nobody bid on and the points it has scored.
+// The pool's unclaimedMode says where those points go.
+type UnclaimedTeamResponse struct {
+	TeamID string `json:"teamId"`
+	Points int    `json:"points"`
 }
 
 type ScoringRuleResponse struct {
-	WinIndex      int `json:"winIndex"`
-	PointsAwarded int `json:"pointsAwarded"`
+	Kind          string `json:"kind"`
+	WinIndex      int    `json:"winIndex"`
+	PointsAwarded int    `json:"pointsAwarded"`
 }
 
 func NewScoringRuleListResponse(rules []*models.ScoringRule) []*ScoringRuleResponse {
 	resp := make([]*ScoringRuleResponse, len(rules))
 	for i, r := range rules {
 		resp[i] = &ScoringRuleResponse{
+			Kind:          r.Kind,
 			WinIndex:      r.WinIndex,
 			PointsAwarded: r.PointsAwarded,
 		}
@@ -54,6 +78,7 @@ type RoundStandingEntry struct {
 	InTheMoney         bool     `json:"inTheMoney"`
 	ExpectedValue      *float64 `json:"expectedValue,omitempty"`
 	ProjectedFavorites *float64 `json:"projectedFavorites,omitempty"`
+	TieResolution      *TieResolutionResponse `json:"tieResolution,omitempty"`
 }
 
 type FinalFourTeam struct {
@@ -83,6 +108,44 @@ func NewFinalFourTeam(bt *models.BracketTeam) *FinalFourTeam {
 	}
 }
 
+// ExactOutcomesResponse is each portfolio's exact finish distribution over
+// every way the remaining games can go. finishProbabilities[k] is the chance
+// of finishing in position k+1.
+type ExactOutcomesResponse struct {
+	RemainingGames int                   `json:"remainingGames"`
+	Outcomes       int                   `json:"outcomes"`
+	Entries        []*ExactOutcomesEntry `json:"entries"`
+}
+
+type ExactOutcomesEntry struct {
+	PortfolioID         string    `json:"portfolioId"`
+	FinishProbabilities []float64 `json:"finishProbabilities"`
+	ExpectedReturns     float64   `json:"expectedReturns"`
+	ExpectedPayoutCents float64   `json:"expectedPayoutCents"`
+	PInTheMoney         float64   `json:"pInTheMoney"`
+}
+
+func NewExactOutcomesResponse(o *apppool.ExactOutcomes) *ExactOutcomesResponse {
+	if o == nil {
+		return nil
+	}
+	resp := &ExactOutcomesResponse{
+		RemainingGames: o.RemainingGames,
+		Outcomes:       o.Outcomes,
+		Entries:        make([]*ExactOutcomesEntry, 0, len(o.Portfolios)),
+	}
+	for _, p := range o.Portfolios {
+		resp.Entries = append(resp.Entries, &ExactOutcomesEntry{
+			PortfolioID:         p.PortfolioID,
+			FinishProbabilities: p.FinishProbabilities,
+			ExpectedReturns:     p.ExpectedReturns,
+			ExpectedPayoutCents: p.ExpectedPayoutCents,
+			PInTheMoney:         p.PInTheMoney,
+		})
+	}
+	return resp
+}
+
 type PoolWithRankingResponse struct {
 	*PoolResponse
 	HasPortfolio         bool                    `json:"hasPortfolio"`
@@ -90,8 +153,13 @@ type PoolWithRankingResponse struct {
 	Ranking              *PoolRankingResponse     `json:"ranking,omitempty"`
 }
 
+// PoolRankingResponse is the user's place in a pool. With several
+// portfolios, rank and returns come from the best-placed one and payoutCents
+// sums all of them.
 type PoolRankingResponse struct {
 	Rank            int     `json:"rank"`
 	TotalPortfolios int     `json:"totalPortfolios"`
 	Returns         float64 `json:"returns"`
+	PortfolioCount  int     `json:"portfolioCount"`
+	PayoutCents     int     `json:"payoutCents"`
 }
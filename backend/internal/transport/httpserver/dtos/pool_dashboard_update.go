@@ -0,0 +1,36 @@
+package dtos
+
+// PoolDashboardUpdate is the part of the dashboard that moves while games are
+// played, pushed to live dashboard streams. Reasons lists the kinds of change
+// that prompted it; PredictionBatchID names the batch when one was created.
+type PoolDashboardUpdate struct {
+	Reasons              []string                    `json:"reasons"`
+	PredictionBatchID    string                      `json:"predictionBatchId,omitempty"`
+	InvestingOpen        bool                        `json:"investingOpen"`
+	Bidding              *BiddingStatusResponse      `json:"bidding"`
+	TotalPortfolios      int                         `json:"totalPortfolios"`
+	CurrentUserPortfolio *PortfolioResponse          `json:"currentUserPortfolio,omitempty"`
+	Portfolios           []*PortfolioResponse        `json:"portfolios"`
+	OwnershipSummaries   []*OwnershipSummaryResponse `json:"ownershipSummaries"`
+	OwnershipDetails     []*OwnershipDetailResponse  `json:"ownershipDetails"`
+	TournamentTeams      []*TournamentTeamResponse   `json:"tournamentTeams"`
+	RoundStandings       []*RoundStandingGroup       `json:"roundStandings"`
+	FinalFourOutcomes    []*FinalFourOutcomeResponse `json:"finalFourOutcomes,omitempty"`
+}
+
+func NewPoolDashboardUpdate(dashboard *PoolDashboardResponse, reasons []string, predictionBatchID string) *PoolDashboardUpdate {
+	return &PoolDashboardUpdate{
+		Reasons:              reasons,
+		PredictionBatchID:    predictionBatchID,
+		InvestingOpen:        dashboard.InvestingOpen,
+		Bidding:              dashboard.Bidding,
+		TotalPortfolios:      dashboard.TotalPortfolios,
+		CurrentUserPortfolio: dashboard.CurrentUserPortfolio,
+		Portfolios:           dashboard.Portfolios,
+		OwnershipSummaries:   dashboard.OwnershipSummaries,
+		OwnershipDetails:     dashboard.OwnershipDetails,
+		TournamentTeams:      dashboard.TournamentTeams,
+		RoundStandings:       dashboard.RoundStandings,
+		FinalFourOutcomes:    dashboard.FinalFourOutcomes,
+	}
+}
@@ -97,6 +97,45 @@ func TestThatCreatePoolRequestAcceptsValidScoringRules(t *testing.T) {
 	}
 }
 
+func TestThatCreatePoolRequestRejectsChampionBonusBeforeTheTitle(t *testing.T) {
+	// GIVEN a champion bonus on the sixth win of a seven-win tournament
+	req := &CreatePoolRequest{
+		Name:         "Test Pool",
+		TournamentID: "t1",
+		ScoringRules: []ScoringRuleInput{
+			{Kind: "champion_bonus", WinIndex: 6, PointsAwarded: 50},
+		},
+	}
+
+	// WHEN validating it against the title win
+	err := req.ValidateChampionBonus(7)
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for champion bonus before the title win")
+	}
+}
+
+func TestThatCreatePoolRequestAcceptsChampionBonusOnTheTitle(t *testing.T) {
+	// GIVEN a champion bonus on the seventh win of a seven-win tournament
+	req := &CreatePoolRequest{
+		Name:         "Test Pool",
+		TournamentID: "t1",
+		ScoringRules: []ScoringRuleInput{
+			{WinIndex: 6, PointsAwarded: 50},
+			{Kind: "champion_bonus", WinIndex: 7, PointsAwarded: 50},
+		},
+	}
+
+	// WHEN validating it against the title win
+	err := req.ValidateChampionBonus(7)
+
+	// THEN no error is returned
+	if err != nil {
+		t.Errorf("unexpected error: %v", err)
+	}
+}
+
 func TestThatUpdatePoolRequestRequiresAtLeastOneField(t *testing.T) {
 	// GIVEN a request with no fields set
 	req := &UpdatePoolRequest{}
@@ -137,3 +176,159 @@ func TestThatUpdatePoolRequestAcceptsValidName(t *testing.T) {
 		t.Errorf("unexpected error: %v", err)
 	}
 }
+
+func TestThatCreatePoolRequestRejectsCappedShareWithoutCap(t *testing.T) {
+	// GIVEN a capped-share request with no cap
+	req := &CreatePoolRequest{
+		Name:          "Test Pool",
+		TournamentID:  "t1",
+		OwnershipMode: "capped_share",
+		ScoringRules:  []ScoringRuleInput{{WinIndex: 1, PointsAwarded: 50}},
+	}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for capped_share without a cap")
+	}
+}
+
+func TestThatCreatePoolRequestRejectsCapOnWinnerTakeAll(t *testing.T) {
+	// GIVEN a winner-take-all request with a cap
+	capPercent := 50
+	req := &CreatePoolRequest{
+		Name:                "Test Pool",
+		TournamentID:        "t1",
+		OwnershipMode:       "winner_take_all",
+		OwnershipCapPercent: &capPercent,
+		ScoringRules:        []ScoringRuleInput{{WinIndex: 1, PointsAwarded: 50}},
+	}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for a cap on a winner_take_all pool")
+	}
+}
+
+func TestThatValidateOwnershipRejectsUnknownMode(t *testing.T) {
+	// GIVEN an unknown ownership mode
+	mode := "auction_house"
+
+	// WHEN validating
+	err := ValidateOwnership(mode, nil)
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for unknown ownership mode")
+	}
+}
+
+func TestThatValidateOwnershipAcceptsCappedShareWithCap(t *testing.T) {
+	// GIVEN a capped-share mode with a 40% cap
+	capPercent := 40
+
+	// WHEN validating
+	err := ValidateOwnership("capped_share", &capPercent)
+
+	// THEN no error is returned
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestThatCreatePoolRequestRejectsUnknownUnclaimedMode(t *testing.T) {
+	// GIVEN a request with an unknown unclaimed-team mode
+	req := &CreatePoolRequest{
+		Name:          "Test Pool",
+		TournamentID:  "t1",
+		UnclaimedMode: "house",
+		ScoringRules:  []ScoringRuleInput{{WinIndex: 1, PointsAwarded: 50}},
+	}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for unknown unclaimed mode")
+	}
+}
+
+func TestThatUpdatePoolRequestAcceptsUnclaimedModeAlone(t *testing.T) {
+	// GIVEN an update that only switches unclaimed teams to pro rata
+	mode := "pro_rata"
+	req := &UpdatePoolRequest{UnclaimedMode: &mode}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN no error is returned
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestThatCreatePoolRequestRejectsUnknownTieBreaker(t *testing.T) {
+	// GIVEN a request with an unknown tie-breaker
+	req := &CreatePoolRequest{
+		Name:         "Test Pool",
+		TournamentID: "t1",
+		TieBreaker:   "coin_flip",
+		ScoringRules: []ScoringRuleInput{{WinIndex: 1, PointsAwarded: 50}},
+	}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for unknown tie-breaker")
+	}
+}
+
+func TestThatUpdatePoolRequestAcceptsTieBreakerAlone(t *testing.T) {
+	// GIVEN an update that only switches ties to fewest credits spent
+	rule := "fewest_credits"
+	req := &UpdatePoolRequest{TieBreaker: &rule}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN no error is returned
+	if err != nil {
+		t.Errorf("expected no error, got %v", err)
+	}
+}
+
+func TestThatUpdatePoolRequestRejectsNegativeEntryFee(t *testing.T) {
+	// GIVEN an update with a negative entry fee
+	fee := -500
+	req := &UpdatePoolRequest{EntryFeeCents: &fee}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for negative entryFeeCents")
+	}
+}
+
+func TestThatUpdatePoolRequestRejectsMaxPortfoliosPerUserAboveLimit(t *testing.T) {
+	// GIVEN an update allowing more portfolios per user than any pool may
+	limit := 11
+	req := &UpdatePoolRequest{MaxPortfoliosPerUser: &limit}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for maxPortfoliosPerUser above the limit")
+	}
+}
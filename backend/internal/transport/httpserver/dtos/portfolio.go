@@ -19,6 +19,7 @@ type PortfolioResponse struct {
 	IsTied         bool      `json:"isTied"`
 	ExpectedValue      *float64  `json:"expectedValue,omitempty"`
 	ProjectedFavorites *float64  `json:"projectedFavorites,omitempty"`
+	TieResolution  *TieResolutionResponse `json:"tieResolution,omitempty"`
 	CreatedAt      time.Time `json:"createdAt"`
 	UpdatedAt      time.Time `json:"updatedAt"`
 }
@@ -40,6 +41,7 @@ func NewPortfolioResponse(p *models.Portfolio, s *models.PortfolioStanding) *Por
 		resp.IsTied = s.IsTied
 		resp.ExpectedValue = s.ExpectedValue
 		resp.ProjectedFavorites = s.ProjectedFavorites
+		resp.TieResolution = NewTieResolutionResponse(s.TieResolution)
 	}
 	return resp
 }
@@ -66,9 +68,8 @@ func (r *CreatePortfolioRequest) Validate() error {
 	if strings.TrimSpace(r.Name) == "" {
 		return ErrFieldRequired("name")
 	}
-	if len(r.Teams) == 0 {
-		return ErrFieldInvalid("teams", "at least one team must be provided")
-	}
+	// Teams may be empty: portfolios in auction pools start empty and buy
+	// their teams at auction. Sealed-bid pools still enforce MinTeams.
 	for _, t := range r.Teams {
 		if t == nil {
 			return ErrFieldInvalid("teams", "team cannot be null")
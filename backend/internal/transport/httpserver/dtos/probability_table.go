@@ -0,0 +1,36 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// ProbabilityTableResponse describes an uploaded matchup probability table.
+type ProbabilityTableResponse struct {
+	SourceKey   string    `json:"sourceKey"`
+	Description *string   `json:"description,omitempty"`
+	PairCount   int       `json:"pairCount"`
+	UploadedBy  *string   `json:"uploadedBy,omitempty"`
+	CreatedAt   time.Time `json:"createdAt"`
+	UpdatedAt   time.Time `json:"updatedAt"`
+}
+
+func NewProbabilityTableResponse(t *models.MatchupProbabilityTable) *ProbabilityTableResponse {
+	return &ProbabilityTableResponse{
+		SourceKey:   t.SourceKey,
+		Description: t.Description,
+		PairCount:   t.PairCount,
+		UploadedBy:  t.UploadedBy,
+		CreatedAt:   t.CreatedAt,
+		UpdatedAt:   t.UpdatedAt,
+	}
+}
+
+func NewProbabilityTableListResponse(tables []*models.MatchupProbabilityTable) []*ProbabilityTableResponse {
+	out := make([]*ProbabilityTableResponse, 0, len(tables))
+	for _, t := range tables {
+		out = append(out, NewProbabilityTableResponse(t))
+	}
+	return out
+}
@@ -3,12 +3,21 @@ package dtos
 import "github.com/andrewcopp/Calcutta/backend/internal/models"
 
 type SchoolResponse struct {
-	ID   string `json:"id"`
-	Name string `json:"name"`
+	ID        string   `json:"id"`
+	Name      string   `json:"name"`
+	Latitude  *float64 `json:"latitude,omitempty"`
+	Longitude *float64 `json:"longitude,omitempty"`
 }
 
 func NewSchoolResponse(s *models.School) *SchoolResponse {
-	return &SchoolResponse{ID: s.ID, Name: s.Name}
+	return &SchoolResponse{ID: s.ID, Name: s.Name, Latitude: s.Latitude, Longitude: s.Longitude}
+}
+
+// UpdateSchoolLocationRequest sets a school's campus location. Omitting both
+// coordinates clears it.
+type UpdateSchoolLocationRequest struct {
+	Latitude  *float64 `json:"latitude"`
+	Longitude *float64 `json:"longitude"`
 }
 
 func NewSchoolListResponse(schools []models.School) []*SchoolResponse {
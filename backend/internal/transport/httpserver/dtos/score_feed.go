@@ -0,0 +1,101 @@
+package dtos
+
+import (
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// ResolveScoreFeedReviewRequest maps a queued feed name to a school.
+type ResolveScoreFeedReviewRequest struct {
+	SchoolID string `json:"schoolId"`
+}
+
+func (r *ResolveScoreFeedReviewRequest) Validate() error {
+	if r.SchoolID == "" {
+		return ErrFieldRequired("schoolId")
+	}
+	return nil
+}
+
+// CreateSchoolNameMappingRequest maps a feed's name for a team to a school.
+type CreateSchoolNameMappingRequest struct {
+	Source       string `json:"source"`
+	ExternalName string `json:"externalName"`
+	SchoolID     string `json:"schoolId"`
+}
+
+func (r *CreateSchoolNameMappingRequest) Validate() error {
+	if r.Source == "" {
+		return ErrFieldRequired("source")
+	}
+	if r.ExternalName == "" {
+		return ErrFieldRequired("externalName")
+	}
+	if r.SchoolID == "" {
+		return ErrFieldRequired("schoolId")
+	}
+	return nil
+}
+
+type SchoolNameMappingResponse struct {
+	ID           string    `json:"id"`
+	Source       string    `json:"source"`
+	ExternalName string    `json:"externalName"`
+	NameKey      string    `json:"nameKey"`
+	SchoolID     string    `json:"schoolId"`
+	CreatedAt    time.Time `json:"createdAt"`
+}
+
+func NewSchoolNameMappingResponse(m *models.SchoolNameMapping) *SchoolNameMappingResponse {
+	return &SchoolNameMappingResponse{
+		ID:           m.ID,
+		Source:       m.Source,
+		ExternalName: m.ExternalName,
+		NameKey:      m.NameKey,
+		SchoolID:     m.SchoolID,
+		CreatedAt:    m.CreatedAt,
+	}
+}
+
+func NewSchoolNameMappingListResponse(mappings []*models.SchoolNameMapping) []*SchoolNameMappingResponse {
+	out := make([]*SchoolNameMappingResponse, 0, len(mappings))
+	for _, m := range mappings {
+		out = append(out, NewSchoolNameMappingResponse(m))
+	}
+	return out
+}
+
+// ScoreFeedReviewResponse is a feed name waiting for an admin to match it,
+// with the schools it might refer to.
+type ScoreFeedReviewResponse struct {
+	ID                 string     `json:"id"`
+	Source             string     `json:"source"`
+	ExternalName       string     `json:"externalName"`
+	CandidateSchoolIDs []string   `json:"candidateSchoolIds"`
+	Status             string     `json:"status"`
+	ResolvedSchoolID   *string    `json:"resolvedSchoolId,omitempty"`
+	ResolvedAt         *time.Time `json:"resolvedAt,omitempty"`
+	CreatedAt          time.Time  `json:"createdAt"`
+}
+
+func NewScoreFeedReviewListResponse(reviews []*models.ScoreFeedReview) []*ScoreFeedReviewResponse {
+	out := make([]*ScoreFeedReviewResponse, 0, len(reviews))
+	for _, rv := range reviews {
+		candidates := rv.CandidateSchoolIDs
+		if candidates == nil {
+			candidates = []string{}
+		}
+		out = append(out, &ScoreFeedReviewResponse{
+			ID:                 rv.ID,
+			Source:             rv.Source,
+			ExternalName:       rv.ExternalName,
+			CandidateSchoolIDs: candidates,
+			Status:             rv.Status,
+			ResolvedSchoolID:   rv.ResolvedSchoolID,
+			ResolvedAt:         rv.ResolvedAt,
+			CreatedAt:          rv.CreatedAt,
+		})
+	}
+	return out
+}
@@ -0,0 +1,131 @@
+package dtos
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// maxSidePots and maxSidePotPositions bound a side pots request.
+const (
+	maxSidePots         = 20
+	maxSidePotPositions = 100
+)
+
+type SidePotPayoutItem struct {
+	Position    int `json:"position"`
+	AmountCents int `json:"amountCents"`
+}
+
+type SidePotInput struct {
+	Name            string              `json:"name"`
+	Metric          string              `json:"metric"`
+	ThroughWinIndex *int                `json:"throughWinIndex"`
+	Payouts         []SidePotPayoutItem `json:"payouts"`
+}
+
+// ReplaceSidePotsRequest replaces every side pot on a pool. An empty list
+// removes them all.
+type ReplaceSidePotsRequest struct {
+	SidePots []SidePotInput `json:"sidePots"`
+}
+
+func (r *ReplaceSidePotsRequest) Validate() error {
+	if len(r.SidePots) > maxSidePots {
+		return ErrFieldInvalid("sidePots", fmt.Sprintf("a pool may have at most %d side pots", maxSidePots))
+	}
+	names := make(map[string]bool, len(r.SidePots))
+	for _, pot := range r.SidePots {
+		name := strings.TrimSpace(pot.Name)
+		if name == "" {
+			return ErrFieldRequired("name")
+		}
+		if names[name] {
+			return ErrFieldInvalid("name", fmt.Sprintf("duplicate side pot %q", name))
+		}
+		names[name] = true
+
+		switch pot.Metric {
+		case models.SidePotMetricTotalPoints, models.SidePotMetricBestTeamROI, models.SidePotMetricFewestPoints:
+			if pot.ThroughWinIndex != nil {
+				return ErrFieldInvalid("throughWinIndex", "only applies to points_through_round side pots")
+			}
+		case models.SidePotMetricPointsThroughRound:
+			if pot.ThroughWinIndex == nil {
+				return ErrFieldRequired("throughWinIndex")
+			}
+			if *pot.ThroughWinIndex < 1 {
+				return ErrFieldInvalid("throughWinIndex", "must be >= 1")
+			}
+		default:
+			return ErrFieldInvalid("metric", "must be total_points, points_through_round, best_team_roi or fewest_points")
+		}
+
+		if len(pot.Payouts) > maxSidePotPositions {
+			return ErrFieldInvalid("payouts", "too many payout positions")
+		}
+		positions := make(map[int]bool, len(pot.Payouts))
+		for _, p := range pot.Payouts {
+			if p.Position < 1 {
+				return ErrFieldInvalid("position", "position must be >= 1")
+			}
+			if p.AmountCents < 0 {
+				return ErrFieldInvalid("amountCents", "amountCents cannot be negative")
+			}
+			if positions[p.Position] {
+				return ErrFieldInvalid("position", "duplicate position")
+			}
+			positions[p.Position] = true
+		}
+	}
+	return nil
+}
+
+func (r *ReplaceSidePotsRequest) ToModels(poolID string) []*models.SidePot {
+	pots := make([]*models.SidePot, 0, len(r.SidePots))
+	for _, input := range r.SidePots {
+		payouts := make([]*models.SidePotPayout, 0, len(input.Payouts))
+		for _, p := range input.Payouts {
+			payouts = append(payouts, &models.SidePotPayout{Position: p.Position, AmountCents: p.AmountCents})
+		}
+		pots = append(pots, &models.SidePot{
+			PoolID:          poolID,
+			Name:            strings.TrimSpace(input.Name),
+			Metric:          input.Metric,
+			ThroughWinIndex: input.ThroughWinIndex,
+			Payouts:         payouts,
+		})
+	}
+	return pots
+}
+
+type SidePotResponse struct {
+	ID              string               `json:"id"`
+	Name            string               `json:"name"`
+	Metric          string               `json:"metric"`
+	ThroughWinIndex *int                 `json:"throughWinIndex,omitempty"`
+	Payouts         []*SidePotPayoutItem `json:"payouts"`
+}
+
+func NewSidePotResponse(pot *models.SidePot) *SidePotResponse {
+	payouts := make([]*SidePotPayoutItem, 0, len(pot.Payouts))
+	for _, p := range pot.Payouts {
+		payouts = append(payouts, &SidePotPayoutItem{Position: p.Position, AmountCents: p.AmountCents})
+	}
+	return &SidePotResponse{
+		ID:              pot.ID,
+		Name:            pot.Name,
+		Metric:          pot.Metric,
+		ThroughWinIndex: pot.ThroughWinIndex,
+		Payouts:         payouts,
+	}
+}
+
+func NewSidePotListResponse(pots []*models.SidePot) []*SidePotResponse {
+	resp := make([]*SidePotResponse, 0, len(pots))
+	for _, pot := range pots {
+		resp = append(resp, NewSidePotResponse(pot))
+	}
+	return resp
+}
@@ -0,0 +1,32 @@
+package dtos
+
+import "testing"
+
+func TestThatReplaceSidePotsRequiresThroughWinIndexForRoundPots(t *testing.T) {
+	// GIVEN a points-through-round side pot without a round
+	req := &ReplaceSidePotsRequest{SidePots: []SidePotInput{{Name: "First Weekend", Metric: "points_through_round"}}}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for missing throughWinIndex")
+	}
+}
+
+func TestThatReplaceSidePotsRejectsDuplicateNames(t *testing.T) {
+	// GIVEN two side pots with the same name
+	req := &ReplaceSidePotsRequest{SidePots: []SidePotInput{
+		{Name: "Last Place", Metric: "fewest_points"},
+		{Name: "Last Place", Metric: "fewest_points"},
+	}}
+
+	// WHEN validating
+	err := req.Validate()
+
+	// THEN an error is returned
+	if err == nil {
+		t.Error("expected error for duplicate side pot names")
+	}
+}
@@ -0,0 +1,29 @@
+package dtos
+
+import "github.com/andrewcopp/Calcutta/backend/internal/models"
+
+// TieResolutionResponse explains how a tie on points was resolved.
+type TieResolutionResponse struct {
+	Rule          string   `json:"rule"`
+	TiedOnReturns float64  `json:"tiedOnReturns"`
+	PortfolioIDs  []string `json:"portfolioIds"`
+	FirstPosition int      `json:"firstPosition"`
+	LastPosition  int      `json:"lastPosition"`
+	PayoutCents   int      `json:"payoutCents"`
+	Reason        string   `json:"reason"`
+}
+
+func NewTieResolutionResponse(r *models.TieResolution) *TieResolutionResponse {
+	if r == nil {
+		return nil
+	}
+	return &TieResolutionResponse{
+		Rule:          r.Rule,
+		TiedOnReturns: r.TiedOnReturns,
+		PortfolioIDs:  r.PortfolioIDs,
+		FirstPosition: r.FirstPosition,
+		LastPosition:  r.LastPosition,
+		PayoutCents:   r.PayoutCents,
+		Reason:        r.Reason,
+	}
+}
@@ -187,11 +187,13 @@ type UpdateKenPomStatsRequest struct {
 }
 
 type KenPomStatEntry struct {
-	TeamID string  `json:"teamId"`
-	NetRtg float64 `json:"netRtg"`
-	ORtg   float64 `json:"oRtg"`
-	DRtg   float64 `json:"dRtg"`
-	AdjT   float64 `json:"adjT"`
+	TeamID       string  `json:"teamId"`
+	NetRtg       float64 `json:"netRtg"`
+	ORtg         float64 `json:"oRtg"`
+	DRtg         float64 `json:"dRtg"`
+	AdjT         float64 `json:"adjT"`
+	SeasonWins   *int    `json:"seasonWins,omitempty"`
+	SeasonLosses *int    `json:"seasonLosses,omitempty"`
 }
 
 func (r *UpdateKenPomStatsRequest) Validate() error {
@@ -202,6 +204,9 @@ func (r *UpdateKenPomStatsRequest) Validate() error {
 		if strings.TrimSpace(s.TeamID) == "" {
 			return ErrFieldInvalid("stats", fmt.Sprintf("stats[%d]: teamId is required", i))
 		}
+		if (s.SeasonWins != nil && *s.SeasonWins < 0) || (s.SeasonLosses != nil && *s.SeasonLosses < 0) {
+			return ErrFieldInvalid("stats", fmt.Sprintf("stats[%d]: season record must be non-negative", i))
+		}
 	}
 	return nil
 }
@@ -352,6 +357,36 @@ func NewPredictionBatchListResponse(batches []prediction.PredictionBatch) []Pred
 	return resp
 }
 
+// PredictedMatchupResponse is one potential game priced by a prediction batch.
+type PredictedMatchupResponse struct {
+	GameID          string   `json:"gameId"`
+	RoundOrder      int      `json:"roundOrder"`
+	Team1ID         string   `json:"team1Id"`
+	Team2ID         string   `json:"team2Id"`
+	PMatchup        float64  `json:"pMatchup"`
+	PTeam1Wins      float64  `json:"pTeam1Wins"`
+	ProjectedSpread *float64 `json:"projectedSpread,omitempty"`
+	SpreadSD        *float64 `json:"spreadSd,omitempty"`
+}
+
+// NewPredictedMatchupListResponse maps a batch's matchups to DTOs.
+func NewPredictedMatchupListResponse(matchups []prediction.PredictedMatchup) []PredictedMatchupResponse {
+	resp := make([]PredictedMatchupResponse, len(matchups))
+	for i, m := range matchups {
+		resp[i] = PredictedMatchupResponse{
+			GameID:          m.GameID,
+			RoundOrder:      m.RoundOrder,
+			Team1ID:         m.Team1ID,
+			Team2ID:         m.Team2ID,
+			PMatchup:        m.PMatchup,
+			PTeam1Wins:      m.PTeam1WinsGivenMatchup,
+			ProjectedSpread: m.ProjectedSpread,
+			SpreadSD:        m.SpreadSD,
+		}
+	}
+	return resp
+}
+
 // CompetitionResponse is the response for a competition.
 type CompetitionResponse struct {
 	ID   string `json:"id"`
@@ -0,0 +1,104 @@
+package dtos
+
+import (
+	apppool "github.com/andrewcopp/Calcutta/backend/internal/app/pool"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// WinScenariosResponse is what a portfolio needs from the remaining games.
+// scenarios lists the likeliest outcomes that finish it in the money, out of
+// scenarioCount; keyGames are the matchups to root for, biggest swing in
+// expected payout first.
+type WinScenariosResponse struct {
+	PortfolioID         string                 `json:"portfolioId"`
+	RemainingGames      int                    `json:"remainingGames"`
+	Outcomes            int                    `json:"outcomes"`
+	PFirst              float64                `json:"pFirst"`
+	PInTheMoney         float64                `json:"pInTheMoney"`
+	ExpectedPayoutCents float64                `json:"expectedPayoutCents"`
+	ScenarioCount       int                    `json:"scenarioCount"`
+	Scenarios           []*WinScenarioResponse `json:"scenarios"`
+	KeyGames            []*KeyGameResponse     `json:"keyGames"`
+}
+
+type WinScenarioResponse struct {
+	Probability    float64                 `json:"probability"`
+	FinishPosition int                     `json:"finishPosition"`
+	IsTied         bool                    `json:"isTied"`
+	PayoutCents    int                     `json:"payoutCents"`
+	Games          []*ScenarioGameResponse `json:"games"`
+}
+
+type ScenarioGameResponse struct {
+	GameID string              `json:"gameId"`
+	Round  models.BracketRound `json:"round"`
+	Winner *FinalFourTeam      `json:"winner"`
+	Loser  *FinalFourTeam      `json:"loser"`
+}
+
+// KeyGameResponse is a matchup to root for. The payouts and chances are
+// conditional on the matchup happening; mustWin means the portfolio cannot
+// finish in the money if rootFor loses it.
+type KeyGameResponse struct {
+	GameID                string              `json:"gameId"`
+	Round                 models.BracketRound `json:"round"`
+	RootFor               *FinalFourTeam      `json:"rootFor"`
+	Against               *FinalFourTeam      `json:"against"`
+	PMatchup              float64             `json:"pMatchup"`
+	PRootForWins          float64             `json:"pRootForWins"`
+	ExpectedPayoutIfWins  float64             `json:"expectedPayoutIfWins"`
+	ExpectedPayoutIfLoses float64             `json:"expectedPayoutIfLoses"`
+	PInTheMoneyIfWins     float64             `json:"pInTheMoneyIfWins"`
+	PInTheMoneyIfLoses    float64             `json:"pInTheMoneyIfLoses"`
+	SwingPayoutCents      float64             `json:"swingPayoutCents"`
+	MustWin               bool                `json:"mustWin"`
+}
+
+func NewWinScenariosResponse(s *apppool.WinScenarios) *WinScenariosResponse {
+	resp := &WinScenariosResponse{
+		PortfolioID:         s.PortfolioID,
+		RemainingGames:      s.RemainingGames,
+		Outcomes:            s.Outcomes,
+		PFirst:              s.PFirst,
+		PInTheMoney:         s.PInTheMoney,
+		ExpectedPayoutCents: s.ExpectedPayoutCents,
+		ScenarioCount:       s.ScenarioCount,
+		Scenarios:           make([]*WinScenarioResponse, 0, len(s.Scenarios)),
+		KeyGames:            make([]*KeyGameResponse, 0, len(s.KeyGames)),
+	}
+	for _, sc := range s.Scenarios {
+		games := make([]*ScenarioGameResponse, 0, len(sc.Games))
+		for _, g := range sc.Games {
+			games = append(games, &ScenarioGameResponse{
+				GameID: g.GameID,
+				Round:  g.Round,
+				Winner: NewFinalFourTeam(g.Winner),
+				Loser:  NewFinalFourTeam(g.Loser),
+			})
+		}
+		resp.Scenarios = append(resp.Scenarios, &WinScenarioResponse{
+			Probability:    sc.Probability,
+			FinishPosition: sc.FinishPosition,
+			IsTied:         sc.IsTied,
+			PayoutCents:    sc.PayoutCents,
+			Games:          games,
+		})
+	}
+	for _, kg := range s.KeyGames {
+		resp.KeyGames = append(resp.KeyGames, &KeyGameResponse{
+			GameID:                kg.GameID,
+			Round:                 kg.Round,
+			RootFor:               NewFinalFourTeam(kg.RootFor),
+			Against:               NewFinalFourTeam(kg.Against),
+			PMatchup:              kg.PMatchup,
+			PRootForWins:          kg.PRootForWins,
+			ExpectedPayoutIfWins:  kg.ExpectedPayoutIfWins,
+			ExpectedPayoutIfLoses: kg.ExpectedPayoutIfLoses,
+			PInTheMoneyIfWins:     kg.PInTheMoneyIfWins,
+			PInTheMoneyIfLoses:    kg.PInTheMoneyIfLoses,
+			SwingPayoutCents:      kg.SwingPayoutCents,
+			MustWin:               kg.MustWin,
+		})
+	}
+	return resp
+}
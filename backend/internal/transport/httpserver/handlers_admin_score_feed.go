@@ -0,0 +1,116 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/response"
+	"github.com/gorilla/mux"
+)
+
+func (s *Server) registerAdminScoreFeedRoutes(r *mux.Router) {
+	r.HandleFunc("/api/v1/admin/score-feed/reviews", s.requirePermission("tournament.game.write", s.adminScoreFeedReviewsListHandler)).Methods("GET")
+	r.HandleFunc("/api/v1/admin/score-feed/reviews/{id}/resolve", s.requirePermission("tournament.game.write", s.adminScoreFeedReviewResolveHandler)).Methods("POST")
+	r.HandleFunc("/api/v1/admin/score-feed/reviews/{id}/dismiss", s.requirePermission("tournament.game.write", s.adminScoreFeedReviewDismissHandler)).Methods("POST")
+	r.HandleFunc("/api/v1/admin/score-feed/mappings", s.requirePermission("tournament.game.write", s.adminSchoolNameMappingsListHandler)).Methods("GET")
+	r.HandleFunc("/api/v1/admin/score-feed/mappings", s.requirePermission("tournament.game.write", s.adminSchoolNameMappingCreateHandler)).Methods("POST")
+	r.HandleFunc("/api/v1/admin/score-feed/mappings/{id}", s.requirePermission("tournament.game.write", s.adminSchoolNameMappingDeleteHandler)).Methods("DELETE")
+}
+
+func (s *Server) adminScoreFeedReviewsListHandler(w http.ResponseWriter, r *http.Request) {
+	reviews, err := s.app.ScoreFeed.ListReviews(r.Context(), r.URL.Query().Get("status"))
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewScoreFeedReviewListResponse(reviews)})
+}
+
+func (s *Server) adminScoreFeedReviewResolveHandler(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if id == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Review ID is required", "id")
+		return
+	}
+
+	var req dtos.ResolveScoreFeedReviewRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
+		return
+	}
+	if err := req.Validate(); err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	mapping, err := s.app.ScoreFeed.ResolveReview(r.Context(), id, req.SchoolID, authUserID(r.Context()))
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, dtos.NewSchoolNameMappingResponse(mapping))
+}
+
+func (s *Server) adminScoreFeedReviewDismissHandler(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if id == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Review ID is required", "id")
+		return
+	}
+
+	if err := s.app.ScoreFeed.DismissReview(r.Context(), id, authUserID(r.Context())); err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (s *Server) adminSchoolNameMappingsListHandler(w http.ResponseWriter, r *http.Request) {
+	mappings, err := s.app.ScoreFeed.ListMappings(r.Context(), r.URL.Query().Get("source"))
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewSchoolNameMappingListResponse(mappings)})
+}
+
+func (s *Server) adminSchoolNameMappingCreateHandler(w http.ResponseWriter, r *http.Request) {
+	var req dtos.CreateSchoolNameMappingRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
+		return
+	}
+	if err := req.Validate(); err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	mapping, err := s.app.ScoreFeed.CreateMapping(r.Context(), req.Source, req.ExternalName, req.SchoolID, authUserID(r.Context()))
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusCreated, dtos.NewSchoolNameMappingResponse(mapping))
+}
+
+func (s *Server) adminSchoolNameMappingDeleteHandler(w http.ResponseWriter, r *http.Request) {
+	id := mux.Vars(r)["id"]
+	if id == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Mapping ID is required", "id")
+		return
+	}
+
+	if err := s.app.ScoreFeed.DeleteMapping(r.Context(), id); err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -2,12 +2,15 @@ package httpserver
 
 import (
 	"context"
+	"encoding/json"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
 	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
 	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/response"
+	"github.com/gorilla/mux"
 )
 
 func (s *Server) healthHandler(w http.ResponseWriter, r *http.Request) {
@@ -38,3 +41,28 @@ func (s *Server) schoolsHandler(w http.ResponseWriter, r *http.Request) {
 	}
 	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewSchoolListResponse(schools)})
 }
+
+func (s *Server) updateSchoolLocationHandler(w http.ResponseWriter, r *http.Request) {
+	schoolID := strings.TrimSpace(mux.Vars(r)["schoolId"])
+	if schoolID == "" {
+		httperr.WriteFromErr(w, r, dtos.ErrFieldRequired("schoolId"), authUserID)
+		return
+	}
+
+	var req dtos.UpdateSchoolLocationRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
+		return
+	}
+
+	if err := s.app.School.UpdateLocation(r.Context(), schoolID, req.Latitude, req.Longitude); err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+	school, err := s.app.School.GetByID(r.Context(), schoolID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusOK, dtos.NewSchoolResponse(school))
+}
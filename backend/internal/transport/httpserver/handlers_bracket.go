@@ -4,6 +4,8 @@ import (
 	"encoding/json"
 	"net/http"
 
+	appbracket "github.com/andrewcopp/Calcutta/backend/internal/app/bracket"
+
 	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
 	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
 	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/response"
@@ -52,7 +54,12 @@ func (s *Server) selectWinnerHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	bracket, err := s.app.Bracket.SelectWinner(r.Context(), tournamentID, gameID, req.WinnerTeamID)
+	bracket, err := s.app.Bracket.SelectWinner(r.Context(), tournamentID, gameID, appbracket.WinnerSelection{
+		WinnerTeamID: req.WinnerTeamID,
+		WinnerScore:  req.WinnerScore,
+		LoserScore:   req.LoserScore,
+		EnteredBy:    authUserID(r.Context()),
+	})
 	if err != nil {
 		httperr.WriteFromErr(w, r, err, authUserID)
 		return
@@ -75,13 +82,123 @@ func (s *Server) unselectWinnerHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	bracket, err := s.app.Bracket.UnselectWinner(r.Context(), tournamentID, gameID)
+	result, err := s.app.Bracket.UnselectWinner(r.Context(), tournamentID, gameID)
 	if err != nil {
 		httperr.WriteFromErr(w, r, err, authUserID)
 		return
 	}
 
-	response.WriteJSON(w, http.StatusOK, dtos.NewBracketResponse(bracket))
+	response.WriteJSON(w, http.StatusOK, dtos.NewUnselectWinnerResponse(result.Bracket, result.RolledBack, result.Invalidations))
+}
+
+func (s *Server) listGameResultsHandler(w http.ResponseWriter, r *http.Request) {
+	tournamentID := mux.Vars(r)["tournamentId"]
+	if tournamentID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Tournament ID is required", "tournamentId")
+		return
+	}
+
+	results, err := s.app.Bracket.ListGameResults(r.Context(), tournamentID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewGameResultListResponse(results)})
+}
+
+func (s *Server) getBracketTemplateHandler(w http.ResponseWriter, r *http.Request) {
+	tournamentID := mux.Vars(r)["tournamentId"]
+	if tournamentID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Tournament ID is required", "tournamentId")
+		return
+	}
+
+	tmpl, err := s.app.Bracket.GetBracketTemplate(r.Context(), tournamentID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, tmpl)
+}
+
+func (s *Server) updateBracketTemplateHandler(w http.ResponseWriter, r *http.Request) {
+	tournamentID := mux.Vars(r)["tournamentId"]
+	if tournamentID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Tournament ID is required", "tournamentId")
+		return
+	}
+
+	var req dtos.UpdateBracketTemplateRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
+		return
+	}
+	if err := req.Validate(); err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	if err := s.app.Bracket.UpdateBracketTemplate(r.Context(), tournamentID, req.Template); err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	tmpl, err := s.app.Bracket.GetBracketTemplate(r.Context(), tournamentID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, tmpl)
+}
+
+func (s *Server) listGameSitesHandler(w http.ResponseWriter, r *http.Request) {
+	tournamentID := mux.Vars(r)["tournamentId"]
+	if tournamentID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Tournament ID is required", "tournamentId")
+		return
+	}
+
+	sites, err := s.app.Bracket.GetGameSites(r.Context(), tournamentID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewGameSiteListResponse(sites)})
+}
+
+func (s *Server) replaceGameSitesHandler(w http.ResponseWriter, r *http.Request) {
+	tournamentID := mux.Vars(r)["tournamentId"]
+	if tournamentID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Tournament ID is required", "tournamentId")
+		return
+	}
+
+	var req dtos.ReplaceGameSitesRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
+		return
+	}
+	if err := req.Validate(); err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	if err := s.app.Bracket.ReplaceGameSites(r.Context(), tournamentID, req.ToModels(tournamentID)); err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	sites, err := s.app.Bracket.GetGameSites(r.Context(), tournamentID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewGameSiteListResponse(sites)})
 }
 
 func (s *Server) validateBracketSetupHandler(w http.ResponseWriter, r *http.Request) {
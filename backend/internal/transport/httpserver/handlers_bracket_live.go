@@ -0,0 +1,124 @@
+package httpserver
+
+import (
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	appbracket "github.com/andrewcopp/Calcutta/backend/internal/app/bracket"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/jobqueue"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/prediction"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/response"
+	"github.com/gorilla/mux"
+)
+
+func (s *Server) listLiveGamesHandler(w http.ResponseWriter, r *http.Request) {
+	tournamentID := mux.Vars(r)["tournamentId"]
+	if tournamentID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Tournament ID is required", "tournamentId")
+		return
+	}
+
+	games, err := s.app.Prediction.LiveGames(r.Context(), prediction.RunParams{TournamentID: tournamentID})
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewLiveGameListResponse(games)})
+}
+
+// updateLiveGameHandler stores a game's live state and queues a prediction
+// refresh so projections reflect it.
+func (s *Server) updateLiveGameHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	tournamentID := vars["tournamentId"]
+	gameID := vars["gameId"]
+
+	if tournamentID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Tournament ID is required", "tournamentId")
+		return
+	}
+	if gameID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Game ID is required", "gameId")
+		return
+	}
+
+	var req dtos.UpdateLiveGameRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
+		return
+	}
+	if err := req.Validate(); err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	if _, err := s.app.Bracket.UpdateLiveGame(r.Context(), tournamentID, gameID, appbracket.LiveGameUpdate{
+		Team1ID:          req.Team1ID,
+		Team2ID:          req.Team2ID,
+		Team1Score:       req.Team1Score,
+		Team2Score:       req.Team2Score,
+		SecondsRemaining: *req.SecondsRemaining,
+		PossessionTeamID: req.PossessionTeamID,
+		Source:           req.Source,
+	}); err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	s.refreshLivePredictions(r, tournamentID)
+
+	games, err := s.app.Prediction.LiveGames(r.Context(), prediction.RunParams{TournamentID: tournamentID})
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+	for _, g := range dtos.NewLiveGameListResponse(games) {
+		if g.GameID == gameID {
+			response.WriteJSON(w, http.StatusOK, g)
+			return
+		}
+	}
+	httperr.Write(w, r, http.StatusNotFound, "not_found", "Live game not found", "gameId")
+}
+
+func (s *Server) clearLiveGameHandler(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	tournamentID := vars["tournamentId"]
+	gameID := vars["gameId"]
+
+	if tournamentID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Tournament ID is required", "tournamentId")
+		return
+	}
+	if gameID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Game ID is required", "gameId")
+		return
+	}
+
+	if err := s.app.Bracket.ClearLiveGame(r.Context(), tournamentID, gameID); err != nil {
+		httperr.WriteFromErr(w, r, err, authUserID)
+		return
+	}
+
+	s.refreshLivePredictions(r, tournamentID)
+
+	w.WriteHeader(http.StatusNoContent)
+}
+
+// refreshLivePredictions queues a prediction refresh for the tournament, so
+// its checkpoints are rerun by the compute worker rather than in the request.
+// One refresh is queued at a time per tournament. Failures are logged; the
+// live state is stored either way.
+func (s *Server) refreshLivePredictions(r *http.Request, tournamentID string) {
+	params, _ := json.Marshal(map[string]string{"tournamentId": tournamentID})
+	dedupKey := fmt.Sprintf("refresh_predictions:%s", tournamentID)
+	if _, err := s.enqueuer.Enqueue(r.Context(), jobqueue.KindRefreshPredictions, params, jobqueue.PriorityCoreApp, dedupKey); err != nil {
+		slog.Warn("prediction_refresh_after_live_update_enqueue_failed",
+			"tournament_id", tournamentID, "error", err)
+	}
+}
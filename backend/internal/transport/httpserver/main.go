@@ -92,6 +92,9 @@ func Run() error {
 	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
 	defer stop()
 
+	// Fan database change notifications out to this instance's live streams
+	go server.app.Changes.Run(ctx)
+
 	errCh := make(chan error, 1)
 
 	// Start server in goroutine
@@ -17,6 +17,12 @@ func (rw *statusCapturingResponseWriter) WriteHeader(code int) {
 	rw.ResponseWriter.WriteHeader(code)
 }
 
+// Unwrap lets http.ResponseController reach the underlying writer, which
+// streaming handlers need to flush.
+func (rw *statusCapturingResponseWriter) Unwrap() http.ResponseWriter {
+	return rw.ResponseWriter
+}
+
 func LoggingMiddleware(
 	observe func(method string, status int, duration time.Duration),
 	logger func(ctx context.Context) *slog.Logger,
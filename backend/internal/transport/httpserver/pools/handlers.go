@@ -6,8 +6,10 @@ import (
 	"log/slog"
 	"net/http"
 	"strings"
+	"time"
 
 	"github.com/andrewcopp/Calcutta/backend/internal/app"
+	poolapp "github.com/andrewcopp/Calcutta/backend/internal/app/pool"
 	"github.com/andrewcopp/Calcutta/backend/internal/models"
 	"github.com/andrewcopp/Calcutta/backend/internal/policy"
 	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
@@ -26,10 +28,18 @@ type Handler struct {
 	authz      policy.AuthorizationChecker
 	granter    RoleGranter
 	authUserID func(context.Context) string
+	// maxExactRemainingGames is the most games left for which a pool's
+	// outcomes are enumerated exactly.
+	maxExactRemainingGames int
 }
 
-func NewHandlerWithAuthUserID(a *app.App, authz policy.AuthorizationChecker, granter RoleGranter, authUserID func(context.Context) string) *Handler {
-	return &Handler{app: a, authz: authz, granter: granter, authUserID: authUserID}
+// NewHandlerWithAuthUserID creates a pools handler. A maxExactRemainingGames
+// below 1 falls back to poolapp.DefaultMaxExactRemainingGames.
+func NewHandlerWithAuthUserID(a *app.App, authz policy.AuthorizationChecker, granter RoleGranter, authUserID func(context.Context) string, maxExactRemainingGames int) *Handler {
+	if maxExactRemainingGames < 1 {
+		maxExactRemainingGames = poolapp.DefaultMaxExactRemainingGames
+	}
+	return &Handler{app: a, authz: authz, granter: granter, authUserID: authUserID, maxExactRemainingGames: maxExactRemainingGames}
 }
 
 func (h *Handler) HandleListPools(w http.ResponseWriter, r *http.Request) {
@@ -103,6 +113,10 @@ func (h *Handler) HandleCreatePool(w http.ResponseWriter, r *http.Request) {
 		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Tournament must have a start time before creating a pool", "tournamentId")
 		return
 	}
+	if err := req.ValidateChampionBonus(tournament.Rounds); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
 
 	pool.ApplyDefaults()
 
@@ -236,6 +250,44 @@ func (h *Handler) HandleUpdatePool(w http.ResponseWriter, r *http.Request) {
 	if req.MaxInvestmentCredits != nil {
 		pool.MaxInvestmentCredits = *req.MaxInvestmentCredits
 	}
+	if req.OwnershipMode != nil {
+		pool.OwnershipMode = *req.OwnershipMode
+		if pool.OwnershipMode != models.OwnershipModeCappedShare {
+			pool.OwnershipCapPercent = nil
+		}
+	}
+	if req.OwnershipCapPercent != nil {
+		pool.OwnershipCapPercent = req.OwnershipCapPercent
+	}
+	if req.UnclaimedMode != nil {
+		pool.UnclaimedMode = *req.UnclaimedMode
+	}
+	if req.TieBreaker != nil {
+		pool.TieBreaker = *req.TieBreaker
+	}
+	if req.EntryFeeCents != nil {
+		pool.EntryFeeCents = *req.EntryFeeCents
+	}
+	if req.MaxPortfoliosPerUser != nil {
+		pool.MaxPortfoliosPerUser = *req.MaxPortfoliosPerUser
+	}
+	if req.RevealAt != nil {
+		schedule, err := h.biddingSchedule(r.Context(), pool)
+		if err != nil {
+			httperr.WriteFromErr(w, r, err, h.authUserID)
+			return
+		}
+		if schedule.IsRevealed(time.Now()) {
+			httperr.Write(w, r, http.StatusConflict, "bids_revealed", "Bids have already been revealed", "revealAt")
+			return
+		}
+		revealAt := req.RevealAt.UTC()
+		pool.RevealAt = &revealAt
+	}
+	if err := dtos.ValidateOwnership(pool.OwnershipMode, pool.OwnershipCapPercent); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
 	if err := h.app.Pool.UpdatePool(r.Context(), pool); err != nil {
 		httperr.WriteFromErr(w, r, err, h.authUserID)
 		return
@@ -0,0 +1,260 @@
+package pools
+
+import (
+	"encoding/json"
+	"log/slog"
+	"net/http"
+	"time"
+
+	appauction "github.com/andrewcopp/Calcutta/backend/internal/app/auction"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/changefeed"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/policy"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/response"
+	"github.com/gorilla/mux"
+)
+
+func (h *Handler) HandleCreateAuction(w http.ResponseWriter, r *http.Request) {
+	pool, userID, ok := h.authorizeManagePool(w, r)
+	if !ok {
+		return
+	}
+
+	var req dtos.CreateAuctionRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
+		return
+	}
+	if err := req.Validate(); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	session, err := h.app.Auction.CreateSession(r.Context(), pool.ID, &userID, appauction.SessionSettings{
+		NominationOrder:     req.NominationOrder,
+		LotSeconds:          req.LotSeconds,
+		BidExtensionSeconds: req.BidExtensionSeconds,
+		MinIncrementCredits: req.MinIncrementCredits,
+	})
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusCreated, dtos.NewAuctionSessionResponse(session))
+}
+
+func (h *Handler) HandleStartAuction(w http.ResponseWriter, r *http.Request) {
+	pool, _, ok := h.authorizeManagePool(w, r)
+	if !ok {
+		return
+	}
+
+	state, err := h.app.Auction.Start(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusOK, dtos.NewAuctionStateResponse(state))
+}
+
+func (h *Handler) HandleGetAuction(w http.ResponseWriter, r *http.Request) {
+	pool, ok := h.authorizeViewPool(w, r)
+	if !ok {
+		return
+	}
+
+	state, err := h.app.Auction.GetState(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusOK, dtos.NewAuctionStateResponse(state))
+}
+
+func (h *Handler) HandleNominateAuctionLot(w http.ResponseWriter, r *http.Request) {
+	var req dtos.NominateAuctionLotRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
+		return
+	}
+	if err := req.Validate(); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	pool, ok := h.authorizeAuctionPortfolio(w, r, req.PortfolioID)
+	if !ok {
+		return
+	}
+
+	state, err := h.app.Auction.Nominate(r.Context(), pool.ID, req.PortfolioID, req.TeamID, req.OpeningCredits)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusCreated, dtos.NewAuctionStateResponse(state))
+}
+
+func (h *Handler) HandlePlaceAuctionBid(w http.ResponseWriter, r *http.Request) {
+	var req dtos.PlaceAuctionBidRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
+		return
+	}
+	if err := req.Validate(); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	pool, ok := h.authorizeAuctionPortfolio(w, r, req.PortfolioID)
+	if !ok {
+		return
+	}
+
+	state, err := h.app.Auction.Bid(r.Context(), pool.ID, req.PortfolioID, req.LotID, req.Credits)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusOK, dtos.NewAuctionStateResponse(state))
+}
+
+// HandleAuctionEvents streams the auction as server-sent events. The first
+// event is the current state; each later event carries the state after a
+// nomination, bid or sale on any API instance.
+func (h *Handler) HandleAuctionEvents(w http.ResponseWriter, r *http.Request) {
+	pool, ok := h.authorizeViewPool(w, r)
+	if !ok {
+		return
+	}
+
+	// Subscribe before reading the state so no change slips between them.
+	events, unsubscribe := h.app.Changes.Subscribe(changefeed.Filter{PoolID: pool.ID})
+	defer unsubscribe()
+
+	state, err := h.app.Auction.GetState(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	stream := openEventStream(w, pool.ID)
+	if err := stream.send("state", dtos.NewAuctionStateResponse(state)); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if err := stream.heartbeat(); err != nil {
+				return
+			}
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			name := ev.Detail
+			switch ev.Kind {
+			case models.ChangeKindAuction:
+			case models.ChangeKindResync:
+				name = "state"
+			default:
+				continue
+			}
+			state, err := h.app.Auction.GetState(r.Context(), pool.ID)
+			if err != nil {
+				slog.Warn("auction_stream_state_failed", "pool_id", pool.ID, "error", err)
+				return
+			}
+			if err := stream.send(name, dtos.NewAuctionStateResponse(state)); err != nil {
+				return
+			}
+		}
+	}
+}
+
+func (h *Handler) authorizeManagePool(w http.ResponseWriter, r *http.Request) (*models.Pool, string, bool) {
+	pool, err := h.app.Pool.GetPoolByID(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return nil, "", false
+	}
+
+	userID := ""
+	if h.authUserID != nil {
+		userID = h.authUserID(r.Context())
+	}
+	decision, err := policy.CanManagePool(r.Context(), h.authz, userID, pool)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return nil, "", false
+	}
+	if !decision.Allowed {
+		httperr.Write(w, r, decision.Status, decision.Code, decision.Message, "")
+		return nil, "", false
+	}
+	return pool, userID, true
+}
+
+func (h *Handler) authorizeViewPool(w http.ResponseWriter, r *http.Request) (*models.Pool, bool) {
+	poolID := mux.Vars(r)["id"]
+	pool, err := h.app.Pool.GetPoolByID(r.Context(), poolID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return nil, false
+	}
+
+	userID := ""
+	if h.authUserID != nil {
+		userID = h.authUserID(r.Context())
+	}
+	participantIDs, err := h.app.Pool.GetDistinctUserIDsByPool(r.Context(), poolID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return nil, false
+	}
+	decision, err := policy.CanViewPool(r.Context(), h.authz, userID, pool, participantIDs)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return nil, false
+	}
+	if !decision.Allowed {
+		httperr.Write(w, r, decision.Status, decision.Code, decision.Message, "")
+		return nil, false
+	}
+	return pool, true
+}
+
+func (h *Handler) authorizeAuctionPortfolio(w http.ResponseWriter, r *http.Request, portfolioID string) (*models.Pool, bool) {
+	pool, err := h.app.Pool.GetPoolByID(r.Context(), mux.Vars(r)["id"])
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return nil, false
+	}
+	portfolio, err := h.app.Pool.GetPortfolio(r.Context(), portfolioID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return nil, false
+	}
+
+	userID := ""
+	if h.authUserID != nil {
+		userID = h.authUserID(r.Context())
+	}
+	decision, err := policy.CanActInAuction(r.Context(), h.authz, userID, portfolio, pool)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return nil, false
+	}
+	if !decision.Allowed {
+		httperr.Write(w, r, decision.Status, decision.Code, decision.Message, "portfolioId")
+		return nil, false
+	}
+	return pool, true
+}
@@ -0,0 +1,66 @@
+package pools
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/response"
+)
+
+func (h *Handler) HandleGetBiddingWindows(w http.ResponseWriter, r *http.Request) {
+	pool, ok := h.authorizeViewPool(w, r)
+	if !ok {
+		return
+	}
+	h.writeBiddingStatus(w, r, pool)
+}
+
+func (h *Handler) HandleReplaceBiddingWindows(w http.ResponseWriter, r *http.Request) {
+	pool, _, ok := h.authorizeManagePool(w, r)
+	if !ok {
+		return
+	}
+
+	var req dtos.ReplaceBiddingWindowsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
+		return
+	}
+	if err := req.Validate(); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	if err := h.app.Pool.ReplaceBiddingWindows(r.Context(), pool.ID, req.ToModels(pool.ID)); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	h.writeBiddingStatus(w, r, pool)
+}
+
+func (h *Handler) writeBiddingStatus(w http.ResponseWriter, r *http.Request, pool *models.Pool) {
+	schedule, err := h.biddingSchedule(r.Context(), pool)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusOK, dtos.NewBiddingStatusResponse(schedule, time.Now()))
+}
+
+// biddingSchedule loads the pool's tournament and bidding windows.
+func (h *Handler) biddingSchedule(ctx context.Context, pool *models.Pool) (models.BiddingSchedule, error) {
+	tournament, err := h.app.Tournament.GetByID(ctx, pool.TournamentID)
+	if err != nil {
+		return models.BiddingSchedule{}, err
+	}
+	windows, err := h.app.Pool.GetBiddingWindows(ctx, pool.ID)
+	if err != nil {
+		return models.BiddingSchedule{}, err
+	}
+	return models.NewPoolBiddingSchedule(pool, tournament, windows), nil
+}
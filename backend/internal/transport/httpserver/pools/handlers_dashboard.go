@@ -1,6 +1,7 @@
 package pools
 
 import (
+	"context"
 	"net/http"
 	"time"
 
@@ -50,59 +51,57 @@ func (h *Handler) HandleGetDashboard(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	portfolios, standings, err := h.app.Pool.GetPortfolios(r.Context(), poolID)
+	resp, err := h.buildDashboard(r.Context(), pool, userID)
 	if err != nil {
 		httperr.WriteFromErr(w, r, err, h.authUserID)
 		return
 	}
+	response.WriteJSON(w, http.StatusOK, resp)
+}
 
-	standingsByID := make(map[string]*models.PortfolioStanding, len(standings))
-	for _, s := range standings {
-		standingsByID[s.PortfolioID] = s
-	}
-
-	tournament, err := h.app.Tournament.GetByID(r.Context(), pool.TournamentID)
+// buildDashboard assembles the dashboard as userID sees it. The caller is
+// responsible for checking that userID may view the pool.
+func (h *Handler) buildDashboard(ctx context.Context, pool *models.Pool, userID string) (*dtos.PoolDashboardResponse, error) {
+	tournament, err := h.app.Tournament.GetByID(ctx, pool.TournamentID)
 	if err != nil {
-		httperr.WriteFromErr(w, r, err, h.authUserID)
-		return
+		return nil, err
 	}
 
-	schools, err := h.app.School.List(r.Context())
+	windows, err := h.app.Pool.GetBiddingWindows(ctx, pool.ID)
 	if err != nil {
-		httperr.WriteFromErr(w, r, err, h.authUserID)
-		return
+		return nil, err
 	}
 
-	tournamentTeams, err := h.app.Tournament.GetTeams(r.Context(), pool.TournamentID)
+	now := time.Now()
+	schedule := models.NewPoolBiddingSchedule(pool, tournament, windows)
+	investingOpen := schedule.IsOpen(now)
+	revealed := schedule.IsRevealed(now)
+
+	st, err := h.loadPoolState(ctx, pool, revealed)
 	if err != nil {
-		httperr.WriteFromErr(w, r, err, h.authUserID)
-		return
+		return nil, err
 	}
+	portfolios := st.portfolios
 
-	tournamentTeamResponses := make([]*dtos.TournamentTeamResponse, 0, len(tournamentTeams))
-	for _, team := range tournamentTeams {
-		tournamentTeamResponses = append(tournamentTeamResponses, dtos.NewTournamentTeamResponse(team, team.School))
+	standingsByID := make(map[string]*models.PortfolioStanding, len(st.standings))
+	for _, s := range st.standings {
+		standingsByID[s.PortfolioID] = s
 	}
 
-	scoringRules, err := h.app.Pool.GetScoringRules(r.Context(), poolID)
+	schools, err := h.app.School.List(ctx)
 	if err != nil {
-		httperr.WriteFromErr(w, r, err, h.authUserID)
-		return
+		return nil, err
 	}
 
-	payouts, err := h.app.Pool.GetPayouts(r.Context(), poolID)
-	if err != nil {
-		httperr.WriteFromErr(w, r, err, h.authUserID)
-		return
+	tournamentTeamResponses := make([]*dtos.TournamentTeamResponse, 0, len(st.tournamentTeams))
+	for _, team := range st.tournamentTeams {
+		tournamentTeamResponses = append(tournamentTeamResponses, dtos.NewTournamentTeamResponse(team, team.School))
 	}
 
-	investingOpen := !tournament.HasStarted(time.Now())
-
-	var currentUserPortfolio *models.Portfolio
+	var currentUserPortfolios []*models.Portfolio
 	for _, portfolio := range portfolios {
 		if portfolio.UserID != nil && *portfolio.UserID == userID {
-			currentUserPortfolio = portfolio
-			break
+			currentUserPortfolios = append(currentUserPortfolios, portfolio)
 		}
 	}
 
@@ -110,73 +109,41 @@ func (h *Handler) HandleGetDashboard(w http.ResponseWriter, r *http.Request) {
 		Pool:                 dtos.NewPoolResponse(pool),
 		TournamentStartingAt: tournament.StartingAt,
 		InvestingOpen:        investingOpen,
+		Bidding:              dtos.NewBiddingStatusResponse(schedule, now),
 		TotalPortfolios:      len(portfolios),
-		Abilities:            computeAbilities(r.Context(), h.authz, userID, pool),
-		ScoringRules:         dtos.NewScoringRuleListResponse(scoringRules),
+		Abilities:            computeAbilities(ctx, h.authz, userID, pool),
+		ScoringRules:         dtos.NewScoringRuleListResponse(st.scoringRules),
 		Schools:              dtos.NewSchoolListResponse(schools),
 		TournamentTeams:      tournamentTeamResponses,
 		RoundStandings:       []*dtos.RoundStandingGroup{},
+		UnclaimedTeams:       []*dtos.UnclaimedTeamResponse{},
+		SidePots:             []*dtos.SidePotStandingGroup{},
 	}
 
-	if currentUserPortfolio != nil {
-		resp.CurrentUserPortfolio = dtos.NewPortfolioResponse(currentUserPortfolio, standingsByID[currentUserPortfolio.ID])
+	if len(currentUserPortfolios) > 0 {
+		first := currentUserPortfolios[0]
+		resp.CurrentUserPortfolio = dtos.NewPortfolioResponse(first, standingsByID[first.ID])
+		resp.CurrentUserPortfolios = make([]*dtos.PortfolioResponse, 0, len(currentUserPortfolios))
+		for _, portfolio := range currentUserPortfolios {
+			resp.CurrentUserPortfolios = append(resp.CurrentUserPortfolios, dtos.NewPortfolioResponse(portfolio, standingsByID[portfolio.ID]))
+		}
 	}
 
-	if investingOpen {
+	// Bids stay sealed until they are revealed, including between windows.
+	if !revealed {
 		resp.Portfolios = []*dtos.PortfolioResponse{}
 		resp.Investments = []*dtos.InvestmentResponse{}
 		resp.OwnershipSummaries = []*dtos.OwnershipSummaryResponse{}
 		resp.OwnershipDetails = []*dtos.OwnershipDetailResponse{}
 	} else {
-		portfolioIDs := make([]string, 0, len(portfolios))
-		for _, portfolio := range portfolios {
-			portfolioIDs = append(portfolioIDs, portfolio.ID)
-		}
-
-		investmentsByPortfolio, err := h.app.Pool.GetInvestmentsByPortfolioIDs(r.Context(), portfolioIDs)
-		if err != nil {
-			httperr.WriteFromErr(w, r, err, h.authUserID)
-			return
-		}
-
-		ownershipByPortfolio, err := h.app.Pool.GetOwnershipSummariesByPortfolioIDs(r.Context(), portfolioIDs)
-		if err != nil {
-			httperr.WriteFromErr(w, r, err, h.authUserID)
-			return
-		}
-
-		var allInvestments []*models.Investment
-		for _, investments := range investmentsByPortfolio {
-			allInvestments = append(allInvestments, investments...)
-		}
-
-		var allOwnershipSummaries []*models.OwnershipSummary
-		for _, summaries := range ownershipByPortfolio {
-			allOwnershipSummaries = append(allOwnershipSummaries, summaries...)
-		}
-
-		ownershipDetailsByPortfolio, err := h.app.Pool.GetOwnershipDetailsByPortfolioIDs(r.Context(), portfolioIDs)
-		if err != nil {
-			httperr.WriteFromErr(w, r, err, h.authUserID)
-			return
-		}
-
-		var allOwnershipDetails []*models.OwnershipDetail
-		for _, details := range ownershipDetailsByPortfolio {
-			allOwnershipDetails = append(allOwnershipDetails, details...)
-		}
-
 		// Best-effort prediction loading: load all checkpoint batches
-		checkpoints := h.app.Prediction.LoadCheckpointPredictions(r.Context(), pool.TournamentID)
+		checkpoints := h.app.Prediction.LoadCheckpointPredictions(ctx, pool.TournamentID)
 
-		rules := make([]scoring.Rule, len(scoringRules))
-		for i, sr := range scoringRules {
-			rules[i] = scoring.Rule{WinIndex: sr.WinIndex, PointsAwarded: sr.PointsAwarded}
-		}
+		rules := scoring.FromModels(st.scoringRules)
 
-		ownershipToPortfolio := prediction.BuildSummaryToPortfolioMap(allOwnershipSummaries)
-		odInputs := prediction.ToPortfolioTeamInputs(allOwnershipDetails)
-		ttInputs := prediction.ToTournamentTeamInputs(tournamentTeams)
+		ownershipToPortfolio := prediction.BuildSummaryToPortfolioMap(st.ownershipSummaries)
+		odInputs := prediction.ToPortfolioTeamInputs(st.ownershipDetails)
+		ttInputs := prediction.ToTournamentTeamInputs(st.tournamentTeams, st.results)
 
 		if proj := prediction.ComputeEntryProjections(checkpoints, rules, ownershipToPortfolio, odInputs, ttInputs); proj != nil {
 			for portfolioID, ev := range proj.EV {
@@ -193,28 +160,42 @@ func (h *Handler) HandleGetDashboard(w http.ResponseWriter, r *http.Request) {
 			}
 		}
 
+		// Once few enough games remain, every outcome is enumerated and the
+		// exact expected returns replace the projections.
+		if exact := h.outcomeTable(ctx, pool, st).Exact(); exact != nil {
+			for _, o := range exact.Portfolios {
+				if s, ok := standingsByID[o.PortfolioID]; ok {
+					v := o.ExpectedReturns
+					s.ExpectedValue = &v
+				}
+			}
+			resp.ExactOutcomes = dtos.NewExactOutcomesResponse(exact)
+		}
+
 		resp.Portfolios = dtos.NewPortfolioListResponse(portfolios, standingsByID)
-		resp.Investments = dtos.NewInvestmentListResponse(allInvestments)
-		resp.OwnershipSummaries = dtos.NewOwnershipSummaryListResponse(allOwnershipSummaries)
-		resp.OwnershipDetails = dtos.NewOwnershipDetailListResponse(allOwnershipDetails)
-		resp.RoundStandings = computeRoundStandings(portfolios, allOwnershipSummaries, allOwnershipDetails, tournamentTeams, scoringRules, payouts, checkpoints)
-
-		bracket, err := h.app.Bracket.GetBracket(r.Context(), pool.TournamentID)
-		if err == nil && bracket != nil {
-			if ffOutcomes := poolapp.ComputeFinalFourOutcomes(bracket, portfolios, allOwnershipSummaries, allOwnershipDetails, tournamentTeams, scoringRules, payouts); ffOutcomes != nil {
+		resp.Investments = dtos.NewInvestmentListResponse(st.investments)
+		resp.OwnershipSummaries = dtos.NewOwnershipSummaryListResponse(st.ownershipSummaries)
+		resp.OwnershipDetails = dtos.NewOwnershipDetailListResponse(st.ownershipDetails)
+		for _, u := range poolapp.ComputeUnclaimedTeams(st.tournamentTeams, st.investments, st.results, st.scoringRules) {
+			resp.UnclaimedTeams = append(resp.UnclaimedTeams, &dtos.UnclaimedTeamResponse{TeamID: u.Team.ID, Points: u.Points})
+		}
+		sidePots, err := h.app.Pool.GetSidePots(ctx, pool.ID)
+		if err != nil {
+			return nil, err
+		}
+		for _, pot := range poolapp.ComputeSidePotStandings(sidePots, portfolios, st.investments, st.ownershipDetails, st.tournamentTeams, st.results, st.scoringRules, st.tieBreak) {
+			resp.SidePots = append(resp.SidePots, &dtos.SidePotStandingGroup{
+				SidePotResponse: dtos.NewSidePotResponse(pot.Pot),
+				Entries:         newStandingEntries(pot.Standings),
+			})
+		}
+		resp.RoundStandings = computeRoundStandings(portfolios, st.ownershipSummaries, st.ownershipDetails, st.tournamentTeams, st.results, st.scoringRules, st.payouts, st.tieBreak, checkpoints)
+
+		if st.bracket != nil {
+			if ffOutcomes := poolapp.ComputeFinalFourOutcomes(st.bracket, portfolios, st.ownershipSummaries, st.ownershipDetails, st.tournamentTeams, st.results, st.scoringRules, st.payouts, st.tieBreak); ffOutcomes != nil {
 				ffResponses := make([]*dtos.FinalFourOutcomeResponse, len(ffOutcomes))
 				for i, o := range ffOutcomes {
-					standingEntries := make([]*dtos.RoundStandingEntry, len(o.Standings))
-					for j, s := range o.Standings {
-						standingEntries[j] = &dtos.RoundStandingEntry{
-							PortfolioID:    s.PortfolioID,
-							TotalReturns:   s.TotalReturns,
-							FinishPosition: s.FinishPosition,
-							IsTied:         s.IsTied,
-							PayoutCents:    s.PayoutCents,
-							InTheMoney:     s.InTheMoney,
-						}
-					}
+					standingEntries := newStandingEntries(o.Standings)
 					ffResponses[i] = &dtos.FinalFourOutcomeResponse{
 						Semifinal1Winner: dtos.NewFinalFourTeam(o.Semifinal1Winner),
 						Semifinal2Winner: dtos.NewFinalFourTeam(o.Semifinal2Winner),
@@ -228,7 +209,7 @@ func (h *Handler) HandleGetDashboard(w http.ResponseWriter, r *http.Request) {
 		}
 	}
 
-	response.WriteJSON(w, http.StatusOK, resp)
+	return resp, nil
 }
 
 func (h *Handler) listPoolsWithRankings(w http.ResponseWriter, r *http.Request, userID string, pools []*models.Pool) {
@@ -258,32 +239,20 @@ func (h *Handler) listPoolsWithRankings(w http.ResponseWriter, r *http.Request,
 			return
 		}
 
-		var userPortfolio *models.Portfolio
-		for _, portfolio := range portfolios {
-			if portfolio.UserID != nil && *portfolio.UserID == userID {
-				userPortfolio = portfolio
-				break
-			}
-		}
-
-		if userPortfolio != nil {
+		if summary := poolapp.SummarizeUserStanding(userID, portfolios, standings); summary != nil {
 			item.HasPortfolio = true
 
-			// standings are already sorted by returns desc
-			rank := 1
-			var userReturns float64
-			for i, s := range standings {
-				if s.PortfolioID == userPortfolio.ID {
-					rank = i + 1
-					userReturns = s.TotalReturns
-					break
-				}
+			// A user with several portfolios ranks by their best one.
+			rank := summary.BestRank
+			if rank == 0 {
+				rank = 1
 			}
-
 			item.Ranking = &dtos.PoolRankingResponse{
 				Rank:            rank,
 				TotalPortfolios: len(portfolios),
-				Returns:         userReturns,
+				Returns:         summary.BestReturns,
+				PortfolioCount:  len(summary.PortfolioIDs),
+				PayoutCents:     summary.TotalPayoutCents,
 			}
 		}
 
@@ -293,6 +262,24 @@ func (h *Handler) listPoolsWithRankings(w http.ResponseWriter, r *http.Request,
 	response.WriteJSON(w, http.StatusOK, map[string]any{"items": results})
 }
 
+// newStandingEntries converts standings to leaderboard entries without
+// projections.
+func newStandingEntries(standings []*models.PortfolioStanding) []*dtos.RoundStandingEntry {
+	entries := make([]*dtos.RoundStandingEntry, len(standings))
+	for i, s := range standings {
+		entries[i] = &dtos.RoundStandingEntry{
+			PortfolioID:    s.PortfolioID,
+			TotalReturns:   s.TotalReturns,
+			FinishPosition: s.FinishPosition,
+			IsTied:         s.IsTied,
+			PayoutCents:    s.PayoutCents,
+			InTheMoney:     s.InTheMoney,
+			TieResolution:  dtos.NewTieResolutionResponse(s.TieResolution),
+		}
+	}
+	return entries
+}
+
 // computeRoundStandings computes standings at each round cap (0 through maxRound).
 // This lets the frontend show "as of" standings for any point in the tournament.
 // Each cap uses the checkpoint batch with the highest throughRound <= cap, so
@@ -302,50 +289,47 @@ func computeRoundStandings(
 	ownershipSummaries []*models.OwnershipSummary,
 	ownershipDetails []*models.OwnershipDetail,
 	tournamentTeams []*models.TournamentTeam,
+	results []*models.GameResult,
 	scoringRules []*models.ScoringRule,
 	payouts []*models.PoolPayout,
+	tieBreak *poolapp.TieBreak,
 	checkpoints []prediction.CheckpointData,
 ) []*dtos.RoundStandingGroup {
 	if len(scoringRules) == 0 {
 		return []*dtos.RoundStandingGroup{}
 	}
 
-	rules := make([]scoring.Rule, len(scoringRules))
+	rules := scoring.FromModels(scoringRules)
 	maxRound := 0
-	for i, sr := range scoringRules {
-		rules[i] = scoring.Rule{WinIndex: sr.WinIndex, PointsAwarded: sr.PointsAwarded}
+	for _, sr := range scoringRules {
 		if sr.WinIndex > maxRound {
 			maxRound = sr.WinIndex
 		}
 	}
 
-	teamByID := make(map[string]*models.TournamentTeam, len(tournamentTeams))
-	for _, tt := range tournamentTeams {
-		teamByID[tt.ID] = tt
-	}
+	runs := scoring.RunsFromResults(tournamentTeams, results)
 
 	ownershipToPortfolio := prediction.BuildSummaryToPortfolioMap(ownershipSummaries)
 	odInputs := prediction.ToPortfolioTeamInputs(ownershipDetails)
-	ttInputs := prediction.ToTournamentTeamInputs(tournamentTeams)
+	ttInputs := prediction.ToTournamentTeamInputs(tournamentTeams, results)
 
 	groups := make([]*dtos.RoundStandingGroup, 0, maxRound+1)
 	for cap := 0; cap <= maxRound; cap++ {
 		returnsByPortfolio := make(map[string]float64)
 		for _, od := range ownershipDetails {
-			team := teamByID[od.TeamID]
-			if team == nil {
+			run, ok := runs[od.TeamID]
+			if !ok {
 				continue
 			}
 			portfolioID := ownershipToPortfolio[od.PortfolioID]
 			if portfolioID == "" {
 				continue
 			}
-			capped := prediction.ProgressAtRound(team.Wins, team.Byes, cap)
-			teamPoints := scoring.PointsForProgress(rules, capped, 0)
+			teamPoints := scoring.PointsForRun(rules, run.Capped(cap))
 			returnsByPortfolio[portfolioID] += od.OwnershipPercentage * float64(teamPoints)
 		}
 
-		standings := poolapp.ComputeStandings(portfolios, returnsByPortfolio, payouts)
+		standings := poolapp.ComputeStandings(portfolios, returnsByPortfolio, payouts, tieBreak)
 		proj := prediction.ComputeRoundProjections(checkpoints, rules, ownershipToPortfolio, odInputs, ttInputs, cap)
 
 		standingEntries := make([]*dtos.RoundStandingEntry, len(standings))
@@ -357,6 +341,7 @@ func computeRoundStandings(
 				IsTied:         s.IsTied,
 				PayoutCents:    s.PayoutCents,
 				InTheMoney:     s.InTheMoney,
+				TieResolution:  dtos.NewTieResolutionResponse(s.TieResolution),
 			}
 			if proj != nil {
 				ev := proj.EV[s.PortfolioID]
@@ -0,0 +1,112 @@
+package pools
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	appauction "github.com/andrewcopp/Calcutta/backend/internal/app/auction"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/changefeed"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
+)
+
+// dashboardCoalesce gathers the burst of changes a single result produces
+// (the game, then each team it touches) into one rebuild.
+const dashboardCoalesce = 250 * time.Millisecond
+
+// HandleDashboardEvents streams dashboard updates as server-sent events. The
+// first "dashboard" event is the current state; later ones follow game
+// results, new prediction batches and auction sales, from any API instance
+// or worker.
+func (h *Handler) HandleDashboardEvents(w http.ResponseWriter, r *http.Request) {
+	pool, ok := h.authorizeViewPool(w, r)
+	if !ok {
+		return
+	}
+	userID := ""
+	if h.authUserID != nil {
+		userID = h.authUserID(r.Context())
+	}
+
+	// Subscribe before building so no change slips between them.
+	events, unsubscribe := h.app.Changes.Subscribe(changefeed.Filter{TournamentID: pool.TournamentID, PoolID: pool.ID})
+	defer unsubscribe()
+
+	dashboard, err := h.buildDashboard(r.Context(), pool, userID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	stream := openEventStream(w, pool.ID)
+	if err := stream.send("dashboard", dtos.NewPoolDashboardUpdate(dashboard, []string{"initial"}, "")); err != nil {
+		return
+	}
+
+	heartbeat := time.NewTicker(streamHeartbeat)
+	defer heartbeat.Stop()
+
+	var (
+		reasons           []string
+		predictionBatchID string
+		flush             <-chan time.Time
+	)
+	for {
+		select {
+		case <-r.Context().Done():
+			return
+		case <-heartbeat.C:
+			if err := stream.heartbeat(); err != nil {
+				return
+			}
+		case ev, ok := <-events:
+			if !ok {
+				return
+			}
+			if !movesDashboard(ev) {
+				continue
+			}
+			reasons = appendReason(reasons, ev.Kind)
+			if ev.Kind == models.ChangeKindPredictions {
+				predictionBatchID = ev.Detail
+			}
+			if flush == nil {
+				flush = time.After(dashboardCoalesce)
+			}
+		case <-flush:
+			flush = nil
+			dashboard, err := h.buildDashboard(r.Context(), pool, userID)
+			if err != nil {
+				slog.Warn("dashboard_stream_build_failed", "pool_id", pool.ID, "error", err)
+				return
+			}
+			if err := stream.send("dashboard", dtos.NewPoolDashboardUpdate(dashboard, reasons, predictionBatchID)); err != nil {
+				return
+			}
+			reasons, predictionBatchID = nil, ""
+		}
+	}
+}
+
+// movesDashboard reports whether a change can alter what the dashboard
+// shows. Of the auction's events only a sale does, since it adds ownership.
+func movesDashboard(ev models.ChangeEvent) bool {
+	switch ev.Kind {
+	case models.ChangeKindStandings, models.ChangeKindPredictions, models.ChangeKindResync:
+		return true
+	case models.ChangeKindAuction:
+		return ev.Detail == appauction.EventLotSold
+	}
+	return false
+}
+
+func appendReason(reasons []string, kind string) []string {
+	for _, r := range reasons {
+		if r == kind {
+			return reasons
+		}
+	}
+	return append(reasons, kind)
+}
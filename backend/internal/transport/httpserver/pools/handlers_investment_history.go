@@ -0,0 +1,47 @@
+package pools
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/response"
+)
+
+func (h *Handler) HandleListInvestmentHistory(w http.ResponseWriter, r *http.Request) {
+	portfolio, ok := h.authorizeViewPortfolioData(w, r)
+	if !ok {
+		return
+	}
+
+	history, err := h.app.Pool.GetInvestmentHistory(r.Context(), portfolio.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewInvestmentHistoryResponse(history)})
+}
+
+func (h *Handler) HandleGetInvestmentsAsOf(w http.ResponseWriter, r *http.Request) {
+	at, err := time.Parse(time.RFC3339, r.URL.Query().Get("at"))
+	if err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "at must be an RFC 3339 timestamp", "at")
+		return
+	}
+
+	portfolio, ok := h.authorizeViewPortfolioData(w, r)
+	if !ok {
+		return
+	}
+
+	snapshot, err := h.app.Pool.GetInvestmentsAsOf(r.Context(), portfolio.ID, at)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusOK, &dtos.InvestmentsAsOfResponse{
+		AsOf:     at.UTC(),
+		Snapshot: dtos.NewInvestmentSnapshotResponse(snapshot),
+	})
+}
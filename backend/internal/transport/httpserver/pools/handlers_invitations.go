@@ -151,7 +151,12 @@ func (h *Handler) HandleAcceptInvitation(w http.ResponseWriter, r *http.Request)
 		httperr.WriteFromErr(w, r, err, h.authUserID)
 		return
 	}
-	decision, err := policy.CanAcceptInvitation(r.Context(), h.authz, userID, pool, tournament, time.Now())
+	windows, err := h.app.Pool.GetBiddingWindows(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	decision, err := policy.CanAcceptInvitation(r.Context(), h.authz, userID, pool, tournament, windows, time.Now())
 	if err != nil {
 		httperr.WriteFromErr(w, r, err, h.authUserID)
 		return
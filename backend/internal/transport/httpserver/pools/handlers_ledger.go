@@ -0,0 +1,217 @@
+package pools
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/apperrors"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/ledger"
+	poolapp "github.com/andrewcopp/Calcutta/backend/internal/app/pool"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/response"
+	"github.com/gorilla/mux"
+)
+
+func (h *Handler) HandleListLedgerEntries(w http.ResponseWriter, r *http.Request) {
+	pool, _, ok := h.authorizeManagePool(w, r)
+	if !ok {
+		return
+	}
+
+	entries, err := h.app.Ledger.ListEntries(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewLedgerEntryListResponse(entries)})
+}
+
+func (h *Handler) HandleCreateLedgerEntry(w http.ResponseWriter, r *http.Request) {
+	pool, userID, ok := h.authorizeManagePool(w, r)
+	if !ok {
+		return
+	}
+
+	var req dtos.CreateLedgerEntryRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
+		return
+	}
+	if err := req.Validate(); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	entry := req.ToModel(pool.ID, userID)
+	if err := h.app.Ledger.RecordEntry(r.Context(), entry); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusCreated, dtos.NewLedgerEntryResponse(entry))
+}
+
+func (h *Handler) HandleVoidLedgerEntry(w http.ResponseWriter, r *http.Request) {
+	pool, _, ok := h.authorizeManagePool(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.app.Ledger.VoidEntry(r.Context(), pool.ID, mux.Vars(r)["entryId"]); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *Handler) HandleGetSettlement(w http.ResponseWriter, r *http.Request) {
+	pool, ok := h.authorizeViewPool(w, r)
+	if !ok {
+		return
+	}
+	h.writeSettlementReport(w, r, pool, http.StatusOK)
+}
+
+func (h *Handler) HandleSettlePool(w http.ResponseWriter, r *http.Request) {
+	pool, userID, ok := h.authorizeManagePool(w, r)
+	if !ok {
+		return
+	}
+
+	if _, err := h.app.Ledger.Settle(r.Context(), pool.ID, userID); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	h.writeSettlementReport(w, r, pool, http.StatusCreated)
+}
+
+func (h *Handler) HandleReopenSettlement(w http.ResponseWriter, r *http.Request) {
+	pool, _, ok := h.authorizeManagePool(w, r)
+	if !ok {
+		return
+	}
+
+	if err := h.app.Ledger.Reopen(r.Context(), pool.ID); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	h.writeSettlementReport(w, r, pool, http.StatusOK)
+}
+
+func (h *Handler) HandleGetMyLedger(w http.ResponseWriter, r *http.Request) {
+	userID := ""
+	if h.authUserID != nil {
+		userID = h.authUserID(r.Context())
+	}
+	if userID == "" {
+		httperr.Write(w, r, http.StatusUnauthorized, "unauthorized", "Authentication required", "")
+		return
+	}
+
+	position, err := h.app.Ledger.GetNetPosition(r.Context(), userID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	poolNames := make(map[string]string, len(position.Pools))
+	for _, p := range position.Pools {
+		pool, err := h.app.Pool.GetPoolByID(r.Context(), p.PoolID)
+		var notFound *apperrors.NotFoundError
+		if errors.As(err, &notFound) {
+			continue
+		}
+		if err != nil {
+			httperr.WriteFromErr(w, r, err, h.authUserID)
+			return
+		}
+		poolNames[p.PoolID] = pool.Name
+	}
+	response.WriteJSON(w, http.StatusOK, dtos.NewNetPositionResponse(position, poolNames))
+}
+
+func (h *Handler) writeSettlementReport(w http.ResponseWriter, r *http.Request, pool *models.Pool, status int) {
+	portfolios, owed, err := h.payoutsOwed(r.Context(), pool)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	entries, err := h.app.Ledger.ListEntries(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	settlement, err := h.app.Ledger.GetSettlement(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	report := ledger.BuildSettlementReport(pool, portfolios, owed, entries, settlement)
+	response.WriteJSON(w, status, dtos.NewSettlementReportResponse(report))
+}
+
+// payoutsOwed returns the pool's portfolios and what each has won across the
+// main pot and the pool's side pots, as the standings currently stand.
+func (h *Handler) payoutsOwed(ctx context.Context, pool *models.Pool) ([]*models.Portfolio, map[string]int, error) {
+	portfolios, standings, err := h.app.Pool.GetPortfolios(ctx, pool.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	owed := make(map[string]int, len(portfolios))
+	for _, s := range standings {
+		owed[s.PortfolioID] += s.PayoutCents
+	}
+
+	sidePots, err := h.app.Pool.GetSidePots(ctx, pool.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(sidePots) == 0 {
+		return portfolios, owed, nil
+	}
+
+	portfolioIDs := make([]string, 0, len(portfolios))
+	for _, p := range portfolios {
+		portfolioIDs = append(portfolioIDs, p.ID)
+	}
+	investmentsByPortfolio, err := h.app.Pool.GetInvestmentsByPortfolioIDs(ctx, portfolioIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	var allInvestments []*models.Investment
+	for _, investments := range investmentsByPortfolio {
+		allInvestments = append(allInvestments, investments...)
+	}
+	detailsByPortfolio, err := h.app.Pool.GetOwnershipDetailsByPortfolioIDs(ctx, portfolioIDs)
+	if err != nil {
+		return nil, nil, err
+	}
+	var allDetails []*models.OwnershipDetail
+	for _, details := range detailsByPortfolio {
+		allDetails = append(allDetails, details...)
+	}
+	tournamentTeams, err := h.app.Tournament.GetTeams(ctx, pool.TournamentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	results, err := h.app.Bracket.ListGameResults(ctx, pool.TournamentID)
+	if err != nil {
+		return nil, nil, err
+	}
+	scoringRules, err := h.app.Pool.GetScoringRules(ctx, pool.ID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	tieBreak := poolapp.NewTieBreak(pool, allInvestments, poolapp.ChampionPointsFromDetails(allDetails))
+	for _, pot := range poolapp.ComputeSidePotStandings(sidePots, portfolios, allInvestments, allDetails, tournamentTeams, results, scoringRules, tieBreak) {
+		for _, s := range pot.Standings {
+			owed[s.PortfolioID] += s.PayoutCents
+		}
+	}
+	return portfolios, owed, nil
+}
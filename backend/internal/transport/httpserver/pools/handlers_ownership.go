@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"time"
 
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
 	"github.com/andrewcopp/Calcutta/backend/internal/policy"
 	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
 	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
@@ -59,8 +60,13 @@ func (h *Handler) HandleListOwnership(w http.ResponseWriter, r *http.Request) {
 		httperr.WriteFromErr(w, r, err, h.authUserID)
 		return
 	}
-	if !policy.IsBiddingPhaseViewAllowed(userID, portfolio, tournament, time.Now(), decision.IsAdmin) {
-		httperr.Write(w, r, http.StatusForbidden, "investing_active", "Portfolio data is sealed until tip-off", "")
+	windows, err := h.app.Pool.GetBiddingWindows(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	if !policy.IsBiddingPhaseViewAllowed(userID, portfolio, models.NewPoolBiddingSchedule(pool, tournament, windows), time.Now(), decision.IsAdmin) {
+		httperr.Write(w, r, http.StatusForbidden, "investing_active", "Portfolio data is sealed until bids are revealed", "")
 		return
 	}
 
@@ -55,7 +55,23 @@ func (h *Handler) HandleCreatePortfolio(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	decision, err := policy.CanCreatePortfolio(r.Context(), h.authz, userID, pool, tournament, req.UserID, time.Now())
+	portfolioUserID := &userID
+	if req.UserID != nil {
+		portfolioUserID = req.UserID
+	}
+
+	heldPortfolios, err := h.app.Pool.CountPortfoliosByUser(r.Context(), poolID, *portfolioUserID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	windows, err := h.app.Pool.GetBiddingWindows(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	decision, err := policy.CanCreatePortfolio(r.Context(), h.authz, userID, pool, tournament, windows, req.UserID, heldPortfolios, time.Now())
 	if err != nil {
 		httperr.WriteFromErr(w, r, err, h.authUserID)
 		return
@@ -65,11 +81,6 @@ func (h *Handler) HandleCreatePortfolio(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	portfolioUserID := &userID
-	if req.UserID != nil {
-		portfolioUserID = req.UserID
-	}
-
 	portfolio := &models.Portfolio{
 		Name:   strings.TrimSpace(req.Name),
 		UserID: portfolioUserID,
@@ -81,7 +92,17 @@ func (h *Handler) HandleCreatePortfolio(w http.ResponseWriter, r *http.Request)
 		investments = append(investments, &models.Investment{TeamID: t.TeamID, Credits: t.Credits})
 	}
 
-	if err := poolapp.ValidatePortfolio(pool, portfolio, investments); err != nil {
+	isAuction, err := h.app.Auction.IsAuctionPool(r.Context(), poolID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	if isAuction {
+		if len(investments) > 0 {
+			httperr.Write(w, r, http.StatusConflict, "auction_pool", "Teams in this pool are bought at auction", "teams")
+			return
+		}
+	} else if err := poolapp.ValidatePortfolio(pool, portfolio, investments); err != nil {
 		httperr.Write(w, r, http.StatusBadRequest, "validation_error", err.Error(), "teams")
 		return
 	}
@@ -178,7 +199,12 @@ func (h *Handler) HandleListPortfolios(w http.ResponseWriter, r *http.Request) {
 		httperr.WriteFromErr(w, r, err, h.authUserID)
 		return
 	}
-	if !tournament.HasStarted(time.Now()) {
+	windows, err := h.app.Pool.GetBiddingWindows(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	if !models.NewPoolBiddingSchedule(pool, tournament, windows).IsRevealed(time.Now()) {
 		manageDecision, err := policy.CanManagePool(r.Context(), h.authz, userID, pool)
 		if err != nil {
 			httperr.WriteFromErr(w, r, err, h.authUserID)
@@ -199,17 +225,52 @@ func (h *Handler) HandleListPortfolios(w http.ResponseWriter, r *http.Request) {
 }
 
 func (h *Handler) HandleListInvestments(w http.ResponseWriter, r *http.Request) {
+	portfolio, ok := h.authorizeViewPortfolioData(w, r)
+	if !ok {
+		return
+	}
+
+	investments, err := h.app.Pool.GetInvestments(r.Context(), portfolio.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewInvestmentListResponse(investments)})
+}
+
+// authorizeViewPortfolioData loads the portfolio named in the route for a
+// caller allowed to see its bids, which stay sealed from other participants
+// until they are revealed.
+func (h *Handler) authorizeViewPortfolioData(w http.ResponseWriter, r *http.Request) (*models.Portfolio, bool) {
+	view, ok := h.authorizeViewPortfolio(w, r)
+	if !ok {
+		return nil, false
+	}
+	return view.portfolio, true
+}
+
+// portfolioView is a portfolio a caller may see the bids of, with the pool
+// it is in and the pool's bidding schedule.
+type portfolioView struct {
+	portfolio *models.Portfolio
+	pool      *models.Pool
+	schedule  models.BiddingSchedule
+}
+
+// authorizeViewPortfolio is authorizeViewPortfolioData for handlers that also
+// need the pool and its schedule.
+func (h *Handler) authorizeViewPortfolio(w http.ResponseWriter, r *http.Request) (*portfolioView, bool) {
 	vars := mux.Vars(r)
 	poolID := vars["poolId"]
 	portfolioID := vars["portfolioId"]
 
 	if poolID == "" {
 		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Pool ID is required", "poolId")
-		return
+		return nil, false
 	}
 	if portfolioID == "" {
 		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Portfolio ID is required", "portfolioId")
-		return
+		return nil, false
 	}
 
 	userID := ""
@@ -218,55 +279,55 @@ func (h *Handler) HandleListInvestments(w http.ResponseWriter, r *http.Request)
 	}
 	if userID == "" {
 		httperr.Write(w, r, http.StatusUnauthorized, "unauthorized", "Authentication required", "")
-		return
+		return nil, false
 	}
 
 	portfolio, err := h.app.Pool.GetPortfolio(r.Context(), portfolioID)
 	if err != nil {
 		httperr.WriteFromErr(w, r, err, h.authUserID)
-		return
+		return nil, false
 	}
 	if portfolio == nil {
 		httperr.Write(w, r, http.StatusNotFound, "not_found", "portfolio not found", "")
-		return
+		return nil, false
 	}
 	if portfolio.PoolID != poolID {
 		httperr.Write(w, r, http.StatusNotFound, "not_found", "portfolio not found", "")
-		return
+		return nil, false
 	}
 
 	pool, err := h.app.Pool.GetPoolByID(r.Context(), portfolio.PoolID)
 	if err != nil {
 		httperr.WriteFromErr(w, r, err, h.authUserID)
-		return
+		return nil, false
 	}
 
 	decision, err := policy.CanViewPortfolioData(r.Context(), h.authz, userID, portfolio, pool)
 	if err != nil {
 		httperr.WriteFromErr(w, r, err, h.authUserID)
-		return
+		return nil, false
 	}
 	if !decision.Allowed {
 		httperr.Write(w, r, decision.Status, decision.Code, decision.Message, "")
-		return
+		return nil, false
 	}
 
 	tournament, err := h.app.Tournament.GetByID(r.Context(), pool.TournamentID)
 	if err != nil {
 		httperr.WriteFromErr(w, r, err, h.authUserID)
-		return
-	}
-	if !policy.IsBiddingPhaseViewAllowed(userID, portfolio, tournament, time.Now(), decision.IsAdmin) {
-		httperr.Write(w, r, http.StatusForbidden, "investing_active", "Portfolio data is sealed until tip-off", "")
-		return
+		return nil, false
 	}
-
-	investments, err := h.app.Pool.GetInvestments(r.Context(), portfolioID)
+	windows, err := h.app.Pool.GetBiddingWindows(r.Context(), pool.ID)
 	if err != nil {
 		httperr.WriteFromErr(w, r, err, h.authUserID)
-		return
+		return nil, false
 	}
-	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewInvestmentListResponse(investments)})
+	schedule := models.NewPoolBiddingSchedule(pool, tournament, windows)
+	if !policy.IsBiddingPhaseViewAllowed(userID, portfolio, schedule, time.Now(), decision.IsAdmin) {
+		httperr.Write(w, r, http.StatusForbidden, "investing_active", "Portfolio data is sealed until bids are revealed", "")
+		return nil, false
+	}
+	return &portfolioView{portfolio: portfolio, pool: pool, schedule: schedule}, true
 }
 
 func (h *Handler) HandleUpdatePortfolio(w http.ResponseWriter, r *http.Request) {
@@ -304,7 +365,12 @@ func (h *Handler) HandleUpdatePortfolio(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	decision, err := policy.CanEditPortfolioInvestments(r.Context(), h.authz, userID, portfolio, pool, tournament, time.Now())
+	windows, err := h.app.Pool.GetBiddingWindows(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	decision, err := policy.CanEditPortfolioInvestments(r.Context(), h.authz, userID, portfolio, pool, tournament, windows, time.Now())
 	if err != nil {
 		httperr.WriteFromErr(w, r, err, h.authUserID)
 		return
@@ -314,6 +380,16 @@ func (h *Handler) HandleUpdatePortfolio(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
+	isAuction, err := h.app.Auction.IsAuctionPool(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	if isAuction {
+		httperr.Write(w, r, http.StatusConflict, "auction_pool", "Teams in this pool are bought at auction", "teams")
+		return
+	}
+
 	var req dtos.UpdatePortfolioRequest
 	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
 		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
@@ -426,7 +502,12 @@ func (h *Handler) HandleDeletePortfolio(w http.ResponseWriter, r *http.Request)
 		return
 	}
 
-	decision, err := policy.CanDeletePortfolio(r.Context(), h.authz, userID, portfolio, pool, tournament, time.Now())
+	windows, err := h.app.Pool.GetBiddingWindows(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	decision, err := policy.CanDeletePortfolio(r.Context(), h.authz, userID, portfolio, pool, tournament, windows, time.Now())
 	if err != nil {
 		httperr.WriteFromErr(w, r, err, h.authUserID)
 		return
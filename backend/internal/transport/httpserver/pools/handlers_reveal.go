@@ -0,0 +1,56 @@
+package pools
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/response"
+)
+
+func (h *Handler) HandleGetMarketSummary(w http.ResponseWriter, r *http.Request) {
+	pool, ok := h.authorizeViewPool(w, r)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.biddingSchedule(r.Context(), pool)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	reveal, err := h.app.Reveal.Get(r.Context(), pool.ID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	if reveal == nil && !schedule.IsRevealed(time.Now()) {
+		httperr.Write(w, r, http.StatusForbidden, "investing_active", "Portfolio data is sealed until bids are revealed", "")
+		return
+	}
+	if reveal == nil {
+		httperr.Write(w, r, http.StatusNotFound, "market_summary_pending", "The market summary is frozen shortly after bids are revealed", "")
+		return
+	}
+	response.WriteJSON(w, http.StatusOK, dtos.NewMarketRevealResponse(reveal))
+}
+
+func (h *Handler) HandleRevealBids(w http.ResponseWriter, r *http.Request) {
+	pool, userID, ok := h.authorizeManagePool(w, r)
+	if !ok {
+		return
+	}
+
+	schedule, err := h.biddingSchedule(r.Context(), pool)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	reveal, err := h.app.Reveal.RevealNow(r.Context(), pool, schedule, userID, time.Now())
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusCreated, dtos.NewMarketRevealResponse(reveal))
+}
@@ -0,0 +1,111 @@
+package pools
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/policy"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/response"
+	"github.com/gorilla/mux"
+)
+
+func (h *Handler) HandleListSidePots(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	poolID := vars["id"]
+	if poolID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Pool ID is required", "id")
+		return
+	}
+
+	pool, err := h.app.Pool.GetPoolByID(r.Context(), poolID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	userID := ""
+	if h.authUserID != nil {
+		userID = h.authUserID(r.Context())
+	}
+
+	participantIDs, err := h.app.Pool.GetDistinctUserIDsByPool(r.Context(), poolID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	decision, err := policy.CanViewPool(r.Context(), h.authz, userID, pool, participantIDs)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	if !decision.Allowed {
+		httperr.Write(w, r, decision.Status, decision.Code, decision.Message, "")
+		return
+	}
+
+	pots, err := h.app.Pool.GetSidePots(r.Context(), poolID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewSidePotListResponse(pots)})
+}
+
+func (h *Handler) HandleReplaceSidePots(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	poolID := vars["id"]
+	if poolID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Pool ID is required", "id")
+		return
+	}
+
+	userID := ""
+	if h.authUserID != nil {
+		userID = h.authUserID(r.Context())
+	}
+	if userID == "" {
+		httperr.Write(w, r, http.StatusUnauthorized, "unauthorized", "Authentication required", "")
+		return
+	}
+
+	pool, err := h.app.Pool.GetPoolByID(r.Context(), poolID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	decision, err := policy.CanManagePool(r.Context(), h.authz, userID, pool)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	if !decision.Allowed {
+		httperr.Write(w, r, decision.Status, decision.Code, decision.Message, "")
+		return
+	}
+
+	var req dtos.ReplaceSidePotsRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
+		return
+	}
+	if err := req.Validate(); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	if err := h.app.Pool.ReplaceSidePots(r.Context(), poolID, req.ToModels(poolID)); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	updated, err := h.app.Pool.GetSidePots(r.Context(), poolID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewSidePotListResponse(updated)})
+}
@@ -0,0 +1,38 @@
+package pools
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/response"
+)
+
+// HandleGetWinScenarios answers what a portfolio needs from the remaining
+// games to finish first or in the money. Every other portfolio's holdings
+// decide that, so it waits until bids are revealed.
+func (h *Handler) HandleGetWinScenarios(w http.ResponseWriter, r *http.Request) {
+	view, ok := h.authorizeViewPortfolio(w, r)
+	if !ok {
+		return
+	}
+	if !view.schedule.IsRevealed(time.Now()) {
+		httperr.Write(w, r, http.StatusForbidden, "investing_active", "Scenarios are available once bids are revealed", "")
+		return
+	}
+
+	ctx := r.Context()
+	st, err := h.loadPoolState(ctx, view.pool, true)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+	scenarios := h.outcomeTable(ctx, view.pool, st).WinScenarios(view.portfolio.ID)
+	if scenarios == nil {
+		httperr.Write(w, r, http.StatusConflict, "scenarios_unavailable", fmt.Sprintf("Scenarios are available once %d or fewer games remain", h.maxExactRemainingGames), "")
+		return
+	}
+	response.WriteJSON(w, http.StatusOK, dtos.NewWinScenariosResponse(scenarios))
+}
@@ -0,0 +1,91 @@
+package pools
+
+import (
+	"context"
+
+	poolapp "github.com/andrewcopp/Calcutta/backend/internal/app/pool"
+	"github.com/andrewcopp/Calcutta/backend/internal/models"
+)
+
+// poolState is what a pool's standings and outcomes are computed from. The
+// holdings, results, bracket and tie-breaker are only loaded once bids are
+// revealed.
+type poolState struct {
+	portfolios      []*models.Portfolio
+	standings       []*models.PortfolioStanding
+	portfolioIDs    []string
+	tournamentTeams []*models.TournamentTeam
+	scoringRules    []*models.ScoringRule
+	payouts         []*models.PoolPayout
+
+	investments        []*models.Investment
+	ownershipSummaries []*models.OwnershipSummary
+	ownershipDetails   []*models.OwnershipDetail
+	results            []*models.GameResult
+	// bracket is nil when the tournament's bracket cannot be built.
+	bracket  *models.BracketStructure
+	tieBreak *poolapp.TieBreak
+}
+
+// loadPoolState loads the pool's portfolios, teams, scoring rules and
+// payouts, and with revealed, everything its bids decide too. Holdings are
+// flattened in portfolio order, so the outcome table's inputs read the same
+// from one request to the next.
+func (h *Handler) loadPoolState(ctx context.Context, pool *models.Pool, revealed bool) (*poolState, error) {
+	portfolios, standings, err := h.app.Pool.GetPortfolios(ctx, pool.ID)
+	if err != nil {
+		return nil, err
+	}
+	st := &poolState{portfolios: portfolios, standings: standings, portfolioIDs: make([]string, 0, len(portfolios))}
+	for _, p := range portfolios {
+		st.portfolioIDs = append(st.portfolioIDs, p.ID)
+	}
+
+	if st.tournamentTeams, err = h.app.Tournament.GetTeams(ctx, pool.TournamentID); err != nil {
+		return nil, err
+	}
+	if st.scoringRules, err = h.app.Pool.GetScoringRules(ctx, pool.ID); err != nil {
+		return nil, err
+	}
+	if st.payouts, err = h.app.Pool.GetPayouts(ctx, pool.ID); err != nil {
+		return nil, err
+	}
+	if !revealed {
+		return st, nil
+	}
+
+	investmentsByPortfolio, err := h.app.Pool.GetInvestmentsByPortfolioIDs(ctx, st.portfolioIDs)
+	if err != nil {
+		return nil, err
+	}
+	ownershipByPortfolio, err := h.app.Pool.GetOwnershipSummariesByPortfolioIDs(ctx, st.portfolioIDs)
+	if err != nil {
+		return nil, err
+	}
+	ownershipDetailsByPortfolio, err := h.app.Pool.GetOwnershipDetailsByPortfolioIDs(ctx, st.portfolioIDs)
+	if err != nil {
+		return nil, err
+	}
+	for _, id := range st.portfolioIDs {
+		st.investments = append(st.investments, investmentsByPortfolio[id]...)
+		st.ownershipSummaries = append(st.ownershipSummaries, ownershipByPortfolio[id]...)
+		st.ownershipDetails = append(st.ownershipDetails, ownershipDetailsByPortfolio[id]...)
+	}
+
+	if st.results, err = h.app.Bracket.ListGameResults(ctx, pool.TournamentID); err != nil {
+		return nil, err
+	}
+	if bracket, err := h.app.Bracket.GetBracket(ctx, pool.TournamentID); err == nil {
+		st.bracket = bracket
+	}
+	st.tieBreak = poolapp.NewTieBreak(pool, st.investments, poolapp.ChampionPointsFromDetails(st.ownershipDetails))
+	return st, nil
+}
+
+// outcomeTable returns the pool's enumerated outcomes under the latest
+// predictions, or nil while more than h.maxExactRemainingGames games remain. The enumeration is cached
+// until the results, holdings or predictions change.
+func (h *Handler) outcomeTable(ctx context.Context, pool *models.Pool, st *poolState) *poolapp.OutcomeTable {
+	batchID, winProbs := h.app.Prediction.LoadLatestWinProbabilities(ctx, pool.TournamentID)
+	return h.app.Pool.GetOutcomeTable(pool.ID, batchID, st.bracket, st.portfolios, st.ownershipSummaries, st.ownershipDetails, st.tournamentTeams, st.results, st.scoringRules, st.payouts, st.tieBreak, winProbs, h.maxExactRemainingGames)
+}
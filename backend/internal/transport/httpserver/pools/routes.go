@@ -11,6 +11,7 @@ type Handlers struct {
 	CreatePool              http.HandlerFunc
 	GetPool                 http.HandlerFunc
 	GetDashboard            http.HandlerFunc
+	DashboardEvents         http.HandlerFunc
 	UpdatePool              http.HandlerFunc
 	ListPortfolios          http.HandlerFunc
 	CreatePortfolio         http.HandlerFunc
@@ -21,11 +22,33 @@ type Handlers struct {
 	RevokeInvitation        http.HandlerFunc
 	ListMyInvitations       http.HandlerFunc
 	ListInvestments         http.HandlerFunc
+	ListInvestmentHistory   http.HandlerFunc
+	GetInvestmentsAsOf      http.HandlerFunc
+	GetWinScenarios         http.HandlerFunc
 	ListOwnership           http.HandlerFunc
 	UpdatePortfolio         http.HandlerFunc
 	Reinvite                http.HandlerFunc
 	ListPayouts             http.HandlerFunc
 	ReplacePayouts          http.HandlerFunc
+	ListSidePots            http.HandlerFunc
+	ReplaceSidePots         http.HandlerFunc
+	GetBiddingWindows       http.HandlerFunc
+	ReplaceBiddingWindows   http.HandlerFunc
+	GetMarketSummary        http.HandlerFunc
+	RevealBids              http.HandlerFunc
+	CreateAuction           http.HandlerFunc
+	GetAuction              http.HandlerFunc
+	StartAuction            http.HandlerFunc
+	NominateAuctionLot      http.HandlerFunc
+	PlaceAuctionBid         http.HandlerFunc
+	AuctionEvents           http.HandlerFunc
+	ListLedgerEntries       http.HandlerFunc
+	CreateLedgerEntry       http.HandlerFunc
+	VoidLedgerEntry         http.HandlerFunc
+	GetSettlement           http.HandlerFunc
+	SettlePool              http.HandlerFunc
+	ReopenSettlement        http.HandlerFunc
+	GetMyLedger             http.HandlerFunc
 }
 
 const uuidPattern = `[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}`
@@ -34,6 +57,7 @@ func RegisterRoutes(r *mux.Router, h Handlers) {
 	r.HandleFunc("/api/v1/pools", h.ListPools).Methods("GET")
 	r.HandleFunc("/api/v1/pools", h.CreatePool).Methods("POST")
 	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/dashboard", h.GetDashboard).Methods("GET")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/dashboard/events", h.DashboardEvents).Methods("GET")
 	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}", h.GetPool).Methods("GET")
 	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}", h.UpdatePool).Methods("PATCH")
 	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/portfolios", h.ListPortfolios).Methods("GET")
@@ -46,7 +70,29 @@ func RegisterRoutes(r *mux.Router, h Handlers) {
 	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/reinvite", h.Reinvite).Methods("POST")
 	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/payouts", h.ListPayouts).Methods("GET")
 	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/payouts", h.ReplacePayouts).Methods("PUT")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/side-pots", h.ListSidePots).Methods("GET")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/side-pots", h.ReplaceSidePots).Methods("PUT")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/bidding-windows", h.GetBiddingWindows).Methods("GET")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/bidding-windows", h.ReplaceBiddingWindows).Methods("PUT")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/market-summary", h.GetMarketSummary).Methods("GET")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/reveal", h.RevealBids).Methods("POST")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/auction", h.CreateAuction).Methods("POST")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/auction", h.GetAuction).Methods("GET")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/auction/start", h.StartAuction).Methods("POST")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/auction/nominations", h.NominateAuctionLot).Methods("POST")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/auction/bids", h.PlaceAuctionBid).Methods("POST")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/auction/events", h.AuctionEvents).Methods("GET")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/ledger", h.ListLedgerEntries).Methods("GET")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/ledger", h.CreateLedgerEntry).Methods("POST")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/ledger/{entryId:"+uuidPattern+"}", h.VoidLedgerEntry).Methods("DELETE")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/settlement", h.GetSettlement).Methods("GET")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/settlement", h.SettlePool).Methods("POST")
+	r.HandleFunc("/api/v1/pools/{id:"+uuidPattern+"}/settlement", h.ReopenSettlement).Methods("DELETE")
+	r.HandleFunc("/api/v1/me/ledger", h.GetMyLedger).Methods("GET")
 	r.HandleFunc("/api/v1/pools/{poolId:"+uuidPattern+"}/portfolios/{portfolioId:"+uuidPattern+"}/investments", h.ListInvestments).Methods("GET")
+	r.HandleFunc("/api/v1/pools/{poolId:"+uuidPattern+"}/portfolios/{portfolioId:"+uuidPattern+"}/investments/history", h.ListInvestmentHistory).Methods("GET")
+	r.HandleFunc("/api/v1/pools/{poolId:"+uuidPattern+"}/portfolios/{portfolioId:"+uuidPattern+"}/investments/as-of", h.GetInvestmentsAsOf).Methods("GET")
+	r.HandleFunc("/api/v1/pools/{poolId:"+uuidPattern+"}/portfolios/{portfolioId:"+uuidPattern+"}/scenarios", h.GetWinScenarios).Methods("GET")
 	r.HandleFunc("/api/v1/pools/{poolId:"+uuidPattern+"}/portfolios/{portfolioId:"+uuidPattern+"}/ownership", h.ListOwnership).Methods("GET")
 	r.HandleFunc("/api/v1/pools/{poolId:"+uuidPattern+"}/portfolios/{portfolioId:"+uuidPattern+"}", h.UpdatePortfolio).Methods("PATCH")
 	r.HandleFunc("/api/v1/pools/{poolId:"+uuidPattern+"}/portfolios/{portfolioId:"+uuidPattern+"}", h.DeletePortfolio).Methods("DELETE")
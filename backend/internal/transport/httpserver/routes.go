@@ -21,6 +21,7 @@ func (s *Server) RegisterRoutes(r *mux.Router) {
 	s.registerAdminAPIKeyRoutes(protected)
 	s.registerAdminUserMergeRoutes(protected)
 	s.registerAdminUsersRoutes(protected)
+	s.registerAdminScoreFeedRoutes(protected)
 	s.registerProtectedRoutes(protected)
 }
 
@@ -35,6 +36,7 @@ func (s *Server) registerProtectedRoutes(r *mux.Router) {
 	r.HandleFunc("/api/v1/me/permissions", s.mePermissionsHandler).Methods("GET", "OPTIONS")
 	r.HandleFunc("/api/v1/me/profile", s.meProfileHandler).Methods("GET", "OPTIONS")
 	r.HandleFunc("/api/v1/schools", s.schoolsHandler).Methods("GET")
+	r.HandleFunc("/api/v1/schools/{schoolId}/location", s.requirePermission("tournament.game.write", s.updateSchoolLocationHandler)).Methods("PUT")
 
 	tHandler := tournaments.NewHandlerWithAuthUserID(s.app, authUserID)
 	tournaments.RegisterRoutes(r, tournaments.Handlers{
@@ -51,16 +53,21 @@ func (s *Server) registerProtectedRoutes(r *mux.Router) {
 		UpdateKenPomStats:    s.requirePermission("tournament.game.write", tHandler.HandleUpdateKenPomStats),
 		GetPredictions:           s.requirePermission("tournament.game.write", tHandler.HandleGetPredictions),
 		ListPredictionBatches:    s.requirePermission("tournament.game.write", tHandler.HandleListPredictionBatches),
+		ListPredictedMatchups:    s.requirePermission("tournament.game.write", tHandler.HandleListPredictedMatchups),
+		ListProbabilityTables:    s.requirePermission("tournament.game.write", tHandler.HandleListProbabilityTables),
+		UploadProbabilityTable:   s.requirePermission("tournament.game.write", tHandler.HandleUploadProbabilityTable),
+		DeleteProbabilityTable:   s.requirePermission("tournament.game.write", tHandler.HandleDeleteProbabilityTable),
 	})
 
 	s.registerBracketRoutes(r)
 
-	pHandler := pools.NewHandlerWithAuthUserID(s.app, s.authzRepo, s.authzRepo, authUserID)
+	pHandler := pools.NewHandlerWithAuthUserID(s.app, s.authzRepo, s.authzRepo, authUserID, s.cfg.ExactOutcomesMaxRemainingGames)
 	pools.RegisterRoutes(r, pools.Handlers{
 		ListPools:               pHandler.HandleListPools,
 		CreatePool:              pHandler.HandleCreatePool,
 		GetPool:                 pHandler.HandleGetPool,
 		GetDashboard:            pHandler.HandleGetDashboard,
+		DashboardEvents:         pHandler.HandleDashboardEvents,
 		UpdatePool:              pHandler.HandleUpdatePool,
 		ListPortfolios:          pHandler.HandleListPortfolios,
 		CreatePortfolio:         pHandler.HandleCreatePortfolio,
@@ -71,11 +78,33 @@ func (s *Server) registerProtectedRoutes(r *mux.Router) {
 		RevokeInvitation:        pHandler.HandleRevokeInvitation,
 		ListMyInvitations:       pHandler.HandleListMyInvitations,
 		ListInvestments:         pHandler.HandleListInvestments,
+		ListInvestmentHistory:   pHandler.HandleListInvestmentHistory,
+		GetInvestmentsAsOf:      pHandler.HandleGetInvestmentsAsOf,
+		GetWinScenarios:         pHandler.HandleGetWinScenarios,
 		ListOwnership:           pHandler.HandleListOwnership,
 		UpdatePortfolio:         idempotencyMiddleware(s.idempotencyRepo, pHandler.HandleUpdatePortfolio),
 		Reinvite:                pHandler.HandleReinvite,
 		ListPayouts:             pHandler.HandleListPayouts,
 		ReplacePayouts:          pHandler.HandleReplacePayouts,
+		ListSidePots:            pHandler.HandleListSidePots,
+		ReplaceSidePots:         pHandler.HandleReplaceSidePots,
+		GetBiddingWindows:       pHandler.HandleGetBiddingWindows,
+		ReplaceBiddingWindows:   pHandler.HandleReplaceBiddingWindows,
+		GetMarketSummary:        pHandler.HandleGetMarketSummary,
+		RevealBids:              pHandler.HandleRevealBids,
+		CreateAuction:           pHandler.HandleCreateAuction,
+		GetAuction:              pHandler.HandleGetAuction,
+		StartAuction:            pHandler.HandleStartAuction,
+		NominateAuctionLot:      pHandler.HandleNominateAuctionLot,
+		PlaceAuctionBid:         pHandler.HandlePlaceAuctionBid,
+		AuctionEvents:           pHandler.HandleAuctionEvents,
+		ListLedgerEntries:       pHandler.HandleListLedgerEntries,
+		CreateLedgerEntry:       pHandler.HandleCreateLedgerEntry,
+		VoidLedgerEntry:         pHandler.HandleVoidLedgerEntry,
+		GetSettlement:           pHandler.HandleGetSettlement,
+		SettlePool:              pHandler.HandleSettlePool,
+		ReopenSettlement:        pHandler.HandleReopenSettlement,
+		GetMyLedger:             pHandler.HandleGetMyLedger,
 	})
 
 	// Lab endpoints (lab.* schema) — returns 404 for unauthorized to hide existence
@@ -120,9 +149,17 @@ func (s *Server) registerAuthRoutes(r *mux.Router) {
 func (s *Server) registerBracketRoutes(r *mux.Router) {
 	// Bracket management
 	r.HandleFunc("/api/v1/tournaments/{tournamentId}/bracket", s.getBracketHandler).Methods("GET", "OPTIONS")
+	r.HandleFunc("/api/v1/tournaments/{tournamentId}/bracket/results", s.listGameResultsHandler).Methods("GET", "OPTIONS")
+	r.HandleFunc("/api/v1/tournaments/{tournamentId}/bracket/template", s.getBracketTemplateHandler).Methods("GET", "OPTIONS")
+	r.HandleFunc("/api/v1/tournaments/{tournamentId}/bracket/template", s.requirePermissionWithScope("tournament.game.write", "tournament", "tournamentId", s.updateBracketTemplateHandler)).Methods("PUT", "OPTIONS")
+	r.HandleFunc("/api/v1/tournaments/{tournamentId}/bracket/sites", s.listGameSitesHandler).Methods("GET", "OPTIONS")
+	r.HandleFunc("/api/v1/tournaments/{tournamentId}/bracket/sites", s.requirePermissionWithScope("tournament.game.write", "tournament", "tournamentId", s.replaceGameSitesHandler)).Methods("PUT", "OPTIONS")
 	r.HandleFunc("/api/v1/tournaments/{tournamentId}/bracket/validate", s.validateBracketSetupHandler).Methods("GET", "OPTIONS")
 	r.HandleFunc("/api/v1/tournaments/{tournamentId}/bracket/games/{gameId}/winner", s.requirePermissionWithScope("tournament.game.write", "tournament", "tournamentId", s.selectWinnerHandler)).Methods("POST", "OPTIONS")
 	r.HandleFunc("/api/v1/tournaments/{tournamentId}/bracket/games/{gameId}/winner", s.requirePermissionWithScope("tournament.game.write", "tournament", "tournamentId", s.unselectWinnerHandler)).Methods("DELETE", "OPTIONS")
+	r.HandleFunc("/api/v1/tournaments/{tournamentId}/bracket/live", s.listLiveGamesHandler).Methods("GET", "OPTIONS")
+	r.HandleFunc("/api/v1/tournaments/{tournamentId}/bracket/games/{gameId}/live", s.requirePermissionWithScope("tournament.game.write", "tournament", "tournamentId", s.updateLiveGameHandler)).Methods("PUT", "OPTIONS")
+	r.HandleFunc("/api/v1/tournaments/{tournamentId}/bracket/games/{gameId}/live", s.requirePermissionWithScope("tournament.game.write", "tournament", "tournamentId", s.clearLiveGameHandler)).Methods("DELETE", "OPTIONS")
 }
 
 func (s *Server) registerTournamentModeratorRoutes(r *mux.Router) {
@@ -10,6 +10,7 @@ import (
 	dbadapters "github.com/andrewcopp/Calcutta/backend/internal/adapters/db"
 	"github.com/andrewcopp/Calcutta/backend/internal/app"
 	appbootstrap "github.com/andrewcopp/Calcutta/backend/internal/app/bootstrap"
+	"github.com/andrewcopp/Calcutta/backend/internal/app/jobqueue"
 	"github.com/andrewcopp/Calcutta/backend/internal/auth"
 	"github.com/andrewcopp/Calcutta/backend/internal/platform"
 	"github.com/andrewcopp/Calcutta/backend/internal/ports"
@@ -24,6 +25,7 @@ type Server struct {
 	userRepo        *dbadapters.UserRepository
 	apiKeysRepo     *dbadapters.APIKeysRepository
 	idempotencyRepo *dbadapters.IdempotencyRepository
+	enqueuer        *jobqueue.Enqueuer
 	pool            *pgxpool.Pool
 	cfg             platform.Config
 	emailSender     platform.EmailSender
@@ -97,6 +99,7 @@ func NewServer(pool *pgxpool.Pool, cfg platform.Config) (*Server, error) {
 		userRepo:        userRepo,
 		apiKeysRepo:     apiKeysRepo,
 		idempotencyRepo: idempotencyRepo,
+		enqueuer:        jobqueue.NewEnqueuer(pool),
 		pool:            pool,
 		cfg:             cfg,
 		emailSender:     emailSender,
@@ -34,11 +34,13 @@ func (h *Handler) HandleUpdateKenPomStats(w http.ResponseWriter, r *http.Request
 	inputs := make([]apptournament.KenPomUpdateInput, 0, len(req.Stats))
 	for _, s := range req.Stats {
 		inputs = append(inputs, apptournament.KenPomUpdateInput{
-			TeamID: s.TeamID,
-			NetRtg: s.NetRtg,
-			ORtg:   s.ORtg,
-			DRtg:   s.DRtg,
-			AdjT:   s.AdjT,
+			TeamID:       s.TeamID,
+			NetRtg:       s.NetRtg,
+			ORtg:         s.ORtg,
+			DRtg:         s.DRtg,
+			AdjT:         s.AdjT,
+			SeasonWins:   s.SeasonWins,
+			SeasonLosses: s.SeasonLosses,
 		})
 	}
 
@@ -55,6 +55,37 @@ func (h *Handler) HandleGetPredictions(w http.ResponseWriter, r *http.Request) {
 	response.WriteJSON(w, http.StatusOK, dtos.NewTournamentPredictionsResponse(tournamentID, batchID, throughRound, teamValues, teams))
 }
 
+func (h *Handler) HandleListPredictedMatchups(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	tournamentID := vars["tournamentId"]
+	if tournamentID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Tournament ID is required", "tournamentId")
+		return
+	}
+
+	batchID := r.URL.Query().Get("batchId")
+	if batchID == "" {
+		latestID, found, err := h.app.Prediction.GetLatestBatchID(r.Context(), tournamentID)
+		if err != nil {
+			httperr.WriteFromErr(w, r, err, h.authUserID)
+			return
+		}
+		if !found {
+			httperr.Write(w, r, http.StatusNotFound, "not_found", "No predictions found for this tournament", "")
+			return
+		}
+		batchID = latestID
+	}
+
+	matchups, err := h.app.Prediction.GetMatchups(r.Context(), batchID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, map[string]any{"batchId": batchID, "items": dtos.NewPredictedMatchupListResponse(matchups)})
+}
+
 func (h *Handler) HandleListPredictionBatches(w http.ResponseWriter, r *http.Request) {
 	vars := mux.Vars(r)
 	tournamentID := vars["tournamentId"]
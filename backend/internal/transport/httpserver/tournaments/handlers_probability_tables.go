@@ -0,0 +1,87 @@
+package tournaments
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/andrewcopp/Calcutta/backend/internal/app/prediction"
+	"github.com/andrewcopp/Calcutta/backend/internal/bundles"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/dtos"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/httperr"
+	"github.com/andrewcopp/Calcutta/backend/internal/transport/httpserver/response"
+	"github.com/gorilla/mux"
+)
+
+// HandleUploadProbabilityTable accepts a matchup probability bundle and stores
+// it under the bundle's source key.
+func (h *Handler) HandleUploadProbabilityTable(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	tournamentID := vars["tournamentId"]
+	if tournamentID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Tournament ID is required", "tournamentId")
+		return
+	}
+
+	var bundle bundles.MatchupProbabilityBundle
+	if err := json.NewDecoder(r.Body).Decode(&bundle); err != nil {
+		httperr.Write(w, r, http.StatusBadRequest, "invalid_request", "Invalid request body", "")
+		return
+	}
+
+	matchups := make([]prediction.MatchupProbabilityInput, 0, len(bundle.Matchups))
+	for _, m := range bundle.Matchups {
+		matchups = append(matchups, prediction.MatchupProbabilityInput{
+			Team1Slug:  m.Team1SchoolSlug,
+			Team2Slug:  m.Team2SchoolSlug,
+			PTeam1Wins: m.PTeam1Wins,
+		})
+	}
+
+	table, err := h.app.Prediction.UploadProbabilityTable(r.Context(), prediction.ProbabilityTableUpload{
+		TournamentID: tournamentID,
+		SourceKey:    bundle.SourceKey,
+		Description:  bundle.Description,
+		UploadedBy:   h.authUserID(r.Context()),
+		Matchups:     matchups,
+	})
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, dtos.NewProbabilityTableResponse(table))
+}
+
+func (h *Handler) HandleListProbabilityTables(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	tournamentID := vars["tournamentId"]
+	if tournamentID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Tournament ID is required", "tournamentId")
+		return
+	}
+
+	tables, err := h.app.Prediction.ListProbabilityTables(r.Context(), tournamentID)
+	if err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	response.WriteJSON(w, http.StatusOK, map[string]any{"items": dtos.NewProbabilityTableListResponse(tables)})
+}
+
+func (h *Handler) HandleDeleteProbabilityTable(w http.ResponseWriter, r *http.Request) {
+	vars := mux.Vars(r)
+	tournamentID := vars["tournamentId"]
+	sourceKey := vars["sourceKey"]
+	if tournamentID == "" {
+		httperr.Write(w, r, http.StatusBadRequest, "validation_error", "Tournament ID is required", "tournamentId")
+		return
+	}
+
+	if err := h.app.Prediction.DeleteProbabilityTable(r.Context(), tournamentID, sourceKey); err != nil {
+		httperr.WriteFromErr(w, r, err, h.authUserID)
+		return
+	}
+
+	w.WriteHeader(http.StatusNoContent)
+}
@@ -20,6 +20,10 @@ type Handlers struct {
 	UpdateKenPomStats    http.HandlerFunc
 	GetPredictions           http.HandlerFunc
 	ListPredictionBatches    http.HandlerFunc
+	ListPredictedMatchups    http.HandlerFunc
+	ListProbabilityTables    http.HandlerFunc
+	UploadProbabilityTable   http.HandlerFunc
+	DeleteProbabilityTable   http.HandlerFunc
 }
 
 func RegisterRoutes(r *mux.Router, h Handlers) {
@@ -33,7 +37,11 @@ func RegisterRoutes(r *mux.Router, h Handlers) {
 	r.HandleFunc("/api/v1/tournaments/{tournamentId}/teams/{teamId}", h.UpdateTeam).Methods("PATCH", "OPTIONS")
 	r.HandleFunc("/api/v1/tournaments/{tournamentId}/kenpom", h.UpdateKenPomStats).Methods("PUT")
 	r.HandleFunc("/api/v1/tournaments/{tournamentId}/predictions", h.GetPredictions).Methods("GET")
+	r.HandleFunc("/api/v1/tournaments/{tournamentId}/predictions/matchups", h.ListPredictedMatchups).Methods("GET")
 	r.HandleFunc("/api/v1/tournaments/{tournamentId}/prediction-batches", h.ListPredictionBatches).Methods("GET")
+	r.HandleFunc("/api/v1/tournaments/{tournamentId}/probability-tables", h.ListProbabilityTables).Methods("GET")
+	r.HandleFunc("/api/v1/tournaments/{tournamentId}/probability-tables", h.UploadProbabilityTable).Methods("POST")
+	r.HandleFunc("/api/v1/tournaments/{tournamentId}/probability-tables/{sourceKey}", h.DeleteProbabilityTable).Methods("DELETE")
 	r.HandleFunc("/api/v1/competitions", h.ListCompetitions).Methods("GET")
 	r.HandleFunc("/api/v1/seasons", h.ListSeasons).Methods("GET")
 }
@@ -0,0 +1,18 @@
+-- Rollback: create_game_results
+-- Created: 2026-02-28 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+DROP INDEX IF EXISTS core.uq_core_game_results_tournament_game;
+ALTER TABLE IF EXISTS core.game_results
+    DROP CONSTRAINT IF EXISTS game_results_entered_by_fkey;
+ALTER TABLE IF EXISTS core.game_results
+    DROP CONSTRAINT IF EXISTS game_results_loser_team_id_fkey;
+ALTER TABLE IF EXISTS core.game_results
+    DROP CONSTRAINT IF EXISTS game_results_winner_team_id_fkey;
+ALTER TABLE IF EXISTS core.game_results
+    DROP CONSTRAINT IF EXISTS game_results_tournament_id_fkey;
+DROP TRIGGER IF EXISTS trg_core_game_results_updated_at ON core.game_results;
+DROP TABLE IF EXISTS core.game_results;
@@ -0,0 +1,53 @@
+-- Migration: create_game_results
+-- Created: 2026-02-28 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- One row per decided bracket game. This is the source of truth for results;
+-- core.teams.wins / is_eliminated are derived from it.
+CREATE TABLE IF NOT EXISTS core.game_results (
+    id UUID PRIMARY KEY DEFAULT public.uuid_generate_v4(),
+    tournament_id UUID NOT NULL,
+    game_id TEXT NOT NULL,
+    winner_team_id UUID NOT NULL,
+    loser_team_id UUID NOT NULL,
+    winner_score INTEGER,
+    loser_score INTEGER,
+    decided_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    entered_by UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT ck_core_game_results_distinct_teams CHECK (winner_team_id <> loser_team_id),
+    CONSTRAINT ck_core_game_results_scores_nonneg CHECK (
+        (winner_score IS NULL OR winner_score >= 0) AND (loser_score IS NULL OR loser_score >= 0)
+    )
+);
+
+-- updated_at trigger
+CREATE TRIGGER trg_core_game_results_updated_at
+    BEFORE UPDATE ON core.game_results
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+-- Foreign key constraints
+ALTER TABLE core.game_results
+    ADD CONSTRAINT game_results_tournament_id_fkey
+    FOREIGN KEY (tournament_id) REFERENCES core.tournaments(id);
+
+ALTER TABLE core.game_results
+    ADD CONSTRAINT game_results_winner_team_id_fkey
+    FOREIGN KEY (winner_team_id) REFERENCES core.teams(id);
+
+ALTER TABLE core.game_results
+    ADD CONSTRAINT game_results_loser_team_id_fkey
+    FOREIGN KEY (loser_team_id) REFERENCES core.teams(id);
+
+ALTER TABLE core.game_results
+    ADD CONSTRAINT game_results_entered_by_fkey
+    FOREIGN KEY (entered_by) REFERENCES core.users(id);
+
+-- Indexes
+CREATE UNIQUE INDEX IF NOT EXISTS uq_core_game_results_tournament_game
+    ON core.game_results(tournament_id, game_id) WHERE (deleted_at IS NULL);
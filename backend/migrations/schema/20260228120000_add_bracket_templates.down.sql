@@ -0,0 +1,14 @@
+-- Rollback: add_bracket_templates
+-- Created: 2026-02-28 12:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+ALTER TABLE core.teams
+    DROP CONSTRAINT IF EXISTS chk_teams_byes_range;
+ALTER TABLE core.teams
+    ADD CONSTRAINT chk_teams_byes_range CHECK ((byes >= 0) AND (byes <= 1));
+
+ALTER TABLE core.tournaments
+    DROP COLUMN IF EXISTS bracket_template;
@@ -0,0 +1,18 @@
+-- Migration: add_bracket_templates
+-- Created: 2026-02-28 12:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Declarative bracket shape per tournament. NULL means the built-in 68-team
+-- NCAA layout derived from the final_four_* columns.
+ALTER TABLE core.tournaments
+    ADD COLUMN IF NOT EXISTS bracket_template JSONB;
+
+-- Templates can seed teams into later rounds (e.g. conference tournaments
+-- where top seeds skip the opening rounds), so byes may exceed one.
+ALTER TABLE core.teams
+    DROP CONSTRAINT IF EXISTS chk_teams_byes_range;
+ALTER TABLE core.teams
+    ADD CONSTRAINT chk_teams_byes_range CHECK ((byes >= 0) AND (byes <= 6));
@@ -0,0 +1,13 @@
+-- Rollback: add_team_season_records
+-- Created: 2026-03-01 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+ALTER TABLE core.team_kenpom_stats
+    DROP CONSTRAINT IF EXISTS chk_team_kenpom_stats_season_record_nonneg;
+
+ALTER TABLE core.team_kenpom_stats
+    DROP COLUMN IF EXISTS season_losses,
+    DROP COLUMN IF EXISTS season_wins;
@@ -0,0 +1,16 @@
+-- Migration: add_team_season_records
+-- Created: 2026-03-01 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Regular-season record alongside the KenPom ratings, read by the log5
+-- win-probability model. NULL means the record is unknown.
+ALTER TABLE core.team_kenpom_stats
+    ADD COLUMN IF NOT EXISTS season_wins INTEGER,
+    ADD COLUMN IF NOT EXISTS season_losses INTEGER;
+
+ALTER TABLE core.team_kenpom_stats
+    ADD CONSTRAINT chk_team_kenpom_stats_season_record_nonneg
+    CHECK ((season_wins IS NULL OR season_wins >= 0) AND (season_losses IS NULL OR season_losses >= 0));
@@ -0,0 +1,11 @@
+-- Rollback: create_matchup_probability_tables
+-- Created: 2026-03-02 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+DROP TABLE IF EXISTS core.matchup_probabilities;
+DROP INDEX IF EXISTS core.uq_core_matchup_probability_tables_tournament_source;
+DROP TRIGGER IF EXISTS trg_core_matchup_probability_tables_updated_at ON core.matchup_probability_tables;
+DROP TABLE IF EXISTS core.matchup_probability_tables;
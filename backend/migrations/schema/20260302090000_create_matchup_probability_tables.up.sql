@@ -0,0 +1,60 @@
+-- Migration: create_matchup_probability_tables
+-- Created: 2026-03-02 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Externally supplied pairwise win probabilities, addressed by the
+-- probability_source_key that prediction and simulation runs select.
+CREATE TABLE IF NOT EXISTS core.matchup_probability_tables (
+    id UUID PRIMARY KEY DEFAULT public.uuid_generate_v4(),
+    tournament_id UUID NOT NULL,
+    source_key TEXT NOT NULL,
+    description TEXT,
+    uploaded_by UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+-- One row per ordered team pair; both orientations are stored.
+CREATE TABLE IF NOT EXISTS core.matchup_probabilities (
+    table_id UUID NOT NULL,
+    team1_id UUID NOT NULL,
+    team2_id UUID NOT NULL,
+    p_team1_wins DOUBLE PRECISION NOT NULL,
+    PRIMARY KEY (table_id, team1_id, team2_id),
+    CONSTRAINT ck_core_matchup_probabilities_distinct_teams CHECK (team1_id <> team2_id),
+    CONSTRAINT ck_core_matchup_probabilities_range CHECK (p_team1_wins >= 0 AND p_team1_wins <= 1)
+);
+
+-- updated_at trigger
+CREATE TRIGGER trg_core_matchup_probability_tables_updated_at
+    BEFORE UPDATE ON core.matchup_probability_tables
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+-- Foreign key constraints
+ALTER TABLE core.matchup_probability_tables
+    ADD CONSTRAINT matchup_probability_tables_tournament_id_fkey
+    FOREIGN KEY (tournament_id) REFERENCES core.tournaments(id);
+
+ALTER TABLE core.matchup_probability_tables
+    ADD CONSTRAINT matchup_probability_tables_uploaded_by_fkey
+    FOREIGN KEY (uploaded_by) REFERENCES core.users(id);
+
+ALTER TABLE core.matchup_probabilities
+    ADD CONSTRAINT matchup_probabilities_table_id_fkey
+    FOREIGN KEY (table_id) REFERENCES core.matchup_probability_tables(id) ON DELETE CASCADE;
+
+ALTER TABLE core.matchup_probabilities
+    ADD CONSTRAINT matchup_probabilities_team1_id_fkey
+    FOREIGN KEY (team1_id) REFERENCES core.teams(id);
+
+ALTER TABLE core.matchup_probabilities
+    ADD CONSTRAINT matchup_probabilities_team2_id_fkey
+    FOREIGN KEY (team2_id) REFERENCES core.teams(id);
+
+-- Indexes
+CREATE UNIQUE INDEX IF NOT EXISTS uq_core_matchup_probability_tables_tournament_source
+    ON core.matchup_probability_tables(tournament_id, source_key) WHERE (deleted_at IS NULL);
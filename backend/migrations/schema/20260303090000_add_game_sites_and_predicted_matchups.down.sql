@@ -0,0 +1,16 @@
+-- Rollback: add_game_sites_and_predicted_matchups
+-- Created: 2026-03-03 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+DROP TABLE IF EXISTS compute.predicted_matchups;
+DROP TABLE IF EXISTS core.game_sites;
+
+ALTER TABLE core.schools
+    DROP CONSTRAINT IF EXISTS ck_core_schools_location;
+
+ALTER TABLE core.schools
+    DROP COLUMN IF EXISTS longitude,
+    DROP COLUMN IF EXISTS latitude;
@@ -0,0 +1,75 @@
+-- Migration: add_game_sites_and_predicted_matchups
+-- Created: 2026-03-03 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Campus location, read by the tempo win-probability model's home-court
+-- adjustment. NULL means unknown.
+ALTER TABLE core.schools
+    ADD COLUMN IF NOT EXISTS latitude DOUBLE PRECISION,
+    ADD COLUMN IF NOT EXISTS longitude DOUBLE PRECISION;
+
+ALTER TABLE core.schools
+    ADD CONSTRAINT ck_core_schools_location CHECK (
+        (latitude IS NULL AND longitude IS NULL)
+        OR (latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180)
+    );
+
+-- Where each bracket game is played. Games without a row are neutral.
+CREATE TABLE IF NOT EXISTS core.game_sites (
+    tournament_id UUID NOT NULL,
+    game_id TEXT NOT NULL,
+    name TEXT NOT NULL,
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tournament_id, game_id),
+    CONSTRAINT ck_core_game_sites_location CHECK (
+        latitude BETWEEN -90 AND 90 AND longitude BETWEEN -180 AND 180
+    )
+);
+
+-- Every matchup a prediction batch priced, with the projected spread when the
+-- model produces one.
+CREATE TABLE IF NOT EXISTS compute.predicted_matchups (
+    prediction_batch_id UUID NOT NULL,
+    game_id TEXT NOT NULL,
+    round_order INTEGER NOT NULL,
+    team1_id UUID NOT NULL,
+    team2_id UUID NOT NULL,
+    p_matchup DOUBLE PRECISION NOT NULL,
+    p_team1_wins DOUBLE PRECISION NOT NULL,
+    projected_spread DOUBLE PRECISION,
+    spread_sd DOUBLE PRECISION,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (prediction_batch_id, game_id, team1_id, team2_id),
+    CONSTRAINT ck_compute_predicted_matchups_probabilities CHECK (
+        p_matchup >= 0 AND p_matchup <= 1 AND p_team1_wins >= 0 AND p_team1_wins <= 1
+    ),
+    CONSTRAINT ck_compute_predicted_matchups_spread_sd CHECK (spread_sd IS NULL OR spread_sd > 0)
+);
+
+-- updated_at trigger
+CREATE TRIGGER trg_core_game_sites_updated_at
+    BEFORE UPDATE ON core.game_sites
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+-- Foreign key constraints
+ALTER TABLE core.game_sites
+    ADD CONSTRAINT game_sites_tournament_id_fkey
+    FOREIGN KEY (tournament_id) REFERENCES core.tournaments(id);
+
+ALTER TABLE compute.predicted_matchups
+    ADD CONSTRAINT predicted_matchups_prediction_batch_id_fkey
+    FOREIGN KEY (prediction_batch_id) REFERENCES compute.prediction_batches(id) ON DELETE CASCADE;
+
+ALTER TABLE compute.predicted_matchups
+    ADD CONSTRAINT predicted_matchups_team1_id_fkey
+    FOREIGN KEY (team1_id) REFERENCES core.teams(id);
+
+ALTER TABLE compute.predicted_matchups
+    ADD CONSTRAINT predicted_matchups_team2_id_fkey
+    FOREIGN KEY (team2_id) REFERENCES core.teams(id);
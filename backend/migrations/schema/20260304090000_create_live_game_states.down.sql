@@ -0,0 +1,8 @@
+-- Rollback: create_live_game_states
+-- Created: 2026-03-04 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+DROP TABLE IF EXISTS core.live_game_states;
@@ -0,0 +1,46 @@
+-- Migration: create_live_game_states
+-- Created: 2026-03-04 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Latest reported state of each bracket game in progress. A row is removed
+-- when the game's result is recorded.
+CREATE TABLE IF NOT EXISTS core.live_game_states (
+    tournament_id UUID NOT NULL,
+    game_id TEXT NOT NULL,
+    team1_id UUID NOT NULL,
+    team2_id UUID NOT NULL,
+    team1_score INTEGER NOT NULL,
+    team2_score INTEGER NOT NULL,
+    seconds_remaining INTEGER NOT NULL,
+    possession_team_id UUID,
+    source TEXT NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    PRIMARY KEY (tournament_id, game_id),
+    CONSTRAINT ck_core_live_game_states_scores CHECK (team1_score >= 0 AND team2_score >= 0),
+    CONSTRAINT ck_core_live_game_states_seconds_remaining CHECK (seconds_remaining >= 0),
+    CONSTRAINT ck_core_live_game_states_possession CHECK (
+        possession_team_id IS NULL OR possession_team_id IN (team1_id, team2_id)
+    )
+);
+
+-- updated_at trigger
+CREATE TRIGGER trg_core_live_game_states_updated_at
+    BEFORE UPDATE ON core.live_game_states
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+-- Foreign key constraints
+ALTER TABLE core.live_game_states
+    ADD CONSTRAINT live_game_states_tournament_id_fkey
+    FOREIGN KEY (tournament_id) REFERENCES core.tournaments(id);
+
+ALTER TABLE core.live_game_states
+    ADD CONSTRAINT live_game_states_team1_id_fkey
+    FOREIGN KEY (team1_id) REFERENCES core.teams(id);
+
+ALTER TABLE core.live_game_states
+    ADD CONSTRAINT live_game_states_team2_id_fkey
+    FOREIGN KEY (team2_id) REFERENCES core.teams(id);
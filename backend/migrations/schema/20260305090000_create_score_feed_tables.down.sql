@@ -0,0 +1,9 @@
+-- Rollback: create_score_feed_tables
+-- Created: 2026-03-05 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+DROP TABLE IF EXISTS core.score_feed_reviews;
+DROP TABLE IF EXISTS core.school_name_mappings;
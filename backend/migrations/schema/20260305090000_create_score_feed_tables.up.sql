@@ -0,0 +1,75 @@
+-- Migration: create_score_feed_tables
+-- Created: 2026-03-05 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Maps a results feed's team names to schools. name_key is the normalized
+-- name that incoming feed names are matched on.
+CREATE TABLE IF NOT EXISTS core.school_name_mappings (
+    id UUID NOT NULL DEFAULT public.uuid_generate_v4() PRIMARY KEY,
+    source TEXT NOT NULL,
+    external_name TEXT NOT NULL,
+    name_key TEXT NOT NULL,
+    school_id UUID NOT NULL,
+    created_by UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT ck_core_school_name_mappings_name_key CHECK (name_key <> '')
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_core_school_name_mappings_source_name_key
+    ON core.school_name_mappings (source, name_key)
+    WHERE deleted_at IS NULL;
+
+-- Feed team names that matched no school or more than one. At most one
+-- pending review exists per source and name.
+CREATE TABLE IF NOT EXISTS core.score_feed_reviews (
+    id UUID NOT NULL DEFAULT public.uuid_generate_v4() PRIMARY KEY,
+    source TEXT NOT NULL,
+    external_name TEXT NOT NULL,
+    name_key TEXT NOT NULL,
+    candidate_school_ids UUID[] NOT NULL DEFAULT '{}',
+    status TEXT NOT NULL DEFAULT 'pending',
+    resolved_school_id UUID,
+    resolved_by UUID,
+    resolved_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT ck_core_score_feed_reviews_status CHECK (status IN ('pending', 'resolved', 'dismissed')),
+    CONSTRAINT ck_core_score_feed_reviews_resolution CHECK (
+        (status = 'resolved') = (resolved_school_id IS NOT NULL)
+    )
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_core_score_feed_reviews_pending
+    ON core.score_feed_reviews (source, name_key)
+    WHERE status = 'pending';
+
+-- updated_at triggers
+CREATE TRIGGER trg_core_school_name_mappings_updated_at
+    BEFORE UPDATE ON core.school_name_mappings
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+CREATE TRIGGER trg_core_score_feed_reviews_updated_at
+    BEFORE UPDATE ON core.score_feed_reviews
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+-- Foreign key constraints
+ALTER TABLE core.school_name_mappings
+    ADD CONSTRAINT school_name_mappings_school_id_fkey
+    FOREIGN KEY (school_id) REFERENCES core.schools(id);
+
+ALTER TABLE core.school_name_mappings
+    ADD CONSTRAINT school_name_mappings_created_by_fkey
+    FOREIGN KEY (created_by) REFERENCES core.users(id);
+
+ALTER TABLE core.score_feed_reviews
+    ADD CONSTRAINT score_feed_reviews_resolved_school_id_fkey
+    FOREIGN KEY (resolved_school_id) REFERENCES core.schools(id);
+
+ALTER TABLE core.score_feed_reviews
+    ADD CONSTRAINT score_feed_reviews_resolved_by_fkey
+    FOREIGN KEY (resolved_by) REFERENCES core.users(id);
@@ -0,0 +1,10 @@
+-- Rollback: create_auction_tables
+-- Created: 2026-03-06 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+DROP TABLE IF EXISTS core.auction_bids;
+DROP TABLE IF EXISTS core.auction_lots;
+DROP TABLE IF EXISTS core.auction_sessions;
@@ -0,0 +1,119 @@
+-- Migration: create_auction_tables
+-- Created: 2026-03-06 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Live ascending auction for a pool. A pool with a session takes its
+-- investments from the auction instead of sealed bids.
+CREATE TABLE IF NOT EXISTS core.auction_sessions (
+    id UUID NOT NULL DEFAULT public.uuid_generate_v4() PRIMARY KEY,
+    pool_id UUID NOT NULL,
+    status TEXT NOT NULL DEFAULT 'pending',
+    nomination_order UUID[] NOT NULL,
+    nomination_index INTEGER NOT NULL DEFAULT 0,
+    lot_seconds INTEGER NOT NULL,
+    bid_extension_seconds INTEGER NOT NULL,
+    min_increment_credits INTEGER NOT NULL,
+    created_by UUID,
+    started_at TIMESTAMPTZ,
+    completed_at TIMESTAMPTZ,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT ck_core_auction_sessions_status CHECK (status IN ('pending', 'open', 'completed')),
+    CONSTRAINT ck_core_auction_sessions_nomination_order CHECK (cardinality(nomination_order) > 0),
+    CONSTRAINT ck_core_auction_sessions_nomination_index CHECK (nomination_index >= 0),
+    CONSTRAINT ck_core_auction_sessions_lot_seconds CHECK (lot_seconds > 0),
+    CONSTRAINT ck_core_auction_sessions_bid_extension_seconds CHECK (bid_extension_seconds >= 0),
+    CONSTRAINT ck_core_auction_sessions_min_increment_credits CHECK (min_increment_credits > 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_core_auction_sessions_pool
+    ON core.auction_sessions (pool_id)
+    WHERE deleted_at IS NULL;
+
+-- One team put up for auction. Sold lots record the investment written for
+-- the winner.
+CREATE TABLE IF NOT EXISTS core.auction_lots (
+    id UUID NOT NULL DEFAULT public.uuid_generate_v4() PRIMARY KEY,
+    session_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    nominated_by_portfolio_id UUID NOT NULL,
+    status TEXT NOT NULL DEFAULT 'open',
+    high_bid_credits INTEGER NOT NULL,
+    high_bid_portfolio_id UUID NOT NULL,
+    closes_at TIMESTAMPTZ NOT NULL,
+    sold_at TIMESTAMPTZ,
+    investment_id UUID,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT ck_core_auction_lots_status CHECK (status IN ('open', 'sold')),
+    CONSTRAINT ck_core_auction_lots_high_bid_credits CHECK (high_bid_credits > 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_core_auction_lots_session_team
+    ON core.auction_lots (session_id, team_id);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_core_auction_lots_session_open
+    ON core.auction_lots (session_id)
+    WHERE status = 'open';
+
+CREATE TABLE IF NOT EXISTS core.auction_bids (
+    id UUID NOT NULL DEFAULT public.uuid_generate_v4() PRIMARY KEY,
+    lot_id UUID NOT NULL,
+    portfolio_id UUID NOT NULL,
+    credits INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT ck_core_auction_bids_credits CHECK (credits > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_core_auction_bids_lot_id
+    ON core.auction_bids (lot_id);
+
+-- updated_at triggers
+CREATE TRIGGER trg_core_auction_sessions_updated_at
+    BEFORE UPDATE ON core.auction_sessions
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+CREATE TRIGGER trg_core_auction_lots_updated_at
+    BEFORE UPDATE ON core.auction_lots
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+-- Foreign key constraints
+ALTER TABLE core.auction_sessions
+    ADD CONSTRAINT auction_sessions_pool_id_fkey
+    FOREIGN KEY (pool_id) REFERENCES core.pools(id) ON DELETE CASCADE;
+
+ALTER TABLE core.auction_sessions
+    ADD CONSTRAINT auction_sessions_created_by_fkey
+    FOREIGN KEY (created_by) REFERENCES core.users(id);
+
+ALTER TABLE core.auction_lots
+    ADD CONSTRAINT auction_lots_session_id_fkey
+    FOREIGN KEY (session_id) REFERENCES core.auction_sessions(id) ON DELETE CASCADE;
+
+ALTER TABLE core.auction_lots
+    ADD CONSTRAINT auction_lots_team_id_fkey
+    FOREIGN KEY (team_id) REFERENCES core.teams(id);
+
+ALTER TABLE core.auction_lots
+    ADD CONSTRAINT auction_lots_nominated_by_portfolio_id_fkey
+    FOREIGN KEY (nominated_by_portfolio_id) REFERENCES core.portfolios(id) ON DELETE CASCADE;
+
+ALTER TABLE core.auction_lots
+    ADD CONSTRAINT auction_lots_high_bid_portfolio_id_fkey
+    FOREIGN KEY (high_bid_portfolio_id) REFERENCES core.portfolios(id) ON DELETE CASCADE;
+
+ALTER TABLE core.auction_lots
+    ADD CONSTRAINT auction_lots_investment_id_fkey
+    FOREIGN KEY (investment_id) REFERENCES core.investments(id) ON DELETE SET NULL;
+
+ALTER TABLE core.auction_bids
+    ADD CONSTRAINT auction_bids_lot_id_fkey
+    FOREIGN KEY (lot_id) REFERENCES core.auction_lots(id) ON DELETE CASCADE;
+
+ALTER TABLE core.auction_bids
+    ADD CONSTRAINT auction_bids_portfolio_id_fkey
+    FOREIGN KEY (portfolio_id) REFERENCES core.portfolios(id) ON DELETE CASCADE;
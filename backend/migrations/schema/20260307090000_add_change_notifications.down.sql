@@ -0,0 +1,12 @@
+-- Rollback: add_change_notifications
+-- Created: 2026-03-07 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+DROP TRIGGER IF EXISTS trg_compute_prediction_batches_notify ON compute.prediction_batches;
+DROP TRIGGER IF EXISTS trg_core_teams_notify ON core.teams;
+DROP TRIGGER IF EXISTS trg_core_game_results_notify ON core.game_results;
+DROP FUNCTION IF EXISTS compute.notify_prediction_batch();
+DROP FUNCTION IF EXISTS core.notify_tournament_change();
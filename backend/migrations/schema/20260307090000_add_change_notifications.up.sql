@@ -0,0 +1,49 @@
+-- Migration: add_change_notifications
+-- Created: 2026-03-07 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Live pool dashboards listen on the calcutta_changes channel. Postgres
+-- delivers notifications at commit and drops duplicates within a
+-- transaction, so a bracket update touching many rows notifies once.
+
+CREATE OR REPLACE FUNCTION core.notify_tournament_change() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    PERFORM pg_notify('calcutta_changes', json_build_object(
+        'kind', TG_ARGV[0],
+        'tournamentId', NEW.tournament_id
+    )::text);
+    RETURN NULL;
+END;
+$$;
+
+CREATE OR REPLACE FUNCTION compute.notify_prediction_batch() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+BEGIN
+    PERFORM pg_notify('calcutta_changes', json_build_object(
+        'kind', 'predictions',
+        'tournamentId', NEW.tournament_id,
+        'detail', NEW.id
+    )::text);
+    RETURN NULL;
+END;
+$$;
+
+CREATE TRIGGER trg_core_game_results_notify
+    AFTER INSERT OR UPDATE ON core.game_results
+    FOR EACH ROW EXECUTE FUNCTION core.notify_tournament_change('standings');
+
+CREATE TRIGGER trg_core_teams_notify
+    AFTER UPDATE OF wins, is_eliminated ON core.teams
+    FOR EACH ROW
+    WHEN (OLD.wins IS DISTINCT FROM NEW.wins OR OLD.is_eliminated IS DISTINCT FROM NEW.is_eliminated)
+    EXECUTE FUNCTION core.notify_tournament_change('standings');
+
+CREATE TRIGGER trg_compute_prediction_batches_notify
+    AFTER INSERT ON compute.prediction_batches
+    FOR EACH ROW EXECUTE FUNCTION compute.notify_prediction_batch();
@@ -0,0 +1,91 @@
+-- Rollback: add_pool_ownership_mode
+-- Created: 2026-03-08 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+CREATE OR REPLACE VIEW derived.ownership_details AS
+ WITH portfolio_investments AS (
+         SELECT p.id AS portfolio_id,
+            p.pool_id,
+            inv.team_id,
+            (inv.credits)::double precision AS credits,
+            inv.created_at AS investment_created_at,
+            inv.updated_at AS investment_updated_at,
+            sum((inv.credits)::double precision) OVER (PARTITION BY p.pool_id, inv.team_id) AS team_total_credits,
+            tt.school_id,
+            tt.tournament_id,
+            tt.seed,
+            tt.region,
+            tt.byes,
+            tt.wins,
+            tt.is_eliminated,
+            tt.created_at AS team_created_at,
+            tt.updated_at AS team_updated_at,
+            t.rounds AS tournament_rounds,
+            s.name AS school_name,
+            GREATEST(p.updated_at, inv.updated_at, tt.updated_at) AS derived_updated_at
+           FROM ((((core.portfolios p
+             JOIN core.investments inv ON (((inv.portfolio_id = p.id) AND (inv.deleted_at IS NULL))))
+             JOIN core.teams tt ON (((tt.id = inv.team_id) AND (tt.deleted_at IS NULL))))
+             JOIN core.tournaments t ON (((t.id = tt.tournament_id) AND (t.deleted_at IS NULL))))
+             LEFT JOIN core.schools s ON (((s.id = tt.school_id) AND (s.deleted_at IS NULL))))
+          WHERE (p.deleted_at IS NULL)
+        ), investment_returns AS (
+         SELECT pi.portfolio_id,
+            pi.pool_id,
+            pi.team_id,
+            pi.credits,
+            pi.team_total_credits,
+                CASE
+                    WHEN (pi.team_total_credits > (0)::double precision) THEN (pi.credits / pi.team_total_credits)
+                    ELSE (0)::double precision
+                END AS ownership_percentage,
+            (
+                CASE
+                    WHEN (pi.team_total_credits > (0)::double precision) THEN (pi.credits / pi.team_total_credits)
+                    ELSE (0)::double precision
+                END * (core.pool_returns_for_progress(pi.pool_id, pi.wins, pi.byes))::double precision) AS actual_returns,
+            (
+                CASE
+                    WHEN (pi.team_total_credits > (0)::double precision) THEN (pi.credits / pi.team_total_credits)
+                    ELSE (0)::double precision
+                END *
+                CASE
+                    WHEN (pi.is_eliminated = true) THEN (core.pool_returns_for_progress(pi.pool_id, pi.wins, pi.byes))::double precision
+                    ELSE (core.pool_returns_for_progress(pi.pool_id, pi.tournament_rounds, 0))::double precision
+                END) AS expected_returns,
+            pi.school_id,
+            pi.tournament_id,
+            pi.seed,
+            pi.region,
+            pi.byes,
+            pi.wins,
+            pi.is_eliminated,
+            pi.team_created_at,
+            pi.team_updated_at,
+            pi.school_name,
+            pi.investment_created_at AS created_at,
+            pi.derived_updated_at AS updated_at,
+            NULL::timestamp with time zone AS deleted_at
+           FROM portfolio_investments pi
+        )
+ SELECT concat(portfolio_id, '-', team_id) AS id,
+    portfolio_id,
+    team_id,
+    ownership_percentage,
+    actual_returns,
+    expected_returns,
+    created_at,
+    updated_at,
+    deleted_at
+   FROM investment_returns;
+
+DROP VIEW IF EXISTS derived.investment_shares;
+
+ALTER TABLE core.pools DROP CONSTRAINT IF EXISTS ck_core_pools_ownership_cap_percent;
+ALTER TABLE core.pools DROP CONSTRAINT IF EXISTS ck_core_pools_ownership_mode;
+ALTER TABLE core.pools
+    DROP COLUMN IF EXISTS ownership_cap_percent,
+    DROP COLUMN IF EXISTS ownership_mode;
@@ -0,0 +1,121 @@
+-- Migration: add_pool_ownership_mode
+-- Created: 2026-03-08 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- How a team's points are split among the portfolios that bid on it:
+--   proportional     each owner's share is its bid over the team's total bids
+--   winner_take_all  the top bid owns the team; tied top bids split it evenly
+--   capped_share     proportional, but no owner holds more than the cap; the
+--                    excess goes to the other owners in proportion to their
+--                    bids, and is left unowned once every owner is capped
+ALTER TABLE core.pools
+    ADD COLUMN ownership_mode TEXT NOT NULL DEFAULT 'proportional',
+    ADD COLUMN ownership_cap_percent INTEGER;
+
+ALTER TABLE core.pools
+    ADD CONSTRAINT ck_core_pools_ownership_mode
+    CHECK (ownership_mode IN ('proportional', 'winner_take_all', 'capped_share'));
+
+ALTER TABLE core.pools
+    ADD CONSTRAINT ck_core_pools_ownership_cap_percent
+    CHECK (
+        (ownership_mode = 'capped_share' AND ownership_cap_percent BETWEEN 1 AND 100)
+        OR (ownership_mode <> 'capped_share' AND ownership_cap_percent IS NULL)
+    );
+
+-- Each investment's share of its team under the pool's ownership mode. For
+-- capped pools, owners are ranked by bid: an owner is capped when, with every
+-- larger bid capped, its share of what remains would still exceed the cap.
+-- The capped owners are always the largest bids, so one pass ranks them.
+CREATE VIEW derived.investment_shares AS
+ WITH ranked AS (
+         SELECT inv.id AS investment_id,
+            inv.portfolio_id,
+            p.pool_id,
+            inv.team_id,
+            (inv.credits)::double precision AS credits,
+            pl.ownership_mode,
+            ((pl.ownership_cap_percent)::double precision / (100)::double precision) AS cap,
+            sum((inv.credits)::double precision) OVER team AS team_total_credits,
+            max((inv.credits)::double precision) OVER team AS team_top_credits,
+            COALESCE(sum((inv.credits)::double precision) OVER (team ORDER BY inv.credits DESC, inv.portfolio_id ROWS BETWEEN UNBOUNDED PRECEDING AND 1 PRECEDING), (0)::double precision) AS credits_ahead,
+            ((row_number() OVER (team ORDER BY inv.credits DESC, inv.portfolio_id) - 1))::double precision AS owners_ahead
+           FROM ((core.investments inv
+             JOIN core.portfolios p ON (((p.id = inv.portfolio_id) AND (p.deleted_at IS NULL))))
+             JOIN core.pools pl ON (((pl.id = p.pool_id) AND (pl.deleted_at IS NULL))))
+          WHERE (inv.deleted_at IS NULL)
+          WINDOW team AS (PARTITION BY p.pool_id, inv.team_id)
+        ), flagged AS (
+         SELECT r.*,
+            ((r.ownership_mode = 'capped_share') AND ((((1)::double precision - (r.owners_ahead * r.cap)) * r.credits) > (r.cap * (r.team_total_credits - r.credits_ahead)))) AS at_cap
+           FROM ranked r
+        ), totals AS (
+         SELECT f.*,
+            count(*) FILTER (WHERE (f.credits = f.team_top_credits)) OVER team AS top_bidders,
+            count(*) FILTER (WHERE f.at_cap) OVER team AS capped_owners,
+            COALESCE(sum(f.credits) FILTER (WHERE f.at_cap) OVER team, (0)::double precision) AS capped_credits
+           FROM flagged f
+          WINDOW team AS (PARTITION BY f.pool_id, f.team_id)
+        )
+ SELECT investment_id,
+    portfolio_id,
+    pool_id,
+    team_id,
+    credits,
+    team_total_credits,
+        CASE
+            WHEN (team_total_credits <= (0)::double precision) THEN (0)::double precision
+            WHEN (ownership_mode = 'winner_take_all') THEN
+            CASE
+                WHEN (credits = team_top_credits) THEN ((1)::double precision / (top_bidders)::double precision)
+                ELSE (0)::double precision
+            END
+            WHEN (ownership_mode = 'capped_share') THEN
+            CASE
+                WHEN at_cap THEN cap
+                ELSE ((((1)::double precision - ((capped_owners)::double precision * cap)) * credits) / (team_total_credits - capped_credits))
+            END
+            ELSE (credits / team_total_credits)
+        END AS share
+   FROM totals;
+
+CREATE OR REPLACE VIEW derived.ownership_details AS
+ WITH portfolio_investments AS (
+         SELECT s.portfolio_id,
+            s.pool_id,
+            s.team_id,
+            s.share,
+            inv.created_at AS investment_created_at,
+            tt.school_id,
+            tt.tournament_id,
+            tt.seed,
+            tt.region,
+            tt.byes,
+            tt.wins,
+            tt.is_eliminated,
+            t.rounds AS tournament_rounds,
+            GREATEST(p.updated_at, inv.updated_at, tt.updated_at, pl.updated_at) AS derived_updated_at
+           FROM (((((derived.investment_shares s
+             JOIN core.investments inv ON ((inv.id = s.investment_id)))
+             JOIN core.portfolios p ON ((p.id = s.portfolio_id)))
+             JOIN core.pools pl ON ((pl.id = s.pool_id)))
+             JOIN core.teams tt ON (((tt.id = s.team_id) AND (tt.deleted_at IS NULL))))
+             JOIN core.tournaments t ON (((t.id = tt.tournament_id) AND (t.deleted_at IS NULL))))
+        )
+ SELECT concat(portfolio_id, '-', team_id) AS id,
+    portfolio_id,
+    team_id,
+    share AS ownership_percentage,
+    (share * (core.pool_returns_for_progress(pool_id, wins, byes))::double precision) AS actual_returns,
+    (share *
+        CASE
+            WHEN (is_eliminated = true) THEN (core.pool_returns_for_progress(pool_id, wins, byes))::double precision
+            ELSE (core.pool_returns_for_progress(pool_id, tournament_rounds, 0))::double precision
+        END) AS expected_returns,
+    investment_created_at AS created_at,
+    derived_updated_at AS updated_at,
+    NULL::timestamp with time zone AS deleted_at
+   FROM portfolio_investments;
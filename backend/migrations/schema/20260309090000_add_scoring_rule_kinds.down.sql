@@ -0,0 +1,69 @@
+-- Rollback: add_scoring_rule_kinds
+-- Created: 2026-03-09 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+ALTER TABLE compute.predicted_team_values DROP COLUMN IF EXISTS expected_upset_margins;
+ALTER TABLE compute.simulated_teams DROP COLUMN IF EXISTS beaten_seeds;
+
+CREATE OR REPLACE VIEW derived.ownership_details AS
+ WITH portfolio_investments AS (
+         SELECT s.portfolio_id,
+            s.pool_id,
+            s.team_id,
+            s.share,
+            inv.created_at AS investment_created_at,
+            tt.school_id,
+            tt.tournament_id,
+            tt.seed,
+            tt.region,
+            tt.byes,
+            tt.wins,
+            tt.is_eliminated,
+            t.rounds AS tournament_rounds,
+            GREATEST(p.updated_at, inv.updated_at, tt.updated_at, pl.updated_at) AS derived_updated_at
+           FROM (((((derived.investment_shares s
+             JOIN core.investments inv ON ((inv.id = s.investment_id)))
+             JOIN core.portfolios p ON ((p.id = s.portfolio_id)))
+             JOIN core.pools pl ON ((pl.id = s.pool_id)))
+             JOIN core.teams tt ON (((tt.id = s.team_id) AND (tt.deleted_at IS NULL))))
+             JOIN core.tournaments t ON (((t.id = tt.tournament_id) AND (t.deleted_at IS NULL))))
+        )
+ SELECT concat(portfolio_id, '-', team_id) AS id,
+    portfolio_id,
+    team_id,
+    share AS ownership_percentage,
+    (share * (core.pool_returns_for_progress(pool_id, wins, byes))::double precision) AS actual_returns,
+    (share *
+        CASE
+            WHEN (is_eliminated = true) THEN (core.pool_returns_for_progress(pool_id, wins, byes))::double precision
+            ELSE (core.pool_returns_for_progress(pool_id, tournament_rounds, 0))::double precision
+        END) AS expected_returns,
+    investment_created_at AS created_at,
+    derived_updated_at AS updated_at,
+    NULL::timestamp with time zone AS deleted_at
+   FROM portfolio_investments;
+
+DROP FUNCTION IF EXISTS core.pool_team_returns(uuid, uuid, integer);
+
+CREATE OR REPLACE FUNCTION core.pool_returns_for_progress(p_pool_id uuid, p_wins integer, p_byes integer DEFAULT 0) RETURNS integer
+    LANGUAGE sql STABLE
+    AS $$
+    SELECT COALESCE(SUM(r.points_awarded), 0)::int
+    FROM core.pool_scoring_rules r
+    WHERE r.pool_id = p_pool_id
+      AND r.deleted_at IS NULL
+      AND r.win_index <= (COALESCE(p_wins, 0) + COALESCE(p_byes, 0));
+$$;
+
+-- Only round rules survive the rollback.
+DELETE FROM core.pool_scoring_rules WHERE kind <> 'round';
+
+ALTER TABLE core.pool_scoring_rules DROP CONSTRAINT uq_core_pool_scoring_rules;
+ALTER TABLE core.pool_scoring_rules
+    ADD CONSTRAINT uq_core_pool_scoring_rules UNIQUE (pool_id, win_index);
+
+ALTER TABLE core.pool_scoring_rules DROP CONSTRAINT IF EXISTS ck_core_pool_scoring_rules_kind;
+ALTER TABLE core.pool_scoring_rules DROP COLUMN IF EXISTS kind;
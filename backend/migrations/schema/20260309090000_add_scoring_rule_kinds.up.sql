@@ -0,0 +1,128 @@
+-- Migration: add_scoring_rule_kinds
+-- Created: 2026-03-09 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Every rule pays on the win at win_index; its kind decides how much:
+--   round            points_awarded
+--   seed_multiplier  points_awarded * the winner's seed
+--   upset_bonus      points_awarded * (winner seed - beaten seed), when positive
+--   champion_bonus   points_awarded, only when win_index is the title win
+--                    (the tournament's rounds)
+ALTER TABLE core.pool_scoring_rules
+    ADD COLUMN kind TEXT NOT NULL DEFAULT 'round';
+
+ALTER TABLE core.pool_scoring_rules
+    ADD CONSTRAINT ck_core_pool_scoring_rules_kind
+    CHECK (kind IN ('round', 'seed_multiplier', 'upset_bonus', 'champion_bonus'));
+
+ALTER TABLE core.pool_scoring_rules DROP CONSTRAINT uq_core_pool_scoring_rules;
+ALTER TABLE core.pool_scoring_rules
+    ADD CONSTRAINT uq_core_pool_scoring_rules UNIQUE (pool_id, kind, win_index);
+
+-- Progress alone only prices the rules that pay every team the same.
+CREATE OR REPLACE FUNCTION core.pool_returns_for_progress(p_pool_id uuid, p_wins integer, p_byes integer DEFAULT 0) RETURNS integer
+    LANGUAGE sql STABLE
+    AS $$
+    SELECT COALESCE(SUM(r.points_awarded), 0)::int
+    FROM core.pool_scoring_rules r
+    JOIN core.pools pl ON pl.id = r.pool_id
+    JOIN core.tournaments t ON t.id = pl.tournament_id
+    WHERE r.pool_id = p_pool_id
+      AND r.deleted_at IS NULL
+      AND (r.kind = 'round' OR (r.kind = 'champion_bonus' AND r.win_index = t.rounds))
+      AND r.win_index <= (COALESCE(p_wins, 0) + COALESCE(p_byes, 0));
+$$;
+
+-- A team's points in a pool up to p_progress under every rule kind. Byes take
+-- the first win indexes; the team's recorded wins follow in the order they
+-- were decided, and each pays an upset bonus against the seed it beat.
+CREATE FUNCTION core.pool_team_returns(p_pool_id uuid, p_team_id uuid, p_progress integer) RETURNS integer
+    LANGUAGE sql STABLE
+    AS $$
+    WITH team AS (
+        SELECT t.seed, COALESCE(t.byes, 0) AS byes
+        FROM core.teams t
+        WHERE t.id = p_team_id
+    ), team_wins AS (
+        SELECT team.byes + row_number() OVER (ORDER BY gr.decided_at, gr.game_id) AS win_index,
+            l.seed AS beaten_seed
+        FROM core.game_results gr
+        JOIN core.teams l ON l.id = gr.loser_team_id
+        CROSS JOIN team
+        WHERE gr.winner_team_id = p_team_id
+          AND gr.deleted_at IS NULL
+    )
+    SELECT (
+        COALESCE((
+            SELECT SUM(CASE WHEN r.kind = 'seed_multiplier' THEN r.points_awarded * team.seed ELSE r.points_awarded END)
+            FROM core.pool_scoring_rules r
+            JOIN core.pools pl ON pl.id = r.pool_id
+            JOIN core.tournaments t ON t.id = pl.tournament_id
+            CROSS JOIN team
+            WHERE r.pool_id = p_pool_id
+              AND r.deleted_at IS NULL
+              AND (r.kind IN ('round', 'seed_multiplier') OR (r.kind = 'champion_bonus' AND r.win_index = t.rounds))
+              AND r.win_index <= COALESCE(p_progress, 0)
+        ), 0)
+        + COALESCE((
+            SELECT SUM(r.points_awarded * GREATEST(team.seed - w.beaten_seed, 0))
+            FROM team_wins w
+            JOIN core.pool_scoring_rules r ON r.win_index = w.win_index
+            CROSS JOIN team
+            WHERE r.pool_id = p_pool_id
+              AND r.deleted_at IS NULL
+              AND r.kind = 'upset_bonus'
+              AND w.win_index <= COALESCE(p_progress, 0)
+        ), 0)
+    )::int;
+$$;
+
+CREATE OR REPLACE VIEW derived.ownership_details AS
+ WITH portfolio_investments AS (
+         SELECT s.portfolio_id,
+            s.pool_id,
+            s.team_id,
+            s.share,
+            inv.created_at AS investment_created_at,
+            tt.school_id,
+            tt.tournament_id,
+            tt.seed,
+            tt.region,
+            tt.byes,
+            tt.wins,
+            tt.is_eliminated,
+            t.rounds AS tournament_rounds,
+            GREATEST(p.updated_at, inv.updated_at, tt.updated_at, pl.updated_at) AS derived_updated_at
+           FROM (((((derived.investment_shares s
+             JOIN core.investments inv ON ((inv.id = s.investment_id)))
+             JOIN core.portfolios p ON ((p.id = s.portfolio_id)))
+             JOIN core.pools pl ON ((pl.id = s.pool_id)))
+             JOIN core.teams tt ON (((tt.id = s.team_id) AND (tt.deleted_at IS NULL))))
+             JOIN core.tournaments t ON (((t.id = tt.tournament_id) AND (t.deleted_at IS NULL))))
+        )
+ SELECT concat(portfolio_id, '-', team_id) AS id,
+    portfolio_id,
+    team_id,
+    share AS ownership_percentage,
+    (share * (core.pool_team_returns(pool_id, team_id, (COALESCE(wins, 0) + COALESCE(byes, 0))))::double precision) AS actual_returns,
+    (share *
+        CASE
+            WHEN (is_eliminated = true) THEN (core.pool_team_returns(pool_id, team_id, (COALESCE(wins, 0) + COALESCE(byes, 0))))::double precision
+            ELSE (core.pool_team_returns(pool_id, team_id, tournament_rounds))::double precision
+        END) AS expected_returns,
+    investment_created_at AS created_at,
+    derived_updated_at AS updated_at,
+    NULL::timestamp with time zone AS deleted_at
+   FROM portfolio_investments;
+
+-- Simulations record whom each team beat so upset bonuses can be scored.
+ALTER TABLE compute.simulated_teams
+    ADD COLUMN beaten_seeds INTEGER[];
+
+-- Predictions record, per round, the expected seed lines gained by winning
+-- that round as an underdog, so pools can price upset bonuses.
+ALTER TABLE compute.predicted_team_values
+    ADD COLUMN expected_upset_margins DOUBLE PRECISION[];
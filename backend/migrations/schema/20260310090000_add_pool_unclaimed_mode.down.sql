@@ -0,0 +1,47 @@
+-- Rollback: add_pool_unclaimed_mode
+-- Created: 2026-03-10 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+CREATE OR REPLACE VIEW derived.ownership_details AS
+ WITH portfolio_investments AS (
+         SELECT s.portfolio_id,
+            s.pool_id,
+            s.team_id,
+            s.share,
+            inv.created_at AS investment_created_at,
+            tt.school_id,
+            tt.tournament_id,
+            tt.seed,
+            tt.region,
+            tt.byes,
+            tt.wins,
+            tt.is_eliminated,
+            t.rounds AS tournament_rounds,
+            GREATEST(p.updated_at, inv.updated_at, tt.updated_at, pl.updated_at) AS derived_updated_at
+           FROM (((((derived.investment_shares s
+             JOIN core.investments inv ON ((inv.id = s.investment_id)))
+             JOIN core.portfolios p ON ((p.id = s.portfolio_id)))
+             JOIN core.pools pl ON ((pl.id = s.pool_id)))
+             JOIN core.teams tt ON (((tt.id = s.team_id) AND (tt.deleted_at IS NULL))))
+             JOIN core.tournaments t ON (((t.id = tt.tournament_id) AND (t.deleted_at IS NULL))))
+        )
+ SELECT concat(portfolio_id, '-', team_id) AS id,
+    portfolio_id,
+    team_id,
+    share AS ownership_percentage,
+    (share * (core.pool_team_returns(pool_id, team_id, (COALESCE(wins, 0) + COALESCE(byes, 0))))::double precision) AS actual_returns,
+    (share *
+        CASE
+            WHEN (is_eliminated = true) THEN (core.pool_team_returns(pool_id, team_id, (COALESCE(wins, 0) + COALESCE(byes, 0))))::double precision
+            ELSE (core.pool_team_returns(pool_id, team_id, tournament_rounds))::double precision
+        END) AS expected_returns,
+    investment_created_at AS created_at,
+    derived_updated_at AS updated_at,
+    NULL::timestamp with time zone AS deleted_at
+   FROM portfolio_investments;
+
+ALTER TABLE core.pools DROP CONSTRAINT IF EXISTS ck_core_pools_unclaimed_mode;
+ALTER TABLE core.pools DROP COLUMN IF EXISTS unclaimed_mode;
@@ -0,0 +1,120 @@
+-- Migration: add_pool_unclaimed_mode
+-- Created: 2026-03-10 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- What happens to the points of a team nobody bid on:
+--   forfeit    the points are not awarded to anyone
+--   pro_rata   every portfolio gets a share of the team in proportion to the
+--              credits it invested across the pool
+--   leftovers  the team is sold in a post-deadline auction round; until it
+--              sells, its points are forfeit
+ALTER TABLE core.pools
+    ADD COLUMN unclaimed_mode TEXT NOT NULL DEFAULT 'forfeit';
+
+ALTER TABLE core.pools
+    ADD CONSTRAINT ck_core_pools_unclaimed_mode
+    CHECK (unclaimed_mode IN ('forfeit', 'pro_rata', 'leftovers'));
+
+-- Pro-rata pools give every portfolio a share of each unclaimed team, so
+-- ownership details gain a row per portfolio and unclaimed team. The
+-- ownership package applies the same split in Go; keep the two in step.
+CREATE OR REPLACE VIEW derived.ownership_details AS
+ WITH portfolio_investments AS (
+         SELECT s.portfolio_id,
+            s.pool_id,
+            s.team_id,
+            s.share,
+            inv.created_at AS investment_created_at,
+            tt.school_id,
+            tt.tournament_id,
+            tt.seed,
+            tt.region,
+            tt.byes,
+            tt.wins,
+            tt.is_eliminated,
+            t.rounds AS tournament_rounds,
+            GREATEST(p.updated_at, inv.updated_at, tt.updated_at, pl.updated_at) AS derived_updated_at
+           FROM (((((derived.investment_shares s
+             JOIN core.investments inv ON ((inv.id = s.investment_id)))
+             JOIN core.portfolios p ON ((p.id = s.portfolio_id)))
+             JOIN core.pools pl ON ((pl.id = s.pool_id)))
+             JOIN core.teams tt ON (((tt.id = s.team_id) AND (tt.deleted_at IS NULL))))
+             JOIN core.tournaments t ON (((t.id = tt.tournament_id) AND (t.deleted_at IS NULL))))
+        ), portfolio_credits AS (
+         SELECT p.id AS portfolio_id,
+            p.pool_id,
+            p.created_at,
+            p.updated_at,
+            (sum(inv.credits))::double precision AS credits,
+            sum((sum(inv.credits))::double precision) OVER (PARTITION BY p.pool_id) AS pool_total_credits
+           FROM ((core.portfolios p
+             JOIN core.pools pl ON (((pl.id = p.pool_id) AND (pl.deleted_at IS NULL) AND (pl.unclaimed_mode = 'pro_rata'))))
+             JOIN core.investments inv ON (((inv.portfolio_id = p.id) AND (inv.deleted_at IS NULL))))
+          WHERE (p.deleted_at IS NULL)
+          GROUP BY p.id, p.pool_id, p.created_at, p.updated_at
+         HAVING (sum(inv.credits) > 0)
+        ), unclaimed_shares AS (
+         SELECT pc.portfolio_id,
+            pc.pool_id,
+            tt.id AS team_id,
+            (pc.credits / pc.pool_total_credits) AS share,
+            pc.created_at AS investment_created_at,
+            tt.school_id,
+            tt.tournament_id,
+            tt.seed,
+            tt.region,
+            tt.byes,
+            tt.wins,
+            tt.is_eliminated,
+            t.rounds AS tournament_rounds,
+            GREATEST(pc.updated_at, tt.updated_at, pl.updated_at) AS derived_updated_at
+           FROM (((portfolio_credits pc
+             JOIN core.pools pl ON ((pl.id = pc.pool_id)))
+             JOIN core.teams tt ON (((tt.tournament_id = pl.tournament_id) AND (tt.deleted_at IS NULL))))
+             JOIN core.tournaments t ON (((t.id = tt.tournament_id) AND (t.deleted_at IS NULL))))
+          WHERE (NOT (EXISTS ( SELECT 1
+                   FROM (core.investments inv
+                     JOIN core.portfolios p ON (((p.id = inv.portfolio_id) AND (p.deleted_at IS NULL))))
+                  WHERE ((p.pool_id = pc.pool_id) AND (inv.team_id = tt.id) AND (inv.deleted_at IS NULL) AND (inv.credits > 0)))))
+        ), owned AS (
+         SELECT portfolio_investments.portfolio_id,
+            portfolio_investments.pool_id,
+            portfolio_investments.team_id,
+            portfolio_investments.share,
+            portfolio_investments.investment_created_at,
+            portfolio_investments.byes,
+            portfolio_investments.wins,
+            portfolio_investments.is_eliminated,
+            portfolio_investments.tournament_rounds,
+            portfolio_investments.derived_updated_at
+           FROM portfolio_investments
+        UNION ALL
+         SELECT unclaimed_shares.portfolio_id,
+            unclaimed_shares.pool_id,
+            unclaimed_shares.team_id,
+            unclaimed_shares.share,
+            unclaimed_shares.investment_created_at,
+            unclaimed_shares.byes,
+            unclaimed_shares.wins,
+            unclaimed_shares.is_eliminated,
+            unclaimed_shares.tournament_rounds,
+            unclaimed_shares.derived_updated_at
+           FROM unclaimed_shares
+        )
+ SELECT concat(portfolio_id, '-', team_id) AS id,
+    portfolio_id,
+    team_id,
+    share AS ownership_percentage,
+    (share * (core.pool_team_returns(pool_id, team_id, (COALESCE(wins, 0) + COALESCE(byes, 0))))::double precision) AS actual_returns,
+    (share *
+        CASE
+            WHEN (is_eliminated = true) THEN (core.pool_team_returns(pool_id, team_id, (COALESCE(wins, 0) + COALESCE(byes, 0))))::double precision
+            ELSE (core.pool_team_returns(pool_id, team_id, tournament_rounds))::double precision
+        END) AS expected_returns,
+    investment_created_at AS created_at,
+    derived_updated_at AS updated_at,
+    NULL::timestamp with time zone AS deleted_at
+   FROM owned;
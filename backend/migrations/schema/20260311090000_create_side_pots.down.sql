@@ -0,0 +1,9 @@
+-- Rollback: create_side_pots
+-- Created: 2026-03-11 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+DROP TABLE IF EXISTS core.side_pot_payouts;
+DROP TABLE IF EXISTS core.side_pots;
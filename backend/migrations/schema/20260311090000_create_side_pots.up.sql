@@ -0,0 +1,73 @@
+-- Migration: create_side_pots
+-- Created: 2026-03-11 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Named payout pools run alongside a pool's main payouts. Each ranks the
+-- pool's portfolios by its own metric:
+--   total_points          the pool's standings
+--   points_through_round  points counting wins up to through_win_index only,
+--                         e.g. most points after the first weekend
+--   best_team_roi         the best points per credit earned on one team
+--   fewest_points         the pool's standings turned upside down
+CREATE TABLE IF NOT EXISTS core.side_pots (
+    id UUID NOT NULL DEFAULT public.uuid_generate_v4() PRIMARY KEY,
+    pool_id UUID NOT NULL,
+    name TEXT NOT NULL,
+    metric TEXT NOT NULL,
+    through_win_index INTEGER,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT ck_core_side_pots_name CHECK (btrim(name) <> ''),
+    CONSTRAINT ck_core_side_pots_metric CHECK (metric IN ('total_points', 'points_through_round', 'best_team_roi', 'fewest_points')),
+    CONSTRAINT ck_core_side_pots_through_win_index CHECK (
+        (metric = 'points_through_round' AND through_win_index >= 1)
+        OR (metric <> 'points_through_round' AND through_win_index IS NULL)
+    )
+);
+
+CREATE INDEX IF NOT EXISTS idx_core_side_pots_pool_id
+    ON core.side_pots (pool_id)
+    WHERE deleted_at IS NULL;
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_core_side_pots_pool_name
+    ON core.side_pots (pool_id, name)
+    WHERE deleted_at IS NULL;
+
+-- A side pot's position -> amount table, like core.payouts for the main pot.
+CREATE TABLE IF NOT EXISTS core.side_pot_payouts (
+    id UUID NOT NULL DEFAULT public.uuid_generate_v4() PRIMARY KEY,
+    side_pot_id UUID NOT NULL,
+    position INTEGER NOT NULL,
+    amount_cents INTEGER NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT ck_core_side_pot_payouts_position CHECK (position >= 1),
+    CONSTRAINT ck_core_side_pot_payouts_amount_cents CHECK (amount_cents >= 0)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_core_side_pot_payouts_pot_position
+    ON core.side_pot_payouts (side_pot_id, position)
+    WHERE deleted_at IS NULL;
+
+-- updated_at triggers
+CREATE TRIGGER trg_core_side_pots_updated_at
+    BEFORE UPDATE ON core.side_pots
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+CREATE TRIGGER trg_core_side_pot_payouts_updated_at
+    BEFORE UPDATE ON core.side_pot_payouts
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+-- Foreign key constraints
+ALTER TABLE core.side_pots
+    ADD CONSTRAINT side_pots_pool_id_fkey
+    FOREIGN KEY (pool_id) REFERENCES core.pools(id) ON DELETE CASCADE;
+
+ALTER TABLE core.side_pot_payouts
+    ADD CONSTRAINT side_pot_payouts_side_pot_id_fkey
+    FOREIGN KEY (side_pot_id) REFERENCES core.side_pots(id) ON DELETE CASCADE;
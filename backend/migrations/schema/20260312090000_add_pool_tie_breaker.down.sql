@@ -0,0 +1,9 @@
+-- Rollback: add_pool_tie_breaker
+-- Created: 2026-03-12 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+ALTER TABLE core.pools DROP CONSTRAINT IF EXISTS ck_core_pools_tie_breaker;
+ALTER TABLE core.pools DROP COLUMN IF EXISTS tie_breaker;
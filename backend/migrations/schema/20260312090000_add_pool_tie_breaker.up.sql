@@ -0,0 +1,20 @@
+-- Migration: add_pool_tie_breaker
+-- Created: 2026-03-12 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- How portfolios tied on points at a paying position are resolved:
+--   split                the tied positions' payouts are shared evenly
+--   champion_points      most points earned from the champion ranks first
+--   fewest_credits       fewest credits spent ranks first
+--   earliest_submission  the portfolio whose bids were finalized first ranks
+--                        first
+-- Portfolios still tied after the tie-breaker split evenly.
+ALTER TABLE core.pools
+    ADD COLUMN tie_breaker TEXT NOT NULL DEFAULT 'split';
+
+ALTER TABLE core.pools
+    ADD CONSTRAINT ck_core_pools_tie_breaker
+    CHECK (tie_breaker IN ('split', 'champion_points', 'fewest_credits', 'earliest_submission'));
@@ -0,0 +1,9 @@
+-- Rollback: create_pool_ledger
+-- Created: 2026-03-13 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+DROP TABLE IF EXISTS core.pool_settlements;
+DROP TABLE IF EXISTS core.pool_ledger_entries;
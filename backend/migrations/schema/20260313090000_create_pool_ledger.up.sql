@@ -0,0 +1,79 @@
+-- Migration: create_pool_ledger
+-- Created: 2026-03-13 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Money that actually changed hands for a portfolio:
+--   payment_received  entry fee collected from the portfolio's owner
+--   payout_sent       winnings paid out to the portfolio's owner
+-- Entries are voided by soft-deleting them, never edited.
+CREATE TABLE IF NOT EXISTS core.pool_ledger_entries (
+    id UUID NOT NULL DEFAULT public.uuid_generate_v4() PRIMARY KEY,
+    pool_id UUID NOT NULL,
+    portfolio_id UUID NOT NULL,
+    kind TEXT NOT NULL,
+    amount_cents INTEGER NOT NULL,
+    note TEXT NOT NULL DEFAULT '',
+    recorded_by UUID NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT ck_core_pool_ledger_entries_kind CHECK (kind IN ('payment_received', 'payout_sent')),
+    CONSTRAINT ck_core_pool_ledger_entries_amount_cents CHECK (amount_cents > 0)
+);
+
+CREATE INDEX IF NOT EXISTS idx_core_pool_ledger_entries_pool_id
+    ON core.pool_ledger_entries (pool_id)
+    WHERE deleted_at IS NULL;
+
+CREATE INDEX IF NOT EXISTS idx_core_pool_ledger_entries_portfolio_id
+    ON core.pool_ledger_entries (portfolio_id)
+    WHERE deleted_at IS NULL;
+
+-- A settled pool's books are closed: no more ledger entries are recorded or
+-- voided until the settlement is reopened.
+CREATE TABLE IF NOT EXISTS core.pool_settlements (
+    id UUID NOT NULL DEFAULT public.uuid_generate_v4() PRIMARY KEY,
+    pool_id UUID NOT NULL,
+    settled_by UUID NOT NULL,
+    settled_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_core_pool_settlements_pool_id
+    ON core.pool_settlements (pool_id)
+    WHERE deleted_at IS NULL;
+
+-- updated_at triggers
+CREATE TRIGGER trg_core_pool_ledger_entries_updated_at
+    BEFORE UPDATE ON core.pool_ledger_entries
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+CREATE TRIGGER trg_core_pool_settlements_updated_at
+    BEFORE UPDATE ON core.pool_settlements
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+-- Foreign key constraints
+ALTER TABLE core.pool_ledger_entries
+    ADD CONSTRAINT pool_ledger_entries_pool_id_fkey
+    FOREIGN KEY (pool_id) REFERENCES core.pools(id) ON DELETE CASCADE;
+
+ALTER TABLE core.pool_ledger_entries
+    ADD CONSTRAINT pool_ledger_entries_portfolio_id_fkey
+    FOREIGN KEY (portfolio_id) REFERENCES core.portfolios(id) ON DELETE CASCADE;
+
+ALTER TABLE core.pool_ledger_entries
+    ADD CONSTRAINT pool_ledger_entries_recorded_by_fkey
+    FOREIGN KEY (recorded_by) REFERENCES core.users(id);
+
+ALTER TABLE core.pool_settlements
+    ADD CONSTRAINT pool_settlements_pool_id_fkey
+    FOREIGN KEY (pool_id) REFERENCES core.pools(id) ON DELETE CASCADE;
+
+ALTER TABLE core.pool_settlements
+    ADD CONSTRAINT pool_settlements_settled_by_fkey
+    FOREIGN KEY (settled_by) REFERENCES core.users(id);
@@ -0,0 +1,15 @@
+-- Rollback: add_pool_max_portfolios_per_user
+-- Created: 2026-03-14 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Restoring the unique index fails while any user holds more than one live
+-- portfolio in a pool; delete the extra portfolios first.
+DROP INDEX IF EXISTS core.idx_core_portfolios_pool_user;
+
+CREATE UNIQUE INDEX uq_portfolios_user_pool ON core.portfolios USING btree (user_id, pool_id) WHERE ((user_id IS NOT NULL) AND (deleted_at IS NULL));
+
+ALTER TABLE core.pools DROP CONSTRAINT IF EXISTS ck_core_pools_max_portfolios_per_user;
+ALTER TABLE core.pools DROP COLUMN IF EXISTS max_portfolios_per_user;
@@ -0,0 +1,23 @@
+-- Migration: add_pool_max_portfolios_per_user
+-- Created: 2026-03-14 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- How many live portfolios one user may hold in a pool. Pools that let
+-- players buy a second entry at double the fee raise it above 1. The limit
+-- is enforced when a portfolio is created, under a lock on the pool row,
+-- so the one-portfolio-per-user unique index is no longer needed.
+ALTER TABLE core.pools
+    ADD COLUMN max_portfolios_per_user INTEGER NOT NULL DEFAULT 1;
+
+ALTER TABLE core.pools
+    ADD CONSTRAINT ck_core_pools_max_portfolios_per_user
+    CHECK (max_portfolios_per_user BETWEEN 1 AND 10);
+
+DROP INDEX IF EXISTS core.uq_portfolios_user_pool;
+
+CREATE INDEX IF NOT EXISTS idx_core_portfolios_pool_user
+    ON core.portfolios (pool_id, user_id)
+    WHERE user_id IS NOT NULL AND deleted_at IS NULL;
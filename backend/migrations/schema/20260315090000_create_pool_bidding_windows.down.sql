@@ -0,0 +1,8 @@
+-- Rollback: create_pool_bidding_windows
+-- Created: 2026-03-15 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+DROP TABLE IF EXISTS core.pool_bidding_windows;
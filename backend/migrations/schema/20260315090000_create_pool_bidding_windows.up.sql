@@ -0,0 +1,35 @@
+-- Migration: create_pool_bidding_windows
+-- Created: 2026-03-15 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Spans during which a pool takes bids, [opens_at, closes_at). A pool with
+-- no windows takes bids until its tournament starts; one with windows takes
+-- them only inside a window, e.g. closing the night before the First Four
+-- and reopening once it is over.
+CREATE TABLE IF NOT EXISTS core.pool_bidding_windows (
+    id UUID NOT NULL DEFAULT public.uuid_generate_v4() PRIMARY KEY,
+    pool_id UUID NOT NULL,
+    opens_at TIMESTAMPTZ NOT NULL,
+    closes_at TIMESTAMPTZ NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ,
+    CONSTRAINT ck_core_pool_bidding_windows_span CHECK (closes_at > opens_at)
+);
+
+CREATE INDEX IF NOT EXISTS idx_core_pool_bidding_windows_pool_id
+    ON core.pool_bidding_windows (pool_id, opens_at)
+    WHERE deleted_at IS NULL;
+
+-- updated_at trigger
+CREATE TRIGGER trg_core_pool_bidding_windows_updated_at
+    BEFORE UPDATE ON core.pool_bidding_windows
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+-- Foreign key constraints
+ALTER TABLE core.pool_bidding_windows
+    ADD CONSTRAINT pool_bidding_windows_pool_id_fkey
+    FOREIGN KEY (pool_id) REFERENCES core.pools(id) ON DELETE CASCADE;
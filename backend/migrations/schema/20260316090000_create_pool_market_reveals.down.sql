@@ -0,0 +1,11 @@
+-- Rollback: create_pool_market_reveals
+-- Created: 2026-03-16 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+DROP TABLE IF EXISTS core.pool_market_reveals;
+
+ALTER TABLE core.pools
+    DROP COLUMN IF EXISTS reveal_at;
@@ -0,0 +1,46 @@
+-- Migration: create_pool_market_reveals
+-- Created: 2026-03-16 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- Keeps bids sealed after bidding closes until this time. NULL reveals them
+-- as soon as bidding closes.
+ALTER TABLE core.pools
+    ADD COLUMN IF NOT EXISTS reveal_at TIMESTAMPTZ;
+
+-- The market summary frozen when a pool's bids are revealed: credits,
+-- market share and rank per team against the latest predictions. Rows are
+-- never updated; teams holds one JSON object per team.
+CREATE TABLE IF NOT EXISTS core.pool_market_reveals (
+    id UUID NOT NULL DEFAULT public.uuid_generate_v4() PRIMARY KEY,
+    pool_id UUID NOT NULL,
+    revealed_at TIMESTAMPTZ NOT NULL,
+    revealed_by UUID,
+    prediction_batch_id UUID,
+    total_credits INTEGER NOT NULL,
+    portfolio_count INTEGER NOT NULL,
+    teams JSONB NOT NULL DEFAULT '[]'::jsonb,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    deleted_at TIMESTAMPTZ
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_core_pool_market_reveals_pool_id
+    ON core.pool_market_reveals (pool_id)
+    WHERE deleted_at IS NULL;
+
+-- updated_at trigger
+CREATE TRIGGER trg_core_pool_market_reveals_updated_at
+    BEFORE UPDATE ON core.pool_market_reveals
+    FOR EACH ROW EXECUTE FUNCTION core.set_updated_at();
+
+-- Foreign key constraints
+ALTER TABLE core.pool_market_reveals
+    ADD CONSTRAINT pool_market_reveals_pool_id_fkey
+    FOREIGN KEY (pool_id) REFERENCES core.pools(id) ON DELETE CASCADE;
+
+ALTER TABLE core.pool_market_reveals
+    ADD CONSTRAINT pool_market_reveals_revealed_by_fkey
+    FOREIGN KEY (revealed_by) REFERENCES core.users(id);
@@ -0,0 +1,16 @@
+-- Rollback: add_packed_simulation_outcomes
+-- Created: 2026-03-17 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+DROP TABLE IF EXISTS compute.simulated_team_round_reach;
+DROP TABLE IF EXISTS compute.simulated_outcome_chunks;
+
+ALTER TABLE compute.simulated_tournaments
+    DROP CONSTRAINT IF EXISTS ck_compute_simulated_tournaments_storage;
+
+ALTER TABLE compute.simulated_tournaments
+    DROP COLUMN IF EXISTS outcome_layout,
+    DROP COLUMN IF EXISTS storage;
@@ -0,0 +1,58 @@
+-- Migration: add_packed_simulation_outcomes
+-- Created: 2026-03-17 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- How a batch's simulations are stored: one compute.simulated_teams row per
+-- team per simulation ('rows'), or bit-packed into
+-- compute.simulated_outcome_chunks ('packed'). outcome_layout holds the
+-- compiled bracket the packed bits are decoded against.
+ALTER TABLE compute.simulated_tournaments
+    ADD COLUMN IF NOT EXISTS storage TEXT NOT NULL DEFAULT 'rows',
+    ADD COLUMN IF NOT EXISTS outcome_layout JSONB;
+
+ALTER TABLE compute.simulated_tournaments
+    ADD CONSTRAINT ck_compute_simulated_tournaments_storage
+    CHECK (storage IN ('rows', 'packed'));
+
+-- A run of packed simulations: one bit per game, bytes_per_sim bytes per
+-- simulation, starting at sim_offset within the batch.
+CREATE TABLE IF NOT EXISTS compute.simulated_outcome_chunks (
+    id UUID NOT NULL DEFAULT public.uuid_generate_v4() PRIMARY KEY,
+    simulated_tournament_id UUID NOT NULL,
+    sim_offset INTEGER NOT NULL,
+    n_sims INTEGER NOT NULL,
+    bytes_per_sim INTEGER NOT NULL,
+    outcomes BYTEA NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW(),
+    CONSTRAINT ck_compute_simulated_outcome_chunks_size
+        CHECK (length(outcomes) = n_sims * bytes_per_sim)
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_compute_simulated_outcome_chunks_offset
+    ON compute.simulated_outcome_chunks (simulated_tournament_id, sim_offset);
+
+ALTER TABLE compute.simulated_outcome_chunks
+    ADD CONSTRAINT simulated_outcome_chunks_simulated_tournament_id_fkey
+    FOREIGN KEY (simulated_tournament_id) REFERENCES compute.simulated_tournaments(id) ON DELETE CASCADE;
+
+-- Per-team round-reach histogram folded while a batch simulates:
+-- progress_counts[k + 1] is the number of simulations in which the team's
+-- run ended at k wins plus byes.
+CREATE TABLE IF NOT EXISTS compute.simulated_team_round_reach (
+    id UUID NOT NULL DEFAULT public.uuid_generate_v4() PRIMARY KEY,
+    simulated_tournament_id UUID NOT NULL,
+    tournament_id UUID NOT NULL,
+    team_id UUID NOT NULL,
+    progress_counts INTEGER[] NOT NULL,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT NOW()
+);
+
+CREATE UNIQUE INDEX IF NOT EXISTS uq_compute_simulated_team_round_reach_team
+    ON compute.simulated_team_round_reach (simulated_tournament_id, team_id);
+
+ALTER TABLE compute.simulated_team_round_reach
+    ADD CONSTRAINT simulated_team_round_reach_simulated_tournament_id_fkey
+    FOREIGN KEY (simulated_tournament_id) REFERENCES compute.simulated_tournaments(id) ON DELETE CASCADE;
@@ -0,0 +1,9 @@
+-- Rollback: add_simulation_strength_uncertainty
+-- Created: 2026-03-18 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+ALTER TABLE compute.simulated_tournaments
+    DROP COLUMN IF EXISTS strength_uncertainty;
@@ -0,0 +1,12 @@
+-- Migration: add_simulation_strength_uncertainty
+-- Created: 2026-03-18 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- The per-team strength perturbation a batch was simulated with, e.g.
+-- {"distribution": "normal", "scale": 0.3}. NULL means every game was an
+-- independent draw.
+ALTER TABLE compute.simulated_tournaments
+    ADD COLUMN IF NOT EXISTS strength_uncertainty JSONB;
@@ -0,0 +1,15 @@
+-- Rollback: add_simulation_sampling
+-- Created: 2026-03-19 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+ALTER TABLE compute.simulated_team_round_reach
+    DROP COLUMN IF EXISTS progress_weights;
+
+ALTER TABLE compute.simulated_outcome_chunks
+    DROP COLUMN IF EXISTS weights;
+
+ALTER TABLE compute.simulated_tournaments
+    DROP COLUMN IF EXISTS sampling;
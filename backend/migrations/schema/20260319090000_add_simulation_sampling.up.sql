@@ -0,0 +1,22 @@
+-- Migration: add_simulation_sampling
+-- Created: 2026-03-19 09:00:00 UTC
+
+SET search_path = '';
+SET lock_timeout = '5s';
+SET statement_timeout = '30s';
+
+-- The variance-reduction method a batch was simulated with, e.g.
+-- {"method": "importance_upsets", "upsetTilt": 1.0}. NULL means independent,
+-- equally weighted simulations.
+ALTER TABLE compute.simulated_tournaments
+    ADD COLUMN IF NOT EXISTS sampling JSONB;
+
+-- Each packed simulation's weight under weighted sampling, in simulation
+-- order. NULL means every simulation counts once.
+ALTER TABLE compute.simulated_outcome_chunks
+    ADD COLUMN IF NOT EXISTS weights DOUBLE PRECISION[];
+
+-- progress_weights[k + 1] is the total weight of the simulations counted in
+-- progress_counts[k + 1].
+ALTER TABLE compute.simulated_team_round_reach
+    ADD COLUMN IF NOT EXISTS progress_weights DOUBLE PRECISION[];